@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/term"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// authCheckTimeout bounds the requests made by 'auth status'.
+const authCheckTimeout = 15 * time.Second
+
+// runAuth handles the "auth" subcommand.
+func runAuth(args []string) {
+	if len(args) > 0 {
+		switch args[0] {
+		case "set":
+			runAuthSet(args[1:])
+			return
+		case "status":
+			runAuthStatus()
+			return
+		}
+	}
+	fmt.Fprintln(os.Stderr, "Usage: jira-tui auth set [--email you@company.com]")
+	fmt.Fprintln(os.Stderr, "       jira-tui auth status")
+	exit(2)
+}
+
+// runAuthStatus reports which account the configured credentials belong
+// to, whether Jira accepts them, and which permissions the account has.
+func runAuthStatus() {
+	dir, err := config.DefaultConfigDir()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		exit(1)
+	}
+	cfg, err := config.Load(filepath.Join(dir, "config.yaml"), filepath.Join(dir, "secrets.yaml"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		exit(1)
+	}
+
+	fmt.Printf("Jira:     %s\n", cfg.Jira.BaseURL)
+	if cfg.Jira.BearerAuth() {
+		fmt.Println("Auth:     bearer token")
+	} else {
+		fmt.Printf("Email:    %s\n", cfg.Jira.Email)
+	}
+	fmt.Printf("Token:    from %s\n", cfg.Jira.TokenSource)
+
+	ctx, cancel := context.WithTimeout(context.Background(), authCheckTimeout)
+	defer cancel()
+	client := newClient(cfg)
+	user, err := client.GetMyself(ctx)
+	if err != nil {
+		fmt.Printf("Status:   not authenticated (%v)\n", err)
+		if hint := jira.AuthHint(err); hint != "" {
+			fmt.Printf("\n%s\n", hint)
+		}
+		exit(1)
+	}
+	fmt.Printf("Account:  %s", user.DisplayName)
+	if user.Email != "" && !strings.EqualFold(user.Email, cfg.Jira.Email) {
+		fmt.Printf(" <%s>", user.Email)
+	}
+	fmt.Printf(" (%s)\n", user.AccountID)
+	fmt.Println("Status:   authenticated")
+	if !user.Active {
+		fmt.Println("Warning:  the account is deactivated")
+	}
+
+	perms, err := client.GetMyPermissions(ctx, cfg.Jira.DefaultProject, jira.UsedPermissions)
+	if err != nil {
+		fmt.Printf("\nCould not check permissions: %v\n", err)
+		exit(1)
+	}
+	scope := "any project"
+	if cfg.Jira.DefaultProject != "" {
+		scope = cfg.Jira.DefaultProject
+	}
+	fmt.Printf("\nPermissions in %s:\n", scope)
+	for _, p := range perms {
+		mark := "✓"
+		if !p.HavePermission {
+			mark = "✗"
+		}
+		fmt.Printf("  %s %s\n", mark, p.Name)
+	}
+}
+
+// runAuthSet prompts for an API token and stores it in the OS keychain.
+// The email defaults to the one in secrets.yaml; without one, as with
+// bearer auth, the token is stored for the Jira base URL.
+func runAuthSet(args []string) {
+	fs := flag.NewFlagSet("auth set", flag.ExitOnError)
+	email := fs.String("email", "", "Jira account email (default: from secrets.yaml)")
+	fs.Parse(args)
+
+	account := *email
+	if account == "" {
+		dir, err := config.DefaultConfigDir()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			exit(1)
+		}
+		secretsEmail, _ := config.SecretsEmail(filepath.Join(dir, "secrets.yaml"))
+		baseURL, _ := config.ConfigBaseURL(filepath.Join(dir, "config.yaml"))
+		account = config.KeychainAccount(secretsEmail, baseURL)
+		if account == "" {
+			fmt.Fprintln(os.Stderr, "Error: no email in secrets.yaml and no base_url in config.yaml; pass --email")
+			exit(1)
+		}
+	}
+
+	token, err := readToken(fmt.Sprintf("API token for %s: ", account))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading token: %v\n", err)
+		exit(1)
+	}
+	if token == "" {
+		fmt.Fprintln(os.Stderr, "Error: empty token")
+		exit(1)
+	}
+	if err := config.SetKeychainToken(account, token); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		exit(1)
+	}
+	fmt.Printf("Stored API token for %s in the system keychain.\n", account)
+	fmt.Println("Leave api_token empty in secrets.yaml to use it.")
+}
+
+// readToken reads a token without echo from a terminal, or as a line from
+// piped stdin.
+func readToken(prompt string) (string, error) {
+	if term.IsTerminal(os.Stdin.Fd()) {
+		fmt.Fprint(os.Stderr, prompt)
+		b, err := term.ReadPassword(os.Stdin.Fd())
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(b)), nil
+	}
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
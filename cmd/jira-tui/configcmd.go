@@ -0,0 +1,89 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+)
+
+const configUsage = `Usage:
+  jira-tui config export [-o bundle.yaml]
+  jira-tui config import [--overwrite] bundle.yaml`
+
+// runConfig handles the "config" subcommand.
+func runConfig(args []string) {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, configUsage)
+		exit(2)
+	}
+	dir, err := config.DefaultConfigDir()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		exit(1)
+	}
+	configPath := filepath.Join(dir, "config.yaml")
+
+	switch args[0] {
+	case "export":
+		runConfigExport(configPath, args[1:])
+	case "import":
+		runConfigImport(configPath, args[1:])
+	default:
+		fmt.Fprintln(os.Stderr, configUsage)
+		exit(2)
+	}
+}
+
+// runConfigExport writes config.yaml without credentials as a bundle that
+// can be shared with teammates.
+func runConfigExport(configPath string, args []string) {
+	fs := flag.NewFlagSet("config export", flag.ExitOnError)
+	out := fs.String("o", "", "write the bundle to this file instead of stdout")
+	fs.Parse(args)
+
+	data, err := config.ExportBundle(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		exit(1)
+	}
+	if *out == "" {
+		os.Stdout.Write(data)
+		return
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		exit(1)
+	}
+	fmt.Printf("Exported config to %s\n", *out)
+}
+
+// runConfigImport merges a bundle into config.yaml.
+func runConfigImport(configPath string, args []string) {
+	fs := flag.NewFlagSet("config import", flag.ExitOnError)
+	overwrite := fs.Bool("overwrite", false, "take the bundle's value when a setting or tab differs")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, configUsage)
+		exit(2)
+	}
+
+	result, err := config.ImportBundle(configPath, fs.Arg(0), *overwrite)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		exit(1)
+	}
+	report := func(label string, items []string) {
+		if len(items) > 0 {
+			fmt.Printf("  %s: %s\n", label, strings.Join(items, ", "))
+		}
+	}
+	fmt.Printf("Imported %s into %s (previous version saved as %s.bak)\n",
+		fs.Arg(0), configPath, filepath.Base(configPath))
+	report("added", result.Added)
+	report("updated", result.Updated)
+	report("kept local (use --overwrite to replace)", result.Kept)
+}
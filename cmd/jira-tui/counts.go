@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/tui"
+)
+
+// runCounts handles the "counts" subcommand: it prints how many issues
+// match a query, or a JSON object of counts per group, small enough for a
+// shell prompt or a tmux status line.
+func runCounts(args []string) {
+	const use = "Usage: jira-tui counts --jql '...' [--group-by status|assignee|priority|epic]"
+	fs := flag.NewFlagSet("counts", flag.ExitOnError)
+	jql := fs.String("jql", "", "the query to count")
+	groupBy := fs.String("group-by", "", "count per group: "+strings.Join(config.GroupByFields, ", "))
+	fs.Parse(args)
+	if *jql == "" || fs.NArg() != 0 {
+		usage(use)
+	}
+	if *groupBy != "" && !slices.Contains(config.GroupByFields, *groupBy) {
+		fmt.Fprintf(os.Stderr, "Error: --group-by must be one of %s\n", strings.Join(config.GroupByFields, ", "))
+		exit(2)
+	}
+
+	cfg := cliConfig()
+	ctx, cancel := context.WithTimeout(context.Background(), listTimeout)
+	defer cancel()
+	client := newClient(cfg)
+	if *groupBy == "" {
+		n, err := client.CountIssues(ctx, *jql)
+		if err != nil {
+			fail(err)
+		}
+		fmt.Println(n)
+		return
+	}
+	counts, err := tui.CountIssuesBy(ctx, client, *jql, *groupBy)
+	if err != nil {
+		fail(err)
+	}
+	fmt.Println(groupCountsJSON(counts))
+}
+
+// groupCountsJSON renders counts as a JSON object keyed by group name,
+// keeping the groups in order.
+func groupCountsJSON(counts []tui.GroupCount) string {
+	var b bytes.Buffer
+	b.WriteString("{")
+	for i, c := range counts {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		name, _ := json.Marshal(c.Name)
+		fmt.Fprintf(&b, "%s:%d", name, c.Count)
+	}
+	b.WriteString("}")
+	return b.String()
+}
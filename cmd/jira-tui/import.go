@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+	"github.com/jbeckham/jira-tui/internal/tui"
+)
+
+// runImport handles the "import" subcommand: it creates an issue per row of
+// a CSV file after showing what will be created and asking to go ahead.
+func runImport(args []string) {
+	const use = "Usage: jira-tui import FILE.csv [--project PROJ] [--type Task] [--yes]"
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	project := fs.String("project", "", "project to create the issues in (default: default_project)")
+	issueType := fs.String("type", "Task", "issue type for rows without a type column")
+	yes := fs.Bool("yes", false, "create without asking")
+	var path string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		path, args = args[0], args[1:]
+	}
+	fs.Parse(args)
+	if path == "" && fs.NArg() > 0 {
+		path = fs.Arg(0)
+	} else if fs.NArg() > 0 {
+		usage(use)
+	}
+	if path == "" {
+		usage(use)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		fail(err)
+	}
+	rows, warnings, err := tui.ReadImportCSV(f)
+	f.Close()
+	if err != nil {
+		fail(fmt.Errorf("%s: %w", path, err))
+	}
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+	}
+
+	cfg := cliConfig()
+	if *project == "" {
+		*project = cfg.Jira.DefaultProject
+	}
+	if *project == "" {
+		usage("Error: pass --project or set default_project in the config")
+	}
+	*project = strings.ToUpper(*project)
+
+	client := newClient(cfg)
+	var users []config.CachedUser
+	for _, row := range rows {
+		if row.Assignee != "" {
+			ctx, cancel := context.WithTimeout(context.Background(), issueTimeout)
+			users = cliUsers(ctx, client)
+			cancel()
+			break
+		}
+	}
+	issues, problems := tui.PlanImport(rows, *project, *issueType, users)
+	if len(issues) > 0 {
+		if err := tui.WriteImportPreview(os.Stdout, issues); err != nil {
+			fail(err)
+		}
+	}
+	for _, p := range problems {
+		fmt.Fprintf(os.Stderr, "Skipping %s\n", p)
+	}
+	if len(issues) == 0 {
+		fail(fmt.Errorf("nothing to import from %s", path))
+	}
+
+	if !*yes {
+		fmt.Printf("\nCreate %d issues in %s? [y/N] ", len(issues), *project)
+		answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		if a := strings.ToLower(strings.TrimSpace(answer)); a != "y" && a != "yes" {
+			fmt.Println("Nothing created")
+			return
+		}
+	}
+
+	failed := 0
+	for _, issue := range issues {
+		ctx, cancel := context.WithTimeout(context.Background(), issueTimeout)
+		resp, err := client.CreateIssue(ctx, jira.CreateIssueRequest{Fields: issue.Fields})
+		cancel()
+		if err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "line %d: %v\n", issue.Row.Line, err)
+			continue
+		}
+		fmt.Printf("line %d: %s %s\n", issue.Row.Line, resp.Key, issue.Row.Summary)
+	}
+	fmt.Printf("Created %d of %d issues\n", len(issues)-failed, len(issues))
+	if failed > 0 || len(problems) > 0 {
+		exit(1)
+	}
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+	"github.com/jbeckham/jira-tui/internal/tui"
+)
+
+// issueTimeout bounds the requests made by the single-issue subcommands.
+const issueTimeout = 30 * time.Second
+
+// runView handles the "view" subcommand: it prints an issue's fields,
+// description, and comments.
+func runView(args []string) {
+	fs := flag.NewFlagSet("view", flag.ExitOnError)
+	noComments := fs.Bool("no-comments", false, "leave out the comments")
+	key, rest := issueArgs(fs, args, "Usage: jira-tui view KEY [--no-comments]")
+	if len(rest) != 0 {
+		usage("Usage: jira-tui view KEY [--no-comments]")
+	}
+
+	client, ctx, cancel := issueClient()
+	defer cancel()
+	issue, err := client.GetIssue(ctx, key)
+	if err != nil {
+		fail(err)
+	}
+	var comments []jira.Comment
+	if !*noComments {
+		if comments, err = client.GetComments(ctx, key); err != nil {
+			fail(err)
+		}
+	}
+	if err := tui.WriteIssue(os.Stdout, *issue, comments); err != nil {
+		fail(err)
+	}
+}
+
+// fieldFlags collects repeated --field NAME=VALUE flags.
+type fieldFlags map[string]string
+
+func (f fieldFlags) String() string { return "" }
+
+func (f fieldFlags) Set(s string) error {
+	name, value, ok := strings.Cut(s, "=")
+	if !ok || strings.TrimSpace(name) == "" {
+		return fmt.Errorf("want NAME=VALUE, got %q", s)
+	}
+	f[strings.TrimSpace(name)] = value
+	return nil
+}
+
+// runTransition handles the "transition" subcommand: it moves an issue
+// through the transition, or to the status, with the given name, filling
+// in the fields its screen requires from --field and --comment.
+func runTransition(args []string) {
+	const use = `Usage: jira-tui transition KEY "Transition or status" [--field NAME=VALUE]... [--comment TEXT]`
+	fs := flag.NewFlagSet("transition", flag.ExitOnError)
+	values := fieldFlags{}
+	fs.Var(values, "field", "a field the transition screen asks for, as NAME=VALUE (repeatable)")
+	comment := fs.String("comment", "", "a comment to add with the transition")
+	key, rest := issueArgs(fs, args, use)
+	if len(rest) > 1 {
+		// Flags may follow the transition name too
+		name := rest[0]
+		fs.Parse(rest[1:])
+		rest = append([]string{name}, fs.Args()...)
+	}
+	if len(rest) != 1 {
+		usage(use)
+	}
+
+	client, ctx, cancel := issueClient()
+	defer cancel()
+	transitions, err := client.GetTransitions(ctx, key)
+	if err != nil {
+		fail(err)
+	}
+	t, err := findTransition(transitions, rest[0])
+	if err != nil {
+		fail(fmt.Errorf("%s: %w", key, err))
+	}
+	fields, body, err := transitionInput(t, values, *comment)
+	if err != nil {
+		fail(fmt.Errorf("%s: %w", key, err))
+	}
+	if err := client.TransitionIssueWith(ctx, key, t.ID, fields, body); err != nil {
+		fail(err)
+	}
+	if t.To != nil {
+		fmt.Printf("%s → %s\n", key, t.To.Name)
+	} else {
+		fmt.Printf("%s: %s\n", key, t.Name)
+	}
+}
+
+// runAssign handles the "assign" subcommand: it assigns an issue to the
+// signed-in user, to a user found by name or email, or to nobody.
+func runAssign(args []string) {
+	const use = `Usage: jira-tui assign KEY (--me | --none | "name or email")`
+	fs := flag.NewFlagSet("assign", flag.ExitOnError)
+	me := fs.Bool("me", false, "assign to yourself")
+	none := fs.Bool("none", false, "unassign")
+	key, rest := issueArgs(fs, args, use)
+	// Exactly one of --me, --none, or a user
+	choices := len(rest)
+	for _, set := range []bool{*me, *none} {
+		if set {
+			choices++
+		}
+	}
+	if choices != 1 {
+		usage(use)
+	}
+
+	client, ctx, cancel := issueClient()
+	defer cancel()
+	var accountID, name string
+	switch {
+	case *me:
+		user, err := client.GetMyself(ctx)
+		if err != nil {
+			fail(err)
+		}
+		accountID, name = user.AccountID, user.DisplayName
+	case *none:
+		name = "nobody"
+	default:
+		user, err := findUser(cliUsers(ctx, client), rest[0])
+		if err != nil {
+			fail(err)
+		}
+		accountID, name = user.AccountID, user.DisplayName
+	}
+	if err := client.AssignIssue(ctx, key, accountID); err != nil {
+		fail(err)
+	}
+	fmt.Printf("%s assigned to %s\n", key, name)
+}
+
+// runComment handles the "comment" subcommand: it adds a comment to an
+// issue. A text of "-" reads the comment from stdin.
+func runComment(args []string) {
+	const use = `Usage: jira-tui comment KEY "text"  (or - to read stdin)`
+	fs := flag.NewFlagSet("comment", flag.ExitOnError)
+	key, rest := issueArgs(fs, args, use)
+	if len(rest) != 1 {
+		usage(use)
+	}
+	text := rest[0]
+	if text == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			fail(err)
+		}
+		text = string(data)
+	}
+	if strings.TrimSpace(text) == "" {
+		usage("Error: the comment is empty")
+	}
+
+	client, ctx, cancel := issueClient()
+	defer cancel()
+	if _, err := client.AddComment(ctx, key, tui.CommentBody(text)); err != nil {
+		fail(err)
+	}
+	fmt.Printf("Commented on %s\n", key)
+}
+
+// issueArgs parses a subcommand's flags around its leading issue key, so
+// both "assign KEY --me" and "assign --me KEY" work, and returns the key
+// and the remaining arguments.
+func issueArgs(fs *flag.FlagSet, args []string, use string) (string, []string) {
+	var key string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		key, args = args[0], args[1:]
+	}
+	fs.Parse(args)
+	rest := fs.Args()
+	if key == "" && len(rest) > 0 {
+		key, rest = rest[0], rest[1:]
+	}
+	if key == "" {
+		usage(use)
+	}
+	return strings.ToUpper(key), rest
+}
+
+// issueClient loads the config and returns a client with a context bounded
+// by issueTimeout.
+func issueClient() (*jira.Client, context.Context, context.CancelFunc) {
+	cfg := cliConfig()
+	ctx, cancel := context.WithTimeout(context.Background(), issueTimeout)
+	return newClient(cfg), ctx, cancel
+}
+
+// cliConfig loads the config, exiting if it can't.
+func cliConfig() *config.Config {
+	dir, err := config.DefaultConfigDir()
+	if err != nil {
+		fail(err)
+	}
+	cfg, err := config.Load(filepath.Join(dir, "config.yaml"), filepath.Join(dir, "secrets.yaml"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		exit(1)
+	}
+	return cfg
+}
+
+// cliUsers returns the cached users, fetching and caching them like the TUI
+// does when there is no cache yet.
+func cliUsers(ctx context.Context, client *jira.Client) []config.CachedUser {
+	if users, _ := config.LoadUserCache(); len(users) > 0 {
+		return users
+	}
+	fetched, err := client.SearchAllUsers(ctx)
+	if err != nil {
+		fail(err)
+	}
+	users := make([]config.CachedUser, len(fetched))
+	for i, u := range fetched {
+		users[i] = config.CachedUser{AccountID: u.AccountID, DisplayName: u.DisplayName, Email: u.Email}
+	}
+	// Best effort: the next run fetches again if this fails
+	_ = config.SaveUserCache(users)
+	return users
+}
+
+// fail prints err, with a hint for credential problems, and exits 1.
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	if hint := jira.AuthHint(err); hint != "" {
+		fmt.Fprintln(os.Stderr, hint)
+	}
+	exit(1)
+}
+
+// usage prints a usage line and exits 2.
+func usage(line string) {
+	fmt.Fprintln(os.Stderr, line)
+	exit(2)
+}
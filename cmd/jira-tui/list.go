@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+	"github.com/jbeckham/jira-tui/internal/tui"
+)
+
+// listTimeout bounds the searches made by 'list'.
+const listTimeout = 2 * time.Minute
+
+// runList handles the "list" subcommand: it runs a tab's query and prints
+// the issues without starting the TUI.
+func runList(args []string) {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	tabName := fs.String("tab", "", "tab label or number (default: the first tab)")
+	format := fs.String("format", "table", "output format: "+strings.Join(tui.ListFormats, ", "))
+	fs.Parse(args)
+	if !slices.Contains(tui.ListFormats, *format) {
+		fmt.Fprintf(os.Stderr, "Error: --format must be one of %s\n", strings.Join(tui.ListFormats, ", "))
+		exit(2)
+	}
+
+	dir, err := config.DefaultConfigDir()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		exit(1)
+	}
+	cfg, err := config.Load(filepath.Join(dir, "config.yaml"), filepath.Join(dir, "secrets.yaml"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		exit(1)
+	}
+	tab, ok := findTab(cfg.Tabs, *tabName)
+	if !ok {
+		var labels []string
+		for _, t := range cfg.Tabs {
+			labels = append(labels, strconv.Quote(t.Label))
+		}
+		fmt.Fprintf(os.Stderr, "Error: no tab %q (tabs: %s)\n", *tabName, strings.Join(labels, ", "))
+		exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), listTimeout)
+	defer cancel()
+	client := newClient(cfg)
+	issues, err := tui.TabIssues(ctx, client, tab)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		if hint := jira.AuthHint(err); hint != "" {
+			fmt.Fprintln(os.Stderr, hint)
+		}
+		exit(1)
+	}
+	if err := tui.WriteIssues(os.Stdout, issues, tab.Columns, *format); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		exit(1)
+	}
+}
+
+// findTab picks a tab by label (case-insensitive) or 1-based number; an
+// empty name is the first tab.
+func findTab(tabs []config.TabConfig, name string) (config.TabConfig, bool) {
+	if name == "" && len(tabs) > 0 {
+		return tabs[0], true
+	}
+	for _, t := range tabs {
+		if strings.EqualFold(t.Label, name) {
+			return t, true
+		}
+	}
+	if n, err := strconv.Atoi(name); err == nil && n >= 1 && n <= len(tabs) {
+		return tabs[n-1], true
+	}
+	return config.TabConfig{}, false
+}
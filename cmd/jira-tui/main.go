@@ -1,7 +1,11 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
 	"os"
 	"path/filepath"
 
@@ -12,19 +16,102 @@ import (
 	"github.com/jbeckham/jira-tui/internal/tui"
 )
 
+// readOnly is set by --read-only, which every command accepts: the
+// client refuses any request that would change Jira.
+var readOnly bool
+
+// verbose is set by --verbose. Commands then log requests and the
+// problems they work around to stderr; the TUI mirrors warnings to its
+// status bar instead.
+var verbose bool
+
+// logger is where the client and TUI log; it discards unless --verbose.
+var logger = slog.New(slog.DiscardHandler)
+
+// debug is set by --debug or a JIRA_TUI_DEBUG environment variable other
+// than 0: every request and response is logged, credentials redacted, to
+// debug.log in the config dir.
+var debug bool
+
+// The --debug log, when it could be opened, and its latest lines for the
+// TUI's ctrl+l viewer.
+var (
+	debugLog     *slog.Logger
+	debugFile    *config.DebugLog
+	debugBuffer  *tui.DebugLogBuffer
+	debugLogPath string
+)
+
 func main() {
+	os.Args = append(os.Args[:1], takeGlobalFlags(os.Args[1:])...)
+	if verbose {
+		logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	}
+	if v := os.Getenv("JIRA_TUI_DEBUG"); v != "" && v != "0" {
+		debug = true
+	}
+	if debug {
+		openDebugLog()
+		defer closeDebugLog()
+	}
+
 	// Handle "init" subcommand
 	if len(os.Args) > 1 && os.Args[1] == "init" {
 		runInit()
 		return
 	}
 
+	// Handle "auth" subcommand
+	if len(os.Args) > 1 && os.Args[1] == "auth" {
+		runAuth(os.Args[2:])
+		return
+	}
+
+	// Handle "config" subcommand
+	if len(os.Args) > 1 && os.Args[1] == "config" {
+		runConfig(os.Args[2:])
+		return
+	}
+
+	// Handle "list" subcommand
+	if len(os.Args) > 1 && os.Args[1] == "list" {
+		runList(os.Args[2:])
+		return
+	}
+
+	// Handle the single-issue subcommands
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "view":
+			runView(os.Args[2:])
+			return
+		case "transition":
+			runTransition(os.Args[2:])
+			return
+		case "assign":
+			runAssign(os.Args[2:])
+			return
+		case "comment":
+			runComment(os.Args[2:])
+			return
+		case "import":
+			runImport(os.Args[2:])
+			return
+		case "counts":
+			runCounts(os.Args[2:])
+			return
+		}
+	}
+
+	// Otherwise start the TUI, optionally on an issue: jira-tui PROJ-123
+	startIssue := parseStartIssue(os.Args[1:])
+
 	// Auto-init if .jira-tui directory doesn't exist
 	if !config.DirExists() {
 		dir, err := config.Init()
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error initializing config: %v\n", err)
-			os.Exit(1)
+			exit(1)
 		}
 		fmt.Printf("Created %s/\n\n", dir)
 		fmt.Println("To get started:")
@@ -32,43 +119,210 @@ func main() {
 		fmt.Printf("  2. Edit %s with your email and API token\n", filepath.Join(dir, "secrets.yaml"))
 		fmt.Printf("     (generate a token at https://id.atlassian.com/manage-profile/security/api-tokens)\n")
 		fmt.Println("  3. Run jira-tui again")
-		os.Exit(0)
+		exit(0)
 	}
 
 	configDir, err := config.DefaultConfigDir()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		exit(1)
 	}
 
-	cfg, err := config.Load(
-		filepath.Join(configDir, "config.yaml"),
-		filepath.Join(configDir, "secrets.yaml"),
-	)
+	configPath := filepath.Join(configDir, "config.yaml")
+	cfg, err := config.Load(configPath, filepath.Join(configDir, "secrets.yaml"))
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
-		os.Exit(1)
+		exit(1)
+	}
+
+	// stderr is hidden behind the alt screen, so warnings go to the
+	// status bar once the program runs
+	var statusLog *tui.StatusLogHandler
+	if verbose {
+		statusLog = tui.NewStatusLogHandler(slog.LevelWarn)
+		logger = slog.New(statusLog)
+	}
+
+	client := newClient(cfg)
+
+	tui.SetTheme(cfg.Theme)
+	app := tui.NewApp(client, cfg.Tabs, cfg.Jira.DefaultProject)
+	app.SetCustomFields(cfg.CustomFields)
+	app.SetStartupWarnings(cfg.Warnings)
+	app.SetStatusBar(cfg.StatusBar.Segments)
+	staleAfter, _ := cfg.TabBar.StaleDuration() // validated by Load
+	app.SetTabBar(cfg.TabBar.Badges, staleAfter)
+	app.SetSplitPane(cfg.SplitPane.Enabled, cfg.SplitPane.ListWidth)
+	app.SetConfigPath(configPath)
+	app.SetQuickFilter(cfg.QuickFilter)
+	app.SetTeamGroups(cfg.Jira.TeamGroups)
+	app.SetDescriptionTemplates(cfg.DescriptionTemplates)
+	app.SetDoneTransitions(cfg.DoneTransitions.Prefer, cfg.DoneTransitions.Exclude)
+	app.SetLinkTypes(cfg.LinkTypes.Default, cfg.LinkTypes.Hide)
+	app.SetConfirmEdits(cfg.ConfirmEdits)
+	app.SetReadOnly(readOnly)
+	app.SetLogger(logger)
+	if debugBuffer != nil {
+		app.SetDebugLog(debugBuffer, debugLogPath)
+	}
+	app.SetClipboard(tui.NewClipboard(cfg.Clipboard))
+	app.SetStartIssue(startIssue)
+	// Validate already checked the TTL; a cache that can't be read is
+	// rebuilt from the first refresh.
+	ttl, _ := cfg.Cache.TTLDuration()
+	cache, err := config.LoadTabCache()
+	if err != nil {
+		logger.Warn("loading the tab cache", "err", err)
+	}
+	app.SetTabCache(cache, ttl)
+	p := tea.NewProgram(app, tea.WithAltScreen())
+	if statusLog != nil {
+		statusLog.Attach(p.Send)
+	}
+	m, err := p.Run()
+	// Copies of app share the crash recorder, so this works even when a
+	// panic leaves Run without a final model.
+	if app.Crashed() {
+		path, werr := app.CrashReport()
+		if werr != nil {
+			fmt.Fprintf(os.Stderr, "jira-tui crashed and the crash report could not be written: %v\n", werr)
+		} else {
+			fmt.Fprintf(os.Stderr, "jira-tui crashed. A crash report was written to %s\n", path)
+		}
+		exit(1)
 	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		exit(1)
+	}
+	if app, ok := m.(tui.App); ok {
+		for _, err := range app.FlushErrors() {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+	}
+}
 
-	client := jira.NewClient(cfg.Jira.BaseURL, cfg.Jira.Email, cfg.Jira.APIToken)
+// parseStartIssue reads the TUI's arguments: an optional issue key, bare or
+// with --issue, whose detail view opens at startup.
+func parseStartIssue(args []string) string {
+	const use = "Usage: jira-tui [--read-only] [--verbose] [--debug] [PROJ-123 | --issue PROJ-123]"
+	fs := flag.NewFlagSet("jira-tui", flag.ExitOnError)
+	issue := fs.String("issue", "", "open this issue's detail view at startup")
+	fs.Parse(args)
+	switch {
+	case fs.NArg() > 1, fs.NArg() == 1 && *issue != "":
+		usage(use)
+	case fs.NArg() == 1:
+		*issue = fs.Arg(0)
+	}
+	if *issue != "" && !tui.IsIssueKey(*issue) {
+		fmt.Fprintf(os.Stderr, "Error: %q is not a command or an issue key like PROJ-123\n", *issue)
+		usage(use)
+	}
+	return *issue
+}
 
-	p := tea.NewProgram(tui.NewApp(client, cfg.Tabs, cfg.Jira.DefaultProject), tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
+// takeGlobalFlags removes the flags every command accepts from args,
+// setting what they turn on.
+func takeGlobalFlags(args []string) []string {
+	var rest []string
+	for _, arg := range args {
+		switch arg {
+		case "--read-only", "-read-only":
+			readOnly = true
+			continue
+		case "--verbose", "-verbose":
+			verbose = true
+			continue
+		case "--debug", "-debug":
+			debug = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	return rest
+}
+
+// openDebugLog starts the --debug log. Without a config dir yet there is
+// nothing to debug, and creating one would skip the first-run setup.
+func openDebugLog() {
+	if !config.DirExists() {
+		return
+	}
+	path, err := config.DebugLogPath()
+	if err == nil {
+		if debugFile, err = config.OpenDebugLog(path); err == nil {
+			debugBuffer = tui.NewDebugLogBuffer()
+			debugLogPath = path
+			debugLog = slog.New(slog.NewTextHandler(io.MultiWriter(debugFile, debugBuffer), &slog.HandlerOptions{Level: slog.LevelDebug}))
+			return
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Warning: --debug: %v\n", err)
+}
+
+// closeDebugLog closes the --debug log, if it was opened.
+func closeDebugLog() {
+	if debugFile == nil {
+		return
+	}
+	if err := debugFile.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: --debug: closing the log: %v\n", err)
+	}
+	debugFile = nil
+}
+
+// exit closes the --debug log, which deferred calls don't get to do past
+// os.Exit, and exits with code.
+func exit(code int) {
+	closeDebugLog()
+	os.Exit(code)
+}
+
+// newClient returns a Jira client for cfg's instance, credentials, and
+// request timeouts, refusing writes under --read-only.
+func newClient(cfg *config.Config) *jira.Client {
+	search, mutation, _ := cfg.Timeouts.Durations() // validated by Load
+	opts := []jira.ClientOption{
+		jira.WithTimeouts(jira.Timeouts{Search: search, Mutation: mutation}),
+		jira.WithLogger(logger),
+		jira.WithMaxConcurrent(cfg.Jira.MaxConcurrentRequests),
+	}
+	if debugLog != nil {
+		opts = append(opts, jira.WithDebugLog(debugLog))
+	}
+	if readOnly {
+		opts = append(opts, jira.WithReadOnly())
+	}
+	if cfg.Jira.BearerAuth() {
+		opts = append(opts, jira.WithBearerAuth())
+	}
+	transport, err := jira.NewTransport(jira.TransportConfig{
+		Proxy:              cfg.Jira.Proxy,
+		CABundle:           cfg.Jira.CABundle,
+		InsecureSkipVerify: cfg.Jira.InsecureSkipVerify,
+	})
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		exit(1)
+	}
+	if cfg.Jira.InsecureSkipVerify {
+		fmt.Fprintf(os.Stderr, "WARNING: %s\n", config.InsecureWarning)
 	}
+	opts = append(opts, jira.WithHTTPClient(&http.Client{Transport: transport}))
+	return jira.NewClient(cfg.Jira.BaseURL, cfg.Jira.Email, cfg.Jira.APIToken, opts...)
 }
 
 func runInit() {
 	if config.DirExists() {
 		dir, _ := config.DefaultConfigDir()
 		fmt.Printf("%s/ already exists\n", dir)
-		os.Exit(0)
+		exit(0)
 	}
 	dir, err := config.Init()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		exit(1)
 	}
 	fmt.Printf("Created %s/\n", dir)
 	fmt.Printf("  config.yaml  — Jira URL, tabs, columns\n")
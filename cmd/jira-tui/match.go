@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+	"github.com/jbeckham/jira-tui/internal/tui"
+)
+
+// findTransition picks the transition named name, or else the one leading
+// to the status named name, ignoring case.
+func findTransition(transitions []jira.Transition, name string) (jira.Transition, error) {
+	for _, t := range transitions {
+		if strings.EqualFold(t.Name, name) {
+			return t, nil
+		}
+	}
+	for _, t := range transitions {
+		if t.To != nil && strings.EqualFold(t.To.Name, name) {
+			return t, nil
+		}
+	}
+	var names []string
+	for _, t := range transitions {
+		names = append(names, fmt.Sprintf("%q", t.Name))
+	}
+	if len(names) == 0 {
+		return jira.Transition{}, fmt.Errorf("no transitions are available")
+	}
+	return jira.Transition{}, fmt.Errorf("no transition %q (available: %s)", name, strings.Join(names, ", "))
+}
+
+// transitionInput fills in the fields t's screen asks for from values,
+// keyed by field name or key ignoring case. A field with allowed values
+// takes one of their names. comment, when not empty, is returned as the
+// ADF document TransitionIssueWith adds. A required field left out is an
+// error naming it, as is a value for a field the screen doesn't have.
+func transitionInput(t jira.Transition, values map[string]string, comment string) (map[string]interface{}, interface{}, error) {
+	byName := make(map[string]jira.FieldMeta, len(t.Fields))
+	for key, f := range t.Fields {
+		byName[strings.ToLower(key)] = f
+		byName[strings.ToLower(f.Name)] = f
+	}
+	fields := make(map[string]interface{})
+	for name, text := range values {
+		f, ok := byName[strings.ToLower(name)]
+		if !ok || f.Key == jira.CommentField {
+			return nil, nil, fmt.Errorf("%s has no %q field", t.Name, name)
+		}
+		v, err := f.InputValue(text)
+		if err != nil {
+			return nil, nil, err
+		}
+		fields[f.Key] = v
+	}
+
+	var doc interface{}
+	if strings.TrimSpace(comment) != "" {
+		doc = tui.CommentBody(comment)
+	}
+	var missing []string
+	for _, f := range t.RequiredFields() {
+		if f.Key == jira.CommentField && doc == nil || f.Key != jira.CommentField && fields[f.Key] == nil {
+			missing = append(missing, f.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, nil, fmt.Errorf("%s requires %s; give them with --field NAME=VALUE or --comment", t.Name, strings.Join(missing, ", "))
+	}
+	if len(fields) == 0 {
+		fields = nil
+	}
+	return fields, doc, nil
+}
+
+// findUser picks the user whose display name or email is query, ignoring
+// case, or else the only one whose name or email contains it.
+func findUser(users []config.CachedUser, query string) (config.CachedUser, error) {
+	lower := strings.ToLower(query)
+	var partial []config.CachedUser
+	for _, u := range users {
+		if strings.EqualFold(u.DisplayName, query) || strings.EqualFold(u.Email, query) {
+			return u, nil
+		}
+		if strings.Contains(strings.ToLower(u.DisplayName), lower) ||
+			strings.Contains(strings.ToLower(u.Email), lower) {
+			partial = append(partial, u)
+		}
+	}
+	switch len(partial) {
+	case 0:
+		return config.CachedUser{}, fmt.Errorf("no user matches %q", query)
+	case 1:
+		return partial[0], nil
+	}
+	var names []string
+	for _, u := range partial {
+		names = append(names, u.DisplayName)
+	}
+	return config.CachedUser{}, fmt.Errorf("%q matches %d users (%s)", query, len(partial), strings.Join(names, ", "))
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestFindTransition(t *testing.T) {
+	transitions := []jira.Transition{
+		{ID: "11", Name: "Start work", To: &jira.Status{Name: "In Progress"}},
+		{ID: "31", Name: "Done", To: &jira.Status{Name: "Closed"}},
+	}
+	tests := []struct {
+		name, want string
+	}{
+		{"done", "31"},        // transition name
+		{"in progress", "11"}, // target status
+		{"closed", "31"},
+	}
+	for _, tt := range tests {
+		got, err := findTransition(transitions, tt.name)
+		if err != nil || got.ID != tt.want {
+			t.Errorf("findTransition(%q) = %v, %v; want %s", tt.name, got.ID, err, tt.want)
+		}
+	}
+	if _, err := findTransition(transitions, "Review"); err == nil || !strings.Contains(err.Error(), `"Start work"`) {
+		t.Errorf("unknown name: err = %v, want the available transitions", err)
+	}
+}
+
+func TestFindUser(t *testing.T) {
+	users := []config.CachedUser{
+		{AccountID: "1", DisplayName: "Jane Doe", Email: "jane@example.com"},
+		{AccountID: "2", DisplayName: "Janet Smith", Email: "janet@example.com"},
+		{AccountID: "3", DisplayName: "Bob Stone", Email: "bob@example.com"},
+	}
+	tests := []struct {
+		query, want string
+	}{
+		{"jane doe", "1"},
+		{"janet@example.com", "2"},
+		{"stone", "3"},
+	}
+	for _, tt := range tests {
+		got, err := findUser(users, tt.query)
+		if err != nil || got.AccountID != tt.want {
+			t.Errorf("findUser(%q) = %v, %v; want %s", tt.query, got.AccountID, err, tt.want)
+		}
+	}
+	if _, err := findUser(users, "jan"); err == nil || !strings.Contains(err.Error(), "2 users") {
+		t.Errorf("ambiguous query: err = %v", err)
+	}
+	if _, err := findUser(users, "zed"); err == nil {
+		t.Error("expected an error for no match")
+	}
+}
+
+func TestTransitionInput(t *testing.T) {
+	done := jira.Transition{ID: "31", Name: "Done", Fields: map[string]jira.FieldMeta{
+		"resolution": {Key: "resolution", Name: "Resolution", Required: true, Schema: jira.FieldSchema{Type: "resolution"},
+			AllowedValues: []jira.AllowedValue{{ID: "1", Name: "Fixed"}, {ID: "2", Name: "Won't Do"}}},
+		"comment": {Key: "comment", Name: "Comment", Required: true, Schema: jira.FieldSchema{Type: "comment"}},
+	}}
+
+	if _, _, err := transitionInput(done, nil, ""); err == nil || !strings.Contains(err.Error(), "Done requires Resolution, Comment") {
+		t.Errorf("nothing given: err = %v, want the required fields named", err)
+	}
+	if _, _, err := transitionInput(done, map[string]string{"resolution": "Maybe"}, "x"); err == nil || !strings.Contains(err.Error(), `"Fixed"`) {
+		t.Errorf("unknown value: err = %v, want the choices", err)
+	}
+	if _, _, err := transitionInput(done, map[string]string{"Severity": "High"}, "x"); err == nil {
+		t.Error("a field the screen doesn't have should be refused")
+	}
+
+	fields, comment, err := transitionInput(done, map[string]string{"Resolution": "fixed"}, "Shipped")
+	if err != nil {
+		t.Fatalf("TransitionInput: %v", err)
+	}
+	if want := map[string]interface{}{"resolution": map[string]interface{}{"id": "1"}}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("fields = %v, want %v", fields, want)
+	}
+	if comment == nil {
+		t.Error("expected the comment as a document")
+	}
+
+	// A transition without a screen needs nothing
+	if fields, comment, err := transitionInput(jira.Transition{ID: "11", Name: "Start"}, nil, ""); err != nil || fields != nil || comment != nil {
+		t.Errorf("no screen: fields = %v, comment = %v, err = %v", fields, comment, err)
+	}
+}
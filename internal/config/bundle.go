@@ -0,0 +1,263 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// bundleMarker is the top-level key that identifies a config bundle.
+const bundleMarker = "jira_tui_bundle"
+
+// bundleVersion is the bundle format written by ExportBundle.
+const bundleVersion = 1
+
+// secretKeys are jira.* keys never written to or read from a bundle.
+var secretKeys = []string{"email", "api_token", "api_token_cmd"}
+
+// mergeKeys names the field that identifies list items when merging, so a
+// bundle's tab replaces the local tab with the same label rather than
+// duplicating it.
+var mergeKeys = map[string]string{
+	"tabs":          "label",
+	"custom_fields": "field_id",
+}
+
+// ImportResult summarizes what ImportBundle changed.
+type ImportResult struct {
+	Added   []string // items or settings that were new
+	Updated []string // conflicts where the bundle's value was taken
+	Kept    []string // conflicts where the local value was kept
+}
+
+// ExportBundle returns the config file as a shareable bundle: the same
+// YAML, comments included, with credentials removed and a version marker.
+func ExportBundle(configPath string) ([]byte, error) {
+	doc, root, err := readYAMLMapping(configPath)
+	if err != nil {
+		return nil, err
+	}
+	stripSecrets(root)
+	marker := scalarNode(bundleMarker)
+	version := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: fmt.Sprint(bundleVersion)}
+	root.Content = append([]*yaml.Node{marker, version}, root.Content...)
+	doc.HeadComment = "# jira-tui config bundle. Import with: jira-tui config import <file>"
+	return encodeYAML(doc)
+}
+
+// ImportBundle merges a bundle into the config file. Tabs are matched by
+// label and custom fields by field_id; settings and list items missing
+// locally are added. On conflicts the local value is kept unless overwrite
+// is set. The merged config is validated before it is written, and the
+// previous file is kept as config.yaml.bak.
+func ImportBundle(configPath, bundlePath string, overwrite bool) (*ImportResult, error) {
+	_, bundle, err := readYAMLMapping(bundlePath)
+	if err != nil {
+		return nil, err
+	}
+	version := mappingValue(bundle, bundleMarker)
+	if version == nil {
+		return nil, fmt.Errorf("%s is not a jira-tui config bundle", bundlePath)
+	}
+	if version.Value != fmt.Sprint(bundleVersion) {
+		return nil, fmt.Errorf("unsupported bundle version %s", version.Value)
+	}
+	removeMappingKey(bundle, bundleMarker)
+	stripSecrets(bundle)
+
+	doc, local, err := readYAMLMapping(configPath)
+	if err != nil {
+		return nil, err
+	}
+	result := &ImportResult{}
+	mergeMapping(local, bundle, "", overwrite, result)
+
+	data, err := encodeYAML(doc)
+	if err != nil {
+		return nil, err
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing merged config: %w", err)
+	}
+	if err := cfg.validateSettings(); err != nil {
+		return nil, fmt.Errorf("merged config is invalid: %w", err)
+	}
+
+	old, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+	if err := os.WriteFile(configPath+".bak", old, 0o644); err != nil {
+		return nil, fmt.Errorf("backing up config file: %w", err)
+	}
+	if err := os.WriteFile(configPath, data, 0o644); err != nil {
+		return nil, fmt.Errorf("writing config file: %w", err)
+	}
+	return result, nil
+}
+
+// mergeMapping merges src into dst. path is the dotted key prefix used in
+// the result summary.
+func mergeMapping(dst, src *yaml.Node, path string, overwrite bool, result *ImportResult) {
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		key, value := src.Content[i].Value, src.Content[i+1]
+		name := key
+		if path != "" {
+			name = path + "." + key
+		}
+		existing := mappingValue(dst, key)
+		switch {
+		case existing == nil:
+			dst.Content = append(dst.Content, scalarNode(key), value)
+			result.Added = append(result.Added, name)
+		case existing.Kind == yaml.MappingNode && value.Kind == yaml.MappingNode:
+			mergeMapping(existing, value, name, overwrite, result)
+		case existing.Kind == yaml.SequenceNode && value.Kind == yaml.SequenceNode && mergeKeys[name] != "":
+			mergeSequence(existing, value, name, mergeKeys[name], overwrite, result)
+		case nodesEqual(existing, value):
+			// Nothing to do
+		case overwrite:
+			*existing = *value
+			result.Updated = append(result.Updated, name)
+		default:
+			result.Kept = append(result.Kept, name)
+		}
+	}
+}
+
+// mergeSequence merges list items matched by their idKey field.
+func mergeSequence(dst, src *yaml.Node, path, idKey string, overwrite bool, result *ImportResult) {
+	for _, item := range src.Content {
+		idNode := mappingValue(item, idKey)
+		if idNode == nil {
+			continue
+		}
+		name := fmt.Sprintf("%s[%s]", path, idNode.Value)
+		var match *yaml.Node
+		for _, existing := range dst.Content {
+			if v := mappingValue(existing, idKey); v != nil && v.Value == idNode.Value {
+				match = existing
+				break
+			}
+		}
+		switch {
+		case match == nil:
+			dst.Content = append(dst.Content, item)
+			result.Added = append(result.Added, name)
+		case nodesEqual(match, item):
+		case overwrite:
+			*match = *item
+			result.Updated = append(result.Updated, name)
+		default:
+			result.Kept = append(result.Kept, name)
+		}
+	}
+}
+
+// readYAMLMapping parses a YAML file whose root is a mapping. It returns
+// the document, which holds the file's comments, and its root mapping.
+func readYAMLMapping(path string) (*yaml.Node, *yaml.Node, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return parseYAMLMapping(data, path)
+}
+
+// parseYAMLMapping is readYAMLMapping for data already in memory. path
+// identifies the source in errors.
+func parseYAMLMapping(data []byte, path string) (*yaml.Node, *yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		root := &yaml.Node{Kind: yaml.MappingNode}
+		return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{root}}, root, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, nil, fmt.Errorf("parsing %s: expected a mapping at the top level", path)
+	}
+	return &doc, root, nil
+}
+
+// encodeYAML renders a node with the two-space indent used by the sample
+// config.
+func encodeYAML(node *yaml.Node) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return nil, fmt.Errorf("encoding YAML: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("encoding YAML: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// stripSecrets removes credentials from the jira section, including a
+// user:password in the proxy URL; the proxy itself is kept.
+func stripSecrets(root *yaml.Node) {
+	jira := mappingValue(root, "jira")
+	if jira == nil {
+		return
+	}
+	for _, key := range secretKeys {
+		removeMappingKey(jira, key)
+	}
+	if proxy := mappingValue(jira, "proxy"); proxy != nil {
+		if u, err := url.Parse(proxy.Value); err != nil {
+			// An unparseable URL may still hold credentials
+			removeMappingKey(jira, "proxy")
+		} else if u.User != nil {
+			u.User = nil
+			proxy.Value = u.String()
+		}
+	}
+}
+
+// mappingValue returns the value for key in a mapping node, or nil.
+func mappingValue(m *yaml.Node, key string) *yaml.Node {
+	if m == nil || m.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// removeMappingKey deletes key and its value from a mapping node.
+func removeMappingKey(m *yaml.Node, key string) {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			m.Content = append(m.Content[:i], m.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+// scalarNode returns a plain string scalar.
+func scalarNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+// nodesEqual compares two nodes by value, ignoring comments and style.
+func nodesEqual(a, b *yaml.Node) bool {
+	var va, vb interface{}
+	if err := a.Decode(&va); err != nil {
+		return false
+	}
+	if err := b.Decode(&vb); err != nil {
+		return false
+	}
+	return fmt.Sprint(va) == fmt.Sprint(vb)
+}
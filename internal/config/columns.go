@@ -0,0 +1,82 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SaveTabColumns writes a tab's columns and widths back to the config file,
+// keeping the rest of the file and its comments as they are. A tab that
+// isn't in the file, e.g. one from the team config, is added in full so
+// it overrides the team's tab.
+func SaveTabColumns(configPath string, tab TabConfig) error {
+	doc, root, err := readYAMLMapping(configPath)
+	if err != nil {
+		return err
+	}
+
+	tabs := mappingValue(root, "tabs")
+	if tabs == nil {
+		tabs = &yaml.Node{Kind: yaml.SequenceNode}
+		root.Content = append(root.Content, scalarNode("tabs"), tabs)
+	}
+	node := tabNode(tabs, tab.Label)
+	if node == nil {
+		node = &yaml.Node{}
+		if err := node.Encode(tab); err != nil {
+			return fmt.Errorf("encoding tab %s: %w", tab.Label, err)
+		}
+		tabs.Content = append(tabs.Content, node)
+	}
+
+	columns := &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}
+	for _, c := range tab.Columns {
+		columns.Content = append(columns.Content, scalarNode(c))
+	}
+	setMappingValue(node, "columns", columns)
+
+	if len(tab.Widths) == 0 {
+		removeMappingKey(node, "widths")
+	} else {
+		widths := &yaml.Node{Kind: yaml.MappingNode, Style: yaml.FlowStyle}
+		names := make([]string, 0, len(tab.Widths))
+		for name := range tab.Widths {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			widths.Content = append(widths.Content, scalarNode(name),
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: fmt.Sprint(tab.Widths[name])})
+		}
+		setMappingValue(node, "widths", widths)
+	}
+
+	return writeYAML(configPath, doc)
+}
+
+// tabNode returns the tab labelled label in the tabs sequence, or nil.
+func tabNode(tabs *yaml.Node, label string) *yaml.Node {
+	if tabs == nil {
+		return nil
+	}
+	for _, item := range tabs.Content {
+		if v := mappingValue(item, "label"); v != nil && v.Value == label {
+			return item
+		}
+	}
+	return nil
+}
+
+// setMappingValue replaces the value for key in a mapping node, or appends
+// the pair if the key is missing.
+func setMappingValue(m *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			m.Content[i+1] = value
+			return
+		}
+	}
+	m.Content = append(m.Content, scalarNode(key), value)
+}
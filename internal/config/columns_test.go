@@ -0,0 +1,59 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestSaveTabColumns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	original := `jira:
+  base_url: https://example.atlassian.net
+tabs:
+  # The team's sprint
+  - label: "Sprint"
+    jql: "sprint in openSprints()"
+    columns: [key, summary, status]
+    widths: {summary: 40}
+`
+	if err := os.WriteFile(path, []byte(original), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := SaveTabColumns(path, TabConfig{
+		Label:   "Sprint",
+		JQL:     "sprint in openSprints()",
+		Columns: []string{"status", "key", "assignee"},
+		Widths:  map[string]int{"key": 10},
+	})
+	if err != nil {
+		t.Fatalf("SaveTabColumns: %v", err)
+	}
+	err = SaveTabColumns(path, TabConfig{Label: "Team bugs", JQL: "type = Bug", Columns: []string{"key"}})
+	if err != nil {
+		t.Fatalf("SaveTabColumns for a new tab: %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), "# The team's sprint") {
+		t.Errorf("comments should be kept:\n%s", data)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Tabs) != 2 {
+		t.Fatalf("tabs = %+v", cfg.Tabs)
+	}
+	sprint := cfg.Tabs[0]
+	if strings.Join(sprint.Columns, ",") != "status,key,assignee" || len(sprint.Widths) != 1 || sprint.Widths["key"] != 10 {
+		t.Errorf("sprint tab = %+v", sprint)
+	}
+	if cfg.Tabs[1].JQL != "type = Bug" {
+		t.Errorf("new tab should be written in full, got %+v", cfg.Tabs[1])
+	}
+}
@@ -3,27 +3,134 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 // Config holds the application configuration.
 type Config struct {
-	Jira  JiraConfig  `yaml:"jira"`
-	Tabs  []TabConfig `yaml:"tabs"`
-	Cache CacheConfig `yaml:"cache"`
+	Jira         JiraConfig          `yaml:"jira"`
+	Tabs         []TabConfig         `yaml:"tabs"`
+	Cache        CacheConfig         `yaml:"cache"`
+	QuickFilter  QuickFilterConfig   `yaml:"quick_filter,omitempty"`
+	CustomFields []CustomFieldConfig `yaml:"custom_fields,omitempty"`
+	TeamConfig   string              `yaml:"team_config,omitempty"` // path or URL of a shared base config
+	Clipboard    string              `yaml:"clipboard,omitempty"`   // one of ClipboardModes; "" = auto
+
+	// DescriptionTemplates maps issue type names to the scaffold 'e' offers
+	// for an empty description, adding to or replacing the built-in ones.
+	DescriptionTemplates map[string]string `yaml:"description_templates,omitempty"`
+
+	DoneTransitions DoneTransitionsConfig `yaml:"done_transitions,omitempty"`
+	Timeouts        TimeoutsConfig        `yaml:"timeouts,omitempty"`
+	LinkTypes       LinkTypesConfig       `yaml:"link_types,omitempty"`
+	Theme           ThemeConfig           `yaml:"theme,omitempty"`
+	StatusBar       StatusBarConfig       `yaml:"status_bar,omitempty"`
+	TabBar          TabBarConfig          `yaml:"tab_bar,omitempty"`
+	SplitPane       SplitPaneConfig       `yaml:"split_pane,omitempty"`
+
+	// ConfirmEdits asks before transitions, assignments, and priority
+	// changes, not just deletes.
+	ConfirmEdits bool `yaml:"confirm_edits,omitempty"`
+
+	// Warnings are problems Load worked around, e.g. an unreachable team
+	// config replaced by its cached copy.
+	Warnings []string `yaml:"-"`
 }
 
 // JiraConfig holds Jira-specific configuration.
 // Connection details live in config.yaml; credentials live in a separate
 // secrets.yaml file that is gitignored.
 type JiraConfig struct {
-	BaseURL        string `yaml:"base_url"`
-	Email          string `yaml:"email"`
-	APIToken       string `yaml:"api_token"` // loaded from secrets file, not config
-	DefaultProject string `yaml:"default_project,omitempty"`
+	BaseURL        string   `yaml:"base_url"`
+	Email          string   `yaml:"email"`
+	APIToken       string   `yaml:"api_token"` // loaded from secrets file, not config
+	DefaultProject string   `yaml:"default_project,omitempty"`
+	TeamGroups     []string `yaml:"team_groups,omitempty"` // groups listed first in the assignee picker
+	AuthType       string   `yaml:"auth_type,omitempty"`   // "basic" (default) or "bearer"
+	TokenSource    string   `yaml:"-"`                     // where the token was found, for 'auth status'
+
+	// MaxConcurrentRequests caps requests in flight at once; 0 is no cap
+	MaxConcurrentRequests int `yaml:"max_concurrent_requests,omitempty"`
+
+	// For corporate networks: a proxy URL (else HTTPS_PROXY is used), a PEM
+	// bundle of extra trusted CAs relative to the config directory, and
+	// skipping certificate checks altogether
+	Proxy              string `yaml:"proxy,omitempty"`
+	CABundle           string `yaml:"ca_bundle,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify,omitempty"`
+}
+
+// InsecureWarning is the warning shown wherever jira-tui runs with
+// insecure_skip_verify on.
+const InsecureWarning = "jira.insecure_skip_verify is on: TLS certificates aren't checked, so anyone on the network can impersonate Jira and read your token"
+
+// BearerAuth reports whether the token is sent as a Bearer header, as for
+// Data Center personal access tokens, so no email is needed.
+func (j JiraConfig) BearerAuth() bool {
+	return j.AuthType == "bearer"
+}
+
+// StatusBarSegments are the parts the status bar can show: the account,
+// read-only and connection problems, when the tab was refreshed, its
+// issue count, its JQL, the time, messages, and key hints.
+var StatusBarSegments = []string{"user", "connection", "refresh", "counts", "filter", "clock", "message", "keys"}
+
+// StatusBarConfig picks the status bar's segments and their order.
+type StatusBarConfig struct {
+	Segments []string `yaml:"segments,omitempty"` // of StatusBarSegments; empty for the default
+}
+
+// Bounds on split_pane.list_width, so neither pane gets too narrow to use.
+const (
+	MinSplitListWidth     = 20
+	MaxSplitListWidth     = 80
+	DefaultSplitListWidth = 50
+)
+
+// SplitPaneConfig sets up the layout with the issue list on the left and
+// a preview of the selected issue on the right.
+type SplitPaneConfig struct {
+	Enabled   bool `yaml:"enabled,omitempty"`    // start with the preview shown
+	ListWidth int  `yaml:"list_width,omitempty"` // percent of the width for the list; 0 for DefaultSplitListWidth
+}
+
+// TabBadges are what the tab bar can show beside each label: the number
+// of issues, that and how many are new since the tab was last looked at,
+// or nothing.
+var TabBadges = []string{"counts", "new", "none"}
+
+// DefaultStaleAfter is how old a tab's results get before the tab bar
+// shows their age, without tab_bar.stale_after.
+const DefaultStaleAfter = 10 * time.Minute
+
+// TabBarConfig sets what the tab bar shows beside each tab's label.
+type TabBarConfig struct {
+	Badges     string `yaml:"badges,omitempty"`      // one of TabBadges; "" is counts
+	StaleAfter string `yaml:"stale_after,omitempty"` // e.g. "15m"; "" is DefaultStaleAfter, "0" never
+}
+
+// StaleDuration parses StaleAfter. Zero means the tab bar never shows how
+// old results are.
+func (t TabBarConfig) StaleDuration() (time.Duration, error) {
+	if t.StaleAfter == "" {
+		return DefaultStaleAfter, nil
+	}
+	d, err := time.ParseDuration(t.StaleAfter)
+	if err != nil {
+		return 0, fmt.Errorf("tab_bar.stale_after: %w", err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("tab_bar.stale_after must not be negative")
+	}
+	return d, nil
 }
 
 // SecretsConfig holds sensitive credentials loaded from a separate file.
@@ -31,27 +138,148 @@ type SecretsConfig struct {
 	Jira JiraSecrets `yaml:"jira"`
 }
 
-// JiraSecrets holds the Jira credentials.
+// JiraSecrets holds the Jira credentials. Values may reference environment
+// variables as ${NAME}; APITokenCmd runs a command that prints the token.
 type JiraSecrets struct {
-	Email    string `yaml:"email"`
-	APIToken string `yaml:"api_token"`
+	Email       string `yaml:"email"`
+	APIToken    string `yaml:"api_token"`
+	APITokenCmd string `yaml:"api_token_cmd,omitempty"`
+	TokenSource string `yaml:"-"` // set by resolve
 }
 
 // TabConfig defines a filter-backed tab in the TUI.
 // Exactly one of FilterID, FilterURL, or JQL must be provided.
 type TabConfig struct {
-	Label     string   `yaml:"label"`
-	FilterID  string   `yaml:"filter_id,omitempty"`
-	FilterURL string   `yaml:"filter_url,omitempty"`
-	JQL       string   `yaml:"jql,omitempty"`
-	Columns   []string `yaml:"columns"`
+	Label            string         `yaml:"label"`
+	FilterID         string         `yaml:"filter_id,omitempty"`
+	FilterURL        string         `yaml:"filter_url,omitempty"`
+	JQL              string         `yaml:"jql,omitempty"`
+	Columns          []string       `yaml:"columns"`
+	Widths           map[string]int `yaml:"widths,omitempty"`            // column → fixed width; others auto-size
+	Sort             string         `yaml:"sort,omitempty"`              // ORDER BY terms, e.g. "updated DESC"
+	RefreshInterval  string         `yaml:"refresh_interval,omitempty"`  // duration string, e.g. "2m"
+	GroupBy          string         `yaml:"group_by,omitempty"`          // one of GroupByFields
+	Tree             bool           `yaml:"tree,omitempty"`              // show issues under their parents
+	DescriptionLimit int            `yaml:"description_limit,omitempty"` // characters shown in the description column
+	Overflow         string         `yaml:"overflow,omitempty"`          // one of OverflowModes; "" is fit
+}
+
+// GroupByFields are the values a tab's group_by accepts.
+var GroupByFields = []string{"status", "assignee", "priority", "epic"}
+
+// OverflowModes are the values a tab's overflow accepts: fit squeezes the
+// columns into the window, scroll keeps them readable and pans.
+var OverflowModes = []string{"fit", "scroll"}
+
+// sortTermPattern matches one ORDER BY term: a field name or cf[id],
+// optionally followed by a direction.
+var sortTermPattern = regexp.MustCompile(`(?i)^[a-z_][\w.]*(\[\d+\])?(\s+(asc|desc))?$`)
+
+// validateSort checks that sort is a comma-separated list of ORDER BY
+// terms, so it can be appended to a query without breaking it.
+func validateSort(sort string) error {
+	for _, term := range strings.Split(sort, ",") {
+		if !sortTermPattern.MatchString(strings.TrimSpace(term)) {
+			return fmt.Errorf("%q is not a field optionally followed by ASC or DESC", strings.TrimSpace(term))
+		}
+	}
+	return nil
+}
+
+// MinRefreshInterval is the shortest allowed tab refresh_interval, so an
+// open tab can't hammer the Jira API.
+const MinRefreshInterval = 10 * time.Second
+
+// RefreshDuration parses RefreshInterval. Zero means the tab only reloads
+// on demand.
+func (t TabConfig) RefreshDuration() (time.Duration, error) {
+	if t.RefreshInterval == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(t.RefreshInterval)
+	if err != nil {
+		return 0, err
+	}
+	if d < MinRefreshInterval {
+		return 0, fmt.Errorf("must be at least %s", MinRefreshInterval)
+	}
+	return d, nil
+}
+
+// CustomFieldConfig declares a select-type custom field (e.g. Severity)
+// that gets a quick-edit overlay like priority. Options come from the
+// issue's edit metadata.
+type CustomFieldConfig struct {
+	Name    string `yaml:"name"`
+	FieldID string `yaml:"field_id"` // e.g. "customfield_10050"
 }
 
-// CacheConfig holds caching configuration.
+// CacheConfig holds caching configuration. Tab results younger than TTL
+// are reused on startup without a fetch; older ones are shown as stale
+// while they refresh.
 type CacheConfig struct {
 	TTL string `yaml:"ttl"` // duration string, e.g. "5m"
 }
 
+// QuickFilterConfig holds quick filter ('/') settings.
+type QuickFilterConfig struct {
+	Mode    string `yaml:"mode,omitempty"`    // "substring" (default) or "fuzzy"
+	Persist bool   `yaml:"persist,omitempty"` // keep each tab's filter across tab switches and reloads
+}
+
+// DoneTransitionsConfig steers which done-category transition 'd' takes.
+// Names match a transition or the status it leads to, ignoring case.
+type DoneTransitionsConfig struct {
+	Prefer  []string `yaml:"prefer,omitempty"`  // taken first, in order, when available
+	Exclude []string `yaml:"exclude,omitempty"` // never taken, e.g. "Won't Do"
+}
+
+// LinkTypesConfig steers the link picker. Names match a link type's name
+// or either of its descriptions ("blocks", "is blocked by"), ignoring case.
+type LinkTypesConfig struct {
+	Default string   `yaml:"default,omitempty"` // listed first
+	Hide    []string `yaml:"hide,omitempty"`    // never listed, e.g. "Cloners"
+}
+
+// TimeoutsConfig bounds Jira requests by kind. Empty values use the
+// client's 30s default.
+type TimeoutsConfig struct {
+	Search   string `yaml:"search,omitempty"`   // duration string, e.g. "10s"
+	Mutation string `yaml:"mutation,omitempty"` // creates, edits, transitions, deletes
+}
+
+// Durations parses Search and Mutation; empty values are zero.
+func (t TimeoutsConfig) Durations() (search, mutation time.Duration, err error) {
+	parse := func(name, v string) (time.Duration, error) {
+		if v == "" {
+			return 0, nil
+		}
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, fmt.Errorf("timeouts.%s: %w", name, err)
+		}
+		if d <= 0 {
+			return 0, fmt.Errorf("timeouts.%s must be positive", name)
+		}
+		return d, nil
+	}
+	if search, err = parse("search", t.Search); err != nil {
+		return 0, 0, err
+	}
+	if mutation, err = parse("mutation", t.Mutation); err != nil {
+		return 0, 0, err
+	}
+	return search, mutation, nil
+}
+
+// QuickFilterModes are the accepted quick_filter.mode values.
+var QuickFilterModes = []string{"substring", "fuzzy"}
+
+// ClipboardModes are the accepted clipboard values: "auto" uses the OS
+// clipboard and falls back to OSC 52 terminal escapes when it is
+// unavailable, as over SSH; "system" and "osc52" use only one.
+var ClipboardModes = []string{"auto", "system", "osc52"}
+
 // DefaultConfigDir returns the .jira-tui directory next to the executable.
 func DefaultConfigDir() (string, error) {
 	exe, err := os.Executable()
@@ -86,14 +314,9 @@ func DefaultSecretsPath() (string, error) {
 // Load reads and parses the config and secrets files.
 // configPath is the path to config.yaml, secretsPath is the path to secrets.yaml.
 func Load(configPath, secretsPath string) (*Config, error) {
-	data, err := os.ReadFile(configPath)
+	cfg, err := loadSettings(configPath)
 	if err != nil {
-		return nil, fmt.Errorf("reading config file: %w", err)
-	}
-
-	var cfg Config
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
-		return nil, fmt.Errorf("parsing config file: %w", err)
+		return nil, err
 	}
 
 	// Load secrets from separate file
@@ -106,28 +329,92 @@ func Load(configPath, secretsPath string) (*Config, error) {
 	if err := yaml.Unmarshal(secretsData, &secrets); err != nil {
 		return nil, fmt.Errorf("parsing secrets file: %w", err)
 	}
+	if err := secrets.Jira.resolve(cfg.Jira.BaseURL); err != nil {
+		return nil, fmt.Errorf("resolving secrets: %w", err)
+	}
 
 	// Merge secrets into config
 	cfg.Jira.Email = secrets.Jira.Email
 	cfg.Jira.APIToken = secrets.Jira.APIToken
+	cfg.Jira.TokenSource = secrets.Jira.TokenSource
 
 	if err := cfg.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid config: %w", err)
 	}
+	cfg.Warnings = append(cfg.Warnings, CheckSecretsFile(secretsPath)...)
+	cfg.Jira.resolveCABundle(filepath.Dir(configPath))
+	if cfg.Jira.InsecureSkipVerify {
+		cfg.Warnings = append(cfg.Warnings, InsecureWarning)
+	}
 
 	return &cfg, nil
 }
 
+// resolveCABundle makes a relative ca_bundle relative to the config directory.
+func (j *JiraConfig) resolveCABundle(configDir string) {
+	if j.CABundle != "" && !filepath.IsAbs(j.CABundle) {
+		j.CABundle = filepath.Join(configDir, j.CABundle)
+	}
+}
+
+// loadSettings reads the config file, layered over its team config if it
+// names one, without the secrets.
+func loadSettings(configPath string) (Config, error) {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return Config{}, fmt.Errorf("reading config file: %w", err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("parsing config file: %w", err)
+	}
+	if cfg.TeamConfig != "" {
+		// The team config is fetched with the personal config's proxy and TLS
+		// settings; the team's own can't apply to fetching itself
+		cfg.Jira.resolveCABundle(filepath.Dir(configPath))
+		merged, warnings, err := layerTeamConfig(data, cfg.TeamConfig, filepath.Dir(configPath), cfg.Jira)
+		if err != nil {
+			return Config{}, err
+		}
+		cfg = Config{Warnings: warnings}
+		if err := yaml.Unmarshal(merged, &cfg); err != nil {
+			return Config{}, fmt.Errorf("parsing merged config: %w", err)
+		}
+	}
+	return cfg, nil
+}
+
 // Validate checks that all required config fields are set.
 func (c *Config) Validate() error {
 	if c.Jira.BaseURL == "" {
 		return fmt.Errorf("jira.base_url is required")
 	}
-	if c.Jira.Email == "" {
+	if c.Jira.Email == "" && !c.Jira.BearerAuth() {
 		return fmt.Errorf("jira.email is required")
 	}
 	if c.Jira.APIToken == "" {
-		return fmt.Errorf("jira.api_token is required")
+		return fmt.Errorf("jira.api_token is required (set it in secrets.yaml or run 'jira-tui auth set')")
+	}
+	return c.validateSettings()
+}
+
+// validateSettings checks everything except the credentials, which come
+// from the secrets file.
+func (c *Config) validateSettings() error {
+	if c.Jira.BaseURL == "" {
+		return fmt.Errorf("jira.base_url is required")
+	}
+	switch c.Jira.AuthType {
+	case "", "basic", "bearer":
+	default:
+		return fmt.Errorf("jira.auth_type must be basic or bearer, not %q", c.Jira.AuthType)
+	}
+	if c.Jira.Proxy != "" {
+		u, err := url.Parse(c.Jira.Proxy)
+		if err != nil || u.Host == "" || !slices.Contains([]string{"http", "https", "socks5"}, u.Scheme) {
+			return fmt.Errorf("jira.proxy must be an http://, https://, or socks5:// URL, not %q", c.Jira.Proxy)
+		}
 	}
 	if len(c.Tabs) == 0 {
 		return fmt.Errorf("at least one tab is required")
@@ -156,6 +443,73 @@ func (c *Config) Validate() error {
 		if len(tab.Columns) == 0 {
 			return fmt.Errorf("tabs[%d].columns must not be empty", i)
 		}
+		for col, w := range tab.Widths {
+			if w <= 0 {
+				return fmt.Errorf("tabs[%d].widths.%s must be positive", i, col)
+			}
+		}
+		if tab.Sort != "" {
+			if err := validateSort(tab.Sort); err != nil {
+				return fmt.Errorf("tabs[%d].sort: %w", i, err)
+			}
+		}
+		if _, err := tab.RefreshDuration(); err != nil {
+			return fmt.Errorf("tabs[%d].refresh_interval: %w", i, err)
+		}
+		if tab.DescriptionLimit < 0 {
+			return fmt.Errorf("tabs[%d].description_limit must not be negative", i)
+		}
+		if tab.GroupBy != "" && !slices.Contains(GroupByFields, tab.GroupBy) {
+			return fmt.Errorf("tabs[%d].group_by must be one of %s", i, strings.Join(GroupByFields, ", "))
+		}
+		if tab.Overflow != "" && !slices.Contains(OverflowModes, tab.Overflow) {
+			return fmt.Errorf("tabs[%d].overflow must be one of %s", i, strings.Join(OverflowModes, ", "))
+		}
+	}
+	if _, err := c.Cache.TTLDuration(); err != nil {
+		return err
+	}
+	if _, _, err := c.Timeouts.Durations(); err != nil {
+		return err
+	}
+	if c.Jira.MaxConcurrentRequests < 0 {
+		return fmt.Errorf("jira.max_concurrent_requests must not be negative")
+	}
+	if c.QuickFilter.Mode != "" && !slices.Contains(QuickFilterModes, c.QuickFilter.Mode) {
+		return fmt.Errorf("quick_filter.mode must be one of %s", strings.Join(QuickFilterModes, ", "))
+	}
+	if c.Clipboard != "" && !slices.Contains(ClipboardModes, c.Clipboard) {
+		return fmt.Errorf("clipboard must be one of %s", strings.Join(ClipboardModes, ", "))
+	}
+	if err := c.Theme.validate(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool)
+	for _, s := range c.StatusBar.Segments {
+		if !slices.Contains(StatusBarSegments, s) {
+			return fmt.Errorf("status_bar.segments: %q must be one of %s", s, strings.Join(StatusBarSegments, ", "))
+		}
+		if seen[s] {
+			return fmt.Errorf("status_bar.segments: %q is listed twice", s)
+		}
+		seen[s] = true
+	}
+	if c.TabBar.Badges != "" && !slices.Contains(TabBadges, c.TabBar.Badges) {
+		return fmt.Errorf("tab_bar.badges must be one of %s", strings.Join(TabBadges, ", "))
+	}
+	if _, err := c.TabBar.StaleDuration(); err != nil {
+		return err
+	}
+	if w := c.SplitPane.ListWidth; w != 0 && (w < MinSplitListWidth || w > MaxSplitListWidth) {
+		return fmt.Errorf("split_pane.list_width must be between %d and %d", MinSplitListWidth, MaxSplitListWidth)
+	}
+	for i, f := range c.CustomFields {
+		if f.Name == "" {
+			return fmt.Errorf("custom_fields[%d].name is required", i)
+		}
+		if f.FieldID == "" {
+			return fmt.Errorf("custom_fields[%d].field_id is required", i)
+		}
 	}
 	return nil
 }
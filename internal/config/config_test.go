@@ -3,7 +3,10 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 )
 
 const validSecrets = `
@@ -91,6 +94,46 @@ jira:
 	}
 }
 
+func TestLoadBearerAuthNeedsNoEmail(t *testing.T) {
+	cfgPath := writeTestFile(t, "config.yaml", `
+jira:
+  base_url: https://jira.example.com
+  auth_type: bearer
+tabs:
+  - label: "Work"
+    filter_id: "10100"
+    columns: ["key", "summary"]
+`)
+	secPath := writeTestFile(t, "secrets.yaml", `
+jira:
+  api_token: personal-access-token
+`)
+	cfg, err := Load(cfgPath, secPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cfg.Jira.BearerAuth() {
+		t.Error("expected bearer auth")
+	}
+}
+
+func TestLoadBadAuthType(t *testing.T) {
+	cfgPath := writeTestFile(t, "config.yaml", `
+jira:
+  base_url: https://example.atlassian.net
+  auth_type: oauth
+tabs:
+  - label: "Work"
+    filter_id: "10100"
+    columns: ["key", "summary"]
+`)
+	secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+	_, err := Load(cfgPath, secPath)
+	if err == nil || !strings.Contains(err.Error(), "jira.auth_type") {
+		t.Fatalf("err = %v, want an auth_type error", err)
+	}
+}
+
 func TestLoadMissingAPIToken(t *testing.T) {
 	cfgPath := writeTestFile(t, "config.yaml", `
 jira:
@@ -332,6 +375,32 @@ func TestValidate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "custom field",
+			config: Config{
+				Jira: JiraConfig{
+					BaseURL:  "https://example.atlassian.net",
+					Email:    "user@example.com",
+					APIToken: "token",
+				},
+				Tabs:         validTabs,
+				CustomFields: []CustomFieldConfig{{Name: "Severity", FieldID: "customfield_10050"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "custom field missing field_id",
+			config: Config{
+				Jira: JiraConfig{
+					BaseURL:  "https://example.atlassian.net",
+					Email:    "user@example.com",
+					APIToken: "token",
+				},
+				Tabs:         validTabs,
+				CustomFields: []CustomFieldConfig{{Name: "Severity"}},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -353,3 +422,327 @@ func writeTestFile(t *testing.T, name, content string) string {
 	}
 	return path
 }
+
+func TestLoadTabRefreshInterval(t *testing.T) {
+	cfgPath := writeTestFile(t, "config.yaml", `
+jira:
+  base_url: https://example.atlassian.net
+tabs:
+  - label: "Sprint"
+    jql: "sprint in openSprints()"
+    columns: ["key", "summary"]
+    refresh_interval: 2m
+`)
+	secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+	cfg, err := Load(cfgPath, secPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	d, err := cfg.Tabs[0].RefreshDuration()
+	if err != nil || d != 2*time.Minute {
+		t.Errorf("RefreshDuration() = %v, %v; want 2m", d, err)
+	}
+}
+
+func TestLoadTabRefreshIntervalInvalid(t *testing.T) {
+	for _, interval := range []string{"soon", "1s"} {
+		cfgPath := writeTestFile(t, "config.yaml", `
+jira:
+  base_url: https://example.atlassian.net
+tabs:
+  - label: "Sprint"
+    jql: "sprint in openSprints()"
+    columns: ["key", "summary"]
+    refresh_interval: `+interval+`
+`)
+		secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+		if _, err := Load(cfgPath, secPath); err == nil {
+			t.Errorf("refresh_interval %q: expected validation error", interval)
+		}
+	}
+}
+
+func TestValidateSort(t *testing.T) {
+	for _, sort := range []string{"priority", "updated DESC", "priority desc, created ASC", "cf[10010] asc"} {
+		if err := validateSort(sort); err != nil {
+			t.Errorf("validateSort(%q) = %v", sort, err)
+		}
+	}
+	for _, sort := range []string{"", "updated DOWN", "created) OR (1=1", "priority,"} {
+		if err := validateSort(sort); err == nil {
+			t.Errorf("validateSort(%q) should fail", sort)
+		}
+	}
+}
+
+func TestLoadTabGroupBy(t *testing.T) {
+	for groupBy, valid := range map[string]bool{"epic": true, "assignee": true, "sprint": false} {
+		cfgPath := writeTestFile(t, "config.yaml", `
+jira:
+  base_url: https://example.atlassian.net
+tabs:
+  - label: "Sprint"
+    jql: "sprint in openSprints()"
+    columns: ["key", "summary"]
+    group_by: `+groupBy+`
+`)
+		secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+		cfg, err := Load(cfgPath, secPath)
+		switch {
+		case valid && err != nil:
+			t.Errorf("group_by %q: unexpected error: %v", groupBy, err)
+		case valid && cfg.Tabs[0].GroupBy != groupBy:
+			t.Errorf("group_by = %q, want %q", cfg.Tabs[0].GroupBy, groupBy)
+		case !valid && err == nil:
+			t.Errorf("group_by %q: expected validation error", groupBy)
+		}
+	}
+}
+
+func TestLoadTabOverflow(t *testing.T) {
+	for overflow, valid := range map[string]bool{"scroll": true, "fit": true, "wrap": false} {
+		cfgPath := writeTestFile(t, "config.yaml", `
+jira:
+  base_url: https://example.atlassian.net
+tabs:
+  - label: "Sprint"
+    jql: "sprint in openSprints()"
+    columns: ["key", "summary"]
+    overflow: `+overflow+`
+`)
+		secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+		cfg, err := Load(cfgPath, secPath)
+		switch {
+		case valid && err != nil:
+			t.Errorf("overflow %q: unexpected error: %v", overflow, err)
+		case valid && cfg.Tabs[0].Overflow != overflow:
+			t.Errorf("overflow = %q, want %q", cfg.Tabs[0].Overflow, overflow)
+		case !valid && err == nil:
+			t.Errorf("overflow %q: expected validation error", overflow)
+		}
+	}
+}
+
+func TestLoadQuickFilterMode(t *testing.T) {
+	for mode, valid := range map[string]bool{"fuzzy": true, "substring": true, "regex": false} {
+		cfgPath := writeTestFile(t, "config.yaml", `
+jira:
+  base_url: https://example.atlassian.net
+tabs:
+  - label: "Sprint"
+    jql: "sprint in openSprints()"
+    columns: ["key", "summary"]
+quick_filter:
+  mode: `+mode+`
+`)
+		secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+		cfg, err := Load(cfgPath, secPath)
+		switch {
+		case valid && err != nil:
+			t.Errorf("mode %q: unexpected error: %v", mode, err)
+		case valid && cfg.QuickFilter.Mode != mode:
+			t.Errorf("mode = %q, want %q", cfg.QuickFilter.Mode, mode)
+		case !valid && err == nil:
+			t.Errorf("mode %q: expected validation error", mode)
+		}
+	}
+}
+
+func TestLoadClipboard(t *testing.T) {
+	for mode, valid := range map[string]bool{"auto": true, "system": true, "osc52": true, "tmux": false} {
+		cfgPath := writeTestFile(t, "config.yaml", `
+jira:
+  base_url: https://example.atlassian.net
+tabs:
+  - label: "Sprint"
+    jql: "sprint in openSprints()"
+    columns: ["key", "summary"]
+clipboard: `+mode+`
+`)
+		secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+		cfg, err := Load(cfgPath, secPath)
+		switch {
+		case valid && err != nil:
+			t.Errorf("clipboard %q: unexpected error: %v", mode, err)
+		case valid && cfg.Clipboard != mode:
+			t.Errorf("clipboard = %q, want %q", cfg.Clipboard, mode)
+		case !valid && err == nil:
+			t.Errorf("clipboard %q: expected validation error", mode)
+		}
+	}
+}
+
+func TestLoadTabDescriptionLimit(t *testing.T) {
+	cfgPath := writeTestFile(t, "config.yaml", `
+jira:
+  base_url: https://example.atlassian.net
+tabs:
+  - label: "Sprint"
+    jql: "sprint in openSprints()"
+    columns: ["key", "description"]
+    description_limit: -1
+`)
+	secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+	if _, err := Load(cfgPath, secPath); err == nil || !strings.Contains(err.Error(), "description_limit") {
+		t.Errorf("expected a description_limit error, got %v", err)
+	}
+}
+
+func TestLoadTimeouts(t *testing.T) {
+	for value, valid := range map[string]bool{"10s": true, "1m30s": true, "ten": false, "0s": false} {
+		cfgPath := writeTestFile(t, "config.yaml", `
+jira:
+  base_url: https://example.atlassian.net
+tabs:
+  - label: "Sprint"
+    jql: "sprint in openSprints()"
+    columns: ["key", "summary"]
+timeouts:
+  search: `+value+`
+`)
+		secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+		_, err := Load(cfgPath, secPath)
+		switch {
+		case valid && err != nil:
+			t.Errorf("search %q: unexpected error: %v", value, err)
+		case !valid && (err == nil || !strings.Contains(err.Error(), "timeouts.search")):
+			t.Errorf("search %q: expected a timeouts.search error, got %v", value, err)
+		}
+	}
+}
+
+func TestLoadMaxConcurrentRequests(t *testing.T) {
+	cfgPath := writeTestFile(t, "config.yaml", `
+jira:
+  base_url: https://example.atlassian.net
+  max_concurrent_requests: -1
+tabs:
+  - label: "Sprint"
+    jql: "sprint in openSprints()"
+    columns: ["key", "summary"]
+`)
+	secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+	if _, err := Load(cfgPath, secPath); err == nil || !strings.Contains(err.Error(), "max_concurrent_requests") {
+		t.Errorf("expected a max_concurrent_requests error, got %v", err)
+	}
+}
+
+func TestLoadNetworkSettings(t *testing.T) {
+	cfgPath := writeTestFile(t, "config.yaml", `
+jira:
+  base_url: https://example.atlassian.net
+  proxy: http://proxy.corp:3128
+  ca_bundle: corp-ca.pem
+  insecure_skip_verify: true
+tabs:
+  - label: "Work"
+    filter_id: "10100"
+    columns: ["key", "summary"]
+`)
+	secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+	cfg, err := Load(cfgPath, secPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := filepath.Join(filepath.Dir(cfgPath), "corp-ca.pem"); cfg.Jira.CABundle != want {
+		t.Errorf("ca_bundle = %q, want %q relative to the config", cfg.Jira.CABundle, want)
+	}
+	if !slices.Contains(cfg.Warnings, InsecureWarning) {
+		t.Errorf("warnings = %q, want the insecure TLS warning", cfg.Warnings)
+	}
+
+	bad := writeTestFile(t, "config.yaml", `
+jira:
+  base_url: https://example.atlassian.net
+  proxy: proxy.corp:3128
+tabs:
+  - label: "Work"
+    filter_id: "10100"
+    columns: ["key", "summary"]
+`)
+	if _, err := Load(bad, secPath); err == nil || !strings.Contains(err.Error(), "jira.proxy") {
+		t.Errorf("err = %v, want a proxy URL error", err)
+	}
+}
+
+func TestLoadStatusBarSegments(t *testing.T) {
+	for _, tt := range []struct {
+		segments string
+		want     string
+	}{
+		{"[clock, user, counts]", ""},
+		{"[user, weather]", `"weather" must be one of`},
+		{"[user, clock, user]", `"user" is listed twice`},
+	} {
+		cfgPath := writeTestFile(t, "config.yaml", validConfigWithTabs+"status_bar:\n  segments: "+tt.segments+"\n")
+		secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+		cfg, err := Load(cfgPath, secPath)
+		if tt.want == "" {
+			if err != nil || len(cfg.StatusBar.Segments) != 3 {
+				t.Errorf("%s: cfg = %+v, err = %v", tt.segments, cfg, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: err = %v, want %q", tt.segments, err, tt.want)
+		}
+	}
+}
+
+func TestSplitPaneListWidth(t *testing.T) {
+	for _, tt := range []struct {
+		width string
+		want  string
+	}{
+		{"60", ""},
+		{"19", "split_pane.list_width must be between 20 and 80"},
+		{"81", "split_pane.list_width must be between 20 and 80"},
+	} {
+		cfgPath := writeTestFile(t, "config.yaml", validConfigWithTabs+"split_pane:\n  enabled: true\n  list_width: "+tt.width+"\n")
+		secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+		cfg, err := Load(cfgPath, secPath)
+		if tt.want == "" {
+			if err != nil || !cfg.SplitPane.Enabled || cfg.SplitPane.ListWidth != 60 {
+				t.Errorf("%s: cfg = %+v, err = %v", tt.width, cfg, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: err = %v, want %q", tt.width, err, tt.want)
+		}
+	}
+}
+
+func TestTabBarBadges(t *testing.T) {
+	for badges, valid := range map[string]bool{"new": true, "none": true, "unread": false} {
+		cfgPath := writeTestFile(t, "config.yaml", validConfigWithTabs+"tab_bar:\n  badges: "+badges+"\n")
+		secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+		cfg, err := Load(cfgPath, secPath)
+		switch {
+		case valid && (err != nil || cfg.TabBar.Badges != badges):
+			t.Errorf("badges %q: cfg = %+v, err = %v", badges, cfg.TabBar, err)
+		case !valid && (err == nil || !strings.Contains(err.Error(), "tab_bar.badges must be one of")):
+			t.Errorf("badges %q: err = %v, want a validation error", badges, err)
+		}
+	}
+}
+
+func TestTabBarStaleAfter(t *testing.T) {
+	tests := []struct {
+		staleAfter string
+		want       time.Duration
+		wantErr    bool
+	}{
+		{"", DefaultStaleAfter, false},
+		{"15m", 15 * time.Minute, false},
+		{"0", 0, false},
+		{"-1m", 0, true},
+		{"soon", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := TabBarConfig{StaleAfter: tt.staleAfter}.StaleDuration()
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("StaleDuration(%q) = %v, %v; want %v, error %v", tt.staleAfter, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// The --debug log is rotated when it would grow past debugLogMaxSize: the
+// full file becomes debug.log.1, the one before debug.log.2, and so on,
+// keeping debugLogBackups of them.
+const (
+	debugLogMaxSize = 5 << 20
+	debugLogBackups = 3
+)
+
+// DebugLog is the rotating file --debug writes requests to. It is safe for
+// concurrent use.
+type DebugLog struct {
+	mu      sync.Mutex
+	path    string
+	file    *os.File
+	size    int64
+	maxSize int64
+}
+
+// DebugLogPath returns the path to the --debug log file.
+func DebugLogPath() (string, error) {
+	dir, err := DefaultConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "debug.log"), nil
+}
+
+// OpenDebugLog opens the debug log at path for appending. The log holds
+// issue contents, so only the owner can read it.
+func OpenDebugLog(path string) (*DebugLog, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("creating config dir: %w", err)
+	}
+	l := &DebugLog{path: path, maxSize: debugLogMaxSize}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Path returns the file the log writes to.
+func (l *DebugLog) Path() string {
+	return l.path
+}
+
+func (l *DebugLog) open() error {
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening debug log: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("opening debug log: %w", err)
+	}
+	l.file, l.size = f, info.Size()
+	return nil
+}
+
+// Write appends p, rotating first if p would take the file past its
+// maximum size.
+func (l *DebugLog) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.size > 0 && l.size+int64(len(p)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := l.file.Write(p)
+	l.size += int64(n)
+	return n, err
+}
+
+// rotate shifts the backups up by one, dropping the oldest, and starts a
+// new file.
+func (l *DebugLog) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("closing debug log: %w", err)
+	}
+	for i := debugLogBackups - 1; i >= 1; i-- {
+		// Missing backups are fine: the log hasn't rotated that often yet
+		_ = os.Rename(fmt.Sprintf("%s.%d", l.path, i), fmt.Sprintf("%s.%d", l.path, i+1))
+	}
+	if err := os.Rename(l.path, l.path+".1"); err != nil {
+		return fmt.Errorf("rotating debug log: %w", err)
+	}
+	return l.open()
+}
+
+// Close closes the file.
+func (l *DebugLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
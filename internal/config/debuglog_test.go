@@ -0,0 +1,57 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDebugLogRotates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "debug.log")
+	l, err := OpenDebugLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	l.maxSize = 10
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n", "fifth\n"} {
+		if _, err := l.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := map[string]string{
+		"debug.log":   "fifth\n",
+		"debug.log.1": "fourth\n",
+		"debug.log.2": "third\n",
+		"debug.log.3": "second\n",
+	}
+	for name, content := range want {
+		data, err := os.ReadFile(filepath.Join(filepath.Dir(path), name))
+		if err != nil || string(data) != content {
+			t.Errorf("%s = %q, %v; want %q", name, data, err, content)
+		}
+	}
+	if _, err := os.Stat(path + ".4"); !os.IsNotExist(err) {
+		t.Error("only three backups should be kept")
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0o600 {
+		t.Errorf("mode = %v, want the log private to its owner", info.Mode().Perm())
+	}
+}
+
+func TestDebugLogAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "debug.log")
+	os.WriteFile(path, []byte("earlier\n"), 0o600)
+	l, err := OpenDebugLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.Write([]byte("later\n"))
+	l.Close()
+	data, _ := os.ReadFile(path)
+	if !strings.HasPrefix(string(data), "earlier\n") {
+		t.Errorf("log = %q, want earlier runs kept", data)
+	}
+}
@@ -13,16 +13,28 @@ const SampleConfig = `# jira-tui configuration
 jira:
   base_url: https://yourcompany.atlassian.net
   default_project: PROJ  # used by 'c' (create issue) hotkey
+  # team_groups: [team-payments]  # listed first when assigning; defaults to your own small groups
+  # max_concurrent_requests: 4  # requests in flight at once; 429 and 503 answers are retried either way
+  # auth_type: bearer  # send the token as a Bearer header, as Data Center personal access tokens need; no email then
+  # proxy: http://proxy.corp:3128  # or https://, socks5://; defaults to HTTPS_PROXY
+  # ca_bundle: corp-ca.pem  # PEM certificates to trust besides the system's, relative to this directory
+  # insecure_skip_verify: true  # don't check TLS certificates at all; exposes your token, last resort only
 
 tabs:
   - label: "My Sprint"
     filter_id: "10042"
     columns: [key, summary, status, assignee, priority]
     sort: priority
+    refresh_interval: 5m   # reload in the background; omit to refresh with 'r' only
+    # group_by: status     # or assignee, priority, epic; 'z' collapses a group
+    # tree: true           # issues under their parents, epic → story → subtask; 'T' toggles
 
   - label: "Backlog"
     filter_id: "10043"
     columns: [key, summary, status, priority]
+    # widths: {key: 12, status: 14}   # fixed widths; 'C' in the app edits these
+    # description_limit: 60           # characters of a 'description' column (default 80)
+    # overflow: scroll                # keep columns readable on narrow windows; '<'/'>' pan
 
   - label: "Bugs"
     filter_id: "10100"
@@ -33,6 +45,122 @@ tabs:
   # - label: "Recent"
   #   jql: "project = PROJ AND updated >= -7d ORDER BY updated DESC"
   #   columns: [key, summary, status, assignee]
+
+# Tab results are cached in .jira-tui/tab_cache.json and shown instantly on
+# startup. Results younger than ttl are reused without a fetch; older ones
+# are marked stale while they refresh in the background.
+# cache:
+#   ttl: 5m
+
+# The quick filter ('/') matches substrings by default. fuzzy matches
+# characters in order (fzf-style) and ranks the best matches first;
+# ctrl+f in the filter bar switches modes. persist keeps each tab's filter
+# when you switch tabs or the tab reloads, instead of clearing it.
+# quick_filter:
+#   mode: fuzzy
+#   persist: true
+
+# Shared team config (file path or http(s) URL) this file is layered on.
+# Settings and tabs (matched by label) here override the team's; a URL's
+# last copy is kept in .jira-tui/team_config.cache.yaml for offline starts.
+# team_config: https://wiki.example.com/jira-tui/team.yaml
+
+# Where y, u, and exports copy to: auto (default) uses the system clipboard
+# and, when there is none (headless or SSH sessions), OSC 52 escapes that
+# the terminal emulator copies locally; system or osc52 use only one.
+# clipboard: osc52
+
+# Select-type custom fields to quick-edit with 'f', like priority.
+# Find field ids under Jira settings → Issues → Custom fields.
+# custom_fields:
+#   - name: Severity
+#     field_id: customfield_10050
+
+# Scaffolds 'e' offers for an empty description, by issue type. Bug and
+# Story have built-in ones; set a type to "" to turn its scaffold off.
+# description_templates:
+#   Bug: |
+#     Steps to Reproduce:
+#     1.
+#
+#     Expected:
+#
+#     Actual:
+#   Story: ""
+
+# Which transition 'd' takes when an issue has several into a done
+# status, matched by transition or status name. With more than one left
+# and none preferred, 'd' asks.
+# done_transitions:
+#   prefer: [Done, Resolve Issue]
+#   exclude: ["Won't Do", Duplicate]
+
+# The link types 'I' offers when linking two issues. They are fetched from
+# Jira once and cached per instance in link_types.json (delete it after
+# adding a type in Jira). default is listed first; hide leaves out types
+# you never use. Names match a type or its wording, like "is blocked by".
+# link_types:
+#   default: Blocks
+#   hide: [Cloners, Duplicate]
+
+# Colors. name picks a built-in theme: dark (the default), light for light
+# terminals, or auto to pick between them by the terminal's background.
+# Any color can then be overridden: an ANSI color number, "#RRGGBB", or
+# {light: ..., dark: ...} for one per background. Keys are accent,
+# accent_text, tab_text, tab_background, muted, border, error, success,
+# warning; status by category (new, indeterminate, done, other); priority
+# by level (highest, high, medium, medium_low, low). statuses and
+# priorities style names from custom workflows and priority schemes, which
+# otherwise get their category's or no color: a color, or an icon and a
+# color. A priority's icon replaces its name in the table; a status's goes
+# before it. Pick symbols for icons, since they are colored wherever they
+# appear in the table.
+# theme:
+#   name: auto
+#   accent: {light: "#0052CC", dark: "12"}
+#   status: {done: "#36B37E"}
+#   priority: {medium: "#FF8B00"}
+#   statuses:
+#     Code Review: "#6554C0"
+#     Blocked: {icon: "⊘", color: "9"}
+#   priorities:
+#     P1: {icon: "‼", color: "#FF5630"}
+#     P2: {icon: "!", color: "#FF7452"}
+
+# The status bar's segments, in order: user, connection (read-only,
+# offline, failed changes), refresh (when the tab was loaded), counts
+# (issues in the tab), filter (the tab's JQL), clock, message, and keys
+# (hints). Without it the bar shows user, connection, refresh, counts,
+# message, and keys, refresh and counts only when stale or truncated.
+# status_bar:
+#   segments: [user, connection, counts, refresh, clock, message]
+
+# What the tab bar shows beside each label: counts (the number of issues,
+# the default), new (also how many arrived since you last looked at the
+# tab), or none. Tabs whose results are older than stale_after (10m by
+# default, 0 for never) also show their age; R refreshes every tab.
+# tab_bar:
+#   badges: new
+#   stale_after: 15m
+
+# Show a preview of the selected issue beside the list, as | does, from
+# the start. list_width is the list's share of the width in percent
+# (20-80, default 50); ctrl+left/right adjust it and save it here.
+# split_pane:
+#   enabled: true
+#   list_width: 60
+
+# How long Jira requests may take before giving up, by kind: JQL searches
+# and writes (creates, edits, transitions, deletes). Other reads, and
+# kinds left unset, get 30s. A timed-out tab says so; press r to retry.
+# timeouts:
+#   search: 10s
+#   mutation: 15s
+
+# Ask before every status change, assignment, and priority change, not
+# just deletes. To browse without any risk of changing Jira, start with
+# --read-only instead.
+# confirm_edits: true
 `
 
 // SampleSecrets is the default secrets.yaml written by Init.
@@ -43,6 +171,13 @@ const SampleSecrets = `# jira-tui secrets — DO NOT COMMIT
 jira:
   email: you@yourcompany.com
   api_token: your-api-token-here
+
+  # Instead of a plaintext token you can reference an environment variable
+  # or a command that prints it:
+  # api_token: ${JIRA_TOKEN}
+  # api_token_cmd: "pass show jira/token"
+  # Or leave api_token out and run 'jira-tui auth set' to keep it in the
+  # OS keychain.
 `
 
 // Init creates the .jira-tui directory with sample config and secrets files.
@@ -59,12 +194,13 @@ func Init() (string, error) {
 	}
 
 	configPath := filepath.Join(dir, "config.yaml")
-	if err := writeIfNotExists(configPath, SampleConfig); err != nil {
+	if err := writeIfNotExists(configPath, SampleConfig, 0o644); err != nil {
 		return dir, err
 	}
 
 	secretsPath := filepath.Join(dir, "secrets.yaml")
-	if err := writeIfNotExists(secretsPath, SampleSecrets); err != nil {
+	// Only the owner may read credentials
+	if err := writeIfNotExists(secretsPath, SampleSecrets, 0o600); err != nil {
 		return dir, err
 	}
 
@@ -81,11 +217,11 @@ func DirExists() bool {
 	return err == nil && info.IsDir()
 }
 
-func writeIfNotExists(path, content string) error {
+func writeIfNotExists(path, content string, perm os.FileMode) error {
 	if _, err := os.Stat(path); err == nil {
 		return nil // already exists — don't overwrite
 	}
-	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+	if err := os.WriteFile(path, []byte(content), perm); err != nil {
 		return fmt.Errorf("writing %s: %w", filepath.Base(path), err)
 	}
 	return nil
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// MaxJQLHistory is the number of recent ad-hoc queries kept.
+const MaxJQLHistory = 20
+
+// JQLHistoryPath returns the path to the JQL search history file.
+func JQLHistoryPath() (string, error) {
+	dir, err := DefaultConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "jql_history.json"), nil
+}
+
+// LoadJQLHistory reads the recent queries, newest first. Returns nil, nil
+// if the file does not exist yet.
+func LoadJQLHistory() ([]string, error) {
+	path, err := JQLHistoryPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading JQL history: %w", err)
+	}
+
+	var history []string
+	if err := json.Unmarshal(data, &history); err != nil {
+		return nil, fmt.Errorf("parsing JQL history: %w", err)
+	}
+	return history, nil
+}
+
+// SaveJQLHistory writes the recent queries to the history file.
+func SaveJQLHistory(history []string) error {
+	path, err := JQLHistoryPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating config dir: %w", err)
+	}
+
+	data, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling JQL history: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("writing JQL history: %w", err)
+	}
+	return nil
+}
+
+// AddJQLHistory returns history with query moved to the front, without
+// duplicates and capped at MaxJQLHistory entries.
+func AddJQLHistory(history []string, query string) []string {
+	out := make([]string, 0, len(history)+1)
+	out = append(out, query)
+	for _, q := range history {
+		if q != query && len(out) < MaxJQLHistory {
+			out = append(out, q)
+		}
+	}
+	return out
+}
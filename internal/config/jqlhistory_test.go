@@ -0,0 +1,32 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestAddJQLHistory(t *testing.T) {
+	got := AddJQLHistory([]string{"a", "b", "c"}, "b")
+	if want := []string{"b", "a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	got = AddJQLHistory(nil, "a")
+	if want := []string{"a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestAddJQLHistoryCaps(t *testing.T) {
+	var history []string
+	for i := 0; i < MaxJQLHistory+5; i++ {
+		history = AddJQLHistory(history, fmt.Sprintf("q%d", i))
+	}
+	if len(history) != MaxJQLHistory {
+		t.Fatalf("len = %d, want %d", len(history), MaxJQLHistory)
+	}
+	if history[0] != fmt.Sprintf("q%d", MaxJQLHistory+4) {
+		t.Errorf("newest = %q", history[0])
+	}
+}
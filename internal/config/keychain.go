@@ -0,0 +1,74 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/zalando/go-keyring"
+	"gopkg.in/yaml.v3"
+)
+
+// KeychainService is the service name the API token is stored under in
+// the OS keychain (macOS Keychain, Windows Credential Manager, libsecret).
+const KeychainService = "jira-tui"
+
+// KeychainAccount is the account a token is stored under in the keychain:
+// the email, or the Jira base URL when there is no email, as with bearer
+// auth.
+func KeychainAccount(email, baseURL string) string {
+	if email != "" {
+		return email
+	}
+	return baseURL
+}
+
+// KeychainToken returns the API token stored for account, or "" if there
+// is none.
+func KeychainToken(account string) (string, error) {
+	token, err := keyring.Get(KeychainService, account)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("reading keychain: %w", err)
+	}
+	return token, nil
+}
+
+// SetKeychainToken stores the API token for account in the OS keychain.
+func SetKeychainToken(account, token string) error {
+	if err := keyring.Set(KeychainService, account, token); err != nil {
+		return fmt.Errorf("writing keychain: %w", err)
+	}
+	return nil
+}
+
+// SecretsEmail returns the email from the secrets file, with ${NAME}
+// references expanded. The keychain entry is keyed by it.
+func SecretsEmail(secretsPath string) (string, error) {
+	data, err := os.ReadFile(secretsPath)
+	if err != nil {
+		return "", fmt.Errorf("reading secrets file: %w", err)
+	}
+	var secrets SecretsConfig
+	if err := yaml.Unmarshal(data, &secrets); err != nil {
+		return "", fmt.Errorf("parsing secrets file: %w", err)
+	}
+	email, err := expandEnvRefs(secrets.Jira.Email)
+	if err != nil {
+		return "", fmt.Errorf("jira.email: %w", err)
+	}
+	return email, nil
+}
+
+// ConfigBaseURL returns jira.base_url from the config file, layered over
+// its team config like Load does, for keying a bearer token that has no
+// email.
+func ConfigBaseURL(configPath string) (string, error) {
+	cfg, err := loadSettings(configPath)
+	if err != nil {
+		return "", err
+	}
+	return cfg.Jira.BaseURL, nil
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/zalando/go-keyring"
+)
+
+func TestMain(m *testing.M) {
+	// Never touch the real keychain from tests
+	keyring.MockInit()
+	os.Exit(m.Run())
+}
+
+func TestKeychainToken(t *testing.T) {
+	token, err := KeychainToken("nobody@example.com")
+	if err != nil || token != "" {
+		t.Fatalf("missing entry: token=%q err=%v", token, err)
+	}
+	if err := SetKeychainToken("me@example.com", "kc-token"); err != nil {
+		t.Fatalf("SetKeychainToken: %v", err)
+	}
+	token, err = KeychainToken("me@example.com")
+	if err != nil || token != "kc-token" {
+		t.Errorf("token=%q err=%v", token, err)
+	}
+}
+
+func TestLoadTokenFromKeychain(t *testing.T) {
+	if err := SetKeychainToken("kc@example.com", "from-keychain"); err != nil {
+		t.Fatalf("SetKeychainToken: %v", err)
+	}
+	cfgPath := writeTestFile(t, "config.yaml", validConfigWithTabs)
+	secPath := writeTestFile(t, "secrets.yaml", `
+jira:
+  email: kc@example.com
+`)
+	cfg, err := Load(cfgPath, secPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Jira.APIToken != "from-keychain" {
+		t.Errorf("APIToken = %q", cfg.Jira.APIToken)
+	}
+}
+
+func TestSecretsEmail(t *testing.T) {
+	t.Setenv("JIRA_TEST_EMAIL", "env@example.com")
+	path := writeTestFile(t, "secrets.yaml", `
+jira:
+  email: ${JIRA_TEST_EMAIL}
+`)
+	email, err := SecretsEmail(path)
+	if err != nil || email != "env@example.com" {
+		t.Errorf("email=%q err=%v", email, err)
+	}
+}
+
+func TestLoadBearerTokenFromKeychain(t *testing.T) {
+	if err := SetKeychainToken("https://example.atlassian.net", "pat-token"); err != nil {
+		t.Fatalf("SetKeychainToken: %v", err)
+	}
+	cfg := strings.Replace(validConfigWithTabs, "jira:\n", "jira:\n  auth_type: bearer\n", 1)
+	cfgPath := writeTestFile(t, "config.yaml", cfg)
+	secPath := writeTestFile(t, "secrets.yaml", "jira: {}\n")
+	loaded, err := Load(cfgPath, secPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loaded.Jira.APIToken != "pat-token" || loaded.Jira.TokenSource != "system keychain" {
+		t.Errorf("APIToken = %q, TokenSource = %q", loaded.Jira.APIToken, loaded.Jira.TokenSource)
+	}
+	if got, err := ConfigBaseURL(cfgPath); err != nil || got != "https://example.atlassian.net" {
+		t.Errorf("ConfigBaseURL = %q, %v", got, err)
+	}
+}
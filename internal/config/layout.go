@@ -0,0 +1,100 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TabLayout is one tab for SaveTabLayout: its config, and the label it
+// has in the config file when it was renamed since.
+type TabLayout struct {
+	Tab      TabConfig
+	Previous string // label in the file before a rename; "" when unchanged
+}
+
+// SaveTabLayout writes the tabs back to the config file in the given
+// order, with their current labels, dropping those left out. Tabs already
+// in the file keep their settings and comments; others, e.g. new ones or
+// the team config's, are added in full.
+func SaveTabLayout(configPath string, tabs []TabLayout) error {
+	doc, root, err := readYAMLMapping(configPath)
+	if err != nil {
+		return err
+	}
+
+	old := mappingValue(root, "tabs")
+	var items []*yaml.Node
+	for _, l := range tabs {
+		label := l.Previous
+		if label == "" {
+			label = l.Tab.Label
+		}
+		node := tabNode(old, label)
+		if node == nil {
+			node = &yaml.Node{}
+			if err := node.Encode(l.Tab); err != nil {
+				return fmt.Errorf("encoding tab %s: %w", l.Tab.Label, err)
+			}
+		} else if l.Previous != "" {
+			setMappingValue(node, "label", scalarNode(l.Tab.Label))
+		}
+		items = append(items, node)
+	}
+	if old == nil || old.Kind != yaml.SequenceNode {
+		setMappingValue(root, "tabs", &yaml.Node{Kind: yaml.SequenceNode, Content: items})
+	} else {
+		old.Content = items
+	}
+
+	return writeYAML(configPath, doc)
+}
+
+// SaveSplitListWidth writes the split pane's list width back to the config
+// file, keeping the rest of the file and its comments as they are.
+func SaveSplitListWidth(configPath string, percent int) error {
+	doc, root, err := readYAMLMapping(configPath)
+	if err != nil {
+		return err
+	}
+
+	split := mappingValue(root, "split_pane")
+	if split == nil || split.Kind != yaml.MappingNode {
+		split = &yaml.Node{Kind: yaml.MappingNode}
+		setMappingValue(root, "split_pane", split)
+	}
+	setMappingValue(split, "list_width", &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: fmt.Sprint(percent)})
+
+	return writeYAML(configPath, doc)
+}
+
+// writeYAML writes doc to the config file. It writes a temporary file
+// beside it and renames it into place, so a reader never sees the file
+// half written, or empty.
+func writeYAML(configPath string, doc *yaml.Node) error {
+	data, err := encodeYAML(doc)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(configPath), ".config-*.yaml")
+	if err != nil {
+		return fmt.Errorf("writing config file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing config file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing config file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("writing config file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), configPath); err != nil {
+		return fmt.Errorf("writing config file: %w", err)
+	}
+	return nil
+}
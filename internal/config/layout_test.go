@@ -0,0 +1,128 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestSaveSplitListWidth(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	original := `jira:
+  base_url: https://example.atlassian.net
+# Preview on the right
+split_pane:
+  enabled: true
+`
+	if err := os.WriteFile(path, []byte(original), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := SaveSplitListWidth(path, 65); err != nil {
+		t.Fatalf("SaveSplitListWidth: %v", err)
+	}
+	if err := SaveSplitListWidth(path, 60); err != nil {
+		t.Fatalf("SaveSplitListWidth again: %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), "# Preview on the right") {
+		t.Errorf("comments should be kept:\n%s", data)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.SplitPane.Enabled || cfg.SplitPane.ListWidth != 60 {
+		t.Errorf("split_pane = %+v", cfg.SplitPane)
+	}
+}
+
+func TestSaveSplitListWidthAddsSection(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("jira:\n  base_url: https://example.atlassian.net\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveSplitListWidth(path, 40); err != nil {
+		t.Fatalf("SaveSplitListWidth: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.SplitPane.ListWidth != 40 || cfg.Jira.BaseURL != "https://example.atlassian.net" {
+		t.Errorf("config = %+v", cfg)
+	}
+}
+
+func TestSaveTabLayout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	original := `jira:
+  base_url: https://example.atlassian.net
+tabs:
+  # The team's sprint
+  - label: "Sprint"
+    jql: "sprint in openSprints()"
+    columns: [key, summary, status]
+  - label: "Backlog"
+    filter_id: "10043"
+    columns: [key, summary]
+  - label: "Old"
+    jql: "project = OLD"
+    columns: [key]
+`
+	if err := os.WriteFile(path, []byte(original), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := SaveTabLayout(path, []TabLayout{
+		{Tab: TabConfig{Label: "Backlog"}},
+		{Tab: TabConfig{Label: "Mine", JQL: "assignee = currentUser()", Columns: []string{"key", "summary"}}},
+		{Tab: TabConfig{Label: "This sprint"}, Previous: "Sprint"},
+	})
+	if err != nil {
+		t.Fatalf("SaveTabLayout: %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), "# The team's sprint") {
+		t.Errorf("comments should be kept:\n%s", data)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		t.Fatal(err)
+	}
+	var labels []string
+	for _, tab := range cfg.Tabs {
+		labels = append(labels, tab.Label)
+	}
+	if strings.Join(labels, ",") != "Backlog,Mine,This sprint" {
+		t.Fatalf("tabs = %v, want the new order without Old", labels)
+	}
+	if cfg.Tabs[0].FilterID != "10043" || cfg.Tabs[1].JQL != "assignee = currentUser()" || cfg.Tabs[2].JQL != "sprint in openSprints()" {
+		t.Errorf("tabs should keep their settings: %+v", cfg.Tabs)
+	}
+}
+
+func TestWriteYAMLLeavesNoTempFiles(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(path, []byte("jira:\n  base_url: https://example.atlassian.net\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveSplitListWidth(path, 40); err != nil {
+		t.Fatalf("SaveSplitListWidth: %v", err)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		t.Errorf("dir = %v, want only config.yaml", names)
+	}
+}
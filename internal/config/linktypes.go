@@ -0,0 +1,67 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// LinkTypeCache maps a Jira base URL to the issue link types defined on
+// that instance, so switching instances doesn't offer the wrong ones.
+type LinkTypeCache map[string][]jira.LinkType
+
+// LinkTypeCachePath returns the path to the link type cache file.
+func LinkTypeCachePath() (string, error) {
+	dir, err := DefaultConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "link_types.json"), nil
+}
+
+// LoadLinkTypeCache reads the cached link types. Returns nil, nil if the
+// file does not exist yet.
+func LoadLinkTypeCache() (LinkTypeCache, error) {
+	path, err := LinkTypeCachePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading link type cache: %w", err)
+	}
+
+	var cache LinkTypeCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("parsing link type cache: %w", err)
+	}
+	return cache, nil
+}
+
+// SaveLinkTypeCache writes the link types to the cache file.
+func SaveLinkTypeCache(cache LinkTypeCache) error {
+	path, err := LinkTypeCachePath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating config dir: %w", err)
+	}
+
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling link type cache: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("writing link type cache: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestLinkTypeCacheJSON(t *testing.T) {
+	cache := LinkTypeCache{
+		"https://a.atlassian.net": {{ID: "1", Name: "Blocks", Inward: "is blocked by", Outward: "blocks"}},
+		"https://b.atlassian.net": {{ID: "9", Name: "Causes", Inward: "is caused by", Outward: "causes"}},
+	}
+	data, err := json.Marshal(cache)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var loaded LinkTypeCache
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got := loaded["https://b.atlassian.net"]; len(got) != 1 || got[0] != (jira.LinkType{ID: "9", Name: "Causes", Inward: "is caused by", Outward: "causes"}) {
+		t.Errorf("instance b = %+v, want its own types", got)
+	}
+}
@@ -0,0 +1,109 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+// envRefPattern matches ${NAME} references in secret values.
+var envRefPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// expandEnvRefs replaces ${NAME} references with environment variables.
+// Unlike os.ExpandEnv, a bare $ is left alone and an unset variable is an
+// error rather than an empty string.
+func expandEnvRefs(value string) (string, error) {
+	var missing []string
+	expanded := envRefPattern.ReplaceAllStringFunc(value, func(ref string) string {
+		name := envRefPattern.FindStringSubmatch(ref)[1]
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return v
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("environment variable %s is not set", strings.Join(missing, ", "))
+	}
+	return expanded, nil
+}
+
+// runSecretCmd runs command through the shell and returns its trimmed
+// stdout, e.g. "pass show jira/token".
+func runSecretCmd(command string) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("running %q: %w: %s", command, err, msg)
+		}
+		return "", fmt.Errorf("running %q: %w", command, err)
+	}
+	value := strings.TrimSpace(string(out))
+	if value == "" {
+		return "", fmt.Errorf("running %q: no output", command)
+	}
+	return value, nil
+}
+
+// resolve evaluates environment references and the token command so the
+// plaintext values never have to live in secrets.yaml. With neither a token
+// nor a command, the token is looked up in the OS keychain, under the email
+// or, without one as for bearer auth, under baseURL.
+func (s *JiraSecrets) resolve(baseURL string) error {
+	email, err := expandEnvRefs(s.Email)
+	if err != nil {
+		return fmt.Errorf("jira.email: %w", err)
+	}
+	s.Email = email
+
+	if s.APITokenCmd != "" {
+		if s.APIToken != "" {
+			return fmt.Errorf("set only one of jira.api_token or jira.api_token_cmd")
+		}
+		token, err := runSecretCmd(s.APITokenCmd)
+		if err != nil {
+			return fmt.Errorf("jira.api_token_cmd: %w", err)
+		}
+		s.APIToken = token
+		s.TokenSource = "api_token_cmd"
+		return nil
+	}
+	if s.APIToken == "" {
+		account := KeychainAccount(s.Email, baseURL)
+		if account == "" {
+			return nil
+		}
+		token, err := KeychainToken(account)
+		if err != nil {
+			return fmt.Errorf("jira.api_token is empty and %w", err)
+		}
+		s.APIToken = token
+		if token != "" {
+			s.TokenSource = "system keychain"
+		}
+		return nil
+	}
+	token, err := expandEnvRefs(s.APIToken)
+	if err != nil {
+		return fmt.Errorf("jira.api_token: %w", err)
+	}
+	if token != s.APIToken {
+		s.TokenSource = "environment variable"
+	} else {
+		s.TokenSource = "secrets.yaml"
+	}
+	s.APIToken = token
+	return nil
+}
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestExpandEnvRefs(t *testing.T) {
+	t.Setenv("JIRA_TEST_TOKEN", "s3cret")
+
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"plain", "plain-token", "plain-token", false},
+		{"reference", "${JIRA_TEST_TOKEN}", "s3cret", false},
+		{"embedded", "pre-${JIRA_TEST_TOKEN}-post", "pre-s3cret-post", false},
+		{"bare dollar kept", "abc$def", "abc$def", false},
+		{"unset", "${JIRA_TEST_UNSET_VAR}", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandEnvRefs(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveSecrets(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh commands")
+	}
+	t.Setenv("JIRA_TEST_EMAIL", "me@example.com")
+
+	tests := []struct {
+		name       string
+		secrets    JiraSecrets
+		wantToken  string
+		wantSource string
+		wantErr    string
+	}{
+		{"token command", JiraSecrets{APITokenCmd: "echo ' from-cmd '"}, "from-cmd", "api_token_cmd", ""},
+		{"failing command", JiraSecrets{APITokenCmd: "echo nope >&2; exit 3"}, "", "", "nope"},
+		{"empty output", JiraSecrets{APITokenCmd: "true"}, "", "", "no output"},
+		{"both set", JiraSecrets{APIToken: "x", APITokenCmd: "echo y"}, "", "", "only one"},
+		{"env token", JiraSecrets{APIToken: "${JIRA_TEST_EMAIL}"}, "me@example.com", "environment variable", ""},
+		{"plain token", JiraSecrets{APIToken: "plain"}, "plain", "secrets.yaml", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := tt.secrets
+			s.Email = "${JIRA_TEST_EMAIL}"
+			err := s.resolve("https://example.atlassian.net")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if s.APIToken != tt.wantToken || s.Email != "me@example.com" {
+				t.Errorf("token=%q email=%q", s.APIToken, s.Email)
+			}
+			if s.TokenSource != tt.wantSource {
+				t.Errorf("TokenSource = %q, want %q", s.TokenSource, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestLoadSecretsFromEnv(t *testing.T) {
+	t.Setenv("JIRA_TEST_TOKEN", "env-token")
+	cfgPath := writeTestFile(t, "config.yaml", validConfigWithTabs)
+	secPath := writeTestFile(t, "secrets.yaml", `
+jira:
+  email: user@example.com
+  api_token: ${JIRA_TEST_TOKEN}
+`)
+	cfg, err := Load(cfgPath, secPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Jira.APIToken != "env-token" {
+		t.Errorf("APIToken = %q", cfg.Jira.APIToken)
+	}
+}
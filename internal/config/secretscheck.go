@@ -0,0 +1,62 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+)
+
+// CheckSecretsFile returns warnings, with the command that fixes each, when
+// the secrets file is readable by other users or could end up in a git
+// repository. Checks that can't run (no git, Windows permissions) are
+// skipped.
+func CheckSecretsFile(path string) []string {
+	var warnings []string
+	if w := checkSecretsMode(path); w != "" {
+		warnings = append(warnings, w)
+	}
+	if w := checkSecretsGit(path); w != "" {
+		warnings = append(warnings, w)
+	}
+	return warnings
+}
+
+// checkSecretsMode warns when group or others have any access to the file.
+// Windows doesn't use Unix permission bits, so it is skipped there.
+func checkSecretsMode(path string) string {
+	if runtime.GOOS == "windows" {
+		return ""
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return ""
+	}
+	if perm := info.Mode().Perm(); perm&0o077 != 0 {
+		return fmt.Sprintf("%s is readable by other users (mode %04o); run: chmod 600 %s", path, perm, path)
+	}
+	return ""
+}
+
+// checkSecretsGit warns when the file is tracked by git, or sits in a
+// repository without being ignored.
+func checkSecretsGit(path string) string {
+	if _, err := exec.LookPath("git"); err != nil {
+		return ""
+	}
+	dir, name := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+	if exec.Command("git", "-C", dir, "rev-parse", "--is-inside-work-tree").Run() != nil {
+		return "" // not in a repository
+	}
+	if exec.Command("git", "-C", dir, "ls-files", "--error-unmatch", "--", name).Run() == nil {
+		return fmt.Sprintf("%s is tracked by git; run: git rm --cached %s, add it to .gitignore, and rotate the API token", path, path)
+	}
+	if exec.Command("git", "-C", dir, "check-ignore", "-q", "--", name).Run() != nil {
+		return fmt.Sprintf("%s is in a git repository but not ignored; add it to .gitignore", path)
+	}
+	return ""
+}
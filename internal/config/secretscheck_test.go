@@ -0,0 +1,66 @@
+package config
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestCheckSecretsFileMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Unix permissions only")
+	}
+	path := writeTestFile(t, "secrets.yaml", validSecrets)
+	if err := os.Chmod(path, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	warnings := CheckSecretsFile(path)
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "chmod 600") {
+		t.Errorf("warnings = %v, want a chmod hint", warnings)
+	}
+
+	if err := os.Chmod(path, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if warnings := CheckSecretsFile(path); len(warnings) != 0 {
+		t.Errorf("0600 file should pass, got %v", warnings)
+	}
+}
+
+func TestCheckSecretsFileGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "-C", dir, "init", "-q").CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	path := filepath.Join(dir, "secrets.yaml")
+	if err := os.WriteFile(path, []byte(validSecrets), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	warnings := CheckSecretsFile(path)
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "not ignored") {
+		t.Errorf("untracked file: warnings = %v", warnings)
+	}
+
+	if out, err := exec.Command("git", "-C", dir, "add", "secrets.yaml").CombinedOutput(); err != nil {
+		t.Fatalf("git add: %v: %s", err, out)
+	}
+	warnings = CheckSecretsFile(path)
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "git rm --cached") {
+		t.Errorf("tracked file: warnings = %v", warnings)
+	}
+
+	exec.Command("git", "-C", dir, "rm", "-q", "--cached", "secrets.yaml").Run()
+	if err := os.WriteFile(filepath.Join(dir, ".gitignore"), []byte("secrets.yaml\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if warnings := CheckSecretsFile(path); len(warnings) != 0 {
+		t.Errorf("ignored file should pass, got %v", warnings)
+	}
+}
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// TabCacheEntry is the last search result of one tab.
+type TabCacheEntry struct {
+	FetchedAt time.Time    `json:"fetchedAt"`
+	RankField string       `json:"rankField,omitempty"` // Rank field id for the rank column
+	Issues    []jira.Issue `json:"issues"`
+}
+
+// TabCache maps TabCacheKey values to cached results.
+type TabCache map[string]TabCacheEntry
+
+// TabCacheKey identifies a tab's results. It changes when the tab's query
+// or columns change, since the cached issues would no longer match.
+func TabCacheKey(tab TabConfig) string {
+	return strings.Join([]string{
+		tab.Label, tab.FilterID, tab.FilterURL, tab.JQL, strings.Join(tab.Columns, ","),
+	}, "\x1f")
+}
+
+// TabCachePath returns the path to the tab results cache file.
+func TabCachePath() (string, error) {
+	dir, err := DefaultConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "tab_cache.json"), nil
+}
+
+// LoadTabCache reads the cached tab results. Returns nil, nil if the file
+// does not exist yet.
+func LoadTabCache() (TabCache, error) {
+	path, err := TabCachePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading tab cache: %w", err)
+	}
+
+	var cache TabCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("parsing tab cache: %w", err)
+	}
+	return cache, nil
+}
+
+// SaveTabCache writes the tab results to the cache file.
+func SaveTabCache(cache TabCache) error {
+	path, err := TabCachePath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating config dir: %w", err)
+	}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return fmt.Errorf("marshaling tab cache: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("writing tab cache: %w", err)
+	}
+	return nil
+}
+
+// TTLDuration parses TTL. An empty TTL is zero: cached results are shown
+// on startup but always refreshed.
+func (c CacheConfig) TTLDuration() (time.Duration, error) {
+	if c.TTL == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(c.TTL)
+	if err != nil {
+		return 0, fmt.Errorf("cache.ttl: %w", err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("cache.ttl must not be negative")
+	}
+	return d, nil
+}
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestTabCacheKey(t *testing.T) {
+	base := TabConfig{Label: "Sprint", FilterID: "1", Columns: []string{"key", "summary"}}
+	key := TabCacheKey(base)
+
+	changed := base
+	changed.Columns = []string{"key", "summary", "rank"}
+	if TabCacheKey(changed) == key {
+		t.Error("changing columns should change the key")
+	}
+	changed = base
+	changed.FilterID = "2"
+	if TabCacheKey(changed) == key {
+		t.Error("changing the filter should change the key")
+	}
+	if TabCacheKey(base) != key {
+		t.Error("key should be stable")
+	}
+}
+
+func TestTabCacheJSONKeepsCustomFields(t *testing.T) {
+	var issue jira.Issue
+	if err := json.Unmarshal([]byte(`{"key":"A-1","fields":{"summary":"Hi","customfield_10019":"0|i0001:"}}`), &issue); err != nil {
+		t.Fatal(err)
+	}
+	cache := TabCache{"k": {FetchedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), Issues: []jira.Issue{issue}}}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var loaded TabCache
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	got := loaded["k"]
+	if !got.FetchedAt.Equal(cache["k"].FetchedAt) || len(got.Issues) != 1 {
+		t.Fatalf("round trip = %+v", got)
+	}
+	if got.Issues[0].Fields.Summary != "Hi" || got.Issues[0].Fields.CustomString("customfield_10019") != "0|i0001:" {
+		t.Errorf("issue = %+v", got.Issues[0].Fields)
+	}
+}
+
+func TestCacheTTLDuration(t *testing.T) {
+	tests := []struct {
+		ttl     string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"", 0, false},
+		{"5m", 5 * time.Minute, false},
+		{"soon", 0, true},
+		{"-1m", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := CacheConfig{TTL: tt.ttl}.TTLDuration()
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("TTLDuration(%q) = %v, %v", tt.ttl, got, err)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// teamFetchTimeout bounds how long startup waits for a remote team config.
+const teamFetchTimeout = 10 * time.Second
+
+// teamCacheFile keeps the last team config fetched from a URL, so startup
+// still works when the URL is unreachable.
+const teamCacheFile = "team_config.cache.yaml"
+
+// layerTeamConfig merges the personal config over the team config it names.
+// Settings missing from the personal config come from the team; tabs
+// (matched by label) and other settings present in both take the personal
+// value. Credentials and team_config itself are ignored in the team file.
+func layerTeamConfig(personal []byte, ref, configDir string, network JiraConfig) ([]byte, []string, error) {
+	data, warnings, err := fetchTeamConfig(ref, configDir, network)
+	if err != nil {
+		return nil, nil, err
+	}
+	doc, team, err := parseYAMLMapping(data, "team config "+ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	stripSecrets(team)
+	removeMappingKey(team, "team_config")
+
+	_, own, err := parseYAMLMapping(personal, "config file")
+	if err != nil {
+		return nil, nil, err
+	}
+	mergeMapping(team, own, "", true, &ImportResult{})
+
+	merged, err := encodeYAML(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return merged, warnings, nil
+}
+
+// fetchTeamConfig reads the team config from a file, relative paths being
+// resolved against the config directory, or from an http(s) URL through
+// network's proxy and TLS settings. A URL that can't be fetched falls back to the last copy that
+// could.
+func fetchTeamConfig(ref, configDir string, network JiraConfig) ([]byte, []string, error) {
+	if !isURL(ref) {
+		path := ref
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(configDir, path)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading team config: %w", err)
+		}
+		return data, nil, nil
+	}
+
+	cachePath := filepath.Join(configDir, teamCacheFile)
+	data, err := downloadTeamConfig(ref, network)
+	if err == nil {
+		// Best effort: a missing cache only matters the next time the URL is down
+		_ = os.WriteFile(cachePath, data, 0o644)
+		return data, nil, nil
+	}
+	cached, cacheErr := os.ReadFile(cachePath)
+	if cacheErr != nil {
+		return nil, nil, fmt.Errorf("fetching team config: %w", err)
+	}
+	warning := fmt.Sprintf("Team config unavailable (%v); using the cached copy", err)
+	if info, statErr := os.Stat(cachePath); statErr == nil {
+		warning = fmt.Sprintf("Team config unavailable (%v); using the copy from %s",
+			err, info.ModTime().Format("2006-01-02 15:04"))
+	}
+	return cached, []string{warning}, nil
+}
+
+// downloadTeamConfig GETs a team config URL, through the same proxy and
+// with the same trusted CAs as Jira.
+func downloadTeamConfig(url string, network JiraConfig) ([]byte, error) {
+	t, err := jira.NewTransport(jira.TransportConfig{
+		Proxy:              network.Proxy,
+		CABundle:           network.CABundle,
+		InsecureSkipVerify: network.InsecureSkipVerify,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), teamFetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := (&http.Client{Transport: t}).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// isURL reports whether ref names an http(s) URL rather than a file.
+func isURL(ref string) bool {
+	return strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://")
+}
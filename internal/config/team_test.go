@@ -0,0 +1,156 @@
+package config
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const teamConfig = `
+jira:
+  base_url: https://team.atlassian.net
+  default_project: TEAM
+  api_token: leaked
+tabs:
+  - label: "Sprint"
+    jql: "sprint in openSprints()"
+    columns: [key, summary, status]
+  - label: "Bugs"
+    jql: "type = Bug"
+    columns: [key, summary]
+custom_fields:
+  - name: Severity
+    field_id: customfield_10050
+`
+
+func TestLoadLayersPersonalOverTeamConfig(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "team.yaml"), []byte(teamConfig), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfgPath := filepath.Join(dir, "config.yaml")
+	personal := `
+team_config: team.yaml
+jira:
+  default_project: MINE
+tabs:
+  - label: "Bugs"
+    jql: "type = Bug AND assignee = currentUser()"
+    columns: [key, summary, priority]
+  - label: "Mine"
+    jql: "assignee = currentUser()"
+    columns: [key, summary]
+`
+	if err := os.WriteFile(cfgPath, []byte(personal), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+
+	cfg, err := Load(cfgPath, secPath)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Jira.BaseURL != "https://team.atlassian.net" || cfg.Jira.DefaultProject != "MINE" {
+		t.Errorf("jira = %+v, want team base_url and personal default_project", cfg.Jira)
+	}
+	if cfg.Jira.APIToken != "secret-token" {
+		t.Errorf("token = %q, team credentials must be ignored", cfg.Jira.APIToken)
+	}
+	var labels []string
+	for _, tab := range cfg.Tabs {
+		labels = append(labels, tab.Label)
+	}
+	if got := strings.Join(labels, ","); got != "Sprint,Bugs,Mine" {
+		t.Errorf("tabs = %s, want Sprint,Bugs,Mine", got)
+	}
+	if cfg.Tabs[1].JQL != "type = Bug AND assignee = currentUser()" {
+		t.Errorf("Bugs tab should be the personal override, got %q", cfg.Tabs[1].JQL)
+	}
+	if len(cfg.CustomFields) != 1 {
+		t.Errorf("custom fields should come from the team config, got %v", cfg.CustomFields)
+	}
+}
+
+func TestLoadTeamConfigMissingFile(t *testing.T) {
+	cfgPath := writeTestFile(t, "config.yaml", "team_config: nope.yaml\n")
+	secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+	if _, err := Load(cfgPath, secPath); err == nil || !strings.Contains(err.Error(), "team config") {
+		t.Errorf("err = %v, want team config error", err)
+	}
+}
+
+func TestTeamConfigURLFallsBackToCache(t *testing.T) {
+	up := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !up {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(teamConfig))
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(cfgPath, []byte("team_config: "+srv.URL+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+	os.Chmod(secPath, 0o600)
+
+	cfg, err := Load(cfgPath, secPath)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(cfg.Tabs) != 2 || len(cfg.Warnings) != 0 {
+		t.Fatalf("tabs = %d, warnings = %v", len(cfg.Tabs), cfg.Warnings)
+	}
+
+	up = false
+	cfg, err = Load(cfgPath, secPath)
+	if err != nil {
+		t.Fatalf("Load with the URL down: %v", err)
+	}
+	if len(cfg.Tabs) != 2 || len(cfg.Warnings) != 1 {
+		t.Errorf("tabs = %d, warnings = %v; want cached tabs and a warning", len(cfg.Tabs), cfg.Warnings)
+	}
+
+	os.Remove(filepath.Join(dir, teamCacheFile))
+	if _, err := Load(cfgPath, secPath); err == nil {
+		t.Error("expected an error with the URL down and no cache")
+	}
+}
+
+func TestTeamConfigURLUsesCABundle(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(teamConfig))
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := os.WriteFile(filepath.Join(dir, "ca.pem"), cert, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfgPath := filepath.Join(dir, "config.yaml")
+	secPath := writeTestFile(t, "secrets.yaml", validSecrets)
+	os.Chmod(secPath, 0o600)
+
+	os.WriteFile(cfgPath, []byte("team_config: "+srv.URL+"\n"), 0o644)
+	if _, err := Load(cfgPath, secPath); err == nil {
+		t.Error("expected the server's certificate to be rejected without ca_bundle")
+	}
+
+	os.WriteFile(cfgPath, []byte("team_config: "+srv.URL+"\njira:\n  ca_bundle: ca.pem\n"), 0o644)
+	cfg, err := Load(cfgPath, secPath)
+	if err != nil {
+		t.Fatalf("Load with ca_bundle: %v", err)
+	}
+	if len(cfg.Tabs) != 2 || len(cfg.Warnings) != 0 {
+		t.Errorf("tabs = %d, warnings = %v", len(cfg.Tabs), cfg.Warnings)
+	}
+}
@@ -0,0 +1,338 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ThemeNames lists the built-in themes; "" is dark.
+var ThemeNames = []string{"dark", "light", "auto"}
+
+// StatusCategories are the status colors a theme sets, by Jira status
+// category; "other" is for statuses without one.
+var StatusCategories = []string{"new", "indeterminate", "done", "other"}
+
+// PriorityLevels are the priority colors a theme sets: highest is for
+// Blocker, Critical, and Highest, low for Low and Lowest.
+var PriorityLevels = []string{"highest", "high", "medium", "medium_low", "low"}
+
+// Color is a terminal color: an ANSI color number such as "12", or
+// "#RRGGBB". Written as {light: ..., dark: ...} it differs by terminal
+// background.
+type Color struct {
+	Light string `yaml:"light"`
+	Dark  string `yaml:"dark"`
+}
+
+// UnmarshalYAML accepts a single color for both backgrounds, or a
+// mapping with one for each.
+func (c *Color) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		c.Light, c.Dark = node.Value, node.Value
+		return nil
+	}
+	type plain Color
+	return node.Decode((*plain)(c))
+}
+
+// IsZero reports whether the color is unset.
+func (c Color) IsZero() bool {
+	return c.Light == "" && c.Dark == ""
+}
+
+// Adaptive reports whether the color differs by terminal background.
+func (c Color) Adaptive() bool {
+	return c.Light != c.Dark
+}
+
+var hexColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// validColor reports whether s is an ANSI color number or "#RRGGBB".
+func validColor(s string) bool {
+	if hexColorPattern.MatchString(s) {
+		return true
+	}
+	n, err := strconv.Atoi(s)
+	return err == nil && n >= 0 && n <= 255
+}
+
+func (c Color) validate() error {
+	for _, s := range []string{c.Light, c.Dark} {
+		if !validColor(s) {
+			return fmt.Errorf("%q is not an ANSI color number (0-255) or #RRGGBB", s)
+		}
+	}
+	return nil
+}
+
+// NameStyle is how one status or priority is shown: an icon, in place of
+// a priority's name or before a status's, and a color. Written as just a
+// color, it keeps the icon.
+type NameStyle struct {
+	Icon  string `yaml:"icon,omitempty"`
+	Color Color  `yaml:"color,omitempty"`
+}
+
+// UnmarshalYAML accepts a color alone, or a mapping with icon and color.
+func (n *NameStyle) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&n.Color)
+	}
+	type plain NameStyle
+	return node.Decode((*plain)(n))
+}
+
+// ThemeConfig sets the colors of the tabs, the table, statuses, and
+// priorities. Name picks a built-in theme; the other fields override its
+// colors one by one.
+type ThemeConfig struct {
+	Name string `yaml:"name,omitempty"` // one of ThemeNames; "" is dark
+
+	Accent        Color `yaml:"accent,omitempty"`         // active tab, selected row, headers, titles
+	AccentText    Color `yaml:"accent_text,omitempty"`    // text on the accent color
+	TabText       Color `yaml:"tab_text,omitempty"`       // inactive tabs
+	TabBackground Color `yaml:"tab_background,omitempty"` // inactive tabs
+	Muted         Color `yaml:"muted,omitempty"`          // hints, labels, counts
+	Border        Color `yaml:"border,omitempty"`
+	Error         Color `yaml:"error,omitempty"`
+	Success       Color `yaml:"success,omitempty"`
+	Warning       Color `yaml:"warning,omitempty"` // loading, mentions of you
+
+	Status   map[string]Color `yaml:"status,omitempty"`   // by StatusCategories
+	Priority map[string]Color `yaml:"priority,omitempty"` // by PriorityLevels
+
+	// By exact name as Jira has it, for custom workflows and priority
+	// schemes; these win over the category and level colors
+	Statuses   map[string]NameStyle `yaml:"statuses,omitempty"`
+	Priorities map[string]NameStyle `yaml:"priorities,omitempty"`
+}
+
+// darkTheme is the original look, made for dark terminals.
+var darkTheme = ThemeConfig{
+	Accent:        solid("12"),
+	AccentText:    solid("0"),
+	TabText:       solid("252"),
+	TabBackground: solid("236"),
+	Muted:         solid("241"),
+	Border:        solid("240"),
+	Error:         solid("9"),
+	Success:       solid("10"),
+	Warning:       solid("11"),
+	Status: map[string]Color{
+		"new":           solid("12"),
+		"indeterminate": solid("11"),
+		"done":          solid("10"),
+		"other":         solid("252"),
+	},
+	Priority: map[string]Color{
+		"highest":    solid("#FF5630"),
+		"high":       solid("#FF7452"),
+		"medium":     solid("#FFAB00"),
+		"medium_low": solid("#6B778C"),
+		"low":        solid("#2684FF"),
+	},
+}
+
+// lightTheme keeps to darker colors that read on a white background.
+var lightTheme = ThemeConfig{
+	Accent:        solid("#0052CC"),
+	AccentText:    solid("#FFFFFF"),
+	TabText:       solid("#172B4D"),
+	TabBackground: solid("#DFE1E6"),
+	Muted:         solid("#6B778C"),
+	Border:        solid("#C1C7D0"),
+	Error:         solid("#DE350B"),
+	Success:       solid("#006644"),
+	Warning:       solid("#974F0C"),
+	Status: map[string]Color{
+		"new":           solid("#42526E"),
+		"indeterminate": solid("#0052CC"),
+		"done":          solid("#006644"),
+		"other":         solid("#42526E"),
+	},
+	Priority: map[string]Color{
+		"highest":    solid("#BF2600"),
+		"high":       solid("#DE350B"),
+		"medium":     solid("#974F0C"),
+		"medium_low": solid("#505F79"),
+		"low":        solid("#0747A6"),
+	},
+}
+
+func solid(c string) Color {
+	return Color{Light: c, Dark: c}
+}
+
+// builtinTheme returns the named built-in theme; auto takes each color
+// from light or dark by the terminal's background.
+func builtinTheme(name string) ThemeConfig {
+	switch name {
+	case "light":
+		return lightTheme
+	case "auto":
+		pick := func(light, dark Color) Color { return Color{Light: light.Light, Dark: dark.Dark} }
+		light, dark := lightTheme, darkTheme
+		t := ThemeConfig{Status: make(map[string]Color), Priority: make(map[string]Color)}
+		lightColors, darkColors := light.colors(), dark.colors()
+		for i, f := range t.colors() {
+			*f.color = pick(*lightColors[i].color, *darkColors[i].color)
+		}
+		for k, c := range dark.Status {
+			t.Status[k] = pick(light.Status[k], c)
+		}
+		for k, c := range dark.Priority {
+			t.Priority[k] = pick(light.Priority[k], c)
+		}
+		return t
+	}
+	return darkTheme
+}
+
+// themeColor is one of a theme's single colors, by its config key.
+type themeColor struct {
+	key   string
+	color *Color
+}
+
+// colors returns the theme's single colors, for going through them all.
+func (t *ThemeConfig) colors() []themeColor {
+	return []themeColor{
+		{"accent", &t.Accent},
+		{"accent_text", &t.AccentText},
+		{"tab_text", &t.TabText},
+		{"tab_background", &t.TabBackground},
+		{"muted", &t.Muted},
+		{"border", &t.Border},
+		{"error", &t.Error},
+		{"success", &t.Success},
+		{"warning", &t.Warning},
+	}
+}
+
+// Resolve returns the theme with every color set: the built-in theme
+// named, with this one's colors laid over it.
+func (t ThemeConfig) Resolve() ThemeConfig {
+	base := builtinTheme(t.Name)
+	out := base
+	out.Name = t.Name
+	own := t.colors()
+	for i, f := range out.colors() {
+		if c := *own[i].color; !c.IsZero() {
+			*f.color = c
+		}
+	}
+	out.Status = overlayColors(base.Status, t.Status)
+	out.Priority = overlayColors(base.Priority, t.Priority)
+	out.Statuses, out.Priorities = t.Statuses, t.Priorities
+	return out
+}
+
+// Adaptive reports whether any of the theme's colors differ by terminal
+// background, so the background needs detecting.
+func (t ThemeConfig) Adaptive() bool {
+	for _, f := range t.colors() {
+		if f.color.Adaptive() {
+			return true
+		}
+	}
+	for _, m := range []map[string]Color{t.Status, t.Priority} {
+		for _, c := range m {
+			if c.Adaptive() {
+				return true
+			}
+		}
+	}
+	for _, m := range []map[string]NameStyle{t.Statuses, t.Priorities} {
+		for _, n := range m {
+			if n.Color.Adaptive() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// overlayColors returns base with the colors in over replacing its own.
+func overlayColors(base, over map[string]Color) map[string]Color {
+	out := make(map[string]Color, len(base))
+	for k, c := range base {
+		out[k] = c
+	}
+	for k, c := range over {
+		out[k] = c
+	}
+	return out
+}
+
+// validate checks the theme name and every color.
+func (t ThemeConfig) validate() error {
+	if t.Name != "" && !slices.Contains(ThemeNames, t.Name) {
+		return fmt.Errorf("theme.name must be one of %s", strings.Join(ThemeNames, ", "))
+	}
+	for _, f := range t.colors() {
+		if f.color.IsZero() {
+			continue
+		}
+		if err := f.color.validate(); err != nil {
+			return fmt.Errorf("theme.%s: %w", f.key, err)
+		}
+	}
+	if err := validateColorMap("theme.status", t.Status, StatusCategories); err != nil {
+		return err
+	}
+	if err := validateColorMap("theme.priority", t.Priority, PriorityLevels); err != nil {
+		return err
+	}
+	if err := validateNameStyles("theme.statuses", t.Statuses); err != nil {
+		return err
+	}
+	return validateNameStyles("theme.priorities", t.Priorities)
+}
+
+// validateNameStyles checks that each name sets an icon or a color, and
+// that its color is valid.
+func validateNameStyles(field string, styles map[string]NameStyle) error {
+	names := make([]string, 0, len(styles))
+	for name := range styles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		n := styles[name]
+		if n.Icon == "" && n.Color.IsZero() {
+			return fmt.Errorf("%s.%s: set an icon, a color, or both", field, name)
+		}
+		if n.Color.IsZero() {
+			continue
+		}
+		if err := n.Color.validate(); err != nil {
+			return fmt.Errorf("%s.%s: %w", field, name, err)
+		}
+	}
+	return nil
+}
+
+// validateColorMap checks that colors only has the given keys, each a
+// valid color.
+func validateColorMap(field string, colors map[string]Color, keys []string) error {
+	names := make([]string, 0, len(colors))
+	for k := range colors {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		if !slices.Contains(keys, k) {
+			return fmt.Errorf("%s.%s: must be one of %s", field, k, strings.Join(keys, ", "))
+		}
+		if err := colors[k].validate(); err != nil {
+			return fmt.Errorf("%s.%s: %w", field, k, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestThemeParseAndResolve(t *testing.T) {
+	var cfg Config
+	err := yaml.Unmarshal([]byte(`
+theme:
+  name: light
+  accent: "#6554C0"
+  muted: {light: "245", dark: "241"}
+  status:
+    done: "2"
+`), &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Theme.validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	theme := cfg.Theme.Resolve()
+	if theme.Accent != (Color{Light: "#6554C0", Dark: "#6554C0"}) {
+		t.Errorf("accent = %+v, want the override for both backgrounds", theme.Accent)
+	}
+	if theme.Muted != (Color{Light: "245", Dark: "241"}) || !theme.Adaptive() {
+		t.Errorf("muted = %+v, want one color per background", theme.Muted)
+	}
+	if theme.Status["done"].Dark != "2" || theme.Status["new"] != lightTheme.Status["new"] {
+		t.Errorf("status = %+v, want done overridden over the light theme", theme.Status)
+	}
+	if theme.Error != lightTheme.Error {
+		t.Errorf("error = %+v, want the light theme's", theme.Error)
+	}
+}
+
+func TestBuiltinThemes(t *testing.T) {
+	for _, name := range append(ThemeNames, "") {
+		theme := ThemeConfig{Name: name}.Resolve()
+		for _, f := range theme.colors() {
+			if err := f.color.validate(); err != nil {
+				t.Errorf("%s.%s: %v", name, f.key, err)
+			}
+		}
+		if len(theme.Status) != len(StatusCategories) || len(theme.Priority) != len(PriorityLevels) {
+			t.Errorf("%s: status = %v, priority = %v, want every key", name, theme.Status, theme.Priority)
+		}
+		if err := theme.validate(); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		if got := theme.Adaptive(); got != (name == "auto") {
+			t.Errorf("%s: Adaptive() = %v", name, got)
+		}
+	}
+}
+
+func TestThemeValidate(t *testing.T) {
+	tests := []struct {
+		theme ThemeConfig
+		want  string
+	}{
+		{ThemeConfig{Name: "solarized"}, "theme.name"},
+		{ThemeConfig{Accent: Color{Light: "blue", Dark: "blue"}}, "theme.accent"},
+		{ThemeConfig{Border: Color{Light: "#FFF", Dark: "#FFF"}}, "theme.border"},
+		{ThemeConfig{Muted: Color{Dark: "241"}}, "theme.muted"},
+		{ThemeConfig{Status: map[string]Color{"closed": solid("2")}}, "theme.status.closed"},
+		{ThemeConfig{Priority: map[string]Color{"high": solid("256")}}, "theme.priority.high"},
+	}
+	for _, tt := range tests {
+		err := tt.theme.validate()
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%+v: err = %v, want %q", tt.theme, err, tt.want)
+		}
+	}
+}
+
+func TestThemeNameStyles(t *testing.T) {
+	var theme ThemeConfig
+	err := yaml.Unmarshal([]byte(`
+statuses:
+  Code Review: "#6554C0"
+  QA: {icon: "◐", color: {light: "5", dark: "13"}}
+priorities:
+  P1: {icon: "!!", color: "9"}
+  P4: {icon: "·"}
+`), &theme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := theme.validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if theme.Statuses["Code Review"] != (NameStyle{Color: solid("#6554C0")}) {
+		t.Errorf("Code Review = %+v, want a color alone", theme.Statuses["Code Review"])
+	}
+	if qa := theme.Statuses["QA"]; qa.Icon != "◐" || qa.Color != (Color{Light: "5", Dark: "13"}) {
+		t.Errorf("QA = %+v", qa)
+	}
+	if !theme.Resolve().Adaptive() {
+		t.Error("a status color per background should make the theme adaptive")
+	}
+	if p1 := theme.Resolve().Priorities["P1"]; p1.Icon != "!!" || p1.Color != solid("9") {
+		t.Errorf("P1 = %+v, want it kept by Resolve", p1)
+	}
+
+	bad := ThemeConfig{Priorities: map[string]NameStyle{"P2": {}}}
+	if err := bad.validate(); err == nil || !strings.Contains(err.Error(), "theme.priorities.P2") {
+		t.Errorf("err = %v, want an error for a priority with nothing set", err)
+	}
+	bad = ThemeConfig{Statuses: map[string]NameStyle{"QA": {Color: solid("purple")}}}
+	if err := bad.validate(); err == nil || !strings.Contains(err.Error(), "theme.statuses.QA") {
+		t.Errorf("err = %v, want a color error", err)
+	}
+}
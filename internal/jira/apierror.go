@@ -0,0 +1,83 @@
+package jira
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// maxErrorBody is how much of a response that isn't Jira's error JSON, such
+// as a proxy's HTML page, an APIError keeps.
+const maxErrorBody = 200
+
+// APIError is a request Jira answered with an error status. Jira explains
+// the failure in errorMessages and, for rejected field values, in errors
+// keyed by field.
+type APIError struct {
+	StatusCode  int
+	Messages    []string
+	FieldErrors map[string]string
+	Body        string // the raw response when it isn't Jira's error JSON
+}
+
+// newAPIError parses an error response's body.
+func newAPIError(status int, body []byte) *APIError {
+	e := &APIError{StatusCode: status}
+	var payload struct {
+		ErrorMessages []string          `json:"errorMessages"`
+		Errors        map[string]string `json:"errors"`
+		Message       string            `json:"message"` // the agile and service desk APIs
+	}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		e.Messages = payload.ErrorMessages
+		if payload.Message != "" {
+			e.Messages = append(e.Messages, payload.Message)
+		}
+		e.FieldErrors = payload.Errors
+		return e
+	}
+	e.Body = strings.TrimSpace(string(body))
+	if len(e.Body) > maxErrorBody {
+		e.Body = e.Body[:maxErrorBody] + "…"
+	}
+	return e
+}
+
+// Message is the readable part of the error: Jira's messages, then each
+// field error naming its field unless the message already does.
+func (e *APIError) Message() string {
+	parts := append([]string(nil), e.Messages...)
+	fields := make([]string, 0, len(e.FieldErrors))
+	for field := range e.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		msg := e.FieldErrors[field]
+		if !strings.Contains(strings.ToLower(msg), strings.ToLower(field)) {
+			msg = field + ": " + msg
+		}
+		parts = append(parts, msg)
+	}
+	switch {
+	case len(parts) > 0:
+		return strings.Join(parts, "; ")
+	case e.Body != "":
+		return e.Body
+	}
+	return http.StatusText(e.StatusCode)
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error %d: %s", e.StatusCode, e.Message())
+}
+
+// AsAPIError returns the APIError in err's chain, if there is one.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	ok := errors.As(err, &apiErr)
+	return apiErr, ok
+}
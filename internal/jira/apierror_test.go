@@ -0,0 +1,62 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAPIErrorFromJiraPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"errorMessages":["Issue is locked"],"errors":{"priority":"Field 'priority' cannot be set. It is not on the appropriate screen, or unknown.","labels":"Bad value"}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	err := c.UpdateIssue(context.Background(), "PROJ-1", map[string]interface{}{"priority": map[string]string{"name": "High"}})
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("err = %v, want an APIError in the chain", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || len(apiErr.Messages) != 1 || len(apiErr.FieldErrors) != 2 {
+		t.Errorf("APIError = %+v", apiErr)
+	}
+	want := "Issue is locked; labels: Bad value; Field 'priority' cannot be set. It is not on the appropriate screen, or unknown."
+	if got := apiErr.Message(); got != want {
+		t.Errorf("Message() = %q, want %q", got, want)
+	}
+	if !strings.HasSuffix(err.Error(), "API error 400: "+want) || strings.Contains(err.Error(), "{") {
+		t.Errorf("err = %q, want the readable message instead of JSON", err)
+	}
+	if apiErrorStatus(err) != http.StatusBadRequest {
+		t.Errorf("status = %d", apiErrorStatus(err))
+	}
+}
+
+func TestAPIErrorWithoutJiraPayload(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{"", "API error 502: Bad Gateway"},
+		{"<html>upstream down</html>\n", "API error 502: <html>upstream down</html>"},
+		{`{"errorMessages":[],"errors":{}}`, "API error 502: Bad Gateway"},
+		{`{"message":"Board does not exist","status-code":404}`, "API error 502: Board does not exist"},
+		{strings.Repeat("x", 300), "API error 502: " + strings.Repeat("x", maxErrorBody) + "…"},
+	}
+	for _, tt := range tests {
+		if got := newAPIError(http.StatusBadGateway, []byte(tt.body)).Error(); got != tt.want {
+			t.Errorf("body %.20q: err = %q, want %q", tt.body, got, tt.want)
+		}
+	}
+	if _, ok := AsAPIError(fmt.Errorf("API error 500: text only")); ok {
+		t.Error("a plain error isn't an APIError")
+	}
+	if apiErrorStatus(fmt.Errorf("API error 500: text only")) != 500 {
+		t.Error("the status should still be read from the text")
+	}
+}
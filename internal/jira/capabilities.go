@@ -0,0 +1,32 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+)
+
+// HasAgileAPI reports whether the instance has the Agile API behind
+// boards, sprints, and ranking, which comes with Jira Software.
+func (c *Client) HasAgileAPI(ctx context.Context) (bool, error) {
+	return c.probe(ctx, "/rest/agile/1.0/board?maxResults=1")
+}
+
+// HasDevStatus reports whether the instance has the dev-status API behind
+// the Development panel. Asked without an issue, it answers 400 when it's
+// there.
+func (c *Client) HasDevStatus(ctx context.Context) (bool, error) {
+	return c.probe(ctx, "/rest/dev-status/latest/issue/summary")
+}
+
+// probe reports whether an API answers at path. Only 404 means it's
+// missing: any other answer, even an error, comes from the API itself.
+func (c *Client) probe(ctx context.Context, path string) (bool, error) {
+	_, err := c.do(ctx, http.MethodGet, path, nil)
+	if err == nil {
+		return true, nil
+	}
+	if apiErr, ok := AsAPIError(err); ok {
+		return apiErr.StatusCode != http.StatusNotFound, nil
+	}
+	return false, err
+}
@@ -5,9 +5,18 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"net"
 	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -17,6 +26,19 @@ type Client struct {
 	httpClient *http.Client
 	email      string
 	apiToken   string
+	bearer     bool // token sent as a Bearer header rather than with the email
+	metrics    *Metrics
+	timeouts   Timeouts
+	readOnly   bool // writes fail with ErrReadOnly instead of reaching Jira
+	logger     *slog.Logger
+	debugLog   *slog.Logger  // every request and response in full, for --debug; nil when off
+	slots      chan struct{} // one per request in flight when capped by WithMaxConcurrent
+
+	rankMu    sync.Mutex
+	rankField string // cached by RankField
+
+	searchMu       sync.Mutex
+	searchEndpoint int // index into searchEndpoints of the one this instance has
 }
 
 // ClientOption configures a Client.
@@ -29,19 +51,68 @@ func WithHTTPClient(hc *http.Client) ClientOption {
 	}
 }
 
+// DefaultTimeout bounds requests whose kind has no timeout set.
+const DefaultTimeout = 30 * time.Second
+
+// Timeouts bound how long each kind of request may take. Zero uses
+// DefaultTimeout; other reads always do.
+type Timeouts struct {
+	Search   time.Duration // JQL searches
+	Mutation time.Duration // requests that create, change, or delete
+}
+
+// WithTimeouts sets per-operation request timeouts.
+func WithTimeouts(t Timeouts) ClientOption {
+	return func(c *Client) {
+		c.timeouts = t
+	}
+}
+
+// WithLogger sets the logger requests are reported to: each request at
+// debug level, failures at debug too since callers report them.
+func WithLogger(l *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = l
+	}
+}
+
+// ErrReadOnly is returned for writes by a client made WithReadOnly.
+var ErrReadOnly = errors.New("read-only mode: changes are disabled")
+
+// WithReadOnly makes every request that would change data fail with
+// ErrReadOnly, for browsing an instance without risk.
+func WithReadOnly() ClientOption {
+	return func(c *Client) {
+		c.readOnly = true
+	}
+}
+
+// WithBearerAuth sends the API token as "Authorization: Bearer", as Data
+// Center personal access tokens and some proxies need, instead of basic
+// auth with the email.
+func WithBearerAuth() ClientOption {
+	return func(c *Client) {
+		c.bearer = true
+	}
+}
+
 // NewClient creates a new Jira API client.
 func NewClient(baseURL, email, apiToken string, opts ...ClientOption) *Client {
 	c := &Client{
-		baseURL:  baseURL,
-		email:    email,
-		apiToken: apiToken,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		baseURL:    baseURL,
+		email:      email,
+		apiToken:   apiToken,
+		metrics:    newMetrics(),
+		httpClient: &http.Client{},
+		logger:     slog.New(slog.DiscardHandler),
 	}
 	for _, opt := range opts {
 		opt(c)
 	}
+	// Reads a refresh repeats are revalidated by ETag, not downloaded again
+	hc := *c.httpClient
+	hc.Transport = newETagTransport(hc.Transport)
+	c.httpClient = &hc
 	return c
 }
 
@@ -50,40 +121,151 @@ func (c *Client) BaseURL() string {
 	return c.baseURL
 }
 
+// Logger returns the client's logger, for callers to report problems
+// they work around.
+func (c *Client) Logger() *slog.Logger {
+	return c.logger
+}
+
+// Metrics returns the request metrics collected by this client.
+func (c *Client) Metrics() *Metrics {
+	return c.metrics
+}
+
 // BrowseURL returns the Jira web URL for the given issue key.
 func (c *Client) BrowseURL(issueKey string) string {
 	return c.baseURL + "/browse/" + issueKey
 }
 
+// isSearch reports whether path is a JQL search endpoint.
+func isSearch(path string) bool {
+	return strings.HasPrefix(path, "/rest/api/3/search") || strings.HasPrefix(path, "/rest/api/2/search")
+}
+
+// isWrite reports whether a request changes data. Searches and JQL
+// parsing are POSTs that only read.
+func isWrite(method, path string) bool {
+	return method != http.MethodGet && !isSearch(path) && !strings.HasPrefix(path, "/rest/api/3/jql/parse")
+}
+
+// timeout returns how long a request may take: the search timeout for
+// JQL searches, the mutation timeout for writes.
+func (c *Client) timeout(method, path string) time.Duration {
+	var d time.Duration
+	switch {
+	case isSearch(path):
+		d = c.timeouts.Search
+	case isWrite(method, path):
+		d = c.timeouts.Mutation
+	}
+	if d <= 0 {
+		d = DefaultTimeout
+	}
+	return d
+}
+
+// IsTimeout reports whether err is a request cut off by its timeout.
+func IsTimeout(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
+// IsTransient reports whether err may go away if the request is simply
+// sent again: a timeout, a dropped connection, or Jira answering 429 or
+// a 5xx status.
+func IsTransient(err error) bool {
+	if err == nil || errors.Is(err, ErrReadOnly) {
+		return false
+	}
+	var netErr net.Error
+	if IsTimeout(err) || errors.As(err, &netErr) {
+		return true
+	}
+	status := apiErrorStatus(err)
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+// IsUnreachable reports whether err means Jira couldn't be reached at
+// all — no network, a failed DNS lookup, a refused connection — rather
+// than a slow or failing server.
+func IsUnreachable(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && !netErr.Timeout()
+}
+
 // do executes an HTTP request with authentication and returns the response body.
+// The request is bounded by the timeout for its kind of operation, which
+// also covers the waits between retries when Jira answers 429 or 503.
 func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
-	url := c.baseURL + path
+	if c.readOnly && isWrite(method, path) {
+		return nil, ErrReadOnly
+	}
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = io.ReadAll(body); err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.timeout(method, path))
+	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	for attempt := 0; ; attempt++ {
+		resp, data, err := c.send(ctx, method, path, payload)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode >= 400 && !c.retryLater(ctx, resp, attempt) {
+			return nil, newAPIError(resp.StatusCode, data)
+		}
+		if resp.StatusCode < 400 {
+			return data, nil
+		}
+	}
+}
+
+// send makes one attempt at a request, waiting for a free slot first when
+// concurrency is capped.
+func (c *Client) send(ctx context.Context, method, path string, payload []byte) (*http.Response, []byte, error) {
+	if err := c.acquire(ctx); err != nil {
+		return nil, nil, fmt.Errorf("waiting to send request: %w", err)
+	}
+	defer c.release()
+
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
 	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
+		return nil, nil, fmt.Errorf("creating request: %w", err)
 	}
 
-	req.SetBasicAuth(c.email, c.apiToken)
+	if c.bearer {
+		req.Header.Set("Authorization", "Bearer "+c.apiToken)
+	} else {
+		req.SetBasicAuth(c.email, c.apiToken)
+	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("executing request: %w", err)
+		c.metrics.record(method, path, time.Since(start), true)
+		c.logger.Debug("request failed", "method", method, "path", path, "err", err)
+		c.debugExchange(req, payload, nil, nil, time.Since(start), err)
+		return nil, nil, fmt.Errorf("executing request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	data, err := io.ReadAll(resp.Body)
+	c.metrics.record(method, path, time.Since(start), err != nil || resp.StatusCode >= 400)
+	c.logger.Debug("request", "method", method, "path", path, "status", resp.StatusCode, "duration", time.Since(start).Round(time.Millisecond))
+	c.debugExchange(req, payload, resp, data, time.Since(start), nil)
 	if err != nil {
-		return nil, fmt.Errorf("reading response body: %w", err)
+		return nil, nil, fmt.Errorf("reading response body: %w", err)
 	}
-
-	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(data))
-	}
-
-	return data, nil
+	return resp, data, nil
 }
 
 // GetMyself returns the currently authenticated user.
@@ -100,6 +282,78 @@ func (c *Client) GetMyself(ctx context.Context) (*User, error) {
 	return &user, nil
 }
 
+// UsedPermissions are the permissions behind jira-tui's features, in the
+// order they are reported.
+var UsedPermissions = []string{
+	"BROWSE_PROJECTS",
+	"CREATE_ISSUES",
+	"EDIT_ISSUES",
+	"TRANSITION_ISSUES",
+	"ASSIGN_ISSUES",
+	"ADD_COMMENTS",
+	"DELETE_ISSUES",
+}
+
+// GetMyPermissions reports whether the authenticated user holds each of
+// keys, in the given order. With a projectKey, project permissions are
+// checked in that project; otherwise in any project.
+func (c *Client) GetMyPermissions(ctx context.Context, projectKey string, keys []string) ([]Permission, error) {
+	params := url.Values{"permissions": {strings.Join(keys, ",")}}
+	if projectKey != "" {
+		params.Set("projectKey", projectKey)
+	}
+	data, err := c.do(ctx, http.MethodGet, "/rest/api/3/mypermissions?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting permissions: %w", err)
+	}
+
+	var resp MyPermissionsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("parsing permissions: %w", err)
+	}
+	perms := make([]Permission, 0, len(keys))
+	for _, key := range keys {
+		if p, ok := resp.Permissions[key]; ok {
+			perms = append(perms, p)
+		}
+	}
+	return perms, nil
+}
+
+// apiErrorStatus extracts the HTTP status from an error returned by do, or
+// 0 if the request didn't get a response. Errors that only carry the
+// status in their text, like "API error 503: ...", count too.
+func apiErrorStatus(err error) int {
+	if err == nil {
+		return 0
+	}
+	if apiErr, ok := AsAPIError(err); ok {
+		return apiErr.StatusCode
+	}
+	m := apiErrorPattern.FindStringSubmatch(err.Error())
+	if m == nil {
+		return 0
+	}
+	status, _ := strconv.Atoi(m[1])
+	return status
+}
+
+var apiErrorPattern = regexp.MustCompile(`API error (\d{3})`)
+
+// AuthHint explains an authentication or authorization failure and how to
+// fix it, or returns "" for other errors.
+func AuthHint(err error) string {
+	switch apiErrorStatus(err) {
+	case http.StatusUnauthorized:
+		return "Jira rejected the API token. It may have expired or been revoked: " +
+			"create a new one at https://id.atlassian.com/manage-profile/security/api-tokens " +
+			"and store it with 'jira-tui auth set'. Also check that jira.email matches the token's account."
+	case http.StatusForbidden:
+		return "The account lacks permission for this. Run 'jira-tui auth status' to see which permissions it has."
+	}
+	return ""
+}
+
 // GetFilter returns a saved Jira filter by ID.
 func (c *Client) GetFilter(ctx context.Context, filterID string) (*Filter, error) {
 	path := fmt.Sprintf("/rest/api/3/filter/%s", filterID)
@@ -115,13 +369,41 @@ func (c *Client) GetFilter(ctx context.Context, filterID string) (*Filter, error
 	return &filter, nil
 }
 
-// SearchIssues performs a JQL search using the enhanced search endpoint
-// (POST /rest/api/3/search/jql) and returns matching issues.
+// searchEndpoints are the JQL search endpoints from newest to oldest.
+// Instances without the enhanced /search/jql (older Cloud mirrors, Server)
+// answer 404 or 410 and are searched with the classic endpoint instead.
+var searchEndpoints = []string{"/rest/api/3/search/jql", "/rest/api/3/search", "/rest/api/2/search"}
+
+// SearchIssues performs a JQL search and returns matching issues. It uses
+// the enhanced search endpoint (POST /rest/api/3/search/jql), falling back
+// for good to the classic POST /search on instances that lack it.
 func (c *Client) SearchIssues(ctx context.Context, opts SearchOptions) (*SearchResult, error) {
 	if opts.MaxResults == 0 {
 		opts.MaxResults = 50
 	}
 
+	c.searchMu.Lock()
+	endpoint := c.searchEndpoint
+	c.searchMu.Unlock()
+	for {
+		result, err := c.search(ctx, searchEndpoints[endpoint], opts)
+		status := apiErrorStatus(err)
+		if (status != http.StatusNotFound && status != http.StatusGone) || endpoint == len(searchEndpoints)-1 {
+			return result, err
+		}
+		endpoint++
+		c.logger.Info("search endpoint unavailable, falling back", "endpoint", searchEndpoints[endpoint-1], "status", status)
+		c.searchMu.Lock()
+		c.searchEndpoint = max(c.searchEndpoint, endpoint)
+		c.searchMu.Unlock()
+	}
+}
+
+// search runs one search against path. The classic endpoints page by
+// startAt, which is passed through NextPageToken so callers page the same
+// way on either.
+func (c *Client) search(ctx context.Context, path string, opts SearchOptions) (*SearchResult, error) {
+	classic := path != searchEndpoints[0]
 	body := map[string]interface{}{
 		"jql":        opts.JQL,
 		"maxResults": opts.MaxResults,
@@ -130,7 +412,12 @@ func (c *Client) SearchIssues(ctx context.Context, opts SearchOptions) (*SearchR
 		body["fields"] = opts.Fields
 	}
 	if opts.NextPageToken != "" {
-		body["nextPageToken"] = opts.NextPageToken
+		if classic {
+			startAt, _ := strconv.Atoi(opts.NextPageToken)
+			body["startAt"] = startAt
+		} else {
+			body["nextPageToken"] = opts.NextPageToken
+		}
 	}
 
 	jsonBody, err := json.Marshal(body)
@@ -138,11 +425,18 @@ func (c *Client) SearchIssues(ctx context.Context, opts SearchOptions) (*SearchR
 		return nil, fmt.Errorf("marshaling search request: %w", err)
 	}
 
-	data, err := c.do(ctx, http.MethodPost, "/rest/api/3/search/jql", bytes.NewReader(jsonBody))
+	data, err := c.do(ctx, http.MethodPost, path, bytes.NewReader(jsonBody))
 	if err != nil {
 		return nil, fmt.Errorf("searching issues: %w", err)
 	}
 
+	if classic {
+		var page classicSearchPage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("parsing search results: %w", err)
+		}
+		return page.result(), nil
+	}
 	var result SearchResult
 	if err := json.Unmarshal(data, &result); err != nil {
 		return nil, fmt.Errorf("parsing search results: %w", err)
@@ -150,6 +444,63 @@ func (c *Client) SearchIssues(ctx context.Context, opts SearchOptions) (*SearchR
 	return &result, nil
 }
 
+// CountIssues returns how many issues match jql, using the approximate
+// count endpoint (POST /rest/api/3/search/approximate-count). Instances
+// without it answer 404 or 410 and are asked for a classic search's total
+// instead, with no issues in the page.
+func (c *Client) CountIssues(ctx context.Context, jql string) (int, error) {
+	jsonBody, err := json.Marshal(map[string]interface{}{"jql": jql})
+	if err != nil {
+		return 0, fmt.Errorf("marshaling count request: %w", err)
+	}
+	data, err := c.do(ctx, http.MethodPost, "/rest/api/3/search/approximate-count", bytes.NewReader(jsonBody))
+	if err == nil {
+		var resp struct {
+			Count int `json:"count"`
+		}
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return 0, fmt.Errorf("parsing count: %w", err)
+		}
+		return resp.Count, nil
+	}
+	for _, path := range searchEndpoints[1:] {
+		if status := apiErrorStatus(err); status != http.StatusNotFound && status != http.StatusGone {
+			return 0, fmt.Errorf("counting issues: %w", err)
+		}
+		c.logger.Info("count endpoint unavailable, falling back", "endpoint", path)
+		jsonBody, _ = json.Marshal(map[string]interface{}{"jql": jql, "maxResults": 0})
+		if data, err = c.do(ctx, http.MethodPost, path, bytes.NewReader(jsonBody)); err == nil {
+			var page classicSearchPage
+			if err := json.Unmarshal(data, &page); err != nil {
+				return 0, fmt.Errorf("parsing count: %w", err)
+			}
+			return page.Total, nil
+		}
+	}
+	return 0, fmt.Errorf("counting issues: %w", err)
+}
+
+// ParseJQL validates JQL queries with POST /rest/api/3/jql/parse using
+// strict validation. Each result carries the query's errors; an empty
+// Errors slice means the query is valid.
+func (c *Client) ParseJQL(ctx context.Context, queries ...string) ([]ParsedJQL, error) {
+	jsonBody, err := json.Marshal(map[string]interface{}{"queries": queries})
+	if err != nil {
+		return nil, fmt.Errorf("marshaling parse request: %w", err)
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/rest/api/3/jql/parse?validation=strict", bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("parsing JQL: %w", err)
+	}
+
+	var resp JQLParseResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("parsing JQL parse response: %w", err)
+	}
+	return resp.Queries, nil
+}
+
 // GetIssue returns the full details for a single issue by key or ID.
 func (c *Client) GetIssue(ctx context.Context, issueKeyOrID string) (*Issue, error) {
 	path := fmt.Sprintf("/rest/api/3/issue/%s", issueKeyOrID)
@@ -164,9 +515,24 @@ func (c *Client) GetIssue(ctx context.Context, issueKeyOrID string) (*Issue, err
 	return &issue, nil
 }
 
-// GetComments returns the comments for a Jira issue, newest first.
+// CommentsPageSize is how many comments GetCommentsPage returns at most.
+const CommentsPageSize = 50
+
+// GetComments returns the newest CommentsPageSize comments for a Jira
+// issue, newest first.
 func (c *Client) GetComments(ctx context.Context, issueKeyOrID string) ([]Comment, error) {
-	path := fmt.Sprintf("/rest/api/3/issue/%s/comment?orderBy=-created&maxResults=50", issueKeyOrID)
+	page, err := c.GetCommentsPage(ctx, issueKeyOrID, 0)
+	if err != nil {
+		return nil, err
+	}
+	return page.Comments, nil
+}
+
+// GetCommentsPage returns a page of an issue's comments, newest first,
+// starting startAt comments from the newest. Total counts them all.
+func (c *Client) GetCommentsPage(ctx context.Context, issueKeyOrID string, startAt int) (*CommentsResponse, error) {
+	path := fmt.Sprintf("/rest/api/3/issue/%s/comment?orderBy=-created&startAt=%d&maxResults=%d",
+		issueKeyOrID, startAt, CommentsPageSize)
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting comments for %s: %w", issueKeyOrID, err)
@@ -175,7 +541,7 @@ func (c *Client) GetComments(ctx context.Context, issueKeyOrID string) ([]Commen
 	if err := json.Unmarshal(data, &resp); err != nil {
 		return nil, fmt.Errorf("parsing comments: %w", err)
 	}
-	return resp.Comments, nil
+	return &resp, nil
 }
 
 // AddComment adds a comment to a Jira issue. The body is an ADF document.
@@ -213,6 +579,31 @@ func (c *Client) UpdateIssue(ctx context.Context, issueKeyOrID string, fields ma
 	return nil
 }
 
+// UpdateIssueLabels adds and removes labels on an issue using update
+// operations, leaving any other labels on the issue untouched.
+func (c *Client) UpdateIssueLabels(ctx context.Context, issueKeyOrID string, add, remove []string) error {
+	ops := make([]map[string]string, 0, len(add)+len(remove))
+	for _, l := range add {
+		ops = append(ops, map[string]string{"add": l})
+	}
+	for _, l := range remove {
+		ops = append(ops, map[string]string{"remove": l})
+	}
+	body := map[string]interface{}{
+		"update": map[string]interface{}{"labels": ops},
+	}
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshaling label update: %w", err)
+	}
+	path := fmt.Sprintf("/rest/api/3/issue/%s", issueKeyOrID)
+	_, err = c.do(ctx, http.MethodPut, path, bytes.NewReader(jsonBody))
+	if err != nil {
+		return fmt.Errorf("updating labels on %s: %w", issueKeyOrID, err)
+	}
+	return nil
+}
+
 // CreateIssue creates a new issue and returns the created issue reference.
 func (c *Client) CreateIssue(ctx context.Context, req CreateIssueRequest) (*CreateIssueResponse, error) {
 	jsonBody, err := json.Marshal(req)
@@ -243,9 +634,219 @@ func (c *Client) DeleteIssue(ctx context.Context, issueKeyOrID string, deleteSub
 	return nil
 }
 
-// GetTransitions returns the available transitions for an issue.
+// GetRemoteLinks returns an issue's links to pages outside Jira.
+func (c *Client) GetRemoteLinks(ctx context.Context, issueKeyOrID string) ([]RemoteLink, error) {
+	path := fmt.Sprintf("/rest/api/3/issue/%s/remotelink", issueKeyOrID)
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting remote links for %s: %w", issueKeyOrID, err)
+	}
+	var links []RemoteLink
+	if err := json.Unmarshal(data, &links); err != nil {
+		return nil, fmt.Errorf("parsing remote links: %w", err)
+	}
+	return links, nil
+}
+
+// GetDevInfo returns the pull requests, branches, and commits linked to an
+// issue, by ID, from each connected source control tool. It uses the
+// dev-status API behind Jira's Development panel, which isn't part of the
+// public REST API.
+func (c *Client) GetDevInfo(ctx context.Context, issueID string) (*DevInfo, error) {
+	path := "/rest/dev-status/latest/issue/summary?issueId=" + url.QueryEscape(issueID)
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting development summary for %s: %w", issueID, err)
+	}
+	var summary struct {
+		Summary map[string]struct {
+			Overall struct {
+				Count int `json:"count"`
+			} `json:"overall"`
+			ByInstanceType map[string]struct {
+				Count int `json:"count"`
+			} `json:"byInstanceType"`
+		} `json:"summary"`
+	}
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return nil, fmt.Errorf("parsing development summary: %w", err)
+	}
+
+	info := &DevInfo{}
+	// Details are fetched per tool and kind; only those with something linked
+	for _, dataType := range []string{"pullrequest", "branch", "repository"} {
+		kind := summary.Summary[dataType]
+		if kind.Overall.Count == 0 {
+			continue
+		}
+		var appTypes []string
+		for appType, inst := range kind.ByInstanceType {
+			if inst.Count > 0 {
+				appTypes = append(appTypes, appType)
+			}
+		}
+		sort.Strings(appTypes)
+		for _, appType := range appTypes {
+			path := fmt.Sprintf("/rest/dev-status/latest/issue/detail?issueId=%s&applicationType=%s&dataType=%s",
+				url.QueryEscape(issueID), url.QueryEscape(appType), dataType)
+			data, err := c.do(ctx, http.MethodGet, path, nil)
+			if err != nil {
+				return nil, fmt.Errorf("getting development details for %s: %w", issueID, err)
+			}
+			var detail struct {
+				Detail []struct {
+					PullRequests []DevPullRequest `json:"pullRequests"`
+					Branches     []DevBranch      `json:"branches"`
+					Repositories []struct {
+						Commits []DevCommit `json:"commits"`
+					} `json:"repositories"`
+				} `json:"detail"`
+			}
+			if err := json.Unmarshal(data, &detail); err != nil {
+				return nil, fmt.Errorf("parsing development details: %w", err)
+			}
+			for _, d := range detail.Detail {
+				info.PullRequests = append(info.PullRequests, d.PullRequests...)
+				info.Branches = append(info.Branches, d.Branches...)
+				for _, repo := range d.Repositories {
+					info.Commits = append(info.Commits, repo.Commits...)
+				}
+			}
+		}
+	}
+	return info, nil
+}
+
+// GetWatchers returns the users watching an issue and whether the signed-in
+// user is one of them.
+func (c *Client) GetWatchers(ctx context.Context, issueKeyOrID string) (*Watchers, error) {
+	path := fmt.Sprintf("/rest/api/3/issue/%s/watchers", issueKeyOrID)
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting watchers for %s: %w", issueKeyOrID, err)
+	}
+	var watchers Watchers
+	if err := json.Unmarshal(data, &watchers); err != nil {
+		return nil, fmt.Errorf("parsing watchers: %w", err)
+	}
+	return &watchers, nil
+}
+
+// GetIssueLinkTypes returns the issue link types defined on the instance.
+func (c *Client) GetIssueLinkTypes(ctx context.Context) ([]LinkType, error) {
+	data, err := c.do(ctx, http.MethodGet, "/rest/api/3/issueLinkType", nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting link types: %w", err)
+	}
+
+	var resp struct {
+		IssueLinkTypes []LinkType `json:"issueLinkTypes"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("parsing link types: %w", err)
+	}
+	return resp.IssueLinkTypes, nil
+}
+
+// CreateIssueLink links two issues with a link type, by name. As in an
+// issue's issuelinks, inwardKey relates to outwardKey by the type's
+// outward description, e.g. "PROJ-1 blocks PROJ-2".
+func (c *Client) CreateIssueLink(ctx context.Context, typeName, inwardKey, outwardKey string) error {
+	body := map[string]interface{}{
+		"type":         map[string]string{"name": typeName},
+		"inwardIssue":  map[string]string{"key": inwardKey},
+		"outwardIssue": map[string]string{"key": outwardKey},
+	}
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshaling issue link: %w", err)
+	}
+	if _, err := c.do(ctx, http.MethodPost, "/rest/api/3/issueLink", bytes.NewReader(jsonBody)); err != nil {
+		return fmt.Errorf("linking %s to %s: %w", inwardKey, outwardKey, err)
+	}
+	return nil
+}
+
+// AddWatcher makes a user, by account ID, watch an issue.
+func (c *Client) AddWatcher(ctx context.Context, issueKeyOrID, accountID string) error {
+	// The body is the bare account ID as a JSON string
+	jsonBody, err := json.Marshal(accountID)
+	if err != nil {
+		return fmt.Errorf("marshaling watcher: %w", err)
+	}
+	path := fmt.Sprintf("/rest/api/3/issue/%s/watchers", issueKeyOrID)
+	if _, err := c.do(ctx, http.MethodPost, path, bytes.NewReader(jsonBody)); err != nil {
+		return fmt.Errorf("watching issue %s: %w", issueKeyOrID, err)
+	}
+	return nil
+}
+
+// RemoveWatcher stops a user, by account ID, watching an issue.
+func (c *Client) RemoveWatcher(ctx context.Context, issueKeyOrID, accountID string) error {
+	path := fmt.Sprintf("/rest/api/3/issue/%s/watchers?accountId=%s", issueKeyOrID, url.QueryEscape(accountID))
+	if _, err := c.do(ctx, http.MethodDelete, path, nil); err != nil {
+		return fmt.Errorf("unwatching issue %s: %w", issueKeyOrID, err)
+	}
+	return nil
+}
+
+// GetProjectVersions returns a project's versions, released or not.
+func (c *Client) GetProjectVersions(ctx context.Context, projectKey string) ([]Version, error) {
+	path := fmt.Sprintf("/rest/api/3/project/%s/versions", projectKey)
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting versions for %s: %w", projectKey, err)
+	}
+	var versions []Version
+	if err := json.Unmarshal(data, &versions); err != nil {
+		return nil, fmt.Errorf("parsing versions: %w", err)
+	}
+	return versions, nil
+}
+
+// GetVersionUnresolvedCount returns how many unresolved issues have the
+// version as a fix version.
+func (c *Client) GetVersionUnresolvedCount(ctx context.Context, versionID string) (int, error) {
+	path := fmt.Sprintf("/rest/api/3/version/%s/unresolvedIssueCount", versionID)
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return 0, fmt.Errorf("counting unresolved issues of version %s: %w", versionID, err)
+	}
+	var resp struct {
+		Count int `json:"issuesUnresolvedCount"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return 0, fmt.Errorf("parsing unresolved issue count: %w", err)
+	}
+	return resp.Count, nil
+}
+
+// ReleaseVersion marks a version released on releaseDate (YYYY-MM-DD).
+// With moveTo, the self URL of another version, its unresolved issues are
+// moved to that version.
+func (c *Client) ReleaseVersion(ctx context.Context, versionID, releaseDate, moveTo string) error {
+	body := map[string]interface{}{
+		"released":    true,
+		"releaseDate": releaseDate,
+	}
+	if moveTo != "" {
+		body["moveUnfixedIssuesTo"] = moveTo
+	}
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshaling release: %w", err)
+	}
+	path := fmt.Sprintf("/rest/api/3/version/%s", versionID)
+	if _, err := c.do(ctx, http.MethodPut, path, bytes.NewReader(jsonBody)); err != nil {
+		return fmt.Errorf("releasing version %s: %w", versionID, err)
+	}
+	return nil
+}
+
+// GetTransitions returns the available transitions for an issue, each
+// with the fields its screen asks for.
 func (c *Client) GetTransitions(ctx context.Context, issueKeyOrID string) ([]Transition, error) {
-	path := fmt.Sprintf("/rest/api/3/issue/%s/transitions", issueKeyOrID)
+	path := fmt.Sprintf("/rest/api/3/issue/%s/transitions?expand=transitions.fields", issueKeyOrID)
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting transitions for %s: %w", issueKeyOrID, err)
@@ -254,16 +855,39 @@ func (c *Client) GetTransitions(ctx context.Context, issueKeyOrID string) ([]Tra
 	if err := json.Unmarshal(data, &resp); err != nil {
 		return nil, fmt.Errorf("parsing transitions: %w", err)
 	}
+	for _, t := range resp.Transitions {
+		for key, f := range t.Fields {
+			if f.Key == "" {
+				f.Key = key
+				t.Fields[key] = f
+			}
+		}
+	}
 	return resp.Transitions, nil
 }
 
 // TransitionIssue executes a workflow transition on an issue.
 func (c *Client) TransitionIssue(ctx context.Context, issueKeyOrID, transitionID string) error {
+	return c.TransitionIssueWith(ctx, issueKeyOrID, transitionID, nil, nil)
+}
+
+// TransitionIssueWith executes a workflow transition whose screen asks
+// for fields, such as a resolution, in the same shape UpdateIssue takes.
+// A non-nil comment (an ADF document) is added to the issue with it.
+func (c *Client) TransitionIssueWith(ctx context.Context, issueKeyOrID, transitionID string, fields map[string]interface{}, comment interface{}) error {
 	body := map[string]interface{}{
 		"transition": map[string]string{
 			"id": transitionID,
 		},
 	}
+	if len(fields) > 0 {
+		body["fields"] = fields
+	}
+	if comment != nil {
+		body["update"] = map[string]interface{}{
+			"comment": []interface{}{map[string]interface{}{"add": map[string]interface{}{"body": comment}}},
+		}
+	}
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
 		return fmt.Errorf("marshaling transition: %w", err)
@@ -313,6 +937,33 @@ func (c *Client) GetPriorities(ctx context.Context) ([]Priority, error) {
 	return priorities, nil
 }
 
+// SearchProjects returns the projects the user can see, ordered by key.
+// The Jira API returns them in pages; this method paginates through all
+// results.
+func (c *Client) SearchProjects(ctx context.Context) ([]Project, error) {
+	var all []Project
+	startAt := 0
+	for {
+		path := fmt.Sprintf("/rest/api/3/project/search?orderBy=key&startAt=%d&maxResults=50", startAt)
+		data, err := c.do(ctx, http.MethodGet, path, nil)
+		if err != nil {
+			return nil, fmt.Errorf("searching projects: %w", err)
+		}
+		var page struct {
+			Values []Project `json:"values"`
+			IsLast bool      `json:"isLast"`
+		}
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("parsing projects: %w", err)
+		}
+		all = append(all, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return all, nil
+		}
+		startAt += len(page.Values)
+	}
+}
+
 // IssueType represents a Jira issue type for a specific project.
 type IssueType struct {
 	ID          string `json:"id"`
@@ -323,6 +974,18 @@ type IssueType struct {
 
 // GetProjectIssueTypes fetches available issue types for a project.
 func (c *Client) GetProjectIssueTypes(ctx context.Context, projectKey string) ([]IssueType, error) {
+	// Filter out subtask types — create flow should only offer standard types
+	return c.projectIssueTypes(ctx, projectKey, false)
+}
+
+// GetProjectSubtaskTypes returns only the subtask issue types for a project.
+func (c *Client) GetProjectSubtaskTypes(ctx context.Context, projectKey string) ([]IssueType, error) {
+	return c.projectIssueTypes(ctx, projectKey, true)
+}
+
+// projectIssueTypes fetches a project's issue types, keeping either the
+// subtask types or the standard ones.
+func (c *Client) projectIssueTypes(ctx context.Context, projectKey string, subtask bool) ([]IssueType, error) {
 	path := fmt.Sprintf("/rest/api/3/project/%s/statuses", projectKey)
 	data, err := c.do(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -333,16 +996,198 @@ func (c *Client) GetProjectIssueTypes(ctx context.Context, projectKey string) ([
 	if err := json.Unmarshal(data, &result); err != nil {
 		return nil, fmt.Errorf("parsing issue types: %w", err)
 	}
-	// Filter out subtask types — create flow should only offer standard types
 	var types []IssueType
 	for _, t := range result {
-		if !t.Subtask {
+		if t.Subtask == subtask {
 			types = append(types, t)
 		}
 	}
 	return types, nil
 }
 
+// GetCreateFieldMeta returns the fields available when creating an issue
+// of the given type in a project, including which are required.
+// The Jira API returns fields in pages; this method paginates through all results.
+func (c *Client) GetCreateFieldMeta(ctx context.Context, projectKey, issueTypeID string) ([]FieldMeta, error) {
+	var all []FieldMeta
+	startAt := 0
+	for {
+		path := fmt.Sprintf("/rest/api/3/issue/createmeta/%s/issuetypes/%s?startAt=%d&maxResults=50",
+			projectKey, issueTypeID, startAt)
+		data, err := c.do(ctx, http.MethodGet, path, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting create metadata for %s: %w", projectKey, err)
+		}
+		var page CreateMetaPage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("parsing create metadata: %w", err)
+		}
+		all = append(all, page.Fields...)
+		startAt += len(page.Fields)
+		if len(page.Fields) == 0 || startAt >= page.Total {
+			break
+		}
+	}
+	return all, nil
+}
+
+// GetEditFieldMeta returns the fields that can be edited on an issue,
+// keyed by field id, with allowed values for select-style fields.
+func (c *Client) GetEditFieldMeta(ctx context.Context, issueKeyOrID string) (map[string]FieldMeta, error) {
+	data, err := c.do(ctx, http.MethodGet, "/rest/api/3/issue/"+issueKeyOrID+"/editmeta", nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting edit metadata for %s: %w", issueKeyOrID, err)
+	}
+	var result EditMeta
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("parsing edit metadata: %w", err)
+	}
+	return result.Fields, nil
+}
+
+// rankFieldType is the custom field type of Jira Software's Rank field.
+const rankFieldType = "com.pyxis.greenhopper.jira:gh-lexo-rank"
+
+// GetFields returns all system and custom fields on the instance.
+func (c *Client) GetFields(ctx context.Context) ([]Field, error) {
+	data, err := c.do(ctx, http.MethodGet, "/rest/api/3/field", nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting fields: %w", err)
+	}
+	var fields []Field
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("parsing fields: %w", err)
+	}
+	return fields, nil
+}
+
+// RankField returns the id of the Rank custom field that orders boards and
+// backlogs, or "" if the instance has none. A successful lookup is cached.
+func (c *Client) RankField(ctx context.Context) (string, error) {
+	c.rankMu.Lock()
+	defer c.rankMu.Unlock()
+	if c.rankField != "" {
+		return c.rankField, nil
+	}
+	fields, err := c.GetFields(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, f := range fields {
+		if f.Schema != nil && f.Schema.Custom == rankFieldType {
+			c.rankField = f.ID
+			break
+		}
+	}
+	return c.rankField, nil
+}
+
+// GetLatestChange returns the most recent changelog entry for an issue, or
+// nil if the issue has never been changed. The changelog is ordered oldest
+// first, so the first request only learns the total and a second fetches
+// the last entry.
+func (c *Client) GetLatestChange(ctx context.Context, issueKeyOrID string) (*ChangeHistory, error) {
+	page, err := c.changelogPage(ctx, issueKeyOrID, 0)
+	if err != nil {
+		return nil, err
+	}
+	if page.Total > len(page.Values) {
+		page, err = c.changelogPage(ctx, issueKeyOrID, page.Total-1)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(page.Values) == 0 {
+		return nil, nil
+	}
+	return &page.Values[len(page.Values)-1], nil
+}
+
+// changelogPage fetches a single changelog entry starting at startAt.
+func (c *Client) changelogPage(ctx context.Context, issueKeyOrID string, startAt int) (*ChangelogPage, error) {
+	path := fmt.Sprintf("/rest/api/3/issue/%s/changelog?startAt=%d&maxResults=1", issueKeyOrID, startAt)
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting changelog for %s: %w", issueKeyOrID, err)
+	}
+	var page ChangelogPage
+	if err := json.Unmarshal(data, &page); err != nil {
+		return nil, fmt.Errorf("parsing changelog: %w", err)
+	}
+	return &page, nil
+}
+
+// GetLabels fetches every label in use on the instance.
+// The Jira API returns labels in pages; this method paginates through all results.
+func (c *Client) GetLabels(ctx context.Context) ([]string, error) {
+	var all []string
+	startAt := 0
+	maxResults := 1000
+
+	for {
+		path := fmt.Sprintf("/rest/api/3/label?startAt=%d&maxResults=%d", startAt, maxResults)
+		data, err := c.do(ctx, http.MethodGet, path, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting labels (startAt=%d): %w", startAt, err)
+		}
+		var page LabelsPage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("parsing labels: %w", err)
+		}
+		all = append(all, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+	return all, nil
+}
+
+// GetUserGroups returns the groups a user belongs to.
+func (c *Client) GetUserGroups(ctx context.Context, accountID string) ([]Group, error) {
+	path := "/rest/api/3/user/groups?accountId=" + url.QueryEscape(accountID)
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting groups: %w", err)
+	}
+	var groups []Group
+	if err := json.Unmarshal(data, &groups); err != nil {
+		return nil, fmt.Errorf("parsing groups: %w", err)
+	}
+	return groups, nil
+}
+
+// GetGroupMembers fetches the active members of a group.
+// The Jira API returns members in pages; this method paginates through all results.
+func (c *Client) GetGroupMembers(ctx context.Context, groupName string) ([]User, error) {
+	var all []User
+	startAt := 0
+	maxResults := 50
+
+	for {
+		path := fmt.Sprintf("/rest/api/3/group/member?groupname=%s&startAt=%d&maxResults=%d",
+			url.QueryEscape(groupName), startAt, maxResults)
+		data, err := c.do(ctx, http.MethodGet, path, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting members of %s (startAt=%d): %w", groupName, startAt, err)
+		}
+		var page GroupMembersPage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("parsing group members: %w", err)
+		}
+		for _, u := range page.Values {
+			if u.Active {
+				all = append(all, u)
+			}
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+	return all, nil
+}
+
 // SearchAllUsers fetches all active users from the instance.
 // The Jira API returns users in pages; this method paginates through all results.
 func (c *Client) SearchAllUsers(ctx context.Context) ([]User, error) {
@@ -375,3 +1220,177 @@ func (c *Client) SearchAllUsers(ctx context.Context) ([]User, error) {
 	}
 	return all, nil
 }
+
+// sprintMoveBatch is the most issues the Agile API moves in one request.
+const sprintMoveBatch = 50
+
+// GetBoards returns the boards of a given type ("scrum" or "kanban") that
+// show a project's issues.
+func (c *Client) GetBoards(ctx context.Context, projectKey, boardType string) ([]Board, error) {
+	path := fmt.Sprintf("/rest/agile/1.0/board?projectKeyOrId=%s&type=%s",
+		url.QueryEscape(projectKey), url.QueryEscape(boardType))
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting boards for %s: %w", projectKey, err)
+	}
+	var resp struct {
+		Values []Board `json:"values"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("parsing boards: %w", err)
+	}
+	return resp.Values, nil
+}
+
+// GetBoardSprints returns a board's sprints in the given comma-separated
+// states, e.g. "active,future".
+func (c *Client) GetBoardSprints(ctx context.Context, boardID int, states string) ([]Sprint, error) {
+	var all []Sprint
+	startAt := 0
+	for {
+		path := fmt.Sprintf("/rest/agile/1.0/board/%d/sprint?state=%s&startAt=%d&maxResults=50",
+			boardID, url.QueryEscape(states), startAt)
+		data, err := c.do(ctx, http.MethodGet, path, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting sprints for board %d: %w", boardID, err)
+		}
+		var page struct {
+			Values []Sprint `json:"values"`
+			IsLast bool     `json:"isLast"`
+		}
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("parsing sprints: %w", err)
+		}
+		all = append(all, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return all, nil
+		}
+		startAt += len(page.Values)
+	}
+}
+
+// RankIssues moves issues in rank to just before, or with after just
+// after, another issue. Jira answers 207 when some issues could not be
+// ranked; their errors are returned.
+func (c *Client) RankIssues(ctx context.Context, keys []string, relativeTo string, after bool) error {
+	body := map[string]interface{}{"issues": keys}
+	if after {
+		body["rankAfterIssue"] = relativeTo
+	} else {
+		body["rankBeforeIssue"] = relativeTo
+	}
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshaling rank: %w", err)
+	}
+	data, err := c.do(ctx, http.MethodPut, "/rest/agile/1.0/issue/rank", bytes.NewReader(jsonBody))
+	if err != nil {
+		return fmt.Errorf("ranking issues: %w", err)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	var resp struct {
+		Entries []struct {
+			IssueKey string   `json:"issueKey"`
+			Status   int      `json:"status"`
+			Errors   []string `json:"errors"`
+		} `json:"entries"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return fmt.Errorf("parsing rank result: %w", err)
+	}
+	for _, e := range resp.Entries {
+		if e.Status >= 400 {
+			return fmt.Errorf("ranking %s: %s", e.IssueKey, strings.Join(e.Errors, "; "))
+		}
+	}
+	return nil
+}
+
+// GetIssueSprint returns the open sprint an issue is in, or nil if it is
+// in none.
+func (c *Client) GetIssueSprint(ctx context.Context, issueKey string) (*Sprint, error) {
+	path := fmt.Sprintf("/rest/agile/1.0/issue/%s?fields=sprint", url.PathEscape(issueKey))
+	data, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting sprint of %s: %w", issueKey, err)
+	}
+	var resp struct {
+		Fields struct {
+			Sprint *Sprint `json:"sprint"`
+		} `json:"fields"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("parsing issue sprint: %w", err)
+	}
+	return resp.Fields.Sprint, nil
+}
+
+// GetSprintIssueKeys returns the keys of a sprint's issues matching jql,
+// or all of them when jql is empty.
+func (c *Client) GetSprintIssueKeys(ctx context.Context, sprintID int, jql string) ([]string, error) {
+	var keys []string
+	startAt := 0
+	for {
+		path := fmt.Sprintf("/rest/agile/1.0/sprint/%d/issue?fields=key&startAt=%d&maxResults=100&jql=%s",
+			sprintID, startAt, url.QueryEscape(jql))
+		data, err := c.do(ctx, http.MethodGet, path, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting issues of sprint %d: %w", sprintID, err)
+		}
+		var page struct {
+			Issues []Issue `json:"issues"`
+			Total  int     `json:"total"`
+		}
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("parsing sprint issues: %w", err)
+		}
+		for _, issue := range page.Issues {
+			keys = append(keys, issue.Key)
+		}
+		startAt += len(page.Issues)
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			return keys, nil
+		}
+	}
+}
+
+// UpdateSprint changes the given fields of a sprint, e.g. its state,
+// startDate, and endDate to start it.
+func (c *Client) UpdateSprint(ctx context.Context, sprintID int, fields map[string]interface{}) error {
+	jsonBody, err := json.Marshal(fields)
+	if err != nil {
+		return fmt.Errorf("marshaling sprint: %w", err)
+	}
+	path := fmt.Sprintf("/rest/agile/1.0/sprint/%d", sprintID)
+	if _, err := c.do(ctx, http.MethodPost, path, bytes.NewReader(jsonBody)); err != nil {
+		return fmt.Errorf("updating sprint %d: %w", sprintID, err)
+	}
+	return nil
+}
+
+// MoveIssuesToSprint moves issues into a sprint.
+func (c *Client) MoveIssuesToSprint(ctx context.Context, sprintID int, keys []string) error {
+	return c.moveIssues(ctx, fmt.Sprintf("/rest/agile/1.0/sprint/%d/issue", sprintID), keys)
+}
+
+// MoveIssuesToBacklog takes issues out of their sprints.
+func (c *Client) MoveIssuesToBacklog(ctx context.Context, keys []string) error {
+	return c.moveIssues(ctx, "/rest/agile/1.0/backlog/issue", keys)
+}
+
+// moveIssues posts keys to an Agile move endpoint in batches.
+func (c *Client) moveIssues(ctx context.Context, path string, keys []string) error {
+	for start := 0; start < len(keys); start += sprintMoveBatch {
+		batch := keys[start:min(start+sprintMoveBatch, len(keys))]
+		jsonBody, err := json.Marshal(map[string]interface{}{"issues": batch})
+		if err != nil {
+			return fmt.Errorf("marshaling issues: %w", err)
+		}
+		if _, err := c.do(ctx, http.MethodPost, path, bytes.NewReader(jsonBody)); err != nil {
+			return fmt.Errorf("moving %d issues: %w", len(batch), err)
+		}
+	}
+	return nil
+}
@@ -3,9 +3,13 @@ package jira
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -61,6 +65,21 @@ func TestGetMyself(t *testing.T) {
 	}
 }
 
+func TestBearerAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer pat-token" {
+			t.Errorf("Authorization = %q, want the token as a Bearer header", got)
+		}
+		json.NewEncoder(w).Encode(User{AccountID: "abc123"})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "", "pat-token", WithBearerAuth())
+	if _, err := client.GetMyself(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestClientAPIError(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusUnauthorized)
@@ -75,6 +94,88 @@ func TestClientAPIError(t *testing.T) {
 	}
 }
 
+func TestClientTimeouts(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/rest/api/3/search") {
+			<-release
+		}
+		w.Write([]byte(`{"accountId":"me"}`))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	c := NewClient(server.URL, "user@example.com", "token", WithTimeouts(Timeouts{Search: 50 * time.Millisecond}))
+	_, err := c.SearchIssues(context.Background(), SearchOptions{JQL: "project = PROJ"})
+	if !IsTimeout(err) {
+		t.Errorf("search err = %v, want a timeout", err)
+	}
+	if _, err := c.GetMyself(context.Background()); err != nil {
+		t.Errorf("reads use the default timeout, got %v", err)
+	}
+
+	if got := c.timeout(http.MethodPut, "/rest/api/3/issue/PROJ-1"); got != DefaultTimeout {
+		t.Errorf("unset mutation timeout = %s, want the default", got)
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/issue/PROJ-1":
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/rest/api/3/issue/PROJ-2":
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	c := NewClient(server.URL, "user@example.com", "token")
+	for key, want := range map[string]bool{"PROJ-1": true, "PROJ-2": true, "PROJ-3": false} {
+		_, err := c.GetIssue(context.Background(), key)
+		if IsTransient(err) != want {
+			t.Errorf("%s: IsTransient(%v) = %v", key, err, !want)
+		}
+	}
+
+	if _, err := c.GetIssue(context.Background(), "PROJ-1"); IsUnreachable(err) {
+		t.Errorf("a 503 is an answer, not an unreachable server: %v", err)
+	}
+
+	server.Close()
+	_, err := c.GetIssue(context.Background(), "PROJ-1")
+	if !IsTransient(err) || !IsUnreachable(err) {
+		t.Errorf("a refused connection should be transient and unreachable, got %v", err)
+	}
+	if IsTransient(ErrReadOnly) {
+		t.Error("read-only refusals are not transient")
+	}
+}
+
+func TestClientReadOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && !strings.HasPrefix(r.URL.Path, "/rest/api/3/search") {
+			t.Errorf("read-only client sent %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"issues":[],"isLast":true}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "user@example.com", "token", WithReadOnly())
+	if _, err := c.SearchIssues(context.Background(), SearchOptions{JQL: "project = PROJ"}); err != nil {
+		t.Errorf("searches should still work, got %v", err)
+	}
+	err := c.TransitionIssue(context.Background(), "PROJ-1", "21")
+	if !errors.Is(err, ErrReadOnly) {
+		t.Errorf("transition err = %v, want ErrReadOnly", err)
+	}
+	if err := c.DeleteIssue(context.Background(), "PROJ-1", false); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("delete err = %v, want ErrReadOnly", err)
+	}
+}
+
 func TestGetFilter(t *testing.T) {
 	expected := Filter{
 		ID:        "10042",
@@ -280,6 +381,43 @@ func TestUpdateIssue(t *testing.T) {
 	}
 }
 
+func TestUpdateIssueLabels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/PROJ-1" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.Method != http.MethodPut {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body struct {
+			Update struct {
+				Labels []map[string]string `json:"labels"`
+			} `json:"update"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		ops := body.Update.Labels
+		if len(ops) != 2 {
+			t.Fatalf("expected 2 label ops, got %d", len(ops))
+		}
+		if ops[0]["add"] != "backend" {
+			t.Errorf("expected add backend, got %v", ops[0])
+		}
+		if ops[1]["remove"] != "stale" {
+			t.Errorf("expected remove stale, got %v", ops[1])
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	err := c.UpdateIssueLabels(context.Background(), "PROJ-1", []string{"backend"}, []string{"stale"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestCreateIssue(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/rest/api/3/issue" {
@@ -440,6 +578,61 @@ func TestTransitionIssue(t *testing.T) {
 	}
 }
 
+func TestGetTransitionsFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("expand"); got != "transitions.fields" {
+			t.Errorf("expand = %q, want transitions.fields", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"transitions": [{"id": "31", "name": "Resolve", "fields": {
+			"resolution": {"required": true, "name": "Resolution", "schema": {"type": "resolution"},
+				"allowedValues": [{"id": "1", "name": "Fixed"}]}
+		}}]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	transitions, err := c.GetTransitions(context.Background(), "PROJ-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	f := transitions[0].Fields["resolution"]
+	if !f.Required || f.Key != "resolution" || len(f.AllowedValues) != 1 {
+		t.Errorf("resolution field = %+v, want it required with its key and values", f)
+	}
+}
+
+func TestTransitionIssueWith(t *testing.T) {
+	var body struct {
+		Fields map[string]interface{} `json:"fields"`
+		Update struct {
+			Comment []struct {
+				Add struct {
+					Body interface{} `json:"body"`
+				} `json:"add"`
+			} `json:"comment"`
+		} `json:"update"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	fields := map[string]interface{}{"resolution": map[string]interface{}{"id": "1"}}
+	comment := map[string]interface{}{"type": "doc", "version": 1}
+	if err := c.TransitionIssueWith(context.Background(), "PROJ-1", "31", fields, comment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res, _ := body.Fields["resolution"].(map[string]interface{}); res["id"] != "1" {
+		t.Errorf("fields = %v, want the resolution", body.Fields)
+	}
+	if len(body.Update.Comment) != 1 || body.Update.Comment[0].Add.Body == nil {
+		t.Errorf("update = %+v, want the comment added", body.Update)
+	}
+}
+
 func TestAssignIssue(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/rest/api/3/issue/PROJ-1/assignee" {
@@ -502,3 +695,886 @@ func TestSearchAllUsers(t *testing.T) {
 		t.Errorf("expected u3, got %s", users[1].AccountID)
 	}
 }
+
+func TestGetLabels(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/label" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("startAt") == "0" {
+			json.NewEncoder(w).Encode(LabelsPage{Values: []string{"backend", "ui"}, IsLast: false})
+			return
+		}
+		if r.URL.Query().Get("startAt") != "2" {
+			t.Errorf("unexpected startAt: %s", r.URL.Query().Get("startAt"))
+		}
+		json.NewEncoder(w).Encode(LabelsPage{Values: []string{"urgent"}, IsLast: true})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	labels, err := c.GetLabels(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 page requests, got %d", calls)
+	}
+	if len(labels) != 3 || labels[2] != "urgent" {
+		t.Errorf("unexpected labels: %v", labels)
+	}
+}
+
+func TestGetGroupMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/group/member" || r.URL.Query().Get("groupname") != "team a" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("startAt") == "0" {
+			json.NewEncoder(w).Encode(GroupMembersPage{Values: []User{
+				{AccountID: "1", Active: true}, {AccountID: "2", Active: false},
+			}})
+			return
+		}
+		json.NewEncoder(w).Encode(GroupMembersPage{Values: []User{{AccountID: "3", Active: true}}, IsLast: true})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	users, err := c.GetGroupMembers(context.Background(), "team a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(users) != 2 || users[0].AccountID != "1" || users[1].AccountID != "3" {
+		t.Errorf("expected the active members 1 and 3, got %+v", users)
+	}
+}
+
+func TestGetCommentsPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/rest/api/3/issue/PROJ-1/comment" || q.Get("orderBy") != "-created" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+		if q.Get("startAt") != "50" || q.Get("maxResults") != "50" {
+			t.Errorf("expected the second page, got %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"comments": [{"id": "10"}], "startAt": 50, "maxResults": 50, "total": 51}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	page, err := c.GetCommentsPage(context.Background(), "PROJ-1", 50)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page.Total != 51 || len(page.Comments) != 1 || page.Comments[0].ID != "10" {
+		t.Errorf("unexpected page: %+v", page)
+	}
+}
+
+func TestGetProjectSubtaskTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/project/PROJ/statuses" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write([]byte(`[
+			{"id": "1", "name": "Task", "subtask": false},
+			{"id": "2", "name": "Sub-task", "subtask": true}
+		]`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	subtasks, err := c.GetProjectSubtaskTypes(context.Background(), "PROJ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(subtasks) != 1 || subtasks[0].Name != "Sub-task" {
+		t.Errorf("subtask types = %+v, want [Sub-task]", subtasks)
+	}
+
+	standard, err := c.GetProjectIssueTypes(context.Background(), "PROJ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(standard) != 1 || standard[0].Name != "Task" {
+		t.Errorf("standard types = %+v, want [Task]", standard)
+	}
+}
+
+func TestParseJQL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/jql/parse" || r.Method != http.MethodPost {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("validation") != "strict" {
+			t.Errorf("expected strict validation, got %q", r.URL.RawQuery)
+		}
+		var body struct {
+			Queries []string `json:"queries"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body.Queries) != 2 {
+			t.Errorf("expected 2 queries, got %v", body.Queries)
+		}
+		w.Write([]byte(`{"queries": [
+			{"query": "project = PROJ", "structure": {}},
+			{"query": "projct = PROJ", "errors": ["Field 'projct' does not exist."]}
+		]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	results, err := c.ParseJQL(context.Background(), "project = PROJ", "projct = PROJ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if len(results[0].Errors) != 0 {
+		t.Errorf("expected first query valid, got %v", results[0].Errors)
+	}
+	if len(results[1].Errors) != 1 {
+		t.Errorf("expected one error for second query, got %v", results[1].Errors)
+	}
+}
+
+func TestGetLatestChange(t *testing.T) {
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/PROJ-1/changelog" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		start := r.URL.Query().Get("startAt")
+		starts = append(starts, start)
+		switch start {
+		case "0":
+			w.Write([]byte(`{"startAt":0,"maxResults":1,"total":3,"values":[
+				{"id":"1","created":"2025-01-01T00:00:00.000+0000","items":[{"field":"summary"}]}]}`))
+		case "2":
+			w.Write([]byte(`{"startAt":2,"maxResults":1,"total":3,"isLast":true,"values":[
+				{"id":"3","author":{"displayName":"Alice"},"created":"2025-02-01T00:00:00.000+0000",
+				 "items":[{"field":"status","fromString":"To Do","toString":"Done"}]}]}`))
+		default:
+			t.Errorf("unexpected startAt %q", start)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	change, err := c.GetLatestChange(context.Background(), "PROJ-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if change == nil || change.ID != "3" {
+		t.Fatalf("expected latest change 3, got %+v", change)
+	}
+	if change.Author.DisplayName != "Alice" || change.Items[0].Field != "status" {
+		t.Errorf("unexpected change: %+v", change)
+	}
+	if len(starts) != 2 {
+		t.Errorf("expected 2 requests, got %v", starts)
+	}
+}
+
+func TestGetLatestChangeNoHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"startAt":0,"maxResults":1,"total":0,"isLast":true,"values":[]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	change, err := c.GetLatestChange(context.Background(), "PROJ-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if change != nil {
+		t.Errorf("expected nil change, got %+v", change)
+	}
+}
+
+func TestGetCreateFieldMeta(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/createmeta/PROJ/issuetypes/10001" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		calls++
+		if r.URL.Query().Get("startAt") == "0" {
+			w.Write([]byte(`{"startAt":0,"maxResults":1,"total":2,"fields":[
+				{"fieldId":"summary","key":"summary","name":"Summary","required":true,"schema":{"type":"string"}}]}`))
+			return
+		}
+		w.Write([]byte(`{"startAt":1,"maxResults":1,"total":2,"fields":[
+			{"fieldId":"customfield_100","key":"customfield_100","name":"Severity","required":true,
+			 "schema":{"type":"option","custom":"com.atlassian.jira.plugin.system.customfieldtypes:select"},
+			 "allowedValues":[{"id":"1","value":"Sev 1"},{"id":"2","value":"Sev 2"}]}]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	fields, err := c.GetCreateFieldMeta(context.Background(), "PROJ", "10001")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 || len(fields) != 2 {
+		t.Fatalf("expected 2 fields over 2 pages, got %d fields in %d calls", len(fields), calls)
+	}
+	sev := fields[1]
+	if sev.Name != "Severity" || sev.Schema.Type != "option" || len(sev.AllowedValues) != 2 {
+		t.Errorf("unexpected field: %+v", sev)
+	}
+	if sev.AllowedValues[0].Label() != "Sev 1" {
+		t.Errorf("Label() = %q", sev.AllowedValues[0].Label())
+	}
+}
+
+func TestGetEditFieldMeta(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/PROJ-1/editmeta" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"fields":{
+			"summary":{"key":"summary","name":"Summary","required":true,"schema":{"type":"string"}},
+			"customfield_100":{"key":"customfield_100","name":"Severity","required":false,
+			 "schema":{"type":"option"},
+			 "allowedValues":[{"id":"1","value":"Sev 1"},{"id":"2","value":"Sev 2"}]}}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	fields, err := c.GetEditFieldMeta(context.Background(), "PROJ-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sev, ok := fields["customfield_100"]
+	if !ok || sev.Name != "Severity" || len(sev.AllowedValues) != 2 {
+		t.Errorf("unexpected fields: %+v", fields)
+	}
+}
+
+func TestRankFieldCached(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/field" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		calls++
+		w.Write([]byte(`[
+			{"id":"summary","name":"Summary","custom":false,"schema":{"type":"string","system":"summary"}},
+			{"id":"customfield_10019","name":"Rank","custom":true,
+			 "schema":{"type":"any","custom":"com.pyxis.greenhopper.jira:gh-lexo-rank","customId":10019}}]`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	for i := 0; i < 2; i++ {
+		id, err := c.RankField(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if id != "customfield_10019" {
+			t.Errorf("RankField() = %q", id)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected one field lookup, got %d", calls)
+	}
+}
+
+func TestIssueFieldsCustom(t *testing.T) {
+	var issue Issue
+	data := `{"key":"PROJ-1","fields":{"summary":"Hi","customfield_10019":"0|i0001:","customfield_2":null}}`
+	if err := json.Unmarshal([]byte(data), &issue); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if issue.Fields.Summary != "Hi" {
+		t.Errorf("Summary = %q", issue.Fields.Summary)
+	}
+	if got := issue.Fields.CustomString("customfield_10019"); got != "0|i0001:" {
+		t.Errorf("CustomString = %q", got)
+	}
+	if got := issue.Fields.CustomString("customfield_2"); got != "" {
+		t.Errorf("null CustomString = %q", got)
+	}
+	if got := issue.Fields.CustomString("customfield_missing"); got != "" {
+		t.Errorf("missing CustomString = %q", got)
+	}
+}
+
+func TestGetMyPermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/mypermissions" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("permissions"); got != "EDIT_ISSUES,DELETE_ISSUES" {
+			t.Errorf("permissions = %q", got)
+		}
+		if got := r.URL.Query().Get("projectKey"); got != "PROJ" {
+			t.Errorf("projectKey = %q", got)
+		}
+		w.Write([]byte(`{"permissions": {
+			"DELETE_ISSUES": {"key": "DELETE_ISSUES", "name": "Delete Issues", "type": "PROJECT", "havePermission": false},
+			"EDIT_ISSUES": {"key": "EDIT_ISSUES", "name": "Edit Issues", "type": "PROJECT", "havePermission": true}
+		}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	perms, err := c.GetMyPermissions(context.Background(), "PROJ", []string{"EDIT_ISSUES", "DELETE_ISSUES"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(perms) != 2 || perms[0].Key != "EDIT_ISSUES" || !perms[0].HavePermission || perms[1].HavePermission {
+		t.Errorf("perms = %+v, want EDIT_ISSUES granted then DELETE_ISSUES denied", perms)
+	}
+}
+
+func TestAuthHint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"errorMessages":["Client must be authenticated"]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "expired")
+	_, err := c.GetMyself(context.Background())
+	if hint := AuthHint(err); !strings.Contains(hint, "auth set") {
+		t.Errorf("401 hint = %q", hint)
+	}
+	if hint := AuthHint(fmt.Errorf("API error 403: nope")); !strings.Contains(hint, "auth status") {
+		t.Errorf("403 hint = %q", hint)
+	}
+	if hint := AuthHint(fmt.Errorf("API error 500: boom")); hint != "" {
+		t.Errorf("500 hint = %q, want none", hint)
+	}
+}
+
+func TestSearchIssuesFallsBackToClassicSearch(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		switch r.URL.Path {
+		case "/rest/api/3/search/jql":
+			w.WriteHeader(http.StatusNotFound)
+		case "/rest/api/3/search":
+			if _, ok := body["nextPageToken"]; ok {
+				t.Error("classic search takes startAt, not nextPageToken")
+			}
+			if body["startAt"] == float64(2) {
+				w.Write([]byte(`{"startAt":2,"total":3,"issues":[{"key":"PROJ-3"}]}`))
+				return
+			}
+			w.Write([]byte(`{"startAt":0,"total":3,"issues":[{"key":"PROJ-1"},{"key":"PROJ-2"}]}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	result, err := c.SearchIssues(context.Background(), SearchOptions{JQL: "project = PROJ", MaxResults: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Issues) != 2 || result.IsLast || result.NextPageToken != "2" {
+		t.Errorf("result = %+v, want the first page with a token for the rest", result)
+	}
+
+	result, err = c.SearchIssues(context.Background(), SearchOptions{JQL: "project = PROJ", MaxResults: 2, NextPageToken: result.NextPageToken})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Issues) != 1 || !result.IsLast {
+		t.Errorf("second page = %+v, want the last issue", result)
+	}
+	want := "/rest/api/3/search/jql,/rest/api/3/search,/rest/api/3/search"
+	if got := strings.Join(paths, ","); got != want {
+		t.Errorf("paths = %s, want the enhanced endpoint tried once", got)
+	}
+}
+
+func TestSearchIssuesFallsBackToV2(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/search/jql":
+			w.WriteHeader(http.StatusGone)
+		case "/rest/api/3/search":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.Write([]byte(`{"startAt":0,"total":1,"issues":[{"key":"PROJ-1","fields":{"description":"wiki *text*"}}]}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	result, err := c.SearchIssues(context.Background(), SearchOptions{JQL: "project = PROJ"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Issues) != 1 || !result.IsLast {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestSearchIssuesBadQueryDoesNotFallBack(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	if _, err := c.SearchIssues(context.Background(), SearchOptions{JQL: "projct = PROJ"}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want no fallback on 400", calls)
+	}
+}
+
+func TestWatchers(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/PROJ-1/watchers" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"watchCount": 2, "isWatching": true, "watchers": [{"accountId": "u1", "displayName": "Ann"}, {"accountId": "u2"}]}`))
+		case http.MethodPost:
+			var id string
+			if err := json.NewDecoder(r.Body).Decode(&id); err != nil || id != "u1" {
+				t.Errorf("expected the account ID as a JSON string, got %q (%v)", id, err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			if got := r.URL.Query().Get("accountId"); got != "u1" {
+				t.Errorf("accountId = %q, want u1", got)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+		requests = append(requests, r.Method)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	ctx := context.Background()
+	watchers, err := c.GetWatchers(ctx, "PROJ-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if watchers.WatchCount != 2 || !watchers.IsWatching || len(watchers.Watchers) != 2 || watchers.Watchers[0].DisplayName != "Ann" {
+		t.Errorf("watchers = %+v", watchers)
+	}
+	if err := c.AddWatcher(ctx, "PROJ-1", "u1"); err != nil {
+		t.Fatalf("AddWatcher: %v", err)
+	}
+	if err := c.RemoveWatcher(ctx, "PROJ-1", "u1"); err != nil {
+		t.Fatalf("RemoveWatcher: %v", err)
+	}
+	if got := strings.Join(requests, ","); got != "GET,POST,DELETE" {
+		t.Errorf("requests = %s", got)
+	}
+}
+
+func TestReleaseVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/project/PROJ/versions":
+			w.Write([]byte(`[{"id": "10", "name": "1.0", "released": true}, {"id": "11", "name": "1.1", "self": "https://x/rest/api/3/version/11"}]`))
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/version/10/unresolvedIssueCount":
+			w.Write([]byte(`{"issuesCount": 7, "issuesUnresolvedCount": 3}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/rest/api/3/version/10":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["released"] != true || body["releaseDate"] != "2026-10-16" {
+				t.Errorf("unexpected release body: %v", body)
+			}
+			if body["moveUnfixedIssuesTo"] != "https://x/rest/api/3/version/11" {
+				t.Errorf("moveUnfixedIssuesTo = %v", body["moveUnfixedIssuesTo"])
+			}
+			w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	ctx := context.Background()
+	versions, err := c.GetProjectVersions(ctx, "PROJ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(versions) != 2 || !versions[0].Released || versions[1].Self == "" {
+		t.Errorf("versions = %+v", versions)
+	}
+	count, err := c.GetVersionUnresolvedCount(ctx, "10")
+	if err != nil || count != 3 {
+		t.Errorf("unresolved = %d, %v; want 3", count, err)
+	}
+	if err := c.ReleaseVersion(ctx, "10", "2026-10-16", versions[1].Self); err != nil {
+		t.Fatalf("ReleaseVersion: %v", err)
+	}
+}
+
+func TestGetRemoteLinks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/PROJ-1/remotelink" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write([]byte(`[
+			{"id": 1, "relationship": "mentioned in", "object": {"url": "https://wiki/page", "title": "Design"}, "application": {"type": "com.atlassian.confluence", "name": "Confluence"}},
+			{"id": 2, "object": {"url": "https://github.com/o/r/pull/7", "title": "PR #7", "status": {"resolved": true}}}
+		]`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	links, err := c.GetRemoteLinks(context.Background(), "PROJ-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(links) != 2 {
+		t.Fatalf("links = %d, want 2", len(links))
+	}
+	if links[0].Object.Title != "Design" || links[0].Application == nil || links[0].Application.Name != "Confluence" {
+		t.Errorf("first link = %+v", links[0])
+	}
+	if links[1].Object.Status == nil || !links[1].Object.Status.Resolved || links[1].Application != nil {
+		t.Errorf("second link = %+v", links[1])
+	}
+}
+
+func TestSprintAPI(t *testing.T) {
+	var moved []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case r.URL.Path == "/rest/agile/1.0/board":
+			if q.Get("projectKeyOrId") != "PROJ" || q.Get("type") != "scrum" {
+				t.Errorf("unexpected board query: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"values": [{"id": 3, "name": "PROJ board", "type": "scrum"}]}`))
+		case r.URL.Path == "/rest/agile/1.0/board/3/sprint":
+			if q.Get("state") != "active,future" {
+				t.Errorf("state = %q", q.Get("state"))
+			}
+			if q.Get("startAt") == "0" {
+				w.Write([]byte(`{"values": [{"id": 7, "name": "Sprint 7", "state": "active"}], "isLast": false}`))
+			} else {
+				w.Write([]byte(`{"values": [{"id": 8, "name": "Sprint 8", "state": "future"}], "isLast": true}`))
+			}
+		case r.URL.Path == "/rest/agile/1.0/sprint/7/issue" && r.Method == http.MethodGet:
+			if q.Get("jql") != "statusCategory != Done" {
+				t.Errorf("jql = %q", q.Get("jql"))
+			}
+			if q.Get("startAt") == "0" {
+				w.Write([]byte(`{"issues": [{"key": "PROJ-1"}], "total": 2}`))
+			} else {
+				w.Write([]byte(`{"issues": [{"key": "PROJ-2"}], "total": 2}`))
+			}
+		case r.URL.Path == "/rest/agile/1.0/sprint/8/issue" && r.Method == http.MethodPost:
+			var body struct{ Issues []string }
+			json.NewDecoder(r.Body).Decode(&body)
+			moved = append(moved, body.Issues...)
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/rest/agile/1.0/sprint/7" && r.Method == http.MethodPost:
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["state"] != "closed" {
+				t.Errorf("sprint update = %v", body)
+			}
+			w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	ctx := context.Background()
+	boards, err := c.GetBoards(ctx, "PROJ", "scrum")
+	if err != nil || len(boards) != 1 || boards[0].ID != 3 {
+		t.Fatalf("boards = %+v, %v", boards, err)
+	}
+	sprints, err := c.GetBoardSprints(ctx, 3, "active,future")
+	if err != nil || len(sprints) != 2 || sprints[1].State != "future" {
+		t.Fatalf("sprints = %+v, %v; want both pages", sprints, err)
+	}
+	keys, err := c.GetSprintIssueKeys(ctx, 7, "statusCategory != Done")
+	if err != nil || strings.Join(keys, ",") != "PROJ-1,PROJ-2" {
+		t.Fatalf("keys = %v, %v", keys, err)
+	}
+
+	many := make([]string, 60)
+	for i := range many {
+		many[i] = fmt.Sprintf("PROJ-%d", i+1)
+	}
+	if err := c.MoveIssuesToSprint(ctx, 8, many); err != nil {
+		t.Fatalf("MoveIssuesToSprint: %v", err)
+	}
+	if len(moved) != 60 {
+		t.Errorf("moved %d issues, want all 60 across batches", len(moved))
+	}
+	if err := c.UpdateSprint(ctx, 7, map[string]interface{}{"state": "closed"}); err != nil {
+		t.Fatalf("UpdateSprint: %v", err)
+	}
+}
+
+func TestRankIssues(t *testing.T) {
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/rest/agile/1.0/issue/rank" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		if body["rankAfterIssue"] == "PROJ-9" {
+			w.WriteHeader(http.StatusMultiStatus)
+			w.Write([]byte(`{"entries": [{"issueKey": "PROJ-1", "status": 400, "errors": ["Issue is not on a board"]}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	if err := c.RankIssues(context.Background(), []string{"PROJ-1"}, "PROJ-2", false); err != nil {
+		t.Fatalf("RankIssues: %v", err)
+	}
+	if bodies[0]["rankBeforeIssue"] != "PROJ-2" || bodies[0]["rankAfterIssue"] != nil {
+		t.Errorf("body = %v, want rankBeforeIssue", bodies[0])
+	}
+	err := c.RankIssues(context.Background(), []string{"PROJ-1"}, "PROJ-9", true)
+	if err == nil || !strings.Contains(err.Error(), "not on a board") {
+		t.Errorf("err = %v, want the entry's error", err)
+	}
+}
+
+func TestGetIssueSprint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("fields") != "sprint" {
+			t.Errorf("fields = %q", r.URL.Query().Get("fields"))
+		}
+		switch r.URL.Path {
+		case "/rest/agile/1.0/issue/PROJ-1":
+			w.Write([]byte(`{"key": "PROJ-1", "fields": {"sprint": {"id": 7, "name": "Sprint 7", "state": "active", "originBoardId": 3}}}`))
+		case "/rest/agile/1.0/issue/PROJ-2":
+			w.Write([]byte(`{"key": "PROJ-2", "fields": {}}`))
+		default:
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	sprint, err := c.GetIssueSprint(context.Background(), "PROJ-1")
+	if err != nil || sprint == nil || sprint.ID != 7 || sprint.OriginBoardID != 3 {
+		t.Fatalf("sprint = %+v, %v", sprint, err)
+	}
+	sprint, err = c.GetIssueSprint(context.Background(), "PROJ-2")
+	if err != nil || sprint != nil {
+		t.Errorf("sprint = %+v, %v; want none for a backlog issue", sprint, err)
+	}
+}
+
+func TestGetDevInfo(t *testing.T) {
+	var details []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("issueId") != "10001" {
+			t.Errorf("issueId = %q", q.Get("issueId"))
+		}
+		switch r.URL.Path {
+		case "/rest/dev-status/latest/issue/summary":
+			w.Write([]byte(`{"summary": {
+				"pullrequest": {"overall": {"count": 1}, "byInstanceType": {"GitHub": {"count": 1}}},
+				"branch": {"overall": {"count": 0}, "byInstanceType": {}},
+				"repository": {"overall": {"count": 2}, "byInstanceType": {"GitHub": {"count": 2}}}
+			}}`))
+		case "/rest/dev-status/latest/issue/detail":
+			details = append(details, q.Get("applicationType")+"/"+q.Get("dataType"))
+			if q.Get("dataType") == "pullrequest" {
+				w.Write([]byte(`{"detail": [{"pullRequests": [{"id": "#12", "name": "Fix widget", "status": "OPEN", "source": {"branch": "fix"}, "destination": {"branch": "main"}}]}]}`))
+			} else {
+				w.Write([]byte(`{"detail": [{"repositories": [{"commits": [{"displayId": "abc1234", "message": "Fix"}, {"displayId": "def5678", "message": "Test"}]}]}]}`))
+			}
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	info, err := c.GetDevInfo(context.Background(), "10001")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Join(details, ","); got != "GitHub/pullrequest,GitHub/repository" {
+		t.Errorf("details fetched = %s, want only kinds with something linked", got)
+	}
+	if len(info.PullRequests) != 1 || info.PullRequests[0].Source.Branch != "fix" || len(info.Commits) != 2 || len(info.Branches) != 0 {
+		t.Errorf("info = %+v", info)
+	}
+	if info.Empty() || !(&DevInfo{}).Empty() {
+		t.Error("Empty should be false only with linked work")
+	}
+}
+
+func TestSearchProjects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/project/search" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+		if r.URL.Query().Get("startAt") == "0" {
+			w.Write([]byte(`{"values": [{"id": "1", "key": "APP", "name": "App"}], "isLast": false}`))
+			return
+		}
+		w.Write([]byte(`{"values": [{"id": "2", "key": "OPS", "name": "Operations"}], "isLast": true}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	projects, err := c.SearchProjects(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(projects) != 2 || projects[0].Key != "APP" || projects[1].Name != "Operations" {
+		t.Errorf("projects = %+v", projects)
+	}
+}
+
+func TestCreateIssueLink(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/issueLink" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+		var body map[string]map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["type"]["name"] != "Blocks" || body["inwardIssue"]["key"] != "PROJ-1" || body["outwardIssue"]["key"] != "PROJ-2" {
+			t.Errorf("body = %v", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	if err := c.CreateIssueLink(context.Background(), "Blocks", "PROJ-1", "PROJ-2"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetIssueLinkTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issueLinkType" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"issueLinkTypes":[{"id":"1","name":"Blocks","inward":"is blocked by","outward":"blocks"}]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	types, err := c.GetIssueLinkTypes(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(types) != 1 || types[0].Name != "Blocks" || types[0].Inward != "is blocked by" {
+		t.Errorf("types = %+v", types)
+	}
+}
+
+func TestCountIssues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if r.URL.Path != "/rest/api/3/search/approximate-count" || body["jql"] != "project = PROJ" {
+			t.Errorf("request = %s %v", r.URL.Path, body)
+		}
+		w.Write([]byte(`{"count":42}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	n, err := c.CountIssues(context.Background(), "project = PROJ")
+	if err != nil || n != 42 {
+		t.Errorf("count = %d, %v, want 42", n, err)
+	}
+}
+
+func TestCountIssuesFallsBackToClassicTotal(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		switch r.URL.Path {
+		case "/rest/api/3/search/approximate-count":
+			w.WriteHeader(http.StatusNotFound)
+		case "/rest/api/3/search":
+			if body["maxResults"] != float64(0) {
+				t.Errorf("maxResults = %v, want no issues fetched", body["maxResults"])
+			}
+			w.Write([]byte(`{"startAt":0,"total":7,"issues":[]}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	n, err := c.CountIssues(context.Background(), "project = PROJ")
+	if err != nil || n != 7 {
+		t.Errorf("count = %d, %v, want the classic total 7", n, err)
+	}
+}
+
+func TestCountIssuesBadQuery(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"errorMessages":["bad JQL"]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	if _, err := c.CountIssues(context.Background(), "nonsense"); err == nil || calls != 1 {
+		t.Errorf("err = %v after %d calls, want the 400 returned without falling back", err, calls)
+	}
+}
+
+func TestProbeCapabilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/rest/agile/"):
+			w.WriteHeader(http.StatusNotFound)
+		case strings.HasPrefix(r.URL.Path, "/rest/dev-status/"):
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errorMessages":["issueId is required"]}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@test.com", "token")
+	if ok, err := c.HasAgileAPI(context.Background()); ok || err != nil {
+		t.Errorf("HasAgileAPI = %v, %v; want false for a 404", ok, err)
+	}
+	if ok, err := c.HasDevStatus(context.Background()); !ok || err != nil {
+		t.Errorf("HasDevStatus = %v, %v; want true for an answer from the API", ok, err)
+	}
+
+	server.Close()
+	if _, err := c.HasAgileAPI(context.Background()); err == nil {
+		t.Error("expected an error when Jira can't be reached")
+	}
+}
@@ -0,0 +1,107 @@
+package jira
+
+import (
+	"encoding/base64"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// debugBodyLimit is how much of a request or response body the debug log
+// keeps; a full search result can run to megabytes.
+const debugBodyLimit = 16 << 10
+
+// redacted replaces credentials in the debug log.
+const redacted = "[REDACTED]"
+
+// secretHeaders are the headers whose values never reach the debug log.
+var secretHeaders = map[string]bool{
+	"Authorization": true,
+	"Cookie":        true,
+	"Set-Cookie":    true,
+}
+
+// WithDebugLog logs every request and response, with headers and bodies,
+// to l at debug level, for --debug. Credentials are redacted.
+func WithDebugLog(l *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.debugLog = l
+	}
+}
+
+// debugExchange logs one request and Jira's answer to it; resp is nil when
+// the request got no answer.
+func (c *Client) debugExchange(req *http.Request, payload []byte, resp *http.Response, data []byte, elapsed time.Duration, err error) {
+	if c.debugLog == nil {
+		return
+	}
+	attrs := []any{
+		"method", req.Method,
+		"url", c.redact(req.URL.String()),
+		"request_headers", c.debugHeaders(req.Header),
+	}
+	if payload != nil {
+		attrs = append(attrs, "request_body", c.debugBody(payload))
+	}
+	attrs = append(attrs, "duration", elapsed.Round(time.Millisecond))
+	if resp == nil {
+		c.debugLog.Debug("request failed", append(attrs, "err", c.redact(err.Error()))...)
+		return
+	}
+	attrs = append(attrs,
+		"status", resp.StatusCode,
+		"response_headers", c.debugHeaders(resp.Header),
+		"response_body", c.debugBody(data),
+	)
+	c.debugLog.Debug("request", attrs...)
+}
+
+// debugHeaders renders headers sorted by name on one line, with secret
+// values redacted.
+func (c *Client) debugHeaders(h http.Header) string {
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		value := strings.Join(h.Values(name), ", ")
+		if secretHeaders[http.CanonicalHeaderKey(name)] {
+			scheme, _, _ := strings.Cut(value, " ")
+			value = redacted
+			if scheme == "Basic" || scheme == "Bearer" {
+				value = scheme + " " + redacted
+			}
+		}
+		parts = append(parts, name+": "+value)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// debugBody returns a body for the log, cut at debugBodyLimit bytes, back
+// to the start of a character so the log stays valid UTF-8.
+func (c *Client) debugBody(body []byte) string {
+	s := c.redact(string(body))
+	if len(s) > debugBodyLimit {
+		cut := debugBodyLimit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		s = s[:cut] + "… (truncated)"
+	}
+	return s
+}
+
+// redact removes the API token, and the basic auth value made from it,
+// wherever it appears, e.g. echoed back in an error.
+func (c *Client) redact(s string) string {
+	if c.apiToken == "" {
+		return s
+	}
+	basic := base64.StdEncoding.EncodeToString([]byte(c.email + ":" + c.apiToken))
+	return strings.NewReplacer(c.apiToken, redacted, basic, redacted).Replace(s)
+}
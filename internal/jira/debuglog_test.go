@@ -0,0 +1,59 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestDebugLogRedactsCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Set-Cookie", "session=abc")
+		w.WriteHeader(http.StatusUnauthorized)
+		// Some proxies echo the credentials back
+		w.Write([]byte(`{"errorMessages":["bad auth ` + r.Header.Get("Authorization") + `"]}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	l := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c := NewClient(server.URL, "me@example.com", "s3cret-token", WithDebugLog(l))
+	c.UpdateIssue(context.Background(), "PROJ-1", map[string]interface{}{"summary": "New title"})
+
+	log := buf.String()
+	for _, want := range []string{"method=PUT", "status=401", "New title", "Basic [REDACTED]", "Set-Cookie: [REDACTED]", "bad auth Basic [REDACTED]"} {
+		if !strings.Contains(log, want) {
+			t.Errorf("log is missing %q:\n%s", want, log)
+		}
+	}
+	basic := base64.StdEncoding.EncodeToString([]byte("me@example.com:s3cret-token"))
+	if strings.Contains(log, "s3cret-token") || strings.Contains(log, basic) || strings.Contains(log, "abc") {
+		t.Errorf("log leaks credentials:\n%s", log)
+	}
+}
+
+func TestDebugLogTruncatesBodies(t *testing.T) {
+	c := NewClient("https://example.atlassian.net", "me@example.com", "token")
+	body := c.debugBody(bytes.Repeat([]byte("x"), debugBodyLimit+10))
+	if len(body) != debugBodyLimit+len("… (truncated)") {
+		t.Errorf("body is %d bytes, want it cut at %d", len(body), debugBodyLimit)
+	}
+}
+
+func TestDebugLogTruncatesAtRuneBoundary(t *testing.T) {
+	c := NewClient("https://example.atlassian.net", "me@example.com", "token")
+	// "é" is two bytes, so the limit falls in the middle of one
+	body := c.debugBody(append([]byte("x"), bytes.Repeat([]byte("é"), debugBodyLimit)...))
+	if !utf8.ValidString(body) {
+		t.Errorf("body is not valid UTF-8 after truncation")
+	}
+	if want := debugBodyLimit - 1 + len("… (truncated)"); len(body) != want {
+		t.Errorf("body is %d bytes, want %d", len(body), want)
+	}
+}
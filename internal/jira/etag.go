@@ -0,0 +1,138 @@
+package jira
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// etagPaths are the GET endpoints whose answers are kept for revalidation:
+// issues, filters, priorities, and user lookups, which a refresh asks for
+// again and which rarely change in between.
+var etagPaths = []string{
+	"/rest/api/3/issue/",
+	"/rest/api/3/filter/",
+	"/rest/api/3/priority",
+	"/rest/api/3/user",
+}
+
+// Limits on what the ETag cache holds, so a long session can't grow it
+// without bound.
+const (
+	maxETagEntries = 500
+	maxETagBody    = 1 << 20
+)
+
+// etagEntry is a cached answer and the ETag it was served with.
+type etagEntry struct {
+	etag   string
+	header http.Header
+	body   []byte
+}
+
+// etagTransport revalidates cacheable GETs with If-None-Match. When Jira
+// answers 304 Not Modified, the caller gets the stored answer as a 200, so
+// an unchanged issue costs no body transfer and no parsing of a new one.
+type etagTransport struct {
+	next http.RoundTripper
+
+	mu      sync.Mutex
+	entries map[string]etagEntry // by URL
+	order   []string             // URLs oldest first, for eviction
+}
+
+func newETagTransport(next http.RoundTripper) *etagTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &etagTransport{next: next, entries: make(map[string]etagEntry)}
+}
+
+// cacheable reports whether req's answer may be kept.
+func cacheable(req *http.Request) bool {
+	if req.Method != http.MethodGet {
+		return false
+	}
+	for _, prefix := range etagPaths {
+		if strings.HasPrefix(req.URL.Path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *etagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !cacheable(req) {
+		return t.next.RoundTrip(req)
+	}
+	key := req.URL.String()
+	t.mu.Lock()
+	entry, ok := t.entries[key]
+	t.mu.Unlock()
+	if ok {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if ok && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		return &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Proto:         resp.Proto,
+			ProtoMajor:    resp.ProtoMajor,
+			ProtoMinor:    resp.ProtoMinor,
+			Header:        entry.header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(entry.body)),
+			ContentLength: int64(len(entry.body)),
+			Request:       req,
+		}, nil
+	}
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || etag == "" || resp.ContentLength > maxETagBody {
+		return resp, nil
+	}
+
+	orig := resp.Body
+	body, err := io.ReadAll(io.LimitReader(orig, maxETagBody+1))
+	if err != nil {
+		orig.Close()
+		return nil, err
+	}
+	if len(body) > maxETagBody {
+		// Too big to keep: hand on what was read followed by the rest
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), orig), orig}
+		return resp, nil
+	}
+	orig.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	header := resp.Header.Clone()
+	// The stored body is already decoded
+	header.Del("Content-Encoding")
+	header.Del("Content-Length")
+	t.store(key, etagEntry{etag: etag, header: header, body: body})
+	return resp, nil
+}
+
+// store keeps an answer, evicting the oldest past maxETagEntries.
+func (t *etagTransport) store(key string, entry etagEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.entries[key]; !ok {
+		t.order = append(t.order, key)
+	}
+	t.entries[key] = entry
+	for len(t.order) > maxETagEntries {
+		delete(t.entries, t.order[0])
+		t.order = t.order[1:]
+	}
+}
@@ -0,0 +1,79 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestETagRevalidation(t *testing.T) {
+	etag := `"v1"`
+	summary := "First"
+	var full, notModified int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full++
+		w.Header().Set("ETag", etag)
+		w.Write([]byte(`{"key":"PROJ-1","fields":{"summary":"` + summary + `"}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	ctx := context.Background()
+	for range 2 {
+		issue, err := c.GetIssue(ctx, "PROJ-1")
+		if err != nil || issue.Fields.Summary != "First" {
+			t.Fatalf("issue = %+v, %v", issue, err)
+		}
+	}
+	if full != 1 || notModified != 1 {
+		t.Errorf("full = %d, not modified = %d, want the second read answered from the cache", full, notModified)
+	}
+
+	// A changed issue has a new ETag and is fetched in full
+	etag, summary = `"v2"`, "Second"
+	issue, err := c.GetIssue(ctx, "PROJ-1")
+	if err != nil || issue.Fields.Summary != "Second" || full != 2 {
+		t.Errorf("issue = %+v, %v, full = %d", issue, err, full)
+	}
+}
+
+func TestETagSkipsOtherRequests(t *testing.T) {
+	var conditional int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			conditional++
+		}
+		w.Header().Set("ETag", `"same"`)
+		w.Write([]byte(`{"isLast":true,"issues":[]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	for range 2 {
+		c.SearchIssues(context.Background(), SearchOptions{JQL: "project = PROJ"})
+		c.UpdateIssue(context.Background(), "PROJ-1", map[string]interface{}{"summary": "x"})
+	}
+	if conditional != 0 {
+		t.Errorf("%d conditional requests, want searches and writes sent as they are", conditional)
+	}
+}
+
+func TestETagCacheEvictsOldest(t *testing.T) {
+	tr := newETagTransport(nil)
+	for i := range maxETagEntries + 1 {
+		tr.store(strings.Repeat("u", i+1), etagEntry{etag: "x"})
+	}
+	if len(tr.entries) != maxETagEntries {
+		t.Errorf("entries = %d, want at most %d", len(tr.entries), maxETagEntries)
+	}
+	if _, ok := tr.entries["u"]; ok {
+		t.Error("the oldest entry should be evicted first")
+	}
+}
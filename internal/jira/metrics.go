@@ -0,0 +1,114 @@
+package jira
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// Metrics records per-endpoint request counts, errors, and latencies for
+// the lifetime of a Client. It is safe for concurrent use.
+type Metrics struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointRecord
+}
+
+type endpointRecord struct {
+	count     int
+	errors    int
+	latencies []time.Duration
+}
+
+// EndpointStats summarizes the requests made to one endpoint.
+type EndpointStats struct {
+	Endpoint string // method and normalized path, e.g. "GET /rest/api/3/issue/{id}"
+	Count    int
+	Errors   int
+	P50      time.Duration
+	P95      time.Duration
+}
+
+// ErrorRate returns the fraction of requests that failed, from 0 to 1.
+func (s EndpointStats) ErrorRate() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Count)
+}
+
+func newMetrics() *Metrics {
+	return &Metrics{endpoints: make(map[string]*endpointRecord)}
+}
+
+// record adds one request. failed covers both transport errors and HTTP
+// error statuses.
+func (m *Metrics) record(method, path string, d time.Duration, failed bool) {
+	key := method + " " + normalizeEndpoint(path)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.endpoints[key]
+	if !ok {
+		r = &endpointRecord{}
+		m.endpoints[key] = r
+	}
+	r.count++
+	if failed {
+		r.errors++
+	}
+	r.latencies = append(r.latencies, d)
+}
+
+// Snapshot returns stats for every endpoint called so far, busiest first.
+func (m *Metrics) Snapshot() []EndpointStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stats := make([]EndpointStats, 0, len(m.endpoints))
+	for key, r := range m.endpoints {
+		sorted := append([]time.Duration(nil), r.latencies...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		stats = append(stats, EndpointStats{
+			Endpoint: key,
+			Count:    r.count,
+			Errors:   r.errors,
+			P50:      percentile(sorted, 50),
+			P95:      percentile(sorted, 95),
+		})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Count != stats[j].Count {
+			return stats[i].Count > stats[j].Count
+		}
+		return stats[i].Endpoint < stats[j].Endpoint
+	})
+	return stats
+}
+
+// percentile returns the nearest-rank percentile of sorted durations.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100 // ceil(p/100 * n)
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// normalizeEndpoint strips the query string and replaces issue keys and
+// numeric IDs with {id} so requests for different issues group together.
+// The leading /rest/<api>/<version> segments are kept as-is.
+func normalizeEndpoint(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		if i > 3 && strings.IndexFunc(seg, unicode.IsDigit) >= 0 {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,75 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNormalizeEndpoint(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/rest/api/3/myself", "/rest/api/3/myself"},
+		{"/rest/api/3/issue/PROJ-123", "/rest/api/3/issue/{id}"},
+		{"/rest/api/3/issue/PROJ-1/transitions", "/rest/api/3/issue/{id}/transitions"},
+		{"/rest/api/3/filter/10042", "/rest/api/3/filter/{id}"},
+		{"/rest/api/3/label?startAt=0&maxResults=1000", "/rest/api/3/label"},
+	}
+	for _, tt := range tests {
+		if got := normalizeEndpoint(tt.path); got != tt.want {
+			t.Errorf("normalizeEndpoint(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 20; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+	if got := percentile(sorted, 50); got != 10*time.Millisecond {
+		t.Errorf("p50 = %s, want 10ms", got)
+	}
+	if got := percentile(sorted, 95); got != 19*time.Millisecond {
+		t.Errorf("p95 = %s, want 19ms", got)
+	}
+	if got := percentile(nil, 50); got != 0 {
+		t.Errorf("empty p50 = %s, want 0", got)
+	}
+}
+
+func TestClientRecordsMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/api/3/issue/PROJ-2" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"key":"PROJ-1","fields":{}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test@example.com", "token")
+	ctx := context.Background()
+	c.GetIssue(ctx, "PROJ-1")
+	c.GetIssue(ctx, "PROJ-2")
+	c.GetMyself(ctx)
+
+	stats := c.Metrics().Snapshot()
+	if len(stats) != 2 {
+		t.Fatalf("expected 2 endpoints, got %+v", stats)
+	}
+	issue := stats[0]
+	if issue.Endpoint != "GET /rest/api/3/issue/{id}" {
+		t.Errorf("busiest endpoint = %q", issue.Endpoint)
+	}
+	if issue.Count != 2 || issue.Errors != 1 {
+		t.Errorf("count/errors = %d/%d, want 2/1", issue.Count, issue.Errors)
+	}
+	if issue.ErrorRate() != 0.5 {
+		t.Errorf("error rate = %v, want 0.5", issue.ErrorRate())
+	}
+}
@@ -0,0 +1,89 @@
+package jira
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxRateRetries is how many times a request answered 429 or 503 is sent
+// again before the error is returned.
+const maxRateRetries = 3
+
+// rateBackoff is the wait before the first retry when Jira doesn't say how
+// long to wait; it doubles with each retry, plus up to half again of jitter
+// so parallel requests don't retry in lockstep.
+var rateBackoff = time.Second
+
+// WithMaxConcurrent caps how many requests are in flight at once; others
+// wait their turn. Loading many tabs at startup otherwise sends all their
+// searches together. n <= 0 leaves requests uncapped.
+func WithMaxConcurrent(n int) ClientOption {
+	return func(c *Client) {
+		if n > 0 {
+			c.slots = make(chan struct{}, n)
+		}
+	}
+}
+
+// acquire waits for a request slot, if concurrency is capped.
+func (c *Client) acquire(ctx context.Context) error {
+	if c.slots == nil {
+		return nil
+	}
+	select {
+	case c.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release frees the slot taken by acquire.
+func (c *Client) release() {
+	if c.slots != nil {
+		<-c.slots
+	}
+}
+
+// retryLater reports whether a failed response is worth another attempt,
+// having waited as long as Jira asked. Only rate limiting (429) and
+// unavailability (503) are retried, and only while the request's timeout
+// leaves room for the wait.
+func (c *Client) retryLater(ctx context.Context, resp *http.Response, attempt int) bool {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return false
+	}
+	if attempt >= maxRateRetries {
+		return false
+	}
+	wait := retryWait(resp.Header.Get("Retry-After"), attempt, time.Now())
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		return false
+	}
+	c.logger.Info("rate limited, retrying", "path", resp.Request.URL.Path, "status", resp.StatusCode, "wait", wait)
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// retryWait is how long to wait before retry number attempt+1: what the
+// Retry-After header asks, in seconds or as a date, or else exponential
+// backoff with jitter.
+func retryWait(retryAfter string, attempt int, now time.Time) time.Duration {
+	if secs, err := strconv.Atoi(retryAfter); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if at, err := http.ParseTime(retryAfter); err == nil {
+		return max(at.Sub(now), 0)
+	}
+	wait := rateBackoff << attempt
+	return wait + rand.N(wait/2+1)
+}
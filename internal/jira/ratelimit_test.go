@@ -0,0 +1,117 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimitRetries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Retried requests resend their body
+		if body, _ := io.ReadAll(r.Body); !strings.Contains(string(body), "Retry me") {
+			t.Errorf("body = %q", body)
+		}
+		if calls.Add(1) <= 2 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"id":"1"}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "user@example.com", "token")
+	if err := c.UpdateIssue(context.Background(), "PROJ-1", map[string]interface{}{"summary": "Retry me"}); err != nil {
+		t.Fatalf("err = %v", err)
+	}
+	if n := calls.Load(); n != 3 {
+		t.Errorf("calls = %d, want two 429s then success", n)
+	}
+}
+
+func TestRateLimitGivesUp(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "user@example.com", "token")
+	_, err := c.GetMyself(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "API error 503") {
+		t.Errorf("err = %v", err)
+	}
+	if n := calls.Load(); n != maxRateRetries+1 {
+		t.Errorf("calls = %d, want %d", n, maxRateRetries+1)
+	}
+
+	// A wait longer than the request's timeout isn't attempted
+	calls.Store(0)
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	if _, err := c.GetMyself(context.Background()); err == nil || calls.Load() != 1 {
+		t.Errorf("calls = %d, err = %v, want one attempt", calls.Load(), err)
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	if got := retryWait("7", 0, now); got != 7*time.Second {
+		t.Errorf("seconds: got %s", got)
+	}
+	if got := retryWait("Fri, 16 Oct 2026 09:00:30 GMT", 0, now); got != 30*time.Second {
+		t.Errorf("date: got %s", got)
+	}
+	for attempt := range 3 {
+		base := rateBackoff << attempt
+		if got := retryWait("", attempt, now); got < base || got > base+base/2 {
+			t.Errorf("attempt %d: got %s, want %s plus jitter", attempt, got, base)
+		}
+	}
+}
+
+func TestMaxConcurrent(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		inFlight.Add(-1)
+		w.Write([]byte(`{"accountId":"me"}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "user@example.com", "token", WithMaxConcurrent(2))
+	var wg sync.WaitGroup
+	for range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetMyself(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if p := peak.Load(); p > 2 {
+		t.Errorf("peak concurrency = %d, want at most 2", p)
+	}
+}
@@ -0,0 +1,57 @@
+package jira
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// TransportConfig is how requests reach Jira on corporate networks.
+type TransportConfig struct {
+	// Proxy is the URL of an http, https, or socks5 proxy. Empty uses
+	// HTTPS_PROXY, HTTP_PROXY, and NO_PROXY from the environment.
+	Proxy string
+	// CABundle is a PEM file of certificates trusted besides the system's,
+	// e.g. for a TLS-intercepting proxy.
+	CABundle string
+	// InsecureSkipVerify accepts any certificate. Anyone on the network
+	// can then read the token.
+	InsecureSkipVerify bool
+}
+
+// NewTransport returns an HTTP transport configured by cfg, based on
+// http.DefaultTransport.
+func NewTransport(cfg TransportConfig) (*http.Transport, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.Proxy != "" {
+		proxy, err := url.Parse(cfg.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("parsing proxy URL: %w", err)
+		}
+		t.Proxy = http.ProxyURL(proxy)
+	}
+	if cfg.CABundle == "" && !cfg.InsecureSkipVerify {
+		return t, nil
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify}
+	if cfg.CABundle != "" {
+		pem, err := os.ReadFile(cfg.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("CA bundle %s has no PEM certificates", cfg.CABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	t.TLSClientConfig = tlsConfig
+	return t, nil
+}
@@ -0,0 +1,74 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewTransportCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(User{AccountID: "abc123"})
+	}))
+	defer server.Close()
+	myself := func(tc TransportConfig) error {
+		transport, err := NewTransport(tc)
+		if err != nil {
+			t.Fatalf("NewTransport: %v", err)
+		}
+		client := NewClient(server.URL, "test@test.com", "token", WithHTTPClient(&http.Client{Transport: transport}))
+		_, err = client.GetMyself(context.Background())
+		return err
+	}
+
+	if err := myself(TransportConfig{}); err == nil {
+		t.Error("expected the test server's certificate to be rejected by default")
+	}
+
+	bundle := filepath.Join(t.TempDir(), "ca.pem")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(bundle, cert, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := myself(TransportConfig{CABundle: bundle}); err != nil {
+		t.Errorf("with the CA bundle: %v", err)
+	}
+	if err := myself(TransportConfig{InsecureSkipVerify: true}); err != nil {
+		t.Errorf("skipping verification: %v", err)
+	}
+}
+
+func TestNewTransportErrors(t *testing.T) {
+	empty := filepath.Join(t.TempDir(), "empty.pem")
+	os.WriteFile(empty, []byte("not a certificate"), 0o600)
+	for _, tc := range []struct {
+		cfg  TransportConfig
+		want string
+	}{
+		{TransportConfig{CABundle: filepath.Join(t.TempDir(), "missing.pem")}, "reading CA bundle"},
+		{TransportConfig{CABundle: empty}, "no PEM certificates"},
+		{TransportConfig{Proxy: "http://[::1"}, "parsing proxy URL"},
+	} {
+		if _, err := NewTransport(tc.cfg); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%+v: err = %v, want %q", tc.cfg, err, tc.want)
+		}
+	}
+}
+
+func TestNewTransportProxy(t *testing.T) {
+	transport, err := NewTransport(TransportConfig{Proxy: "http://proxy.corp:3128"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://example.atlassian.net/rest/api/3/myself", nil)
+	proxy, err := transport.Proxy(req)
+	if err != nil || proxy == nil || proxy.Host != "proxy.corp:3128" {
+		t.Errorf("proxy = %v, %v", proxy, err)
+	}
+}
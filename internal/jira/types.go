@@ -1,5 +1,13 @@
 package jira
 
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
 // User represents a Jira user.
 type User struct {
 	AccountID   string `json:"accountId"`
@@ -30,9 +38,64 @@ type IssueFields struct {
 	Updated     string       `json:"updated"`
 	DueDate     string       `json:"duedate"`
 	Labels      []string     `json:"labels"`
+	Components  []Named      `json:"components"`
 	Subtasks    []Issue      `json:"subtasks"`
 	IssueLinks  []IssueLink  `json:"issuelinks"`
 	Parent      *ParentIssue `json:"parent"`
+
+	// Custom holds the raw values of any customfield_* fields returned,
+	// keyed by field id. Their meaning depends on the Jira instance.
+	Custom map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the known fields and keeps custom fields raw.
+func (f *IssueFields) UnmarshalJSON(data []byte) error {
+	type plain IssueFields
+	if err := json.Unmarshal(data, (*plain)(f)); err != nil {
+		return err
+	}
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	for id, raw := range all {
+		if !strings.HasPrefix(id, "customfield_") {
+			continue
+		}
+		if f.Custom == nil {
+			f.Custom = make(map[string]json.RawMessage)
+		}
+		f.Custom[id] = raw
+	}
+	return nil
+}
+
+// MarshalJSON encodes the known fields along with the raw custom fields,
+// so issues round-trip through the disk cache.
+func (f IssueFields) MarshalJSON() ([]byte, error) {
+	type plain IssueFields
+	data, err := json.Marshal(plain(f))
+	if err != nil || len(f.Custom) == 0 {
+		return data, err
+	}
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	for id, raw := range f.Custom {
+		all[id] = raw
+	}
+	return json.Marshal(all)
+}
+
+// CustomString returns a string-valued custom field, or "" if it is
+// missing, null, or not a string.
+func (f IssueFields) CustomString(fieldID string) string {
+	var s string
+	if err := json.Unmarshal(f.Custom[fieldID], &s); err != nil {
+		return ""
+	}
+	return s
 }
 
 // ParentIssue is a minimal issue reference for the parent field.
@@ -89,19 +152,30 @@ type Filter struct {
 	Favourite   bool   `json:"favourite"`
 }
 
+// Project is a Jira project as listed by project search.
+type Project struct {
+	ID   string `json:"id"`
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
 // Board represents a Jira board.
 type Board struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
-	Type string `json:"type"`
+	Type string `json:"type"` // "scrum" or "kanban"
 }
 
 // Sprint represents a Jira sprint.
 type Sprint struct {
-	ID    int    `json:"id"`
-	Name  string `json:"name"`
-	State string `json:"state"`
-	Goal  string `json:"goal"`
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	State     string `json:"state"` // "future", "active", or "closed"
+	Goal      string `json:"goal"`
+	StartDate string `json:"startDate,omitempty"` // RFC 3339, "" if unset
+	EndDate   string `json:"endDate,omitempty"`   // RFC 3339, "" if unset
+
+	OriginBoardID int `json:"originBoardId,omitempty"` // the board the sprint was created on
 }
 
 // SearchResult represents the response from a JQL search
@@ -112,6 +186,25 @@ type SearchResult struct {
 	IsLast        bool    `json:"isLast"`
 }
 
+// classicSearchPage is a page from the classic POST /search endpoint,
+// which pages by offset and reports the total.
+type classicSearchPage struct {
+	StartAt int     `json:"startAt"`
+	Total   int     `json:"total"`
+	Issues  []Issue `json:"issues"`
+}
+
+// result converts the page to the enhanced search's shape, with the next
+// offset as the page token.
+func (p classicSearchPage) result() *SearchResult {
+	next := p.StartAt + len(p.Issues)
+	r := &SearchResult{Issues: p.Issues, IsLast: next >= p.Total || len(p.Issues) == 0}
+	if !r.IsLast {
+		r.NextPageToken = strconv.Itoa(next)
+	}
+	return r
+}
+
 // SearchOptions configures a JQL search request.
 type SearchOptions struct {
 	JQL           string
@@ -125,6 +218,34 @@ type Transition struct {
 	ID   string  `json:"id"`
 	Name string  `json:"name"`
 	To   *Status `json:"to"`
+
+	// Fields are those on the transition's screen, by field key
+	Fields map[string]FieldMeta `json:"fields,omitempty"`
+}
+
+// CommentField is the key a transition screen's comment comes under.
+const CommentField = "comment"
+
+// RequiredFields returns the fields t's screen requires that have no
+// default, by key with the comment last.
+func (t Transition) RequiredFields() []FieldMeta {
+	keys := make([]string, 0, len(t.Fields))
+	for key, f := range t.Fields {
+		if f.Required && !f.HasDefaultValue {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if (keys[i] == CommentField) != (keys[j] == CommentField) {
+			return keys[j] == CommentField
+		}
+		return keys[i] < keys[j]
+	})
+	fields := make([]FieldMeta, len(keys))
+	for i, key := range keys {
+		fields[i] = t.Fields[key]
+	}
+	return fields
 }
 
 // TransitionsResponse wraps the list returned by GET transitions.
@@ -153,6 +274,143 @@ type Comment struct {
 	Updated string      `json:"updated"`
 }
 
+// CreateMetaPage is one page of
+// GET /rest/api/3/issue/createmeta/{project}/issuetypes/{issueTypeId}.
+type CreateMetaPage struct {
+	StartAt    int         `json:"startAt"`
+	MaxResults int         `json:"maxResults"`
+	Total      int         `json:"total"`
+	Fields     []FieldMeta `json:"fields"`
+}
+
+// EditMeta is the response from the issue editmeta endpoint.
+type EditMeta struct {
+	Fields map[string]FieldMeta `json:"fields"`
+}
+
+// FieldMeta describes a field on the create or edit screen.
+type FieldMeta struct {
+	FieldID         string         `json:"fieldId"`
+	Key             string         `json:"key"`
+	Name            string         `json:"name"`
+	Required        bool           `json:"required"`
+	HasDefaultValue bool           `json:"hasDefaultValue"`
+	Schema          FieldSchema    `json:"schema"`
+	AllowedValues   []AllowedValue `json:"allowedValues,omitempty"`
+}
+
+// IDValue is the JSON shape an allowed value of f is sent as: its id, in
+// a list for array fields.
+func (f FieldMeta) IDValue(id string) interface{} {
+	v := map[string]interface{}{"id": id}
+	if f.Schema.Type == "array" {
+		return []interface{}{v}
+	}
+	return v
+}
+
+// InputValue converts typed text into the JSON shape the create, edit, and
+// transition APIs expect for f. A field with allowed values takes the
+// label of one of them, ignoring case.
+func (f FieldMeta) InputValue(text string) (interface{}, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, fmt.Errorf("%s is required", f.Name)
+	}
+	if len(f.AllowedValues) > 0 {
+		var labels []string
+		for _, v := range f.AllowedValues {
+			if strings.EqualFold(v.Label(), text) {
+				return f.IDValue(v.ID), nil
+			}
+			labels = append(labels, fmt.Sprintf("%q", v.Label()))
+		}
+		return nil, fmt.Errorf("no %s %q (choices: %s)", f.Name, text, strings.Join(labels, ", "))
+	}
+	if f.Schema.Type == "number" {
+		n, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a number", f.Name)
+		}
+		return n, nil
+	}
+	return text, nil
+}
+
+// FieldSchema is the data type of a field. Type is e.g. "string",
+// "number", "option", or "array" (with Items holding the element type).
+type FieldSchema struct {
+	Type   string `json:"type"`
+	Items  string `json:"items,omitempty"`
+	Custom string `json:"custom,omitempty"`
+}
+
+// AllowedValue is one choice for a select-style field. Options use Value,
+// most other types (components, versions, priorities) use Name.
+type AllowedValue struct {
+	ID    string `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// Label returns the display text of the value.
+func (v AllowedValue) Label() string {
+	if v.Value != "" {
+		return v.Value
+	}
+	return v.Name
+}
+
+// ChangelogPage is one page of GET /rest/api/3/issue/{key}/changelog.
+type ChangelogPage struct {
+	StartAt    int             `json:"startAt"`
+	MaxResults int             `json:"maxResults"`
+	Total      int             `json:"total"`
+	IsLast     bool            `json:"isLast"`
+	Values     []ChangeHistory `json:"values"`
+}
+
+// ChangeHistory is one changelog entry: a set of field changes made by one
+// user at one time.
+type ChangeHistory struct {
+	ID      string       `json:"id"`
+	Author  *User        `json:"author"`
+	Created string       `json:"created"`
+	Items   []ChangeItem `json:"items"`
+}
+
+// ChangeItem is a single field change within a ChangeHistory.
+type ChangeItem struct {
+	Field      string `json:"field"`
+	FieldType  string `json:"fieldtype"`
+	FromString string `json:"fromString"`
+	ToString   string `json:"toString"`
+}
+
+// Group is a Jira user group.
+type Group struct {
+	Name    string `json:"name"`
+	GroupID string `json:"groupId"`
+}
+
+// GroupMembersPage is one page of the response from GET /rest/api/3/group/member.
+type GroupMembersPage struct {
+	Values     []User `json:"values"`
+	StartAt    int    `json:"startAt"`
+	MaxResults int    `json:"maxResults"`
+	Total      int    `json:"total"`
+	IsLast     bool   `json:"isLast"`
+}
+
+// LabelsPage is one page of the response from GET /rest/api/3/label.
+type LabelsPage struct {
+	Values     []string `json:"values"`
+	StartAt    int      `json:"startAt"`
+	MaxResults int      `json:"maxResults"`
+	Total      int      `json:"total"`
+	IsLast     bool     `json:"isLast"`
+}
+
 // CommentsResponse is the paginated response from GET issue comments.
 type CommentsResponse struct {
 	Comments   []Comment `json:"comments"`
@@ -160,3 +418,131 @@ type CommentsResponse struct {
 	MaxResults int       `json:"maxResults"`
 	Total      int       `json:"total"`
 }
+
+// Version is a project version (release), as used by fixVersions.
+type Version struct {
+	ID          string `json:"id"`
+	Self        string `json:"self"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Released    bool   `json:"released"`
+	Archived    bool   `json:"archived"`
+	ReleaseDate string `json:"releaseDate"` // YYYY-MM-DD, "" if unset
+}
+
+// RemoteLink is a link from an issue to something outside Jira: a
+// Confluence page, a pull request, or any web page.
+type RemoteLink struct {
+	ID           int                `json:"id"`
+	Relationship string             `json:"relationship"` // e.g. "mentioned in", may be empty
+	Object       RemoteObject       `json:"object"`
+	Application  *RemoteApplication `json:"application,omitempty"`
+}
+
+// RemoteObject is the page a remote link points to.
+type RemoteObject struct {
+	URL    string        `json:"url"`
+	Title  string        `json:"title"`
+	Status *RemoteStatus `json:"status,omitempty"`
+}
+
+// RemoteStatus is a remote object's state, e.g. a merged pull request.
+type RemoteStatus struct {
+	Resolved bool `json:"resolved"`
+}
+
+// RemoteApplication is the application a remote link belongs to.
+type RemoteApplication struct {
+	Type string `json:"type"`
+	Name string `json:"name"` // e.g. "Confluence", "GitHub"
+}
+
+// DevInfo is the development work linked to an issue through a connected
+// GitHub, GitLab, or Bitbucket: pull requests, branches, and commits.
+type DevInfo struct {
+	PullRequests []DevPullRequest
+	Branches     []DevBranch
+	Commits      []DevCommit
+}
+
+// Empty reports whether no development work is linked.
+func (d *DevInfo) Empty() bool {
+	return d == nil || len(d.PullRequests)+len(d.Branches)+len(d.Commits) == 0
+}
+
+// DevPullRequest is a pull (or merge) request linked to an issue.
+type DevPullRequest struct {
+	ID     string `json:"id"` // e.g. "#12"
+	Name   string `json:"name"`
+	Status string `json:"status"` // "OPEN", "MERGED", "DECLINED"
+	URL    string `json:"url"`
+	Source struct {
+		Branch string `json:"branch"`
+	} `json:"source"`
+	Destination struct {
+		Branch string `json:"branch"`
+	} `json:"destination"`
+	Author struct {
+		Name string `json:"name"`
+	} `json:"author"`
+}
+
+// DevBranch is a branch whose name mentions an issue.
+type DevBranch struct {
+	Name       string `json:"name"`
+	URL        string `json:"url"`
+	Repository struct {
+		Name string `json:"name"`
+	} `json:"repository"`
+}
+
+// DevCommit is a commit whose message mentions an issue.
+type DevCommit struct {
+	DisplayID string `json:"displayId"` // short hash
+	Message   string `json:"message"`
+	URL       string `json:"url"`
+	Author    struct {
+		Name string `json:"name"`
+	} `json:"author"`
+	Timestamp string `json:"authorTimestamp"`
+}
+
+// Watchers is the response from GET issue watchers.
+type Watchers struct {
+	WatchCount int    `json:"watchCount"`
+	IsWatching bool   `json:"isWatching"` // the signed-in user watches the issue
+	Watchers   []User `json:"watchers"`
+}
+
+// JQLParseResponse is the response from POST /rest/api/3/jql/parse.
+type JQLParseResponse struct {
+	Queries []ParsedJQL `json:"queries"`
+}
+
+// ParsedJQL is the parse result for a single query. The query structure is
+// not decoded; only validation errors are used.
+type ParsedJQL struct {
+	Query  string   `json:"query"`
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Field describes a system or custom field from GET /rest/api/3/field.
+type Field struct {
+	ID     string       `json:"id"`
+	Name   string       `json:"name"`
+	Custom bool         `json:"custom"`
+	Schema *FieldSchema `json:"schema,omitempty"`
+}
+
+// Permission is one entry of GET /rest/api/3/mypermissions.
+type Permission struct {
+	Key            string `json:"key"`
+	Name           string `json:"name"`
+	Type           string `json:"type"` // GLOBAL or PROJECT
+	HavePermission bool   `json:"havePermission"`
+}
+
+// MyPermissionsResponse is the response from GET /rest/api/3/mypermissions.
+type MyPermissionsResponse struct {
+	Permissions map[string]Permission `json:"permissions"`
+}
@@ -0,0 +1,56 @@
+package jira
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestTransitionRequiredFields(t *testing.T) {
+	resolve := Transition{ID: "31", Name: "Resolve", Fields: map[string]FieldMeta{
+		"comment":     {Key: "comment", Name: "Comment", Required: true},
+		"resolution":  {Key: "resolution", Name: "Resolution", Required: true},
+		"assignee":    {Key: "assignee", Name: "Assignee", Required: true, HasDefaultValue: true},
+		"fixVersions": {Key: "fixVersions", Name: "Fix versions"},
+	}}
+	var keys []string
+	for _, f := range resolve.RequiredFields() {
+		keys = append(keys, f.Key)
+	}
+	if got := strings.Join(keys, ","); got != "resolution,comment" {
+		t.Errorf("required = %s, want the resolution then the comment", got)
+	}
+}
+
+func TestFieldInputValue(t *testing.T) {
+	resolution := FieldMeta{Name: "Resolution", AllowedValues: []AllowedValue{{ID: "1", Name: "Fixed"}, {ID: "2", Name: "Won't Do"}}}
+	tests := []struct {
+		name    string
+		field   FieldMeta
+		text    string
+		want    string
+		wantErr string
+	}{
+		{"allowed value", resolution, "fixed", "map[id:1]", ""},
+		{"array of options", FieldMeta{Name: "Teams", Schema: FieldSchema{Type: "array"}, AllowedValues: []AllowedValue{{ID: "7", Value: "Core"}}}, "Core", "[map[id:7]]", ""},
+		{"unknown value", resolution, "Maybe", "", `"Fixed", "Won't Do"`},
+		{"string", FieldMeta{Name: "Env", Schema: FieldSchema{Type: "string"}}, " prod ", "prod", ""},
+		{"number", FieldMeta{Name: "Points", Schema: FieldSchema{Type: "number"}}, "3.5", "3.5", ""},
+		{"bad number", FieldMeta{Name: "Points", Schema: FieldSchema{Type: "number"}}, "lots", "", "must be a number"},
+		{"empty", FieldMeta{Name: "Env", Schema: FieldSchema{Type: "string"}}, "  ", "", "Env is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.field.InputValue(tt.text)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || fmt.Sprint(got) != tt.want {
+				t.Errorf("value = %v, err = %v; want %s", got, err, tt.want)
+			}
+		})
+	}
+}
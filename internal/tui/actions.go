@@ -0,0 +1,185 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// actionsResult is what the actions overlay asks for: to retry or discard
+// one failed write, or to retry them all.
+type actionsResult struct {
+	id      int // failedWrite.id; 0 with all
+	discard bool
+	all     bool
+}
+
+// actionsOverlay lists the writes in flight and those that failed, so
+// each failed one can be retried or discarded on its own. It closes with
+// a result per action; the app reopens it while failures remain.
+type actionsOverlay struct {
+	pending []pendingWrite
+	failed  []failedWrite
+	offline bool
+	cursor  int
+	isDone  bool
+	result  interface{} // actionsResult or nil
+}
+
+func newActionsOverlay(pending []pendingWrite, failed []failedWrite, offline bool, cursor int) *actionsOverlay {
+	return &actionsOverlay{
+		pending: pending,
+		failed:  failed,
+		offline: offline,
+		cursor:  max(0, min(cursor, len(failed)-1)),
+	}
+}
+
+func (o *actionsOverlay) Update(msg tea.Msg) (overlay, tea.Cmd) {
+	km, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return o, nil
+	}
+	switch km.String() {
+	case "esc", "q", "ctrl+p":
+		o.isDone = true
+	case "up", "k":
+		o.cursor = max(0, o.cursor-1)
+	case "down", "j":
+		o.cursor = min(len(o.failed)-1, o.cursor+1)
+	case "enter", "r":
+		if len(o.failed) > 0 {
+			o.isDone = true
+			o.result = actionsResult{id: o.failed[o.cursor].id}
+		}
+	case "d", "x", "delete":
+		if len(o.failed) > 0 {
+			o.isDone = true
+			o.result = actionsResult{id: o.failed[o.cursor].id, discard: true}
+		}
+	case "R":
+		if len(o.failed) > 0 {
+			o.isDone = true
+			o.result = actionsResult{all: true}
+		}
+	}
+	return o, nil
+}
+
+func (o *actionsOverlay) View(width, height int) string {
+	w := min(80, width-8)
+	var b strings.Builder
+	b.WriteString(overlayTitleStyle.Render("Pending and failed changes") + "\n\n")
+
+	b.WriteString(detailSectionStyle.Render("Sending") + "\n")
+	if len(o.pending) == 0 {
+		b.WriteString(detailTypeStyle.Render("  Nothing in flight") + "\n")
+	}
+	for _, p := range o.pending {
+		b.WriteString("  " + p.describe() + "\n")
+	}
+
+	title := "Failed"
+	if o.offline {
+		title = "Queued while offline"
+	}
+	b.WriteString("\n" + detailSectionStyle.Render(title) + "\n")
+	if len(o.failed) == 0 {
+		b.WriteString(detailTypeStyle.Render("  Nothing failed") + "\n")
+	}
+	for i, f := range o.failed {
+		line := truncateRunes(f.write.describe(), w-2)
+		if i == o.cursor {
+			b.WriteString(overlaySelectedStyle.Render("> "+line) + "\n")
+		} else {
+			b.WriteString("  " + line + "\n")
+		}
+		if f.err != nil {
+			b.WriteString(errorStyle.Render("    "+truncateRunes(f.err.Error(), w-4)) + "\n")
+		}
+	}
+
+	hint := "esc: close"
+	if len(o.failed) > 0 {
+		hint = "enter/r: retry · d: discard · R: retry all · esc: close"
+	}
+	b.WriteString("\n" + overlayHintStyle.Render(hint))
+	content := overlayBorderStyle.Width(w + 4).Render(b.String())
+	return lipgloss.Place(width, height-2, lipgloss.Center, lipgloss.Center, content)
+}
+
+func (o *actionsOverlay) done() (bool, interface{}) {
+	return o.isDone, o.result
+}
+
+// openActions shows the writes in flight and those that failed.
+func (a App) openActions(cursor int) (tea.Model, tea.Cmd) {
+	a.overlay = newActionsOverlay(a.pending, a.failed, a.offline, cursor)
+	a.overlayIssue = ""
+	a.overlayAction = overlayActionActions
+	return a, nil
+}
+
+// handleActionsResult retries or discards a failed write, then reopens the
+// list while anything is left in it.
+func (a App) handleActionsResult(r actionsResult) (tea.Model, tea.Cmd) {
+	if r.all {
+		return a.retryFailed()
+	}
+	i := -1
+	for j, f := range a.failed {
+		if f.id == r.id {
+			i = j
+			break
+		}
+	}
+	if i < 0 {
+		return a, nil
+	}
+	f := a.failed[i]
+	a.failed = append(a.failed[:i:i], a.failed[i+1:]...)
+
+	var cmd tea.Cmd
+	if r.discard {
+		cmd = a.discardWrite(f.write)
+		a.flash = fmt.Sprintf("Discarded: %s", f.write.describe())
+	} else {
+		f.write.attempt = 0
+		cmd = a.resend(f.write)
+		a.flash = fmt.Sprintf("Retrying: %s...", f.write.describe())
+	}
+	a.flashIsErr = false
+	if len(a.failed) > 0 {
+		model, _ := a.openActions(i)
+		a = model.(App)
+	}
+	return a, cmd
+}
+
+// discardWrite drops what a failed write showed ahead of Jira: a comment's
+// placeholder, or an edit or delete, by loading the issue or tab again.
+// Offline, the placeholder goes but the rest waits for the next refresh.
+func (a *App) discardWrite(w pendingWrite) tea.Cmd {
+	a.forgetUndo(w.issueKey)
+	switch w.kind {
+	case writeComment:
+		for _, v := range a.viewStack {
+			if dv, ok := v.(*issueDetailView); ok && dv.issue.Key == w.issueKey && len(dv.comments) > 0 && dv.comments[0].ID == "" {
+				dv.comments = dv.comments[1:]
+				dv.buildViewport()
+			}
+		}
+		return nil
+	case writeCreate:
+		return nil
+	}
+	if a.offline || a.client == nil {
+		return nil
+	}
+	if w.kind == writeDelete && a.activeTab < len(a.tabs) {
+		return a.startNetwork(a.loadTab(a.activeTab))
+	}
+	return a.startNetwork(a.cmdFetchIssue(w.issueKey))
+}
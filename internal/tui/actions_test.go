@@ -0,0 +1,67 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestActionsOverlay(t *testing.T) {
+	app := testAppConnected()
+	sent := 0
+	send := func() tea.Msg { sent++; return nil }
+	app.pending = []pendingWrite{{kind: writeUpdate, issueKey: "PROJ-3", cmd: send}}
+	app.failed = []failedWrite{
+		{id: 1, write: pendingWrite{kind: writeUpdate, issueKey: "PROJ-1", cmd: send}, err: errors.New("API error 503: down")},
+		{id: 2, write: pendingWrite{kind: writeComment, issueKey: "PROJ-2", cmd: send, attempt: 3}, err: errors.New("timeout")},
+	}
+
+	model, _ := app.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	app = model.(App)
+	o, ok := app.overlay.(*actionsOverlay)
+	if !ok {
+		t.Fatalf("overlay = %T, want the actions list", app.overlay)
+	}
+	view := o.View(100, 30)
+	for _, want := range []string{"update PROJ-3", "update PROJ-1", "API error 503: down", "comment on PROJ-2"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view is missing %q:\n%s", want, view)
+		}
+	}
+
+	// Discard the first; the list stays open on what's left
+	model, cmd := app.Update(keyMsg("d"))
+	app = model.(App)
+	if len(app.failed) != 1 || app.failed[0].id != 2 || cmd == nil {
+		t.Fatalf("failed = %+v, want PROJ-1 discarded and reloaded", app.failed)
+	}
+	if _, ok := app.overlay.(*actionsOverlay); !ok || app.flash != "Discarded: update PROJ-1" {
+		t.Errorf("overlay = %T, flash = %q", app.overlay, app.flash)
+	}
+
+	// Retry the other with a fresh round of retries
+	model, cmd = app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	app = model.(App)
+	if len(app.failed) != 0 || app.overlay != nil || len(app.pending) != 2 || app.pending[1].attempt != 0 {
+		t.Fatalf("failed = %d, pending = %+v", len(app.failed), app.pending)
+	}
+	collectMsgs(cmd)
+	if sent != 1 || app.flash != "Retrying: comment on PROJ-2..." {
+		t.Errorf("sent = %d, flash = %q", sent, app.flash)
+	}
+}
+
+func TestActionsOverlayEmpty(t *testing.T) {
+	app := testAppReady()
+	model, _ := app.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	app = model.(App)
+	if !strings.Contains(app.overlay.View(100, 30), "Nothing failed") {
+		t.Error("the list should say when nothing failed")
+	}
+	model, _ = app.Update(keyMsg("d"))
+	if _, ok := model.(App).overlay.(*actionsOverlay); !ok {
+		t.Error("d with nothing failed should leave the list open")
+	}
+}
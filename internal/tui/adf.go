@@ -2,20 +2,55 @@ package tui
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
+
+	"github.com/charmbracelet/lipgloss"
 )
 
-// extractADFText recursively extracts plain text from a Jira ADF document.
-// ADF is a JSON structure with "type" and "content" fields. We walk the tree
-// and concatenate all "text" nodes, inserting newlines at paragraph/heading
-// boundaries.
+// extractADFText converts a Jira ADF document to plain text without
+// wrapping. ADF is a JSON structure with "type" and "content" fields; block
+// nodes become lines and lists keep their bullets, numbers, and nesting.
 func extractADFText(doc interface{}) string {
+	return renderADF(doc, adfOptions{})
+}
+
+// adfOptions controls how renderADF lays out and decorates text.
+type adfOptions struct {
+	width  int            // wrap column; 0 disables wrapping
+	people mentionContext // resolves mention nodes
+	styled bool           // highlight mentions of the current user and issue keys
+
+	// projects limits the highlighted issue keys to these projects, so
+	// look-alikes such as UTF-8 stay plain; nil highlights any key
+	projects map[string]bool
+}
+
+// issueKeyInText finds issue keys such as PROJ-123 in running text.
+var issueKeyInText = regexp.MustCompile(`\b[A-Z][A-Z0-9_]*-[1-9][0-9]*\b`)
+
+// mentionContext resolves ADF mention nodes: account IDs to display names
+// and which account is the current user.
+type mentionContext struct {
+	names map[string]string // accountId → display name
+	me    string            // current user's accountId
+}
+
+// renderADF converts an ADF document to text wrapped to opts.width columns.
+// Nested lists are indented under their parent item and wrapped lines
+// continue at the item's text column.
+func renderADF(doc interface{}, opts adfOptions) string {
 	if doc == nil {
 		return ""
 	}
 
-	// If it's already a string, return it directly.
+	// If it's already a string, return it directly, unless it is wiki
+	// markup from Server or Data Center.
 	if s, ok := doc.(string); ok {
+		if isWikiMarkup(s) {
+			return renderWiki(s, opts)
+		}
 		return s
 	}
 
@@ -24,55 +59,272 @@ func extractADFText(doc interface{}) string {
 		return fmt.Sprintf("%v", doc)
 	}
 
+	w := adfWriter{opts: opts}
+	w.blocks(adfContent(node), "", "", 0)
+	return strings.TrimSpace(w.b.String())
+}
+
+// bulletMarkers are the list bullets by nesting depth, cycling when deeper.
+var bulletMarkers = []string{"• ", "◦ ", "▪ "}
+
+// adfWriter renders ADF block nodes line by line.
+type adfWriter struct {
+	b    strings.Builder
+	opts adfOptions
+}
+
+// blocks renders a sequence of block nodes. prefix is written before the
+// first line and indent before every later line, which is how list markers
+// hang in front of an item's first block.
+func (w *adfWriter) blocks(nodes []map[string]interface{}, prefix, indent string, depth int) {
+	for i, node := range nodes {
+		p := indent
+		if i == 0 {
+			p = prefix
+		}
+		w.block(node, p, indent, depth)
+	}
+}
+
+// block renders a single block node.
+func (w *adfWriter) block(node map[string]interface{}, prefix, indent string, depth int) {
+	switch nodeType, _ := node["type"].(string); nodeType {
+	case "bulletList", "orderedList", "taskList", "decisionList":
+		start := 1
+		if attrs, ok := node["attrs"].(map[string]interface{}); ok {
+			if order, ok := attrs["order"].(float64); ok {
+				start = int(order)
+			}
+		}
+		for i, item := range adfContent(node) {
+			marker := listMarker(nodeType, item, start+i, depth)
+			p := indent
+			if i == 0 {
+				p = prefix
+			}
+			pad := strings.Repeat(" ", lipgloss.Width(marker))
+			if children := adfContent(item); len(children) > 0 && isBlockNode(children[0]) {
+				w.blocks(children, p+marker, indent+pad, depth+1)
+			} else {
+				// taskItem/decisionItem hold inline content directly
+				w.text(w.inlineText(item), p+marker, indent+pad)
+			}
+		}
+
+	case "codeBlock":
+		lines := strings.Split(w.inlineText(node), "\n")
+		for i, line := range lines {
+			p := indent
+			if i == 0 {
+				p = prefix
+			}
+			w.b.WriteString(p + line + "\n")
+		}
+
+	case "blockquote":
+		w.blocks(adfContent(node), prefix+"│ ", indent+"│ ", depth)
+
+	case "rule":
+		w.b.WriteString(prefix + "───\n")
+
+	case "paragraph", "heading":
+		w.text(w.inlineText(node), prefix, indent)
+
+	default:
+		// Unknown containers (panels, expands, media): render their children
+		if children := adfContent(node); len(children) > 0 && isBlockNode(children[0]) {
+			w.blocks(children, prefix, indent, depth)
+		} else if text := w.inlineText(node); text != "" {
+			w.text(text, prefix, indent)
+		}
+	}
+}
+
+// text writes text split on hard breaks and wrapped to the available width.
+func (w *adfWriter) text(text, prefix, indent string) {
+	first := true
+	for _, line := range strings.Split(text, "\n") {
+		for _, wrapped := range wrapWords(line, w.opts.width-lipgloss.Width(indent)) {
+			p := indent
+			if first {
+				p = prefix
+				first = false
+			}
+			w.b.WriteString(p + wrapped + "\n")
+		}
+	}
+}
+
+// listMarker returns the marker for the nth item of a list at depth.
+func listMarker(listType string, item map[string]interface{}, n, depth int) string {
+	switch listType {
+	case "orderedList":
+		return fmt.Sprintf("%d. ", n)
+	case "taskList":
+		if attrs, ok := item["attrs"].(map[string]interface{}); ok && attrs["state"] == "DONE" {
+			return "[x] "
+		}
+		return "[ ] "
+	case "decisionList":
+		return "◆ "
+	}
+	return bulletMarkers[depth%len(bulletMarkers)]
+}
+
+// inlineText concatenates the text of a node's inline content. Hard breaks
+// become newlines and mentions become @Display Name.
+func (w *adfWriter) inlineText(node map[string]interface{}) string {
 	var b strings.Builder
-	extractNode(&b, node, true)
-	return strings.TrimSpace(b.String())
+	for _, child := range adfContent(node) {
+		switch child["type"] {
+		case "text":
+			if text, ok := child["text"].(string); ok {
+				b.WriteString(w.linkKeys(text))
+			}
+		case "hardBreak":
+			b.WriteString("\n")
+		case "mention":
+			b.WriteString(w.mention(child))
+		case "inlineCard":
+			b.WriteString(w.inlineCard(child))
+		default:
+			b.WriteString(w.inlineText(child))
+		}
+	}
+	return b.String()
 }
 
-// extractNode recursively processes an ADF node.
-func extractNode(b *strings.Builder, node map[string]interface{}, topLevel bool) {
-	nodeType, _ := node["type"].(string)
+// mention renders a mention node as @Display Name, preferring the cached
+// name over the node's text, which is a snapshot from when it was written.
+// Mentions of the current user are highlighted word by word so wrapping
+// can't split the styling.
+func (w *adfWriter) mention(node map[string]interface{}) string {
+	attrs, _ := node["attrs"].(map[string]interface{})
+	id, _ := attrs["id"].(string)
+	name := w.opts.people.names[id]
+	if name == "" {
+		text, _ := attrs["text"].(string)
+		name = strings.TrimPrefix(text, "@")
+	}
+	if name == "" {
+		name = "unknown"
+	}
+	text := "@" + name
+	if !w.opts.styled || id == "" || id != w.opts.people.me {
+		return text
+	}
+	words := strings.Split(text, " ")
+	for i, word := range words {
+		words[i] = mentionMeStyle.Render(word)
+	}
+	return strings.Join(words, " ")
+}
 
-	// If this is a text node, write the text content.
-	if nodeType == "text" {
-		if text, ok := node["text"].(string); ok {
-			b.WriteString(text)
+// linkKeys highlights the issue keys in text when styled. Keys have no
+// spaces, so wrapping can't split the styling.
+func (w *adfWriter) linkKeys(text string) string {
+	if !w.opts.styled {
+		return text
+	}
+	return issueKeyInText.ReplaceAllStringFunc(text, func(key string) string {
+		if !w.opts.knownKey(key) {
+			return key
 		}
-		return
+		return issueLinkStyle.Render(key)
+	})
+}
+
+// inlineCard renders a smart link as its URL, or as the issue key for a
+// link to an issue.
+func (w *adfWriter) inlineCard(node map[string]interface{}) string {
+	attrs, _ := node["attrs"].(map[string]interface{})
+	url, _ := attrs["url"].(string)
+	if _, key, ok := strings.Cut(url, "/browse/"); ok && issueKeyPattern.MatchString(key) {
+		return w.linkKeys(key)
 	}
+	return url
+}
+
+// knownKey reports whether key belongs to one of the projects.
+func (o adfOptions) knownKey(key string) bool {
+	return o.projects == nil || o.projects[projectKeyOf(key)]
+}
 
-	// If this is a hardBreak, emit a newline.
-	if nodeType == "hardBreak" {
-		b.WriteString("\n")
-		return
+// issueKeysIn returns the distinct issue keys in text of the given
+// projects (any, when nil), in order of appearance.
+func issueKeysIn(text string, projects map[string]bool) []string {
+	opts := adfOptions{projects: projects}
+	seen := make(map[string]bool)
+	var keys []string
+	for _, key := range issueKeyInText.FindAllString(text, -1) {
+		if !seen[key] && opts.knownKey(key) {
+			seen[key] = true
+			keys = append(keys, key)
+		}
 	}
+	return keys
+}
 
-	// Process children.
-	content, ok := node["content"].([]interface{})
-	if !ok {
-		return
+// isBlockNode reports whether node is block-level rather than inline.
+func isBlockNode(node map[string]interface{}) bool {
+	switch node["type"] {
+	case "text", "hardBreak", "mention", "emoji", "inlineCard", "date", "status":
+		return false
 	}
+	return true
+}
 
-	for _, child := range content {
-		childNode, ok := child.(map[string]interface{})
-		if !ok {
-			continue
+// adfContent returns a node's child nodes, skipping malformed entries.
+func adfContent(node map[string]interface{}) []map[string]interface{} {
+	raw, _ := node["content"].([]interface{})
+	children := make([]map[string]interface{}, 0, len(raw))
+	for _, c := range raw {
+		if child, ok := c.(map[string]interface{}); ok {
+			children = append(children, child)
 		}
-		extractNode(b, childNode, false)
 	}
+	return children
+}
 
-	// Add newline after block-level elements.
-	switch nodeType {
-	case "paragraph", "heading", "blockquote", "codeBlock",
-		"bulletList", "orderedList", "listItem", "rule",
-		"mediaSingle", "mediaGroup", "decisionList", "taskList":
-		b.WriteString("\n")
+// wrapWords breaks line into lines of at most width columns at spaces.
+// Words longer than width are left whole. width <= 0 disables wrapping.
+func wrapWords(line string, width int) []string {
+	if width <= 0 || lipgloss.Width(line) <= width {
+		return []string{line}
+	}
+	var lines []string
+	var cur strings.Builder
+	curWidth := 0
+	for _, word := range strings.Fields(line) {
+		ww := lipgloss.Width(word)
+		if curWidth > 0 && curWidth+1+ww > width {
+			lines = append(lines, cur.String())
+			cur.Reset()
+			curWidth = 0
+		}
+		if curWidth > 0 {
+			cur.WriteByte(' ')
+			curWidth++
+		}
+		cur.WriteString(word)
+		curWidth += ww
 	}
+	if curWidth > 0 {
+		lines = append(lines, cur.String())
+	}
+	return lines
 }
 
 // makeADFDocument wraps plain text in a minimal ADF document suitable for
 // the Jira API description field.
 func makeADFDocument(text string) map[string]interface{} {
+	return makeADFDocumentMentions(text, nil)
+}
+
+// makeADFDocumentMentions is makeADFDocument with each "@Display Name" in
+// mentions (display name → account ID) sent as a mention node, so Jira
+// notifies the person.
+func makeADFDocumentMentions(text string, mentions map[string]string) map[string]interface{} {
 	// Split into paragraphs on double newlines, fall back to single line
 	paragraphs := strings.Split(text, "\n\n")
 	content := make([]interface{}, 0, len(paragraphs))
@@ -82,13 +334,8 @@ func makeADFDocument(text string) map[string]interface{} {
 			continue
 		}
 		content = append(content, map[string]interface{}{
-			"type": "paragraph",
-			"content": []interface{}{
-				map[string]interface{}{
-					"type": "text",
-					"text": p,
-				},
-			},
+			"type":    "paragraph",
+			"content": adfInline(p, mentions),
 		})
 	}
 	return map[string]interface{}{
@@ -97,3 +344,39 @@ func makeADFDocument(text string) map[string]interface{} {
 		"content": content,
 	}
 }
+
+// adfInline splits text into text nodes and mention nodes for the names in
+// mentions. Where names overlap, like "@Ann" and "@Ann Lee", the longest
+// wins.
+func adfInline(text string, mentions map[string]string) []interface{} {
+	names := make([]string, 0, len(mentions))
+	for name := range mentions {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return len(names[i]) > len(names[j]) })
+
+	var nodes []interface{}
+	for text != "" {
+		at, name := -1, ""
+		for _, n := range names {
+			if i := strings.Index(text, "@"+n); i >= 0 && (at < 0 || i < at) {
+				at, name = i, n
+			}
+		}
+		if at < 0 {
+			break
+		}
+		if at > 0 {
+			nodes = append(nodes, map[string]interface{}{"type": "text", "text": text[:at]})
+		}
+		nodes = append(nodes, map[string]interface{}{
+			"type":  "mention",
+			"attrs": map[string]interface{}{"id": mentions[name], "text": "@" + name},
+		})
+		text = text[at+1+len(name):]
+	}
+	if text != "" {
+		nodes = append(nodes, map[string]interface{}{"type": "text", "text": text})
+	}
+	return nodes
+}
@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -182,3 +183,205 @@ func TestMakeADFDocument_Empty(t *testing.T) {
 		t.Fatalf("expected 0 paragraphs, got %d", len(content))
 	}
 }
+
+// adfList builds a list node of the given type whose items each hold a
+// paragraph followed by optional nested blocks.
+func adfList(listType string, items ...[]interface{}) map[string]interface{} {
+	content := make([]interface{}, len(items))
+	for i, blocks := range items {
+		content[i] = map[string]interface{}{"type": "listItem", "content": blocks}
+	}
+	return map[string]interface{}{"type": listType, "content": content}
+}
+
+func adfPara(text string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":    "paragraph",
+		"content": []interface{}{map[string]interface{}{"type": "text", "text": text}},
+	}
+}
+
+func TestRenderADFNestedLists(t *testing.T) {
+	doc := map[string]interface{}{
+		"type": "doc",
+		"content": []interface{}{
+			adfPara("Steps:"),
+			adfList("orderedList",
+				[]interface{}{adfPara("Open the page")},
+				[]interface{}{
+					adfPara("Click login"),
+					adfList("bulletList",
+						[]interface{}{adfPara("with SSO")},
+						[]interface{}{
+							adfPara("without SSO"),
+							adfList("bulletList", []interface{}{adfPara("deep")}),
+						},
+					),
+				},
+			),
+		},
+	}
+	want := strings.Join([]string{
+		"Steps:",
+		"1. Open the page",
+		"2. Click login",
+		"   ◦ with SSO",
+		"   ◦ without SSO",
+		"     ▪ deep",
+	}, "\n")
+	if got := extractADFText(doc); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderADFWrapsListItems(t *testing.T) {
+	doc := map[string]interface{}{
+		"type": "doc",
+		"content": []interface{}{
+			adfList("bulletList", []interface{}{adfPara("alpha beta gamma delta epsilon")}),
+		},
+	}
+	want := "• alpha beta\n  gamma delta\n  epsilon"
+	if got := renderADF(doc, adfOptions{width: 14}); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderADFOrderedListStart(t *testing.T) {
+	list := adfList("orderedList", []interface{}{adfPara("a")}, []interface{}{adfPara("b")})
+	list["attrs"] = map[string]interface{}{"order": float64(3)}
+	doc := map[string]interface{}{"type": "doc", "content": []interface{}{list}}
+	if got := extractADFText(doc); got != "3. a\n4. b" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestRenderADFTaskList(t *testing.T) {
+	doc := map[string]interface{}{
+		"type": "doc",
+		"content": []interface{}{
+			map[string]interface{}{
+				"type": "taskList",
+				"content": []interface{}{
+					map[string]interface{}{
+						"type":    "taskItem",
+						"attrs":   map[string]interface{}{"state": "DONE"},
+						"content": []interface{}{map[string]interface{}{"type": "text", "text": "ship it"}},
+					},
+					map[string]interface{}{
+						"type":    "taskItem",
+						"attrs":   map[string]interface{}{"state": "TODO"},
+						"content": []interface{}{map[string]interface{}{"type": "text", "text": "celebrate"}},
+					},
+				},
+			},
+		},
+	}
+	if got := extractADFText(doc); got != "[x] ship it\n[ ] celebrate" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestWrapWords(t *testing.T) {
+	tests := []struct {
+		line  string
+		width int
+		want  []string
+	}{
+		{"short", 10, []string{"short"}},
+		{"one two three", 7, []string{"one two", "three"}},
+		{"unbreakableword x", 5, []string{"unbreakableword", "x"}},
+		{"no wrap at zero", 0, []string{"no wrap at zero"}},
+	}
+	for _, tt := range tests {
+		got := wrapWords(tt.line, tt.width)
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("wrapWords(%q, %d) = %q, want %q", tt.line, tt.width, got, tt.want)
+		}
+	}
+}
+
+func adfMentionDoc(id, text string) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "doc",
+		"content": []interface{}{
+			map[string]interface{}{
+				"type": "paragraph",
+				"content": []interface{}{
+					map[string]interface{}{"type": "text", "text": "ping "},
+					map[string]interface{}{
+						"type":  "mention",
+						"attrs": map[string]interface{}{"id": id, "text": text},
+					},
+					map[string]interface{}{"type": "text", "text": " please"},
+				},
+			},
+		},
+	}
+}
+
+func TestRenderADFMentions(t *testing.T) {
+	people := mentionContext{names: map[string]string{"u1": "Alice Smith"}, me: "u2"}
+	tests := []struct {
+		name string
+		doc  map[string]interface{}
+		want string
+	}{
+		{"resolved from cache", adfMentionDoc("u1", "@alice"), "ping @Alice Smith please"},
+		{"falls back to node text", adfMentionDoc("u9", "@Bob"), "ping @Bob please"},
+		{"no name at all", adfMentionDoc("u9", ""), "ping @unknown please"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := renderADF(tt.doc, adfOptions{people: people}); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderADFHighlightsMe(t *testing.T) {
+	people := mentionContext{names: map[string]string{"u2": "Me Myself"}, me: "u2"}
+	doc := adfMentionDoc("u2", "@Me Myself")
+
+	want := "ping " + mentionMeStyle.Render("@Me") + " " + mentionMeStyle.Render("Myself") + " please"
+	if got := renderADF(doc, adfOptions{people: people, styled: true}); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	// Plain extraction (used to pre-fill editors) never adds styling
+	if got := extractADFText(doc); got != "ping @Me Myself please" {
+		t.Errorf("plain got %q", got)
+	}
+}
+
+func TestRenderADFLinksIssueKeys(t *testing.T) {
+	doc := map[string]interface{}{
+		"type": "doc",
+		"content": []interface{}{
+			map[string]interface{}{"type": "paragraph", "content": []interface{}{
+				map[string]interface{}{"type": "text", "text": "see PROJ-12, not UTF-8; also "},
+				map[string]interface{}{"type": "inlineCard", "attrs": map[string]interface{}{
+					"url": "https://example.atlassian.net/browse/OPS-7",
+				}},
+			}},
+		},
+	}
+	opts := adfOptions{styled: true, projects: map[string]bool{"PROJ": true, "OPS": true}}
+	want := "see " + issueLinkStyle.Render("PROJ-12") + ", not UTF-8; also " + issueLinkStyle.Render("OPS-7")
+	if got := renderADF(doc, opts); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got := extractADFText(doc); got != "see PROJ-12, not UTF-8; also OPS-7" {
+		t.Errorf("plain got %q", got)
+	}
+}
+
+func TestIssueKeysIn(t *testing.T) {
+	text := "PROJ-1 duplicates PROJ-2 (and PROJ-1 again); xPROJ-3, PROJ-04 and UTF-8 aren't keys"
+	if got := issueKeysIn(text, map[string]bool{"PROJ": true}); strings.Join(got, ",") != "PROJ-1,PROJ-2" {
+		t.Errorf("got %q", got)
+	}
+	if got := issueKeysIn(text, nil); strings.Join(got, ",") != "PROJ-1,PROJ-2,UTF-8" {
+		t.Errorf("got %q with any project", got)
+	}
+}
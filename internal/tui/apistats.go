@@ -0,0 +1,61 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// showAPIStats opens an overlay with the signed-in account, its
+// permissions, and the client's request metrics, so slow or failing
+// endpoints and 403s can be explained without a debugger.
+func (a App) showAPIStats() (tea.Model, tea.Cmd) {
+	if a.client == nil {
+		a.flash = "Not connected to Jira"
+		a.flashIsErr = true
+		return a, nil
+	}
+	body := formatAccount(a.user, a.permissions) + "\n\n" + formatAPIStats(a.client.Metrics().Snapshot())
+	a.overlay = newInfoOverlay("API usage this session", body)
+	a.overlayIssue = ""
+	a.overlayAction = overlayActionNone
+	return a, nil
+}
+
+// formatAPIStats renders endpoint stats as an aligned table with a total
+// line on top.
+func formatAPIStats(stats []jira.EndpointStats) string {
+	if len(stats) == 0 {
+		return "No requests yet."
+	}
+
+	total, errors := 0, 0
+	width := len("ENDPOINT")
+	for _, s := range stats {
+		total += s.Count
+		errors += s.Errors
+		width = max(width, len(s.Endpoint))
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d requests, %d errors\n\n", total, errors)
+	fmt.Fprintf(&b, "%-*s  %5s  %5s  %7s  %7s\n", width, "ENDPOINT", "CALLS", "ERR%", "P50", "P95")
+	for _, s := range stats {
+		fmt.Fprintf(&b, "%-*s  %5d  %4.0f%%  %7s  %7s\n", width, s.Endpoint,
+			s.Count, s.ErrorRate()*100, formatLatency(s.P50), formatLatency(s.P95))
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// formatLatency rounds d for display: milliseconds below a second,
+// otherwise seconds with one decimal.
+func formatLatency(d time.Duration) string {
+	if d < time.Second {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
+	return fmt.Sprintf("%.1fs", d.Seconds())
+}
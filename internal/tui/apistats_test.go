@@ -0,0 +1,41 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestFormatAPIStats(t *testing.T) {
+	if got := formatAPIStats(nil); got != "No requests yet." {
+		t.Errorf("empty stats = %q", got)
+	}
+
+	got := formatAPIStats([]jira.EndpointStats{
+		{Endpoint: "POST /rest/api/3/search/jql", Count: 4, Errors: 1, P50: 320 * time.Millisecond, P95: 1500 * time.Millisecond},
+		{Endpoint: "GET /rest/api/3/myself", Count: 1, P50: 80 * time.Millisecond, P95: 80 * time.Millisecond},
+	})
+	for _, want := range []string{"5 requests, 1 errors", "POST /rest/api/3/search/jql", "25%", "320ms", "1.5s", "80ms"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestDiagnosticsHotkeyOpensStats(t *testing.T) {
+	app := testAppReady()
+	app.client = jira.NewClient("https://fake.atlassian.net", "test@test.com", "token")
+
+	model, _ := app.Update(keyMsg("D"))
+	updated := model.(App)
+	if _, ok := updated.overlay.(*infoOverlay); !ok {
+		t.Fatalf("expected infoOverlay, got %T", updated.overlay)
+	}
+
+	model, _ = updated.Update(keyMsg("esc"))
+	if model.(App).overlay != nil {
+		t.Error("expected esc to close the stats overlay")
+	}
+}
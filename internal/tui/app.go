@@ -3,12 +3,14 @@ package tui
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"os"
 	"os/exec"
 	"runtime"
+	"runtime/debug"
 	"strings"
+	"time"
 
-	"github.com/atotto/clipboard"
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -27,10 +29,15 @@ type connStatusMsg struct {
 
 // tabDataMsg delivers fetched issues (or an error) for a specific tab index.
 type tabDataMsg struct {
-	tabIndex int
-	issues   []jira.Issue
-	filter   *jira.Filter
-	err      error
+	tabIndex  int
+	tabID     int // the tab's id, in case tabs moved while it loaded
+	issues    []jira.Issue
+	filter    *jira.Filter
+	rankField string             // Rank field id when the tab shows a rank column
+	jqlValid  bool               // the query passed (or skipped) the first-load JQL check
+	search    jira.SearchOptions // the search that was run, for fetching more pages
+	nextPage  string             // token for the results past those returned, "" when complete
+	err       error
 }
 
 // issueUpdatedMsg is sent after a successful issue edit (status, assignee, etc.).
@@ -63,13 +70,21 @@ type transitionsLoadedMsg struct {
 
 // usersLoadedMsg delivers the user list for the assignee overlay.
 type usersLoadedMsg struct {
-	users []config.CachedUser
-	err   error
+	users   []config.CachedUser
+	saveErr error // writing the disk cache failed; retried on quit
+	err     error
+}
+
+// labelsLoadedMsg delivers the site's labels for the label overlay.
+type labelsLoadedMsg struct {
+	labels []string
+	err    error
 }
 
 // prioritiesLoadedMsg delivers the priority list for the priority overlay.
 type prioritiesLoadedMsg struct {
-	issues    string // issue key the overlay targets
+	issues     string // issue key the overlay targets
+	bump       int    // nonzero: move the issue's priority instead of opening the overlay
 	priorities []jira.Priority
 	err        error
 }
@@ -77,6 +92,7 @@ type prioritiesLoadedMsg struct {
 // issueDeletedMsg is sent after a successful issue deletion.
 type issueDeletedMsg struct {
 	issueKey string
+	issue    *jira.Issue // as fetched just before deleting, for undo; nil if that failed
 	err      error
 }
 
@@ -88,14 +104,26 @@ type issueTypesLoadedMsg struct {
 
 // issueCreatedMsg is sent after a successful issue creation.
 type issueCreatedMsg struct {
-	issueKey string
-	err      error
+	issueKey  string
+	parentKey string // set when the issue was created as a subtask or epic child
+	err       error
 }
 
 // commentsLoadedMsg delivers comments for the detail view.
 type commentsLoadedMsg struct {
 	issueKey string
 	comments []jira.Comment
+	total    int  // comments on the issue, loaded or not
+	older    bool // older comments to append to those shown
+	jump     bool // scroll to the oldest comment once shown
+	err      error
+}
+
+// lastChangeLoadedMsg delivers the most recent changelog entry for the
+// detail view.
+type lastChangeLoadedMsg struct {
+	issueKey string
+	change   *jira.ChangeHistory
 	err      error
 }
 
@@ -151,8 +179,6 @@ func (a App) clientBaseURL() string {
 	return a.client.BaseURL()
 }
 
-
-
 // --- App model ---
 
 // App is the root bubbletea model for jira-tui.
@@ -167,6 +193,8 @@ type App struct {
 	checking  bool
 	connected bool
 
+	permissions []jira.Permission // checked after connecting; empty if unknown
+
 	tabs      []tab
 	activeTab int
 	viewStack []view
@@ -174,18 +202,107 @@ type App struct {
 	overlay       overlay       // active overlay (nil = none)
 	overlayIssue  string        // issue key the overlay is targeting
 	overlayAction overlayAction // which edit action the overlay is for
+	overlayKeys   []string      // multi-selected issue keys (bulk edit), nil for single
 
-	flash      string // transient status message
-	flashIsErr bool   // true if the flash is an error
+	flash      string   // transient status message
+	flashIsErr bool     // true if the flash is an error
+	warnings   []string // startup warnings, shown until the first keypress
 
 	cachedUsers      []config.CachedUser // loaded at startup from user cache
+	usersDirty       bool                // cachedUsers not yet saved to disk
+	teamGroups       []string            // configured team groups; nil uses the user's own
+	teamMembers      map[string]bool     // account IDs listed first in the assignee picker
+	descTemplates    map[string]string   // issue type → description scaffold; nil uses the defaults
+	startIssue       string              // issue whose detail view opens once connected
+	exportFormat     string              // format of the export whose file name is being asked for
 	cachedPriorities []jira.Priority     // loaded on first use from API
-
-	defaultProject string // project key for creating issues
-	createSummary  string // holds summary during multi-step create flow
+	cachedLabels     []string            // loaded on first use from API
+	jqlHistory       []string            // recent ad-hoc queries, newest first
+	jqlHistoryDirty  bool                // jqlHistory not yet saved to disk
+	labelsBefore     []string            // labels on the issue when the label overlay opened
+
+	customFields  []config.CustomFieldConfig // select fields editable with 'f'
+	editField     config.CustomFieldConfig   // custom field being edited
+	editFieldMeta jira.FieldMeta             // its edit metadata
+
+	fetchedAt     map[string]time.Time  // when each issue was last loaded from Jira
+	prefetched    map[string]jira.Issue // full issues fetched ahead of enter, by key
+	prefetchOrder []string              // prefetched keys oldest first, for eviction
+	unavailable   map[capability]bool   // optional capabilities the instance lacks
+	statusBar     []string              // status bar segments in order, empty for the default
+	tabBadges     string                // what the tab bar shows beside labels, one of config.TabBadges
+	staleAfter    time.Duration         // age at which the tab bar shows how old a tab's results are, zero for never
+
+	split        bool             // the preview shows beside the list (toggled with |)
+	splitWidth   int              // the list's share of the width in percent
+	splitSaveSeq int              // bumped on each resize, so only the last one saves
+	preview      *issueDetailView // the issue under the cursor, nil when none
+	previewRow   string           // key and update time of the row previewed
+	previewSeq   int              // bumped as the cursor moves, to drop stale preview fetches
+
+	tabCacheOn    bool          // persist tab results to disk (set by SetTabCache)
+	cacheTTL      time.Duration // cached tab results younger than this skip the startup fetch
+	tabCacheDirty bool          // tab results changed since the cache was written
+
+	configPath string // config.yaml, for saving column changes (set by SetConfigPath)
+
+	fuzzyFilter    bool // quick filter matches fuzzily (set by SetQuickFilter, toggled with ctrl+f)
+	persistFilters bool // tabs keep their quick filter when left or reloaded (set by SetQuickFilter)
+
+	defaultProject string         // project key for creating issues
+	createIn       string         // project picked for a new top-level issue
+	lastProject    string         // project of the last create this session, offered first
+	cachedProjects []jira.Project // projects to create in, fetched on first use
+	createParent   string         // parent key when creating a subtask or epic child
+	createSubtask  bool           // createParent takes subtask types (false for epics)
+
+	workload map[string]projectWorkload // open issues per assignee by project, for the assignee picker
+	undo     []undoEntry                // recent changes ctrl+z can revert, newest last
+	failed   []failedWrite              // writes that failed transiently, for ctrl+r
+	retrySeq int                        // id of the last failedWrite
+	offline  bool                       // Jira can't be reached; writes queue in failed
+
+	confirmEdits bool                           // ask before transitions, assignments, and priority changes
+	confirmApply func(App) (tea.Model, tea.Cmd) // the edit waiting on its confirmation
+	readOnly     bool                           // --read-only: no action may change Jira
+
+	offered        []jira.Transition  // the transitions last offered in a picker, with their screens' fields
+	transitionForm *pendingTransition // the transition waiting on its required fields
+
+	log *slog.Logger // problems worked around, e.g. a cache that can't be saved (set by SetLogger)
 
 	spinner  spinner.Model // activity spinner
 	inflight int           // number of in-flight network requests
+
+	pending []pendingWrite // mutations sent but not yet acknowledged
+
+	bulk       *bulkOp       // running bulk operation (nil = none)
+	bulkSeq    int           // id source for bulk operations
+	bulkCreate *bulkCreateOp // issues being created from a list (nil = none)
+	release    *releaseOp    // version release being set up (nil = none)
+	sprint     *sprintOp     // sprint start or completion being set up (nil = none)
+	punt       *puntOp       // issue waiting to move to the next sprint (nil = none)
+	handoff    *handoffOp    // assignment a comment suggested, awaiting confirmation (nil = none)
+
+	quitting  bool    // shutdown flush in progress
+	flushErrs []error // errors from the shutdown flush
+
+	crash *crashRecorder // recent-message log and crash report, shared across copies
+
+	workflows *workflowCache // transitions by workflow status for the next column, shared with the tabs
+	donePrefs donePrefs      // which transition 'd' takes (set by SetDoneTransitions)
+
+	linkPrefs  linkPrefs       // link types 'I' lists first or hides (set by SetLinkTypes)
+	linkTypes  []jira.LinkType // the instance's link types, nil until 'I' loads them
+	linkChoice *linkChoice     // link type and direction picked, while the other issue is entered
+
+	debugLog     *DebugLogBuffer // latest --debug log lines for ctrl+l; nil without --debug
+	debugLogPath string          // the --debug log file
+
+	clipboard Clipboard // where y, u, and exports copy to; nil = the OS clipboard
+	clock     Clock     // tells the time; nil = the wall clock
+
+	rebuildScheduled bool // a detailRebuildMsg tick is pending
 }
 
 // NewApp creates a new App model.
@@ -195,9 +312,13 @@ func NewApp(client *jira.Client, tabs []config.TabConfig, defaultProject string)
 	for i, cfg := range tabs {
 		t[i] = newTab(cfg)
 	}
+	workflows := newWorkflowCache()
+	for i := range t {
+		t[i].workflows = workflows
+	}
 	s := spinner.New()
 	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("12"))
+	s.Style = lipgloss.NewStyle().Foreground(titleStyle.GetForeground())
 	return App{
 		client:         client,
 		checking:       client != nil,
@@ -205,15 +326,24 @@ func NewApp(client *jira.Client, tabs []config.TabConfig, defaultProject string)
 		defaultProject: defaultProject,
 		spinner:        s,
 		inflight:       boolToInt(client != nil), // checkConnection will be in-flight
+		crash:          newCrashRecorder(),
+		workflows:      workflows,
+		log:            slog.New(slog.DiscardHandler),
 	}
 }
 
+// SetStartupWarnings shows problems found while loading the config in the
+// status bar until the first keypress, whatever else it says meanwhile.
+func (a *App) SetStartupWarnings(warnings []string) {
+	a.warnings = append(a.warnings, warnings...)
+}
+
 // Init implements tea.Model.
 func (a App) Init() tea.Cmd {
 	if a.client == nil {
 		return nil
 	}
-	return tea.Batch(a.checkConnection(), a.spinner.Tick)
+	return guardCmd(tea.Batch(a.checkConnection(), a.spinner.Tick))
 }
 
 // checkConnection returns a Cmd that verifies Jira credentials.
@@ -228,67 +358,93 @@ func (a App) checkConnection() tea.Cmd {
 // loadTab returns a Cmd that fetches issues for a tab.
 // If the tab has a jql field, it searches directly with that JQL.
 // If the tab has a filter_id, it fetches the filter's JQL first.
+// Queries without an ORDER BY get the tab's sort, or for sprint queries
+// Rank to match the board.
 func (a App) loadTab(index int) tea.Cmd {
 	if a.client == nil || index < 0 || index >= len(a.tabs) {
 		return nil
 	}
 	client := a.client
 	cfg := a.tabs[index].config
+	checked := a.tabs[index].jqlChecked
+	all := a.tabs[index].fetchAll
+	id := a.tabs[index].id
 
 	return func() tea.Msg {
 		ctx := context.Background()
 
-		var jql string
-		var filter *jira.Filter
-
-		switch {
-		case cfg.JQL != "":
-			// Direct JQL — no filter fetch needed
-			jql = cfg.JQL
+		jql, filter, err := tabJQL(ctx, client, cfg)
+		if err != nil {
+			return tabDataMsg{tabIndex: index, tabID: id, err: err}
+		}
 
-		case cfg.FilterID != "":
-			f, err := client.GetFilter(ctx, cfg.FilterID)
-			if err != nil {
-				return tabDataMsg{tabIndex: index, err: err}
+		query := sortedJQL(jql, cfg.Sort)
+		if !checked {
+			if err := checkTabJQL(ctx, client, query); err != nil {
+				return tabDataMsg{tabIndex: index, tabID: id, filter: filter, err: err}
 			}
-			filter = f
-			jql = f.JQL
+		}
 
-		default:
-			return tabDataMsg{
-				tabIndex: index,
-				err:      fmt.Errorf("filter_url is not yet supported"),
+		fields := tabFields(cfg)
+		var rankField string
+		if hasColumn(cfg.Columns, "rank") {
+			// The rank column is cosmetic; without the field it stays blank
+			if id, err := client.RankField(ctx); err == nil && id != "" {
+				rankField = id
+				fields = append(fields, id)
 			}
 		}
 
-		result, err := client.SearchIssues(ctx, jira.SearchOptions{
-			JQL:        jql,
-			Fields:     mergeSearchFields(cfg.Columns),
+		search := jira.SearchOptions{
+			JQL:        query,
+			Fields:     fields,
 			MaxResults: 50,
-		})
+		}
+		issues, nextPage, err := searchPages(ctx, client, search, all)
 		if err != nil {
-			return tabDataMsg{tabIndex: index, filter: filter, err: err}
+			return tabDataMsg{tabIndex: index, tabID: id, filter: filter, jqlValid: true, err: err}
 		}
 
 		return tabDataMsg{
-			tabIndex: index,
-			filter:   filter,
-			issues:   result.Issues,
+			tabIndex:  index,
+			tabID:     id,
+			filter:    filter,
+			issues:    issues,
+			rankField: rankField,
+			jqlValid:  true,
+			search:    search,
+			nextPage:  nextPage,
 		}
 	}
 }
 
-// loadAllTabs returns Cmds that load every tab in parallel.
-func (a App) loadAllTabs() tea.Cmd {
-	cmds := make([]tea.Cmd, 0, len(a.tabs))
-	for i := range a.tabs {
-		cmds = append(cmds, a.loadTab(i))
+// Update implements tea.Model. A panic while handling msg, or in a command
+// it returns, is written to a crash report and ends the program cleanly.
+func (a App) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
+	a.crash.record(msg)
+	if c, ok := msg.(crashMsg); ok {
+		return a.crashed(c.value, c.stack)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			model, cmd = a.crashed(r, debug.Stack())
+		}
+	}()
+	model, cmd = a.update(msg)
+	if app, ok := model.(App); ok && app.tabBadges == "new" {
+		app.markSeen()
+		model = app
+	}
+	if app, ok := model.(App); ok && app.split {
+		var sync tea.Cmd
+		if model, sync = app.syncPreview(); sync != nil {
+			cmd = tea.Batch(cmd, sync)
+		}
 	}
-	return tea.Batch(cmds...)
+	return model, guardCmd(cmd)
 }
 
-// Update implements tea.Model.
-func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+func (a App) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 
 	case tea.WindowSizeMsg:
@@ -296,58 +452,192 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.height = msg.Height
 		a.ready = true
 		// Resize all tab tables
-		tableH := a.tableHeight()
-		for i := range a.tabs {
-			a.tabs[i].setSize(a.width, tableH)
+		a.resizeTabs()
+		if a.preview != nil {
+			a.preview.setSize(a.previewWidth(), a.height-1)
 		}
 		// Resize detail view if on stack
 		if len(a.viewStack) > 0 {
-			if dv, ok := a.viewStack[len(a.viewStack)-1].(*issueDetailView); ok {
-				dv.setSize(a.width, a.height)
+			switch v := a.viewStack[len(a.viewStack)-1].(type) {
+			case *issueDetailView:
+				v.setSize(a.width, a.height)
+			case *timelineView:
+				v.setSize(a.width, a.height)
 			}
 		}
 
 	case connStatusMsg:
 		a.inflight--
 		a.checking = false
+		if jira.IsUnreachable(msg.err) {
+			if a.offline {
+				return a, probeLater()
+			}
+			return a, a.goOffline()
+		}
+		if msg.err == nil && a.offline && a.connected {
+			return a.backOnline()
+		}
 		if msg.err != nil {
 			a.connErr = msg.err
 		} else {
 			a.user = msg.user
 			a.connected = true
 			// Load user cache (non-blocking, best effort)
-			a.cachedUsers, _ = config.LoadUserCache()
-			// Auth succeeded — load all tabs eagerly
-			a.inflight += len(a.tabs)
-			return a, tea.Batch(a.loadAllTabs(), a.spinner.Tick)
+			var err error
+			if a.cachedUsers, err = config.LoadUserCache(); err != nil {
+				a.log.Warn("loading the user cache", "err", err)
+			}
+			if a.jqlHistory, err = config.LoadJQLHistory(); err != nil {
+				a.log.Warn("loading the JQL history", "err", err)
+			}
+			// Auth succeeded — load all tabs eagerly, except fresh cached ones
+			cmds := []tea.Cmd{a.loadStaleTabs(), a.spinner.Tick, a.cmdCheckPermissions(), a.loadWorkflows(), a.cmdLoadTeam(), a.cmdProbeCapabilities()}
+			if a.startIssue != "" {
+				cmds = append(cmds, a.openDetail(jira.Issue{Key: a.startIssue}))
+				a.startIssue = ""
+			}
+			if a.autoRefreshing() || a.statusBarTicks() || a.staleAfter > 0 {
+				cmds = append(cmds, clockTick())
+			}
+			// Started offline: send what was queued meanwhile
+			if a.offline {
+				a.offline = false
+				cmds = append(cmds, a.syncQueued())
+			}
+			return a, tea.Batch(cmds...)
 		}
 
 	case tabDataMsg:
 		a.inflight--
+		msg.tabIndex = a.tabIndexFor(msg.tabIndex, msg.tabID)
 		if msg.tabIndex >= 0 && msg.tabIndex < len(a.tabs) {
 			tab := &a.tabs[msg.tabIndex]
 			if msg.filter != nil {
 				tab.jiraFilter = msg.filter
+				if tab.autoLabel && msg.filter.Name != "" {
+					tab.config.Label = msg.filter.Name
+					tab.autoLabel = false
+				}
+			}
+			tab.jqlChecked = tab.jqlChecked || msg.jqlValid
+			if jira.IsUnreachable(msg.err) {
+				// Keep the cached results; the tabs reload once Jira is back
+				if !tab.hasData() {
+					tab.setError(offlineNoCache)
+				}
+				return a, a.goOffline()
 			}
-			if msg.err != nil {
-				tab.setError(msg.err.Error())
+			if msg.err != nil && tab.hasData() {
+				// Background reload: keep showing the previous results
+				a.flash = fmt.Sprintf("Refreshing %s failed: %v", tab.config.Label, msg.err)
+				if jira.IsTimeout(msg.err) {
+					a.flash = fmt.Sprintf("Refreshing %s timed out — press r to retry", tab.config.Label)
+				}
+				a.flashIsErr = true
+			} else if msg.err != nil {
+				tab.setError(tabErrorText(msg.err))
 			} else {
-				tab.setIssues(msg.issues)
+				tab.rankField = msg.rankField
+				tab.search = msg.search
+				tab.nextPage = msg.nextPage
+				var changes issueChanges
+				if tab.hasData() {
+					changes = diffIssues(tab.issues, msg.issues)
+					tab.refreshIssues(msg.issues)
+				} else {
+					tab.setIssues(msg.issues)
+				}
+				tab.fetchedAt = a.now()
+				tab.stale = false
+				a.tabCacheDirty = a.tabCacheDirty || (a.tabCacheOn && !tab.temporary)
+				a.markFetched(msg.issues...)
+				if !changes.empty() {
+					tab.lastChanges = changes
+					a.flash = tab.config.Label + ": " + changes.summary() + " · w: what changed"
+					a.flashIsErr = false
+					a.forgetPrefetched(changes.keys()...)
+					return a, tea.Batch(
+						a.highlight(msg.tabIndex, changes.keys()),
+						a.scheduleRefresh(msg.tabIndex, tab.refreshEvery),
+						a.loadWorkflows(),
+						a.prefetchDetails(msg.tabIndex),
+					)
+				}
+				return a, tea.Batch(a.scheduleRefresh(msg.tabIndex, tab.refreshEvery), a.loadWorkflows(), a.prefetchDetails(msg.tabIndex))
 			}
+			return a, a.scheduleRefresh(msg.tabIndex, tab.refreshEvery)
 		}
 
+	case tabMoreMsg:
+		a.inflight--
+		return a.handleTabMore(msg)
+
+	case detailsPrefetchedMsg:
+		return a.handleDetailsPrefetched(msg)
+
+	case previewTickMsg:
+		return a.handlePreviewTick(msg)
+
+	case previewFetchedMsg:
+		return a.handlePreviewFetched(msg)
+
+	case splitSaveTickMsg:
+		return a.handleSplitSaveTick(msg)
+
+	case splitSavedMsg:
+		return a.handleSplitSaved(msg)
+
+	case tabsSavedMsg:
+		return a.handleTabsSaved(msg)
+
+	case columnsSavedMsg:
+		return a.handleColumnsSaved(msg)
+
+	case workflowLoadedMsg:
+		a.inflight--
+		return a.handleWorkflowLoaded(msg)
+
+	case capabilitiesMsg:
+		return a.handleCapabilities(msg)
+
+	case permissionsMsg:
+		return a.handlePermissions(msg)
+
+	case highlightExpiredMsg:
+		a.handleHighlightExpired(msg)
+
+	case tabRefreshMsg:
+		return a.handleTabRefresh(msg)
+
+	case clockTickMsg:
+		return a, clockTick()
+
 	case issueUpdatedMsg:
 		a.inflight--
+		w := a.finishWrite(writeUpdate, msg.issueKey)
 		a.flash = ""
 		if msg.err != nil {
+			if retry, ok := a.queueRetry(w, msg.err); ok {
+				return a, retry
+			}
+			a.forgetUndo(msg.issueKey)
 			a.flash = msg.err.Error()
 			a.flashIsErr = true
 		} else if msg.issue != nil {
 			a.applyIssueUpdate(msg.issueKey, msg.issue)
 			a.flash = msg.issueKey + " updated"
 			a.flashIsErr = false
+			// The edit is now the latest change
+			if a.topDetail(msg.issueKey) != nil {
+				return a, tea.Batch(a.startNetwork(a.cmdFetchLastChange(msg.issueKey)), a.loadWorkflows())
+			}
+			return a, a.loadWorkflows()
 		}
 
+	case priorityBumpedMsg:
+		return a.handlePriorityBumped(msg)
+
 	case flashMsg:
 		a.flash = msg.text
 		a.flashIsErr = msg.isErr
@@ -358,68 +648,117 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			a.flash = fmt.Sprintf("Failed to load %s: %v", msg.issueKey, msg.err)
 			a.flashIsErr = true
 			// Still show what we have from the search result
-			if len(a.viewStack) > 0 {
-				if dv, ok := a.viewStack[len(a.viewStack)-1].(*issueDetailView); ok {
-					if dv.issue.Key == msg.issueKey {
-						dv.loading = false
-						dv.buildViewport()
-					}
-				}
+			if dv := a.topDetail(msg.issueKey); dv != nil {
+				dv.loading = false
+				return a, a.markDetailStale(dv)
 			}
 		} else if msg.issue != nil {
 			// Patch updated data into tab list rows
 			a.applyIssueUpdate(msg.issueKey, msg.issue)
 			// Update the detail view if it's still showing this issue
-			if len(a.viewStack) > 0 {
-				if dv, ok := a.viewStack[len(a.viewStack)-1].(*issueDetailView); ok {
-					if dv.issue.Key == msg.issueKey {
-						dv.issue = *msg.issue
-						dv.loading = false
-						dv.buildViewport()
-					}
-				}
+			if dv := a.topDetail(msg.issueKey); dv != nil {
+				dv.issue = *msg.issue
+				dv.loading = false
+				return a, a.markDetailStale(dv)
 			}
 		}
 
 	case commentsLoadedMsg:
 		a.inflight--
-		if msg.err != nil {
-			// Silently fail — comments are supplementary
-			if len(a.viewStack) > 0 {
-				if dv, ok := a.viewStack[len(a.viewStack)-1].(*issueDetailView); ok {
-					if dv.issue.Key == msg.issueKey {
-						dv.commentsLoading = false
-						dv.buildViewport()
-					}
-				}
+		if dv := a.topDetail(msg.issueKey); dv != nil {
+			if msg.older {
+				return a.handleOlderComments(dv, msg)
 			}
-		} else if len(a.viewStack) > 0 {
-			if dv, ok := a.viewStack[len(a.viewStack)-1].(*issueDetailView); ok {
-				if dv.issue.Key == msg.issueKey {
-					dv.comments = msg.comments
-					dv.commentsLoading = false
-					dv.buildViewport()
-				}
+			// A failed fetch is only logged — comments are supplementary
+			if msg.err == nil {
+				dv.comments = msg.comments
+				dv.commentsTotal = max(msg.total, len(msg.comments))
+			} else {
+				a.log.Warn("loading comments", "issue", msg.issueKey, "err", msg.err)
 			}
+			dv.commentsLoading = false
+			return a, a.markDetailStale(dv)
 		}
 
 	case childrenLoadedMsg:
 		a.inflight--
-		if len(a.viewStack) > 0 {
-			if dv, ok := a.viewStack[len(a.viewStack)-1].(*issueDetailView); ok {
-				if dv.issue.Key == msg.issueKey {
-					if msg.err == nil {
-						dv.children = msg.children
-					}
-					dv.childrenLoading = false
-					dv.buildViewport()
-				}
+		if dv := a.topDetail(msg.issueKey); dv != nil {
+			if msg.err == nil {
+				dv.children = msg.children
+			} else {
+				a.log.Warn("loading children", "issue", msg.issueKey, "err", msg.err)
+			}
+			dv.childrenLoading = false
+			return a, a.markDetailStale(dv)
+		}
+
+	case lastChangeLoadedMsg:
+		a.inflight--
+		// A failed fetch is only logged — the updated timestamp is still shown
+		if msg.err != nil {
+			a.log.Warn("loading the last change", "issue", msg.issueKey, "err", msg.err)
+		}
+		if dv := a.topDetail(msg.issueKey); dv != nil && msg.err == nil {
+			dv.lastChange = msg.change
+			return a, a.markDetailStale(dv)
+		}
+
+	case watchersLoadedMsg:
+		a.inflight--
+		// A failed fetch is only logged — the field is left out
+		if msg.err != nil {
+			a.log.Warn("loading watchers", "issue", msg.issueKey, "err", msg.err)
+		}
+		if dv := a.topDetail(msg.issueKey); dv != nil && msg.err == nil {
+			dv.watchers = msg.watchers
+			return a, a.markDetailStale(dv)
+		}
+
+	case watchToggledMsg:
+		return a.handleWatchToggled(msg)
+
+	case devInfoLoadedMsg:
+		a.inflight--
+		// A failed fetch is only logged, at debug level — the instance may
+		// not have source control connected
+		if msg.err != nil {
+			a.log.Debug("loading development info", "issue", msg.issueKey, "err", msg.err)
+		}
+		if dv := a.topDetail(msg.issueKey); dv != nil && msg.err == nil {
+			dv.dev = msg.info
+			return a, a.markDetailStale(dv)
+		}
+
+	case remoteLinksLoadedMsg:
+		a.inflight--
+		// A failed fetch is only logged — the section is left out
+		if msg.err != nil {
+			a.log.Warn("loading web links", "issue", msg.issueKey, "err", msg.err)
+		}
+		if dv := a.topDetail(msg.issueKey); dv != nil && msg.err == nil {
+			dv.remoteLinks = msg.links
+			return a, a.markDetailStale(dv)
+		}
+
+	case timelineLoadedMsg:
+		return a.handleTimelineLoaded(msg)
+
+	case detailRebuildMsg:
+		a.rebuildScheduled = false
+		for _, v := range a.viewStack {
+			if dv, ok := v.(*issueDetailView); ok && dv.stale {
+				dv.rebuild()
 			}
 		}
 
 	case commentAddedMsg:
 		a.inflight--
+		w := a.finishWrite(writeComment, msg.issueKey)
 		if msg.err != nil {
+			// The placeholder stays while the comment waits to be retried
+			if retry, ok := a.queueRetry(w, msg.err); ok {
+				return a, retry
+			}
 			a.flash = msg.err.Error()
 			a.flashIsErr = true
 			// Remove the optimistic placeholder (first comment) on failure
@@ -445,17 +784,28 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+	case linkTypesMsg:
+		return a.handleLinkTypes(msg)
+
+	case doneTransitionsMsg:
+		return a.handleDoneTransitions(msg)
+
 	case transitionsLoadedMsg:
 		a.inflight--
 		if msg.err != nil {
 			a.flash = msg.err.Error()
 			a.flashIsErr = true
 		} else {
+			if issue := a.findIssue(msg.issueKey); issue != nil {
+				a.workflows.store(*issue, msg.transitions)
+				a.refreshNextColumns()
+			}
+			a.offered = msg.transitions
 			items := make([]selectionItem, len(msg.transitions))
 			for i, t := range msg.transitions {
 				items[i] = selectionItem{ID: t.ID, Label: t.Name}
 			}
-			a.overlay = newSelectionOverlay("Change Status", items)
+			a.overlay = newSelectionOverlay(a.overlayTitle("Change Status"), items)
 			a.overlayIssue = msg.issueKey
 			// overlayAction was already set to overlayActionTransition by handleEditHotkey
 		}
@@ -467,15 +817,39 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			a.flashIsErr = true
 		} else {
 			a.cachedPriorities = msg.priorities
+			if msg.bump != 0 {
+				return a.bumpPriority(msg.issues, msg.bump)
+			}
 			items := make([]selectionItem, len(msg.priorities))
 			for i, p := range msg.priorities {
 				items[i] = selectionItem{ID: p.ID, Label: p.Name}
 			}
-			a.overlay = newSelectionOverlay("Change Priority", items)
+			a.overlay = newSelectionOverlay(a.overlayTitle("Change Priority"), items)
 			a.overlayIssue = msg.issues
 			// overlayAction was already set to overlayActionPriority by handleEditHotkey
 		}
 
+	case labelsLoadedMsg:
+		a.inflight--
+		if msg.err != nil {
+			a.flash = msg.err.Error()
+			a.flashIsErr = true
+		} else if a.overlayAction == overlayActionLabels {
+			a.cachedLabels = msg.labels
+			a.flash = ""
+			a.overlay = newChecklistOverlay("Edit Labels", msg.labels, a.labelsBefore)
+			// overlayIssue and overlayAction were already set by handleEditHotkey
+		} else {
+			a.cachedLabels = msg.labels
+			if input, ok := a.overlay.(*textInputOverlay); ok && a.overlayAction == overlayActionAddLabels {
+				var tabIssues []jira.Issue
+				if a.activeTab < len(a.tabs) {
+					tabIssues = a.tabs[a.activeTab].issues
+				}
+				input.suggestions = labelSuggestions(tabIssues, msg.labels, a.labelsBefore)
+			}
+		}
+
 	case usersLoadedMsg:
 		a.inflight--
 		if msg.err != nil {
@@ -483,56 +857,81 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			a.flashIsErr = true
 		} else {
 			a.cachedUsers = msg.users
-			items := make([]selectionItem, len(msg.users))
-			for i, u := range msg.users {
-				items[i] = selectionItem{ID: u.AccountID, Label: u.DisplayName, Desc: u.Email}
+			a.usersDirty = msg.saveErr != nil
+			if msg.saveErr != nil {
+				a.log.Warn("saving the user cache, retrying on quit", "err", msg.saveErr)
 			}
-			a.overlay = newSelectionOverlay("Assign To", items)
+			a.overlay = newSelectionOverlay(a.overlayTitle("Assign To"), a.assigneeItems(msg.users))
 			// overlayIssue and overlayAction were already set by handleEditHotkey
+			return a, a.assigneeWorkload()
+		}
+
+	case workloadMsg:
+		return a.handleWorkload(msg)
+
+	case teamLoadedMsg:
+		// Best effort: without a team the picker lists everyone unsectioned
+		if msg.err == nil {
+			a.teamMembers = msg.members
+		} else {
+			a.log.Warn("loading the team", "err", msg.err)
 		}
 
 	case issueDeletedMsg:
 		a.inflight--
+		a.finishWrite(writeDelete, msg.issueKey)
 		if msg.err != nil {
 			a.flash = "Delete failed: " + msg.err.Error()
 			a.flashIsErr = true
+		} else if msg.issue != nil {
+			// The issue was already removed optimistically
+			a.pushUndo(undoEntry{kind: undoDelete, issueKey: msg.issueKey, issue: msg.issue})
+			a.flash = "Deleted " + msg.issueKey + " — ctrl+z recreates it"
+			a.flashIsErr = false
 		}
-		// Success is silent — the issue was already removed optimistically
 
 	case issueTypesLoadedMsg:
 		a.inflight--
+		form, ok := a.overlay.(*createFormOverlay)
+		if !ok {
+			break
+		}
 		if msg.err != nil {
 			a.flash = msg.err.Error()
 			a.flashIsErr = true
 			a.overlay = nil
 			a.overlayAction = overlayActionNone
+			a.resetCreate()
 		} else {
-			items := make([]selectionItem, len(msg.types))
-			for i, t := range msg.types {
-				items[i] = selectionItem{ID: t.ID, Label: t.Name}
-			}
-			a.overlay = newSelectionOverlay("Issue Type", items)
-			// overlayAction was already set to overlayActionCreateType
+			return a, form.setTypes(msg.types)
 		}
 
 	case issueCreatedMsg:
 		a.inflight--
+		a.finishWrite(writeCreate, "")
 		a.flash = ""
 		if msg.err != nil {
 			a.flash = msg.err.Error()
 			a.flashIsErr = true
+		} else if msg.parentKey != "" {
+			// Stay on the parent and refresh its children section
+			a.flash = "Created " + msg.issueKey + " under " + msg.parentKey
+			a.flashIsErr = false
+			if dv := a.topDetail(msg.parentKey); dv != nil {
+				dv.dirty = true
+				dv.childrenLoading = true
+				a.inflight++ // extra inflight for children
+				return a, tea.Batch(
+					a.startNetwork(a.cmdFetchIssue(msg.parentKey)),
+					a.cmdFetchChildren(msg.parentKey),
+					a.markDetailStale(dv),
+				)
+			}
 		} else {
 			a.flash = "Created " + msg.issueKey
 			a.flashIsErr = false
 			// Push detail view for the new issue and fetch its data
-			stub := jira.Issue{Key: msg.issueKey}
-			dv := newIssueDetailView(stub, a.clientBaseURL(), a.width, a.height)
-			a.viewStack = append(a.viewStack, &dv)
-			var cmds []tea.Cmd
-			cmds = append(cmds, a.cmdFetchIssue(msg.issueKey))
-			cmds = append(cmds, a.cmdFetchComments(msg.issueKey))
-			cmds = append(cmds, a.cmdFetchChildren(msg.issueKey))
-			a.inflight += 2 // extra inflight for comments + children
+			cmds := []tea.Cmd{a.openDetail(jira.Issue{Key: msg.issueKey})}
 			// Refresh the active tab in the background to pick up the new issue.
 			// Don't call setLoading() — keep the current list visible so esc-back is instant.
 			if a.connected && a.activeTab < len(a.tabs) {
@@ -541,6 +940,97 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return a, tea.Batch(cmds...)
 		}
 
+	case bulkItemMsg:
+		a.handleBulkItem(msg)
+
+	case bulkCreateTypesMsg:
+		return a.handleBulkCreateTypes(msg)
+
+	case bulkCreatedMsg:
+		return a.handleBulkCreated(msg)
+
+	case releaseVersionsMsg:
+		return a.handleReleaseVersions(msg)
+
+	case releaseCountMsg:
+		return a.handleReleaseCount(msg)
+
+	case versionReleasedMsg:
+		return a.handleVersionReleased(msg)
+
+	case sprintBoardsMsg:
+		return a.handleSprintBoards(msg)
+
+	case sprintListMsg:
+		return a.handleSprintList(msg)
+
+	case sprintIncompleteMsg:
+		return a.handleSprintIncomplete(msg)
+
+	case sprintDoneMsg:
+		return a.handleSprintDone(msg)
+
+	case puntTargetMsg:
+		return a.handlePuntTarget(msg)
+
+	case issuePuntedMsg:
+		return a.handleIssuePunted(msg)
+
+	case issueRankedMsg:
+		return a.handleIssueRanked(msg)
+
+	case freshIssueMsg:
+		a.inflight--
+		return a.handleFreshIssue(msg)
+
+	case editMetaLoadedMsg:
+		a.inflight--
+		return a.handleEditMeta(msg)
+
+	case createMetaLoadedMsg:
+		if form, ok := a.overlay.(*createFormOverlay); ok {
+			form.setMeta(msg)
+		}
+
+	case projectsLoadedMsg:
+		return a.handleProjectsLoaded(msg)
+
+	case cloneSourceMsg:
+		return a.handleCloneSource(msg)
+
+	case undoneMsg:
+		return a.handleUndone(msg)
+
+	case retryDueMsg:
+		return a.handleRetryDue(msg)
+
+	case peekLoadedMsg:
+		return a.handlePeekLoaded(msg)
+
+	case offlineProbeMsg:
+		return a.handleOfflineProbe()
+
+	case writeConflictMsg:
+		return a.handleWriteConflict(msg)
+
+	case logLineMsg:
+		return a.handleLogLine(msg)
+
+	case createSprintsMsg:
+		return a.handleCreateSprints(msg)
+
+	case jqlValidatedMsg:
+		a.inflight--
+		return a.handleJQLValidated(msg)
+
+	case jqlHistorySavedMsg:
+		// A failed save is retried by the shutdown flush
+		a.jqlHistoryDirty = msg.err != nil
+
+	case flushDoneMsg:
+		a.flushErrs = msg.errs
+		return a, tea.Quit
+
 	case spinner.TickMsg:
 		if a.inflight > 0 {
 			var cmd tea.Cmd
@@ -550,6 +1040,7 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.KeyMsg:
 		a.flash = "" // clear flash on any keypress
+		a.warnings = nil
 		return a.handleKey(msg)
 	}
 	return a, nil
@@ -559,10 +1050,22 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (a App) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	key := msg.String()
 
-	// Global keys always work
+	// Global keys always work. A second ctrl+c on the pending-writes prompt
+	// forces the quit.
 	switch key {
 	case "ctrl+c":
-		return a, tea.Quit
+		if a.quitting {
+			return a, tea.Quit
+		}
+		return a.quit(a.overlayAction == overlayActionQuit)
+	}
+	if a.quitting {
+		return a, nil
+	}
+
+	// Moving the cursor closes a peek and then moves as usual
+	if _, ok := a.overlay.(*peekOverlay); ok && peekPassKeys[key] {
+		a.overlay = nil
 	}
 
 	// If an overlay is active, route ALL keys to it
@@ -575,11 +1078,26 @@ func (a App) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return a, cmd
 	}
 
+	if key == "ctrl+z" {
+		return a.undoLast()
+	}
+	if key == "ctrl+r" {
+		return a.retryFailed()
+	}
+	if key == "ctrl+l" {
+		return a.openDebugLog()
+	}
+	if key == "ctrl+p" {
+		return a.openActions(0)
+	}
+
 	// If a view is on the stack, handle stack-specific keys
 	if len(a.viewStack) > 0 {
 		switch key {
 		case "q":
-			return a, tea.Quit
+			return a.quit(false)
+		case "D":
+			return a.showAPIStats()
 		case "esc":
 			// Capture the dirty issue key before popping the detail view
 			var dirtyKey string
@@ -616,11 +1134,36 @@ func (a App) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 					a.flashIsErr = true
 					return a, nil
 				}
-				a.overlay = newTextEditorOverlay("Add Comment", "", a.width, a.height)
+				if a.refuseReadOnly() {
+					return a, nil
+				}
+				a.overlay = newCommentEditorOverlay("Add Comment", a.cachedUsers, a.width, a.height)
 				a.overlayIssue = dv.issue.Key
 				a.overlayAction = overlayActionAddComment
 				return a, nil
 			}
+			if (key == "c" || key == "b") && a.refuseReadOnly() {
+				return a, nil
+			}
+			if key == "c" {
+				return a.startCreateChild(&dv.issue)
+			}
+			if key == "b" {
+				return a.startBulkCreate(&dv.issue)
+			}
+			if key == "T" {
+				// The issue's children, or an epic's stories, as a tree
+				return a.openTreeTab(dv.issue)
+			}
+			if key == "w" {
+				// Open a web link in the browser
+				return a.openRemoteLink(dv)
+			}
+			if key == "O" || key == "G" {
+				// Older comments; G loads them all and jumps to the oldest
+				return a.loadOlderComments(dv, key == "G")
+			}
+			a.overlayKeys = nil
 			if model, cmd, handled := a.handleEditHotkey(msg, &dv.issue); handled {
 				return model, cmd
 			}
@@ -628,9 +1171,24 @@ func (a App) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			cmd := dv.Update(msg)
 			return a, cmd
 		}
+		if tv, ok := a.viewStack[len(a.viewStack)-1].(*timelineView); ok {
+			if key == "enter" {
+				if epic := tv.selected(); epic != nil {
+					return a, a.openDetail(*epic)
+				}
+				return a, nil
+			}
+			tv.Update(msg)
+			return a, nil
+		}
 		return a, nil
 	}
 
+	// A summary being edited in its row takes all keys
+	if a.activeTab < len(a.tabs) && a.tabs[a.activeTab].inline != nil {
+		return a.handleInlineEditKey(msg)
+	}
+
 	// If filter input is focused, route keypresses to the text input
 	if a.activeTab < len(a.tabs) && a.tabs[a.activeTab].quickFilter.isFocused() {
 		return a.handleFilterKey(msg)
@@ -639,10 +1197,14 @@ func (a App) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Tab-level keys (no stack views open, filter not focused)
 	switch key {
 	case "q":
-		return a, tea.Quit
+		return a.quit(false)
 
 	case "esc":
-		// If a filter is applied, clear it
+		// Clear the multi-selection first, then any applied filter
+		if a.activeTab < len(a.tabs) && len(a.tabs[a.activeTab].selected) > 0 {
+			a.tabs[a.activeTab].clearSelection()
+			return a, nil
+		}
 		if a.activeTab < len(a.tabs) && a.tabs[a.activeTab].quickFilter.isActive() {
 			a.tabs[a.activeTab].clearFilter()
 			return a, nil
@@ -656,6 +1218,92 @@ func (a App) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return a, a.tabs[a.activeTab].quickFilter.input.Focus()
 		}
 
+	case " ":
+		// Toggle multi-select on the current row
+		if a.activeTab < len(a.tabs) && a.tabs[a.activeTab].state == tabReady {
+			a.tabs[a.activeTab].toggleSelected()
+			return a, nil
+		}
+
+	case "H":
+		// Quick peek at the current row without leaving the list
+		return a.startPeek()
+
+	case "V":
+		// Select every row between the last toggled row and the cursor
+		if a.activeTab < len(a.tabs) && a.tabs[a.activeTab].state == tabReady {
+			a.tabs[a.activeTab].selectRange()
+			return a, nil
+		}
+
+	case "D":
+		// Diagnostics — API usage for this session
+		return a.showAPIStats()
+
+	case ":":
+		// Ad-hoc JQL search in a temporary tab
+		return a.startJQLSearch("", "")
+
+	case "x":
+		// Close the search tab
+		if a.closeTemporaryTab() {
+			return a, nil
+		}
+
+	case "!", "@", "#", "$", "%", "^", "&", "*", "(":
+		// shift+number sorts by that column: ascending, descending, off
+		if a.activeTab < len(a.tabs) && a.tabs[a.activeTab].hasData() {
+			col := shiftDigits[key]
+			if !a.tabs[a.activeTab].cycleSort(col) {
+				a.flash = fmt.Sprintf("No column %d to sort by", col)
+				a.flashIsErr = true
+			}
+			return a, nil
+		}
+
+	case "C":
+		// Show, hide, reorder, and size columns
+		return a.startColumnEdit()
+
+	case "ctrl+t":
+		// Open, rename, reorder, and close tabs
+		return a.startTabManager()
+
+	case "w":
+		// What the last refresh changed
+		return a.showChanges()
+
+	case "E":
+		// Export the visible issues as CSV or Markdown
+		return a.startExport()
+
+	case "M":
+		// Load the rest of a truncated result
+		cmd := a.loadRemainder()
+		return a, cmd
+
+	case "z", "Z":
+		// Collapse or expand the group under the cursor, or all groups;
+		// in the tree view, the issue under the cursor or all issues
+		if a.activeTab < len(a.tabs) && a.tabs[a.activeTab].state == tabReady {
+			tab := &a.tabs[a.activeTab]
+			if tab.tree {
+				if key == "z" {
+					tab.toggleNode()
+				} else {
+					tab.toggleAllNodes()
+				}
+			} else if tab.groupBy == "" {
+				a.flash = "Set group_by on this tab to group its issues"
+				a.flashIsErr = true
+			} else if key == "z" {
+				tab.toggleGroup()
+			} else {
+				tab.toggleAllGroups()
+			}
+			return a, nil
+		}
+
 	case "r":
 		// Refresh active tab
 		if a.connected && a.activeTab < len(a.tabs) {
@@ -663,6 +1311,9 @@ func (a App) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return a, a.startNetwork(a.loadTab(a.activeTab))
 		}
 
+	case "R":
+		return a.refreshAllTabs()
+
 	case "c":
 		// Create new issue
 		if a.client == nil {
@@ -670,63 +1321,106 @@ func (a App) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			a.flashIsErr = true
 			return a, nil
 		}
-		if a.defaultProject == "" {
-			a.flash = "Set default_project in config to create issues"
-			a.flashIsErr = true
+		if a.refuseReadOnly() {
 			return a, nil
 		}
-		a.overlay = newTextInputOverlay("New Issue Summary", "")
-		a.overlayAction = overlayActionCreateSummary
-		return a, nil
+		a.resetCreate()
+		return a.startProjectPick()
+
+	case "b":
+		// Create several issues from a list
+		if a.refuseReadOnly() {
+			return a, nil
+		}
+		return a.startBulkCreate(nil)
+
+	case "T":
+		// Show the tab's issues under their parents, or as before
+		return a.toggleTree()
+
+	case "v":
+		// Release a version of the project
+		if a.refuseReadOnly() {
+			return a, nil
+		}
+		return a.startRelease()
+
+	case "S":
+		// Start or complete a sprint of the project's board
+		if a.refuseReadOnly() || a.refuseUnavailable(capAgile) {
+			return a, nil
+		}
+		return a.startSprintAction()
+
+	case "P":
+		// The project's epics on a timeline
+		return a.openTimeline()
+
+	case "<", ">":
+		// Pan a scrolling tab's columns
+		return a.panTab(key == ">")
+
+	case "|":
+		// Preview the issue under the cursor beside the list
+		return a.toggleSplit()
+
+	case "ctrl+left", "ctrl+right":
+		// Move the divider between the list and the preview
+		if a.split {
+			if key == "ctrl+left" {
+				return a.resizeSplit(-splitStep)
+			}
+			return a.resizeSplit(splitStep)
+		}
+
+	case "J", "K":
+		// Move the issue down or up in rank
+		if a.refuseReadOnly() || a.refuseUnavailable(capAgile) {
+			return a, nil
+		}
+		return a.rankIssue(key == "J")
 
 	case "enter":
 		// Push issue detail onto stack and fetch full issue + comments
 		if a.activeTab < len(a.tabs) {
 			if issue := a.tabs[a.activeTab].selectedIssue(); issue != nil {
-				dv := newIssueDetailView(*issue, a.clientBaseURL(), a.width, a.height)
-				a.viewStack = append(a.viewStack, &dv)
-				a.inflight += 2 // extra inflight for comments + children
-				return a, tea.Batch(
-					a.startNetwork(a.cmdFetchIssue(issue.Key)),
-					a.cmdFetchComments(issue.Key),
-					a.cmdFetchChildren(issue.Key),
-				)
+				return a, a.openDetail(*issue)
 			}
+			// On a group header enter collapses or expands the group
+			a.tabs[a.activeTab].toggleGroup()
 		}
 
 	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
 		idx := int(key[0]-'0') - 1
 		if idx < len(a.tabs) {
-			// Clear filter when switching tabs
-			if a.activeTab < len(a.tabs) {
-				a.tabs[a.activeTab].clearFilter()
-			}
+			a.leaveTab()
 			a.activeTab = idx
 			return a, nil
 		}
 
-	case "left", "h":
+	case "left", "h", "shift+tab":
 		if len(a.tabs) > 0 {
-			if a.activeTab < len(a.tabs) {
-				a.tabs[a.activeTab].clearFilter()
-			}
+			a.leaveTab()
 			a.activeTab = (a.activeTab - 1 + len(a.tabs)) % len(a.tabs)
 			return a, nil
 		}
 
-	case "right", "l":
+	case "right", "tab":
 		if len(a.tabs) > 0 {
-			if a.activeTab < len(a.tabs) {
-				a.tabs[a.activeTab].clearFilter()
-			}
+			a.leaveTab()
 			a.activeTab = (a.activeTab + 1) % len(a.tabs)
 			return a, nil
 		}
 
 	default:
-		// Edit hotkeys on the selected issue in the list
+		// Edit hotkeys on the selected issue in the list, or on every
+		// multi-selected issue for keys that support bulk edits
 		if a.activeTab < len(a.tabs) && a.tabs[a.activeTab].state == tabReady {
+			if keys := a.tabs[a.activeTab].selectedKeys(); len(keys) > 0 && bulkHotkeys[key] {
+				return a.handleBulkHotkey(msg, keys)
+			}
 			if issue := a.tabs[a.activeTab].selectedIssue(); issue != nil {
+				a.overlayKeys = nil
 				if model, cmd, handled := a.handleEditHotkey(msg, issue); handled {
 					return model, cmd
 				}
@@ -759,6 +1453,9 @@ func (a App) handleFilterKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Cancel filter entirely
 		tab.clearFilter()
 		return a, nil
+
+	case "ctrl+f":
+		return a.toggleFilterMode(), nil
 	}
 
 	// Forward to text input
@@ -788,8 +1485,19 @@ func (a App) tableHeight() int {
 
 // --- View ---
 
-// View implements tea.Model.
-func (a App) View() string {
+// View implements tea.Model. View can't quit the program, so a panic here
+// is reported and then re-raised for Bubble Tea to restore the terminal.
+func (a App) View() string {
+	defer func() {
+		if r := recover(); r != nil {
+			a.crash.report(r, debug.Stack())
+			panic(r)
+		}
+	}()
+	return a.view()
+}
+
+func (a App) view() string {
 	if !a.ready {
 		return "Loading..."
 	}
@@ -809,12 +1517,15 @@ func (a App) View() string {
 		}
 	} else if len(a.viewStack) > 0 {
 		sections = append(sections, a.renderStackView())
-	} else if a.checking {
+	} else if a.checking && (a.activeTab >= len(a.tabs) || !a.tabs[a.activeTab].hasData()) {
 		sections = append(sections, loadingStyle.Render("Connecting to Jira..."))
 	} else if a.connErr != nil {
 		sections = append(sections, errorStyle.Render(
 			fmt.Sprintf("Connection failed: %v", a.connErr),
 		))
+		if hint := jira.AuthHint(a.connErr); hint != "" {
+			sections = append(sections, helpStyle.Width(a.width).Render(hint))
+		}
 	} else if len(a.tabs) > 0 {
 		sections = append(sections, a.renderActiveTab())
 	}
@@ -825,24 +1536,6 @@ func (a App) View() string {
 	return lipgloss.JoinVertical(lipgloss.Left, sections...)
 }
 
-// renderTabBar draws the tab strip across the top.
-func (a App) renderTabBar() string {
-	if len(a.tabs) == 0 {
-		return ""
-	}
-
-	var tabs []string
-	for i, t := range a.tabs {
-		label := fmt.Sprintf(" %d %s ", i+1, t.config.Label)
-		if i == a.activeTab {
-			tabs = append(tabs, activeTabStyle.Render(label))
-		} else {
-			tabs = append(tabs, inactiveTabStyle.Render(label))
-		}
-	}
-	return tabBarStyle.Render(lipgloss.JoinHorizontal(lipgloss.Top, tabs...))
-}
-
 // renderActiveTab draws the content of the currently active tab.
 func (a App) renderActiveTab() string {
 	if a.activeTab >= len(a.tabs) {
@@ -865,13 +1558,16 @@ func (a App) renderActiveTab() string {
 	case tabEmpty:
 		parts = append(parts, emptyStyle.Render("No issues found"))
 	case tabReady:
-		rendered := colorizePriorities(t.table.View())
+		rendered := colorizeOverdue(colorizeChanged(colorizePriorities(t.highlightMatches(t.pan(t.table.View())))))
 		if t.statusReplacer != nil {
 			rendered = t.statusReplacer.Replace(rendered)
 		}
 		parts = append(parts, rendered)
 	}
 
+	if a.split {
+		return a.renderSplit(lipgloss.JoinVertical(lipgloss.Left, parts...))
+	}
 	return lipgloss.JoinVertical(lipgloss.Left, parts...)
 }
 
@@ -885,10 +1581,13 @@ func (a App) renderFilterBar(t *tab) string {
 		bar = filterPromptStyle.Render("/ ") + helpStyle.Render(t.quickFilter.query)
 	}
 
-	// Append match count
+	// Append match count and mode
 	count := filterCountStyle.Render(
-		fmt.Sprintf("  %d of %d issues", t.quickFilter.matched, t.quickFilter.total),
+		fmt.Sprintf("  %d of %d issues · %s", t.quickFilter.matched, t.quickFilter.total, t.quickFilter.modeName()),
 	)
+	if t.quickFilter.isFocused() {
+		count += helpStyle.Render("  ctrl+f: switch mode")
+	}
 
 	return filterBarStyle.Render(bar + count)
 }
@@ -903,45 +1602,88 @@ func (a App) renderStackView() string {
 	switch v := top.(type) {
 	case *issueDetailView:
 		return v.View()
+	case *timelineView:
+		return v.View()
 	}
 	return ""
 }
 
-// renderStatusBar draws the bottom help/status line.
-func (a App) renderStatusBar() string {
-	var parts []string
+// --- Edit hotkeys ---
 
-	if a.user != nil {
-		parts = append(parts, successStyle.Render(a.user.DisplayName))
-	}
+// editHotkeys is the set of keys that trigger issue editing actions.
+var editHotkeys = map[string]bool{
+	"s": true, "p": true, "d": true, "e": true,
+	"t": true, "i": true, "a": true, "delete": true,
+	"u": true, "y": true, "o": true, "L": true,
+	"l": true, "f": true, "n": true, "+": true,
+	"-": true, "W": true, "U": true, "N": true,
+	"Y": true, "I": true,
+}
 
-	// Flash message (transient feedback)
-	if a.flash != "" {
-		if a.flashIsErr {
-			parts = append(parts, errorStyle.Render(a.flash))
-		} else {
-			parts = append(parts, successStyle.Render(a.flash))
-		}
-	}
+// bulkHotkeys are the edit hotkeys that apply to every multi-selected issue.
+var bulkHotkeys = map[string]bool{
+	"s": true, "p": true, "a": true, "L": true, "l": true, "d": true,
+	"i": true, "f": true,
+}
 
-	if len(a.viewStack) > 0 {
-		parts = append(parts, helpStyle.Render("enter: related  m: comment  d: done  del: delete  q: quit"))
-	} else {
-		parts = append(parts, helpStyle.Render("/: filter  c: create  o: open  q: quit"))
+// selectionCount returns the number of multi-selected issues in the active tab.
+func (a App) selectionCount() int {
+	if a.activeTab >= len(a.tabs) {
+		return 0
 	}
+	return len(a.tabs[a.activeTab].selectedKeys())
+}
 
-	return lipgloss.JoinHorizontal(lipgloss.Top,
-		strings.Join(parts, helpStyle.Render("  │  ")),
-	)
+// overlayTitle appends the selection size to an overlay title when the
+// overlay targets multiple issues.
+func (a App) overlayTitle(title string) string {
+	if len(a.overlayKeys) > 1 {
+		return fmt.Sprintf("%s (%d issues)", title, len(a.overlayKeys))
+	}
+	return title
 }
 
-// --- Edit hotkeys ---
+// handleBulkHotkey applies an edit hotkey to every multi-selected issue.
+// Immediate actions (d, i) start right away; actions that need input reuse
+// the single-issue overlays with overlayKeys set so the result fans out.
+func (a App) handleBulkHotkey(msg tea.KeyMsg, keys []string) (tea.Model, tea.Cmd) {
+	if a.client == nil {
+		a.flash = "Not connected to Jira"
+		a.flashIsErr = true
+		return a, nil
+	}
+	switch msg.String() {
+	case "d":
+		return a.confirmEdit(fmt.Sprintf("Mark %s done?", editSubject("", keys)), func(a App) (tea.Model, tea.Cmd) {
+			return a, a.startBulk("Mark done", keys, bulkMarkDone(a.donePrefs))
+		})
+	case "i":
+		if a.user == nil {
+			a.flash = "Not logged in"
+			a.flashIsErr = true
+			return a, nil
+		}
+		accountID := a.user.AccountID
+		return a.confirmEdit(fmt.Sprintf("Assign %s to you?", editSubject("", keys)), func(a App) (tea.Model, tea.Cmd) {
+			return a, a.startBulk("Assign to me", keys, bulkAssign(accountID))
+		})
+	}
 
-// editHotkeys is the set of keys that trigger issue editing actions.
-var editHotkeys = map[string]bool{
-	"s": true, "p": true, "d": true, "e": true,
-	"t": true, "i": true, "a": true, "delete": true,
-	"u": true, "y": true, "o": true,
+	// Overlays are populated from the first selected issue (e.g. its
+	// available transitions); the chosen value is applied to all of them.
+	var first *jira.Issue
+	for i := range a.tabs[a.activeTab].issues {
+		if a.tabs[a.activeTab].issues[i].Key == keys[0] {
+			first = &a.tabs[a.activeTab].issues[i]
+			break
+		}
+	}
+	if first == nil {
+		return a, nil
+	}
+	a.overlayKeys = keys
+	model, cmd, _ := a.handleEditHotkey(msg, first)
+	return model, cmd
 }
 
 // handleEditHotkey processes edit hotkeys (s/p/d/e/t/i/a/del) for the given
@@ -957,7 +1699,7 @@ func (a App) handleEditHotkey(msg tea.KeyMsg, issue *jira.Issue) (tea.Model, tea
 	switch key {
 	case "y":
 		// Yank (copy) issue key to clipboard
-		if err := clipboard.WriteAll(issue.Key); err != nil {
+		if err := a.copyText(issue.Key); err != nil {
 			a.flash = "Clipboard unavailable"
 			a.flashIsErr = true
 		} else {
@@ -974,7 +1716,7 @@ func (a App) handleEditHotkey(msg tea.KeyMsg, issue *jira.Issue) (tea.Model, tea
 			return a, nil, true
 		}
 		url := a.client.BrowseURL(issue.Key)
-		if err := clipboard.WriteAll(url); err != nil {
+		if err := a.copyText(url); err != nil {
 			a.flash = "Clipboard unavailable"
 			a.flashIsErr = true
 		} else {
@@ -1006,13 +1748,51 @@ func (a App) handleEditHotkey(msg tea.KeyMsg, issue *jira.Issue) (tea.Model, tea
 		a.flashIsErr = true
 		return a, nil, true
 	}
+	if a.refuseReadOnly() {
+		return a, nil, true
+	}
 
 	switch key {
+	case "n":
+		// Next transition — the one suggested by the next column
+		model, cmd := a.applyNextTransition(issue)
+		return model, cmd, true
+
 	case "d":
-		// Mark as done — find the "done" category transition and execute immediately
-		a.flash = "Marking " + issue.Key + " as done..."
+		// Mark as done — the preferred "done" category transition, asking
+		// when several are left
+		a.flash = "Loading transitions..."
+		a.flashIsErr = false
+		return a, a.startNetwork(a.cmdFetchDoneTransitions(issue.Key)), true
+
+	case "W":
+		// Watch, or stop watching
+		cmd := a.toggleWatch(issue.Key)
+		return a, cmd, true
+
+	case "U":
+		// Due date — date input with a calendar, empty clears
+		model, cmd := a.startDueDateEdit(issue)
+		return model, cmd, true
+
+	case "N":
+		// Punt — move to the next planned sprint, after confirming
+		if a.refuseUnavailable(capAgile) {
+			return a, nil, true
+		}
+		cmd := a.startPunt(issue.Key)
+		return a, cmd, true
+
+	case "I":
+		// Link to another issue — pick the link type, then the issue
+		model, cmd := a.startLink(issue.Key)
+		return model, cmd, true
+
+	case "Y":
+		// Clone — the create form, pre-filled from the full issue
+		a.flash = "Loading " + issue.Key + "..."
 		a.flashIsErr = false
-		return a, a.cmdMarkDone(issue.Key), true
+		return a, a.startNetwork(a.cmdFetchCloneSource(issue.Key)), true
 
 	case "i":
 		// Assign to me
@@ -1021,9 +1801,14 @@ func (a App) handleEditHotkey(msg tea.KeyMsg, issue *jira.Issue) (tea.Model, tea
 			a.flashIsErr = true
 			return a, nil, true
 		}
-		a.flash = "Assigning " + issue.Key + " to you..."
-		a.flashIsErr = false
-		return a, a.cmdAssignToMe(issue.Key, a.user), true
+		key := issue.Key
+		model, cmd := a.confirmEdit("Assign "+key+" to you?", func(a App) (tea.Model, tea.Cmd) {
+			a.rememberAssignee(key)
+			a.flash = "Assigning " + key + " to you..."
+			a.flashIsErr = false
+			return a, a.trackWrite(writeUpdate, key, a.cmdAssignToMe(key, a.user))
+		})
+		return model, cmd, true
 
 	case "s":
 		// Status — async fetch transitions, then show selection overlay
@@ -1042,7 +1827,7 @@ func (a App) handleEditHotkey(msg tea.KeyMsg, issue *jira.Issue) (tea.Model, tea
 			for i, p := range a.cachedPriorities {
 				items[i] = selectionItem{ID: p.ID, Label: p.Name}
 			}
-			a.overlay = newSelectionOverlay("Change Priority", items)
+			a.overlay = newSelectionOverlay(a.overlayTitle("Change Priority"), items)
 			return a, nil, true
 		}
 		// No cache — fetch priorities from API
@@ -1050,17 +1835,22 @@ func (a App) handleEditHotkey(msg tea.KeyMsg, issue *jira.Issue) (tea.Model, tea
 		a.flashIsErr = false
 		return a, a.cmdFetchPriorities(issue.Key), true
 
+	case "+", "-":
+		// Priority one level up or down, without the overlay
+		delta := 1
+		if key == "+" {
+			delta = -1
+		}
+		model, cmd := a.bumpPriority(issue.Key, delta)
+		return model, cmd, true
+
 	case "a":
 		// Assignee — show selection overlay with cached users (or fetch them)
 		a.overlayIssue = issue.Key
 		a.overlayAction = overlayActionAssignee
 		if len(a.cachedUsers) > 0 {
-			items := make([]selectionItem, len(a.cachedUsers))
-			for i, u := range a.cachedUsers {
-				items[i] = selectionItem{ID: u.AccountID, Label: u.DisplayName, Desc: u.Email}
-			}
-			a.overlay = newSelectionOverlay("Assign To", items)
-			return a, nil, true
+			a.overlay = newSelectionOverlay(a.overlayTitle("Assign To"), a.assigneeItems(a.cachedUsers))
+			return a, a.assigneeWorkload(), true
 		}
 		// No cache — fetch users from API
 		a.flash = "Loading users..."
@@ -1069,22 +1859,47 @@ func (a App) handleEditHotkey(msg tea.KeyMsg, issue *jira.Issue) (tea.Model, tea
 
 	case "t":
 		// Title — text input overlay pre-filled with current summary
-		a.overlay = newTextInputOverlay("Edit Title", issue.Fields.Summary)
-		a.overlayIssue = issue.Key
-		a.overlayAction = overlayActionTitle
-		return a, nil, true
+		model, cmd := a.editWithFreshIssue(issue, overlayActionTitle)
+		return model, cmd, true
 
 	case "e":
 		// Description — text editor overlay pre-filled with current description
-		desc := extractADFText(issue.Fields.Description)
-		a.overlay = newTextEditorOverlay("Edit Description", desc, a.width, a.height)
+		model, cmd := a.editWithFreshIssue(issue, overlayActionDescription)
+		return model, cmd, true
+
+	case "l":
+		// Labels — checklist of all labels with the current ones checked.
+		// A multi-selection can only have labels removed: the checklist
+		// lists the labels on the selected issues, none checked.
+		if len(a.overlayKeys) > 0 {
+			a.removeLabelsOverlay(issue)
+			return a, nil, true
+		}
 		a.overlayIssue = issue.Key
-		a.overlayAction = overlayActionDescription
-		return a, nil, true
+		a.overlayAction = overlayActionLabels
+		a.labelsBefore = append([]string(nil), issue.Fields.Labels...)
+		if len(a.cachedLabels) > 0 {
+			a.overlay = newChecklistOverlay("Edit Labels", a.cachedLabels, a.labelsBefore)
+			return a, nil, true
+		}
+		a.flash = "Loading labels..."
+		a.flashIsErr = false
+		return a, a.startNetwork(a.cmdFetchLabels()), true
+
+	case "f":
+		// Custom select field (e.g. Severity) — options come from editmeta
+		model, cmd := a.startCustomFieldEdit(issue.Key)
+		return model, cmd, true
+
+	case "L":
+		// Add labels — comma-separated text input with completions,
+		// existing labels are kept
+		cmd := a.addLabelsOverlay(issue)
+		return a, cmd, true
 
 	case "delete":
 		// Delete — confirmation overlay
-		a.overlay = newConfirmOverlay(fmt.Sprintf("Delete %s? This cannot be undone.", issue.Key))
+		a.overlay = newConfirmOverlay(fmt.Sprintf("Delete %s? Undo recreates it as a new issue.", issue.Key))
 		a.overlayIssue = issue.Key
 		a.overlayAction = overlayActionDelete
 		return a, nil, true
@@ -1135,10 +1950,40 @@ const (
 	overlayActionTitle
 	overlayActionDescription
 	overlayActionDelete
-	overlayActionCreateSummary // step 1: enter summary
-	overlayActionCreateType    // step 2: pick issue type
-	overlayActionAddComment    // add comment from detail view
-	overlayActionDrillIn       // drill into a related issue from detail view
+	overlayActionCreateProject    // pick the project to create in
+	overlayActionCreate           // fill in the create form
+	overlayActionAddComment       // add comment from detail view
+	overlayActionDrillIn          // drill into a related issue from detail view
+	overlayActionQuit             // confirm quitting with writes still pending
+	overlayActionAddLabels        // add one or more labels
+	overlayActionLabels           // edit the full label set
+	overlayActionRemoveLabels     // remove labels from the multi-selection
+	overlayActionJQLSearch        // run an ad-hoc JQL query
+	overlayActionCustomField      // pick which configured custom field to edit
+	overlayActionCustomFieldValue // pick a value for the custom field
+	overlayActionColumns          // show, reorder, and size the tab's columns
+	overlayActionExport           // pick the export format and destination
+	overlayActionExportFile       // name the file to export to
+	overlayActionBulkCreate       // enter a list of issues to create
+	overlayActionReleaseVersion   // pick the version to release
+	overlayActionReleaseMove      // pick where its unresolved issues go
+	overlayActionReleaseConfirm   // confirm the release
+	overlayActionOpenLink         // pick a web link to open in the browser
+	overlayActionSprintBoard      // pick the board whose sprints to manage
+	overlayActionSprintPick       // pick a sprint to start or complete
+	overlayActionSprintMove       // pick where a completed sprint's unfinished issues go
+	overlayActionSprintConfirm    // type the sprint's name to confirm
+	overlayActionDueDate          // set or clear the due date
+	overlayActionPunt             // confirm moving the issue to the next sprint
+	overlayActionHandoff          // also assign the issue to the person a comment hands it to
+	overlayActionConfirmEdit      // confirm a transition, assignment, or priority change (confirm_edits)
+	overlayActionDescTemplate     // start an empty description from its type's scaffold
+	overlayActionLinkType         // pick the link type and direction
+	overlayActionLinkTarget       // enter the issue to link to
+	overlayActionActions          // retry or discard a failed change
+	overlayActionTabs             // rename, reorder, and close tabs
+	overlayActionNewTab           // enter the query for a new tab
+	overlayActionTransitionFields // fill in the fields a transition requires
 )
 
 // handleOverlayResult processes the result of a completed overlay and dispatches
@@ -1146,53 +1991,173 @@ const (
 func (a App) handleOverlayResult(result interface{}) (tea.Model, tea.Cmd) {
 	issueKey := a.overlayIssue
 	action := a.overlayAction
+	bulkKeys := a.overlayKeys
 	a.overlay = nil
 	a.overlayIssue = ""
 	a.overlayAction = overlayActionNone
+	a.overlayKeys = nil
 
 	if result == nil {
 		// User cancelled
+		if action == overlayActionCreate {
+			a.resetCreate()
+			a.flash = "Create cancelled"
+			a.flashIsErr = false
+		}
+		if action == overlayActionBulkCreate {
+			a.bulkCreate = nil
+		}
+		if action == overlayActionTransitionFields {
+			a.transitionForm = nil
+		}
+		if action == overlayActionReleaseVersion || action == overlayActionReleaseMove || action == overlayActionReleaseConfirm {
+			a.release = nil
+		}
+		if action == overlayActionSprintBoard || action == overlayActionSprintPick ||
+			action == overlayActionSprintMove || action == overlayActionSprintConfirm {
+			a.sprint = nil
+		}
+		if action == overlayActionPunt {
+			a.punt = nil
+		}
+		if action == overlayActionHandoff {
+			a.handoff = nil
+		}
+		if action == overlayActionConfirmEdit {
+			a.confirmApply = nil
+		}
+		if action == overlayActionLinkTarget {
+			a.linkChoice = nil
+		}
 		return a, nil
 	}
 
 	switch action {
+	case overlayActionQuit:
+		return a.shutdown()
+
+	case overlayActionConfirmEdit:
+		return a.handleEditConfirmed()
+
 	case overlayActionTransition:
 		item := result.(*selectionItem)
-		a.flash = "Transitioning " + issueKey + "..."
-		a.flashIsErr = false
-		return a, a.cmdTransitionIssue(issueKey, item.ID)
+		return a.confirmEdit(fmt.Sprintf("%s %s?", item.Label, editSubject(issueKey, bulkKeys)), func(a App) (tea.Model, tea.Cmd) {
+			if len(bulkKeys) > 0 {
+				return a, a.startBulk("Transition to "+item.Label, bulkKeys, bulkTransition(item.Label))
+			}
+			if issue := a.findIssue(issueKey); issue != nil {
+				a.workflows.record(*issue, item.ID)
+			}
+			return a.startTransition(issueKey, a.transitionByID(item.ID), "Transitioning "+issueKey+"...")
+		})
 
 	case overlayActionPriority:
 		item := result.(*selectionItem)
-		a.flash = "Setting priority on " + issueKey + "..."
-		a.flashIsErr = false
-		return a, a.cmdUpdateField(issueKey, map[string]interface{}{
-			"priority": map[string]interface{}{"id": item.ID},
+		return a.confirmEdit(fmt.Sprintf("Set priority of %s to %s?", editSubject(issueKey, bulkKeys), item.Label), func(a App) (tea.Model, tea.Cmd) {
+			if len(bulkKeys) > 0 {
+				return a, a.startBulk("Priority "+item.Label, bulkKeys, bulkUpdateFields(map[string]interface{}{
+					"priority": map[string]interface{}{"id": item.ID},
+				}))
+			}
+			a.flash = "Setting priority on " + issueKey + "..."
+			a.flashIsErr = false
+			return a, a.trackWrite(writeUpdate, issueKey, a.cmdUpdateField(issueKey, map[string]interface{}{
+				"priority": map[string]interface{}{"id": item.ID},
+			}))
 		})
 
 	case overlayActionAssignee:
 		item := result.(*selectionItem)
-		a.flash = "Assigning " + issueKey + "..."
-		a.flashIsErr = false
-		return a, a.cmdUpdateField(issueKey, map[string]interface{}{
-			"assignee": map[string]interface{}{"accountId": item.ID},
+		return a.confirmEdit(fmt.Sprintf("Assign %s to %s?", editSubject(issueKey, bulkKeys), item.Label), func(a App) (tea.Model, tea.Cmd) {
+			a.forgetWorkload(issueKey)
+			if len(bulkKeys) > 0 {
+				return a, a.startBulk("Assign to "+item.Label, bulkKeys, bulkAssign(item.ID))
+			}
+			a.rememberAssignee(issueKey)
+			a.flash = "Assigning " + issueKey + "..."
+			a.flashIsErr = false
+			return a, a.trackWrite(writeUpdate, issueKey, a.cmdUpdateField(issueKey, map[string]interface{}{
+				"assignee": map[string]interface{}{"accountId": item.ID},
+			}))
 		})
 
 	case overlayActionTitle:
 		newTitle := result.(string)
 		a.flash = "Updating title of " + issueKey + "..."
 		a.flashIsErr = false
-		return a, a.cmdUpdateField(issueKey, map[string]interface{}{
+		return a, a.trackWrite(writeUpdate, issueKey, a.cmdUpdateField(issueKey, map[string]interface{}{
 			"summary": newTitle,
-		})
+		}))
+
+	case overlayActionDueDate:
+		return a.setDueDate(issueKey, result.(string))
+
+	case overlayActionActions:
+		return a.handleActionsResult(result.(actionsResult))
+
+	case overlayActionTabs:
+		return a.applyTabs(result.(tabsResult))
+
+	case overlayActionTransitionFields:
+		return a.handleTransitionFields(issueKey, result.(createFormResult))
+
+	case overlayActionNewTab:
+		return a.openNewTab(result.(string))
+
+	case overlayActionLinkType:
+		return a.handleLinkTypePick(issueKey, result.(*selectionItem))
+
+	case overlayActionLinkTarget:
+		return a.handleLinkTarget(issueKey, result.(string))
+
+	case overlayActionDescTemplate:
+		return a.handleTemplatePick(issueKey, result.(*selectionItem))
+
+	case overlayActionAddLabels:
+		labels := parseLabels(result.(string))
+		if len(labels) == 0 {
+			return a, nil
+		}
+		if len(bulkKeys) > 0 {
+			return a, a.startBulk("Add labels", bulkKeys, bulkAddLabels(labels))
+		}
+		a.flash = "Adding labels to " + issueKey + "..."
+		a.flashIsErr = false
+		return a, a.trackWrite(writeUpdate, issueKey, a.cmdUpdateLabels(issueKey, labels, nil))
+
+	case overlayActionLabels:
+		add, remove := diffLabels(a.labelsBefore, result.([]string))
+		a.labelsBefore = nil
+		if len(add) == 0 && len(remove) == 0 {
+			return a, nil
+		}
+		a.flash = "Updating labels on " + issueKey + "..."
+		a.flashIsErr = false
+		return a, a.trackWrite(writeUpdate, issueKey, a.cmdUpdateLabels(issueKey, add, remove))
+
+	case overlayActionRemoveLabels:
+		labels := result.([]string)
+		if len(labels) == 0 || len(bulkKeys) == 0 {
+			return a, nil
+		}
+		return a, a.startBulk("Remove labels", bulkKeys, bulkRemoveLabels(labels))
+
+	case overlayActionJQLSearch:
+		query := strings.TrimSpace(result.(string))
+		if query == "" {
+			return a, nil
+		}
+		a.flash = "Validating JQL..."
+		a.flashIsErr = false
+		return a, a.startNetwork(a.cmdValidateJQL(query))
 
 	case overlayActionDescription:
 		newDesc := result.(string)
 		a.flash = "Updating description of " + issueKey + "..."
 		a.flashIsErr = false
-		return a, a.cmdUpdateField(issueKey, map[string]interface{}{
+		return a, a.trackWrite(writeUpdate, issueKey, a.cmdUpdateField(issueKey, map[string]interface{}{
 			"description": makeADFDocument(newDesc),
-		})
+		}))
 
 	case overlayActionDelete:
 		// Optimistic delete: remove from UI immediately, send API call in background
@@ -1216,44 +2181,73 @@ func (a App) handleOverlayResult(result interface{}) (tea.Model, tea.Cmd) {
 		}
 		a.flash = issueKey + " deleted"
 		a.flashIsErr = false
-		return a, a.cmdDeleteIssue(issueKey)
+		return a, a.trackWrite(writeDelete, issueKey, a.cmdDeleteIssue(issueKey))
 
-	case overlayActionCreateSummary:
-		summary := result.(string)
-		if strings.TrimSpace(summary) == "" {
-			a.flash = "Summary cannot be empty"
-			a.flashIsErr = true
-			return a, nil
-		}
-		// Store summary and move to step 2: pick issue type
-		a.createSummary = summary
-		a.overlayAction = overlayActionCreateType
-		a.flash = "Loading issue types..."
-		a.flashIsErr = false
-		return a, a.cmdFetchIssueTypes()
+	case overlayActionCreateProject:
+		return a.handleProjectPick(result.(*selectionItem))
 
-	case overlayActionCreateType:
+	case overlayActionCreate:
+		return a.submitCreate(result.(createFormResult))
+
+	case overlayActionCustomField:
 		item := result.(*selectionItem)
-		summary := a.createSummary
-		a.createSummary = ""
-		a.flash = "Creating issue..."
-		a.flashIsErr = false
-		return a, a.cmdCreateIssue(summary, item.Label)
+		field, _ := a.customField(item.ID)
+		a.overlayKeys = bulkKeys
+		return a.fetchCustomFieldOptions(issueKey, field)
+
+	case overlayActionCustomFieldValue:
+		return a.applyCustomFieldValue(issueKey, bulkKeys, result.(*selectionItem))
+
+	case overlayActionColumns:
+		return a.applyColumns(result.(columnsResult))
+
+	case overlayActionExport:
+		return a.handleExportChoice(result.(*selectionItem))
+
+	case overlayActionExportFile:
+		return a.exportToFile(result.(string))
 
 	case overlayActionDrillIn:
 		item := result.(*selectionItem)
-		stub := jira.Issue{Key: item.ID}
-		dv := newIssueDetailView(stub, a.clientBaseURL(), a.width, a.height)
-		a.viewStack = append(a.viewStack, &dv)
-		a.inflight += 2
-		return a, tea.Batch(
-			a.startNetwork(a.cmdFetchIssue(item.ID)),
-			a.cmdFetchComments(item.ID),
-			a.cmdFetchChildren(item.ID),
-		)
+		if item.ID == "" {
+			// e.g. an issue a bulk create failed to make
+			return a, nil
+		}
+		return a, a.openDetail(jira.Issue{Key: item.ID})
+
+	case overlayActionBulkCreate:
+		return a.handleBulkCreateText(result.(string))
+
+	case overlayActionReleaseVersion:
+		return a.handleReleasePick(result.(*selectionItem))
+
+	case overlayActionReleaseMove:
+		return a.handleReleaseMove(result.(*selectionItem))
+
+	case overlayActionReleaseConfirm:
+		return a.releaseVersion()
+
+	case overlayActionOpenLink:
+		return a.openURL(result.(*selectionItem).ID), nil
+
+	case overlayActionSprintBoard:
+		return a.handleSprintBoardPick(result.(*selectionItem))
+
+	case overlayActionSprintPick:
+		return a.handleSprintPick(result.(*selectionItem))
+
+	case overlayActionSprintMove:
+		return a.handleSprintMove(result.(*selectionItem))
+
+	case overlayActionSprintConfirm:
+		return a.handleSprintConfirm(result.(string))
+
+	case overlayActionPunt:
+		return a.confirmPunt()
 
 	case overlayActionAddComment:
-		text := result.(string)
+		draft := result.(commentDraft)
+		text := draft.text
 		if strings.TrimSpace(text) == "" {
 			a.flash = "Comment cannot be empty"
 			a.flashIsErr = true
@@ -1263,7 +2257,7 @@ func (a App) handleOverlayResult(result interface{}) (tea.Model, tea.Cmd) {
 		if len(a.viewStack) > 0 {
 			if dv, ok := a.viewStack[len(a.viewStack)-1].(*issueDetailView); ok {
 				placeholder := jira.Comment{
-					Body:    makeADFDocument(text),
+					Body:    makeADFDocumentMentions(text, draft.mentions),
 					Created: "just now",
 				}
 				dv.comments = append([]jira.Comment{placeholder}, dv.comments...)
@@ -1272,7 +2266,12 @@ func (a App) handleOverlayResult(result interface{}) (tea.Model, tea.Cmd) {
 		}
 		a.flash = "Adding comment..."
 		a.flashIsErr = false
-		return a, a.startNetwork(a.cmdAddComment(issueKey, text))
+		cmd := a.trackWrite(writeComment, issueKey, a.startNetwork(a.cmdAddComment(issueKey, draft)))
+		a.suggestHandoff(issueKey, draft)
+		return a, cmd
+
+	case overlayActionHandoff:
+		return a.assignHandoff()
 	}
 
 	return a, nil
@@ -1312,6 +2311,19 @@ func (a App) cmdFetchChildren(issueKey string) tea.Cmd {
 	}
 }
 
+// cmdFetchLastChange fetches the most recent changelog entry for the
+// detail view.
+func (a App) cmdFetchLastChange(issueKey string) tea.Cmd {
+	if a.client == nil {
+		return nil
+	}
+	client := a.client
+	return func() tea.Msg {
+		change, err := client.GetLatestChange(context.Background(), issueKey)
+		return lastChangeLoadedMsg{issueKey: issueKey, change: change, err: err}
+	}
+}
+
 // cmdFetchComments fetches comments for the detail view.
 func (a App) cmdFetchComments(issueKey string) tea.Cmd {
 	if a.client == nil {
@@ -1319,21 +2331,21 @@ func (a App) cmdFetchComments(issueKey string) tea.Cmd {
 	}
 	client := a.client
 	return func() tea.Msg {
-		comments, err := client.GetComments(context.Background(), issueKey)
+		page, err := client.GetCommentsPage(context.Background(), issueKey, 0)
 		if err != nil {
 			return commentsLoadedMsg{issueKey: issueKey, err: err}
 		}
-		return commentsLoadedMsg{issueKey: issueKey, comments: comments}
+		return commentsLoadedMsg{issueKey: issueKey, comments: page.Comments, total: page.Total}
 	}
 }
 
 // cmdAddComment posts a comment to a Jira issue.
-func (a App) cmdAddComment(issueKey, text string) tea.Cmd {
+func (a App) cmdAddComment(issueKey string, draft commentDraft) tea.Cmd {
 	if a.client == nil {
 		return nil
 	}
 	client := a.client
-	body := makeADFDocument(text)
+	body := makeADFDocumentMentions(draft.text, draft.mentions)
 	return func() tea.Msg {
 		comment, err := client.AddComment(context.Background(), issueKey, body)
 		if err != nil {
@@ -1343,34 +2355,16 @@ func (a App) cmdAddComment(issueKey, text string) tea.Cmd {
 	}
 }
 
-// cmdMarkDone fetches transitions, finds the "done" category, and executes it.
-func (a App) cmdMarkDone(issueKey string) tea.Cmd {
+// cmdAssignToMe assigns the issue to the current user and re-fetches it.
+func (a App) cmdAssignToMe(issueKey string, user *jira.User) tea.Cmd {
 	client := a.client
 	return func() tea.Msg {
 		ctx := context.Background()
 
-		transitions, err := client.GetTransitions(ctx, issueKey)
-		if err != nil {
-			return issueUpdatedMsg{issueKey: issueKey, err: fmt.Errorf("get transitions: %w", err)}
-		}
-
-		// Find a transition whose target status category is "done"
-		var doneTransition *jira.Transition
-		for i, t := range transitions {
-			if t.To != nil && t.To.StatusCategory != nil && t.To.StatusCategory.Key == "done" {
-				doneTransition = &transitions[i]
-				break
-			}
-		}
-		if doneTransition == nil {
-			return issueUpdatedMsg{issueKey: issueKey, err: fmt.Errorf("no 'done' transition available for %s", issueKey)}
-		}
-
-		if err := client.TransitionIssue(ctx, issueKey, doneTransition.ID); err != nil {
-			return issueUpdatedMsg{issueKey: issueKey, err: fmt.Errorf("transition: %w", err)}
+		if err := client.AssignIssue(ctx, issueKey, user.AccountID); err != nil {
+			return issueUpdatedMsg{issueKey: issueKey, err: fmt.Errorf("assign: %w", err)}
 		}
 
-		// Re-fetch the issue to get the updated state
 		issue, err := client.GetIssue(ctx, issueKey)
 		if err != nil {
 			return issueUpdatedMsg{issueKey: issueKey, err: fmt.Errorf("refresh: %w", err)}
@@ -1379,26 +2373,97 @@ func (a App) cmdMarkDone(issueKey string) tea.Cmd {
 	}
 }
 
-// cmdAssignToMe assigns the issue to the current user and re-fetches it.
-func (a App) cmdAssignToMe(issueKey string, user *jira.User) tea.Cmd {
-	client := a.client
-	return func() tea.Msg {
-		ctx := context.Background()
-
-		if err := client.AssignIssue(ctx, issueKey, user.AccountID); err != nil {
-			return issueUpdatedMsg{issueKey: issueKey, err: fmt.Errorf("assign: %w", err)}
+// knownProjects returns the keys of the projects in use: the default
+// project, those of the issues in the tabs, and that of issueKey.
+func (a App) knownProjects(issueKey string) map[string]bool {
+	projects := map[string]bool{projectKeyOf(issueKey): true}
+	if a.defaultProject != "" {
+		projects[a.defaultProject] = true
+	}
+	for _, t := range a.tabs {
+		for _, issue := range t.issues {
+			projects[projectKeyOf(issue.Key)] = true
 		}
+	}
+	return projects
+}
 
-		issue, err := client.GetIssue(ctx, issueKey)
-		if err != nil {
-			return issueUpdatedMsg{issueKey: issueKey, err: fmt.Errorf("refresh: %w", err)}
-		}
-		return issueUpdatedMsg{issueKey: issueKey, issue: issue}
+// openDetail pushes a detail view for issue, rendering with what is known
+// so far, and fetches the full issue, its comments, and its children.
+func (a *App) openDetail(issue jira.Issue) tea.Cmd {
+	full, prefetched := a.prefetched[issue.Key]
+	if prefetched {
+		issue = full
+	}
+	dv := newIssueDetailView(issue, a.clientBaseURL(), a.width, a.height)
+	// A prefetched issue shows complete at once; the fetch below refreshes it
+	dv.loading = !prefetched
+	dv.people = a.mentionContext()
+	dv.clock = a.clock
+	dv.projects = a.knownProjects(issue.Key)
+	dv.buildViewport()
+	a.viewStack = append(a.viewStack, &dv)
+	a.inflight += 5 // extra inflight for comments, children, last change, watchers, web links
+	dev := a.cmdFetchDevInfo(issue)
+	if dev != nil {
+		a.inflight++
+	}
+	return tea.Batch(
+		a.startNetwork(a.cmdFetchIssue(issue.Key)),
+		a.cmdFetchComments(issue.Key),
+		a.cmdFetchChildren(issue.Key),
+		a.cmdFetchLastChange(issue.Key),
+		a.cmdFetchWatchers(issue.Key),
+		a.cmdFetchRemoteLinks(issue.Key),
+		dev,
+	)
+}
+
+// mentionContext resolves mentions from the user cache and marks the
+// current user's account for highlighting.
+func (a App) mentionContext() mentionContext {
+	ctx := mentionContext{names: make(map[string]string, len(a.cachedUsers))}
+	for _, u := range a.cachedUsers {
+		ctx.names[u.AccountID] = u.DisplayName
+	}
+	if a.user != nil {
+		ctx.me = a.user.AccountID
+	}
+	return ctx
+}
+
+// topDetail returns the detail view on top of the stack if it is showing
+// issueKey, or nil.
+func (a App) topDetail(issueKey string) *issueDetailView {
+	if len(a.viewStack) == 0 {
+		return nil
+	}
+	if dv, ok := a.viewStack[len(a.viewStack)-1].(*issueDetailView); ok && dv.issue.Key == issueKey {
+		return dv
+	}
+	return nil
+}
+
+// markDetailStale flags dv for a rebuild on the next frame. Results that
+// land within the same frame (issue, comments, children) share one rebuild.
+func (a *App) markDetailStale(dv *issueDetailView) tea.Cmd {
+	dv.stale = true
+	if a.rebuildScheduled {
+		return nil
 	}
+	a.rebuildScheduled = true
+	return tea.Tick(detailFrame, func(time.Time) tea.Msg { return detailRebuildMsg{} })
 }
 
 // applyIssueUpdate updates the issue in both the tab data and the detail view.
 func (a *App) applyIssueUpdate(issueKey string, updated *jira.Issue) {
+	a.markFetched(*updated)
+	a.replaceIssue(issueKey, updated)
+}
+
+// replaceIssue swaps the local copies of an issue in every tab and the
+// detail view for updated.
+func (a *App) replaceIssue(issueKey string, updated *jira.Issue) {
 	// Update in all tabs
 	for ti := range a.tabs {
 		for ii := range a.tabs[ti].issues {
@@ -1453,13 +2518,13 @@ func (a App) cmdFetchPriorities(issueKey string) tea.Cmd {
 	}
 }
 
-// cmdTransitionIssue executes a transition then re-fetches the issue.
-func (a App) cmdTransitionIssue(issueKey, transitionID string) tea.Cmd {
+// cmdUpdateField updates one or more fields on an issue then re-fetches it.
+func (a App) cmdUpdateField(issueKey string, fields map[string]interface{}) tea.Cmd {
 	client := a.client
 	return func() tea.Msg {
 		ctx := context.Background()
-		if err := client.TransitionIssue(ctx, issueKey, transitionID); err != nil {
-			return issueUpdatedMsg{issueKey: issueKey, err: fmt.Errorf("transition: %w", err)}
+		if err := client.UpdateIssue(ctx, issueKey, fields); err != nil {
+			return issueUpdatedMsg{issueKey: issueKey, err: fmt.Errorf("update: %w", err)}
 		}
 		issue, err := client.GetIssue(ctx, issueKey)
 		if err != nil {
@@ -1469,13 +2534,13 @@ func (a App) cmdTransitionIssue(issueKey, transitionID string) tea.Cmd {
 	}
 }
 
-// cmdUpdateField updates one or more fields on an issue then re-fetches it.
-func (a App) cmdUpdateField(issueKey string, fields map[string]interface{}) tea.Cmd {
+// cmdUpdateLabels adds and removes labels on an issue then re-fetches it.
+func (a App) cmdUpdateLabels(issueKey string, add, remove []string) tea.Cmd {
 	client := a.client
 	return func() tea.Msg {
 		ctx := context.Background()
-		if err := client.UpdateIssue(ctx, issueKey, fields); err != nil {
-			return issueUpdatedMsg{issueKey: issueKey, err: fmt.Errorf("update: %w", err)}
+		if err := client.UpdateIssueLabels(ctx, issueKey, add, remove); err != nil {
+			return issueUpdatedMsg{issueKey: issueKey, err: fmt.Errorf("update labels: %w", err)}
 		}
 		issue, err := client.GetIssue(ctx, issueKey)
 		if err != nil {
@@ -1485,14 +2550,32 @@ func (a App) cmdUpdateField(issueKey string, fields map[string]interface{}) tea.
 	}
 }
 
+// cmdFetchLabels fetches every label defined on the Jira site.
+func (a App) cmdFetchLabels() tea.Cmd {
+	client := a.client
+	return func() tea.Msg {
+		labels, err := client.GetLabels(context.Background())
+		if err != nil {
+			return labelsLoadedMsg{err: fmt.Errorf("fetch labels: %w", err)}
+		}
+		return labelsLoadedMsg{labels: labels}
+	}
+}
+
 // cmdDeleteIssue deletes an issue from Jira.
 func (a App) cmdDeleteIssue(issueKey string) tea.Cmd {
 	client := a.client
 	return func() tea.Msg {
-		if err := client.DeleteIssue(context.Background(), issueKey, false); err != nil {
+		ctx := context.Background()
+		// A copy to recreate it from on undo; the delete goes ahead without
+		issue, err := client.GetIssue(ctx, issueKey)
+		if err != nil {
+			client.Logger().Warn("keeping a copy for undo", "issue", issueKey, "err", err)
+		}
+		if err := client.DeleteIssue(ctx, issueKey, false); err != nil {
 			return issueDeletedMsg{issueKey: issueKey, err: fmt.Errorf("delete: %w", err)}
 		}
-		return issueDeletedMsg{issueKey: issueKey}
+		return issueDeletedMsg{issueKey: issueKey, issue: issue}
 	}
 }
 
@@ -1515,22 +2598,41 @@ func (a App) cmdFetchAndCacheUsers() tea.Cmd {
 			}
 		}
 
-		// Best-effort save to disk cache
-		_ = config.SaveUserCache(cached)
+		// A failed save is retried by the shutdown flush
+		saveErr := config.SaveUserCache(cached)
 
-		return usersLoadedMsg{users: cached}
+		return usersLoadedMsg{users: cached, saveErr: saveErr}
 	}
 }
 
-// cmdFetchIssueTypes fetches issue types for the default project.
+// cmdFetchIssueTypes fetches issue types for the project being created
+// in, or the child types of createParent's project when creating a subtask.
 func (a App) cmdFetchIssueTypes() tea.Cmd {
+	return a.cmdFetchIssueTypesFor(a.createProject(), a.createParent, a.createSubtask)
+}
+
+// cmdFetchIssueTypesFor fetches the types an issue can be created as: in
+// project, or as a child of parent.
+func (a App) cmdFetchIssueTypesFor(project, parent string, subtask bool) tea.Cmd {
 	if a.client == nil {
 		return nil
 	}
 	client := a.client
-	project := a.defaultProject
+	if parent != "" {
+		project = projectKeyOf(parent)
+	}
 	return func() tea.Msg {
-		types, err := client.GetProjectIssueTypes(context.Background(), project)
+		ctx := context.Background()
+		var types []jira.IssueType
+		var err error
+		if parent != "" && subtask {
+			types, err = client.GetProjectSubtaskTypes(ctx, project)
+		} else {
+			types, err = client.GetProjectIssueTypes(ctx, project)
+		}
+		if parent != "" && !subtask {
+			types = withoutEpics(types)
+		}
 		if err != nil {
 			return issueTypesLoadedMsg{err: fmt.Errorf("get issue types: %w", err)}
 		}
@@ -1538,44 +2640,70 @@ func (a App) cmdFetchIssueTypes() tea.Cmd {
 	}
 }
 
-// cmdCreateIssue creates a new issue with the given summary and type.
-// It auto-assigns the issue to the current user and transitions it to "To Do".
-func (a App) cmdCreateIssue(summary, issueTypeName string) tea.Cmd {
+// cmdCreateIssue creates the issue the create form describes, moves it
+// to "To Do", adds it to the sprint picked, if any, and copies the links
+// of the issue it was cloned from when asked. A non-empty
+// createParent creates it in the parent's project linked as a child.
+func (a App) cmdCreateIssue(form createFormResult) tea.Cmd {
 	if a.client == nil {
 		return nil
 	}
 	client := a.client
-	project := a.defaultProject
-	var accountID string
-	if a.user != nil {
-		accountID = a.user.AccountID
-	}
+	project := a.createProject()
+	parentKey := a.createParent
 	return func() tea.Msg {
 		ctx := context.Background()
-		fields := map[string]interface{}{
-			"project":   map[string]interface{}{"key": project},
-			"summary":   summary,
-			"issuetype": map[string]interface{}{"name": issueTypeName},
-		}
-		if accountID != "" {
-			fields["assignee"] = map[string]interface{}{"accountId": accountID}
-		}
-		req := jira.CreateIssueRequest{Fields: fields}
-		resp, err := client.CreateIssue(ctx, req)
+		key, err := createIssue(ctx, client, project, parentKey, form.summary, form.issueType, form.fields, "")
 		if err != nil {
 			return issueCreatedMsg{err: fmt.Errorf("create issue: %w", err)}
 		}
-
-		// Best-effort transition to "To Do".
-		if transitions, err := client.GetTransitions(ctx, resp.Key); err == nil {
-			for _, t := range transitions {
-				if t.To != nil && t.To.Name == "To Do" {
-					_ = client.TransitionIssue(ctx, resp.Key, t.ID)
-					break
-				}
+		if form.sprintID != 0 {
+			if err := client.MoveIssuesToSprint(ctx, form.sprintID, []string{key}); err != nil {
+				return issueCreatedMsg{err: fmt.Errorf("created %s, but adding it to the sprint failed: %w", key, err)}
 			}
 		}
+		if err := copyLinks(ctx, client, key, form.links); err != nil {
+			return issueCreatedMsg{err: fmt.Errorf("created %s, but copying links failed: %w", key, err)}
+		}
+		return issueCreatedMsg{issueKey: key, parentKey: parentKey}
+	}
+}
+
+// createIssue creates an issue in project, assigned to accountID when set,
+// and moves it to "To Do", returning its key.
+func createIssue(ctx context.Context, client *jira.Client, project, parentKey, summary, issueTypeName string, extra map[string]interface{}, accountID string) (string, error) {
+	fields := map[string]interface{}{
+		"project":   map[string]interface{}{"key": project},
+		"summary":   summary,
+		"issuetype": map[string]interface{}{"name": issueTypeName},
+	}
+	if parentKey != "" {
+		fields["parent"] = map[string]interface{}{"key": parentKey}
+	}
+	for k, v := range extra {
+		fields[k] = v
+	}
+	if accountID != "" {
+		fields["assignee"] = map[string]interface{}{"accountId": accountID}
+	}
+	req := jira.CreateIssueRequest{Fields: fields}
+	resp, err := client.CreateIssue(ctx, req)
+	if err != nil {
+		return "", err
+	}
 
-		return issueCreatedMsg{issueKey: resp.Key}
+	// Best-effort transition to "To Do".
+	transitions, err := client.GetTransitions(ctx, resp.Key)
+	if err != nil {
+		client.Logger().Warn("moving the new issue to To Do", "issue", resp.Key, "err", err)
+	}
+	for _, t := range transitions {
+		if t.To != nil && t.To.Name == "To Do" {
+			if err := client.TransitionIssue(ctx, resp.Key, t.ID); err != nil {
+				client.Logger().Warn("moving the new issue to To Do", "issue", resp.Key, "err", err)
+			}
+			break
+		}
 	}
+	return resp.Key, nil
 }
@@ -570,18 +570,17 @@ func TestEditHotkeySpawnsOverlays(t *testing.T) {
 		}
 	})
 
-	t.Run("t opens title overlay", func(t *testing.T) {
+	t.Run("t edits the title in its row", func(t *testing.T) {
 		model, _ := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
 		updated := model.(App)
-		if updated.overlay == nil {
-			t.Fatal("expected overlay to be set")
-		}
-		if _, ok := updated.overlay.(*textInputOverlay); !ok {
-			t.Fatalf("expected textInputOverlay, got %T", updated.overlay)
+		if updated.overlay != nil {
+			t.Fatalf("expected no overlay, got %T", updated.overlay)
 		}
-		if updated.overlayAction != overlayActionTitle {
-			t.Errorf("expected overlayActionTitle, got %d", updated.overlayAction)
+		if updated.tabs[0].inline == nil {
+			t.Fatal("expected an inline title editor")
 		}
+		// Tabs are shared between copies; close the editor for the next subtest
+		updated.Update(tea.KeyMsg{Type: tea.KeyEscape})
 	})
 
 	t.Run("e opens description overlay", func(t *testing.T) {
@@ -1158,3 +1157,73 @@ func TestEditHotkeyFromDetailView(t *testing.T) {
 		t.Errorf("expected overlayIssue=PROJ-1, got %s", updated.overlayIssue)
 	}
 }
+
+func TestAppFilterCtrlFSwitchesMode(t *testing.T) {
+	app := testAppReady()
+	app.tabs[0].quickFilter.activate()
+	app.tabs[0].quickFilter.input.SetValue("fxlg")
+	app.tabs[0].quickFilter.updateQuery(app.tabs[0].issues, app.tabs[0].columns)
+	if app.tabs[0].quickFilter.matched != 0 {
+		t.Fatalf("substring matched %d, want 0", app.tabs[0].quickFilter.matched)
+	}
+
+	model, _ := app.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	app = model.(App)
+	f := app.tabs[0].quickFilter
+	if !f.fuzzy || !app.tabs[1].quickFilter.fuzzy || !app.fuzzyFilter {
+		t.Fatal("ctrl+f should switch every tab to fuzzy matching")
+	}
+	if f.matched != 2 || len(app.tabs[0].table.Rows()) != 2 {
+		t.Errorf("fuzzy matched %d, rows %d, want both Fix issues", f.matched, len(app.tabs[0].table.Rows()))
+	}
+	if !f.isFocused() {
+		t.Error("the filter should stay focused")
+	}
+	if bar := app.renderFilterBar(&app.tabs[0]); !strings.Contains(bar, "fuzzy") {
+		t.Errorf("filter bar should name the mode: %q", bar)
+	}
+}
+
+func TestAppFilterHighlightsMatches(t *testing.T) {
+	app := testAppReady()
+	app.SetQuickFilter(config.QuickFilterConfig{Mode: "fuzzy"})
+	app.tabs[0].quickFilter.activate()
+	app.tabs[0].quickFilter.input.SetValue("dash")
+	app.tabs[0].quickFilter.updateQuery(app.tabs[0].issues, app.tabs[0].columns)
+	app.tabs[0].applyFilter()
+
+	view := app.renderActiveTab()
+	if !strings.Contains(view, "Update "+ansiHighlight("d")+ansiHighlight("a")) {
+		t.Errorf("matched characters should be highlighted:\n%q", view)
+	}
+}
+
+func TestAppPersistentFilter(t *testing.T) {
+	app := testAppReady()
+	app.SetQuickFilter(config.QuickFilterConfig{Persist: true})
+	app.tabs[0].quickFilter.activate()
+	app.tabs[0].quickFilter.input.SetValue("fix")
+	model, _ := app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	app = model.(App)
+
+	for _, k := range []string{"2", "1"} {
+		model, _ = app.Update(keyMsg(k))
+		app = model.(App)
+	}
+	if f := app.tabs[0].quickFilter; !f.isActive() || f.query != "fix" {
+		t.Fatalf("filter should survive a tab switch, query = %q", f.query)
+	}
+
+	// A reload that starts from scratch re-applies the filter
+	app.tabs[0].setLoading()
+	model, _ = app.Update(tabDataMsg{tabIndex: 0, issues: []jira.Issue{
+		{Key: "PROJ-1", Fields: jira.IssueFields{Summary: "Fix login page"}},
+		{Key: "PROJ-2", Fields: jira.IssueFields{Summary: "Update dashboard"}},
+		{Key: "PROJ-4", Fields: jira.IssueFields{Summary: "Fix signup"}},
+	}})
+	app = model.(App)
+	tab := app.tabs[0]
+	if !tab.quickFilter.isActive() || len(tab.table.Rows()) != 2 || tab.quickFilter.total != 3 {
+		t.Errorf("active = %v, rows = %d, total = %d", tab.quickFilter.isActive(), len(tab.table.Rows()), tab.quickFilter.total)
+	}
+}
@@ -0,0 +1,91 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// permissionsMsg delivers the account's permissions, checked once after
+// connecting.
+type permissionsMsg struct {
+	perms []jira.Permission
+	err   error
+}
+
+// cmdCheckPermissions fetches which of the permissions jira-tui uses the
+// account holds, in the default project when one is configured.
+func (a App) cmdCheckPermissions() tea.Cmd {
+	client := a.client
+	project := a.defaultProject
+	return func() tea.Msg {
+		perms, err := client.GetMyPermissions(context.Background(), project, jira.UsedPermissions)
+		return permissionsMsg{perms: perms, err: err}
+	}
+}
+
+// handlePermissions records the permissions. A failed check is only
+// logged; the status bar then simply shows no warning.
+func (a App) handlePermissions(msg permissionsMsg) (tea.Model, tea.Cmd) {
+	if msg.err == nil {
+		a.permissions = msg.perms
+	} else {
+		a.log.Warn("checking permissions", "err", msg.err)
+	}
+	return a, nil
+}
+
+// missingPermissions returns the names of the checked permissions the
+// account lacks.
+func missingPermissions(perms []jira.Permission) []string {
+	var missing []string
+	for _, p := range perms {
+		if !p.HavePermission {
+			missing = append(missing, p.Name)
+		}
+	}
+	return missing
+}
+
+// renderAccount is the status bar segment naming the signed-in account,
+// flagged when it lacks permissions some features need.
+func (a App) renderAccount() string {
+	name := successStyle.Render(a.user.DisplayName)
+	if missing := missingPermissions(a.permissions); len(missing) > 0 {
+		noun := "permissions"
+		if len(missing) == 1 {
+			noun = "permission"
+		}
+		name += loadingStyle.Render(fmt.Sprintf(" ⚠ %d %s missing (D)", len(missing), noun))
+	}
+	return name
+}
+
+// formatAccount describes the signed-in account and its permissions for
+// the diagnostics overlay.
+func formatAccount(user *jira.User, perms []jira.Permission) string {
+	if user == nil {
+		return "Not signed in."
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Signed in as %s", user.DisplayName)
+	if user.Email != "" {
+		fmt.Fprintf(&b, " <%s>", user.Email)
+	}
+	b.WriteString(" — token accepted")
+	if len(perms) > 0 {
+		b.WriteString("\n")
+		for _, p := range perms {
+			mark := "✓"
+			if !p.HavePermission {
+				mark = "✗"
+			}
+			fmt.Fprintf(&b, "\n%s %s", mark, p.Name)
+		}
+	}
+	return b.String()
+}
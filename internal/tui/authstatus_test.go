@@ -0,0 +1,43 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestStatusBarFlagsMissingPermissions(t *testing.T) {
+	app := testAppReady()
+	app.user = &jira.User{DisplayName: "Jane"}
+	if bar := app.renderStatusBar(); strings.Contains(bar, "missing") {
+		t.Errorf("no warning before permissions are known: %q", bar)
+	}
+
+	model, _ := app.Update(permissionsMsg{perms: []jira.Permission{
+		{Key: "EDIT_ISSUES", Name: "Edit Issues", HavePermission: true},
+		{Key: "DELETE_ISSUES", Name: "Delete Issues", HavePermission: false},
+	}})
+	app = model.(App)
+	if bar := app.renderStatusBar(); !strings.Contains(bar, "1 permission missing") {
+		t.Errorf("status bar = %q, want missing-permission warning", bar)
+	}
+	body := formatAccount(app.user, app.permissions)
+	if !strings.Contains(body, "✓ Edit Issues") || !strings.Contains(body, "✗ Delete Issues") {
+		t.Errorf("account details = %q", body)
+	}
+}
+
+func TestConnectionFailureShowsAuthHint(t *testing.T) {
+	app := testAppWithTabs()
+	model, _ := app.Update(tea.WindowSizeMsg{Width: 200, Height: 30})
+	app = model.(App)
+	model, _ = app.Update(connStatusMsg{err: errors.New("getting myself: API error 401: unauthorized")})
+	app = model.(App)
+	if view := app.View(); !strings.Contains(view, "auth set") {
+		t.Errorf("view should explain the rejected token:\n%s", view)
+	}
+}
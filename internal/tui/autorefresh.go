@@ -0,0 +1,86 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// clockInterval is how often the status bar redraws so "updated X ago"
+// stays accurate while the app is idle.
+const clockInterval = 30 * time.Second
+
+// tabRefreshMsg fires when a tab's refresh_interval has elapsed. seq must
+// match the tab's current generation; older ticks were superseded by a
+// later load.
+type tabRefreshMsg struct {
+	tabIndex int
+	tabID    int
+	seq      int
+}
+
+// clockTickMsg redraws time-relative indicators.
+type clockTickMsg struct{}
+
+// scheduleRefresh starts the tab's next background reload after delay,
+// superseding any tick already pending. Tabs without a refresh_interval
+// return nil.
+func (a *App) scheduleRefresh(index int, delay time.Duration) tea.Cmd {
+	if index < 0 || index >= len(a.tabs) || a.tabs[index].refreshEvery <= 0 {
+		return nil
+	}
+	t := &a.tabs[index]
+	t.refreshSeq++
+	seq, id := t.refreshSeq, t.id
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return tabRefreshMsg{tabIndex: index, tabID: id, seq: seq}
+	})
+}
+
+// handleTabRefresh reloads a tab in the background. The list stays on
+// screen; the results are swapped in by refreshIssues when they land.
+func (a App) handleTabRefresh(msg tabRefreshMsg) (tea.Model, tea.Cmd) {
+	msg.tabIndex = a.tabIndexFor(msg.tabIndex, msg.tabID)
+	if msg.tabIndex < 0 || msg.tabIndex >= len(a.tabs) {
+		return a, nil
+	}
+	t := &a.tabs[msg.tabIndex]
+	if t.refreshSeq != msg.seq || !a.connected {
+		return a, nil
+	}
+	if t.state == tabLoading {
+		// A manual refresh is in flight; its result reschedules the tick
+		return a, nil
+	}
+	cmd := a.startNetwork(a.loadTab(msg.tabIndex))
+	return a, cmd
+}
+
+// autoRefreshing reports whether any tab reloads on an interval.
+func (a App) autoRefreshing() bool {
+	for _, t := range a.tabs {
+		if t.refreshEvery > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// clockTick schedules the next status bar redraw.
+func clockTick() tea.Cmd {
+	return tea.Tick(clockInterval, func(time.Time) tea.Msg {
+		return clockTickMsg{}
+	})
+}
+
+// refreshDelay returns how long until a tab restored from the cache is
+// due for its next reload.
+func refreshDelay(t *tab, now time.Time) time.Duration {
+	if t.fetchedAt.IsZero() {
+		return t.refreshEvery
+	}
+	if d := t.refreshEvery - now.Sub(t.fetchedAt); d > 0 {
+		return d
+	}
+	return 0
+}
@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestBackgroundRefreshKeepsCursorAndFilter(t *testing.T) {
+	app := testAppReady()
+	tab := &app.tabs[0]
+	tab.quickFilter.input.SetValue("fix")
+	tab.quickFilter.apply(tab.issues, tab.columns)
+	tab.applyFilter()
+	tab.table.SetCursor(1) // PROJ-3
+
+	reloaded := []jira.Issue{
+		{Key: "PROJ-4", Fields: jira.IssueFields{Summary: "Fix signup", Status: &jira.Status{Name: "Open"}}},
+		{Key: "PROJ-3", Fields: jira.IssueFields{Summary: "Fix logout bug", Status: &jira.Status{Name: "Open"}}},
+		{Key: "PROJ-1", Fields: jira.IssueFields{Summary: "Fix login page", Status: &jira.Status{Name: "Open"}}},
+		{Key: "PROJ-2", Fields: jira.IssueFields{Summary: "Update dashboard", Status: &jira.Status{Name: "Done"}}},
+	}
+	model, _ := app.Update(tabDataMsg{tabIndex: 0, issues: reloaded})
+	app = model.(App)
+	tab = &app.tabs[0]
+
+	if !tab.quickFilter.isActive() || tab.quickFilter.matched != 3 {
+		t.Errorf("filter active = %v, matched = %d; want active with 3 matches",
+			tab.quickFilter.isActive(), tab.quickFilter.matched)
+	}
+	if sel := tab.selectedIssue(); sel == nil || sel.Key != "PROJ-3" {
+		t.Errorf("cursor should stay on PROJ-3, got %v", sel)
+	}
+}
+
+func TestRefreshIntervalSchedulesTicks(t *testing.T) {
+	app := testAppConnected()
+	app.connected = true
+	app.tabs[0].refreshEvery = time.Minute
+	app.inflight = 1
+
+	model, cmd := app.Update(tabDataMsg{tabIndex: 0, issues: app.tabs[0].issues})
+	app = model.(App)
+	if cmd == nil || app.tabs[0].refreshSeq != 1 {
+		t.Fatalf("load should schedule a tick, cmd = %v, seq = %d", cmd, app.tabs[0].refreshSeq)
+	}
+
+	// A tick from a superseded schedule is ignored
+	model, cmd = app.Update(tabRefreshMsg{tabIndex: 0, seq: 0})
+	app = model.(App)
+	if cmd != nil || app.inflight != 0 {
+		t.Errorf("stale tick should be ignored, inflight = %d", app.inflight)
+	}
+
+	model, cmd = app.Update(tabRefreshMsg{tabIndex: 0, seq: 1})
+	app = model.(App)
+	if cmd == nil || app.inflight != 1 {
+		t.Errorf("due tick should reload the tab, inflight = %d", app.inflight)
+	}
+	if !app.tabs[0].hasData() {
+		t.Error("list should stay visible during a background reload")
+	}
+}
+
+func TestRefreshTickSkipsManualReload(t *testing.T) {
+	app := testAppConnected()
+	app.connected = true
+	app.tabs[0].refreshEvery = time.Minute
+	app.tabs[0].refreshSeq = 1
+	app.tabs[0].setLoading()
+
+	_, cmd := app.Update(tabRefreshMsg{tabIndex: 0, seq: 1})
+	if cmd != nil {
+		t.Error("tick should not start a second load while one is in flight")
+	}
+}
+
+func TestStatusBarShowsLastUpdated(t *testing.T) {
+	app := testAppReady()
+	if strings.Contains(app.renderStatusBar(), "updated") {
+		t.Error("tabs without refresh_interval should not show the indicator")
+	}
+
+	app.tabs[0].refreshEvery = time.Minute
+	app.tabs[0].fetchedAt = time.Now().Add(-3 * time.Minute)
+	if bar := app.renderStatusBar(); !strings.Contains(bar, "updated 3m ago") {
+		t.Errorf("status bar = %q, want last-updated indicator", bar)
+	}
+}
+
+func TestRefreshDelayFromCache(t *testing.T) {
+	now := time.Now()
+	tab := &tab{refreshEvery: 5 * time.Minute, fetchedAt: now.Add(-2 * time.Minute)}
+	if d := refreshDelay(tab, now); d != 3*time.Minute {
+		t.Errorf("refreshDelay = %v, want 3m", d)
+	}
+	tab.fetchedAt = now.Add(-time.Hour)
+	if d := refreshDelay(tab, now); d != 0 {
+		t.Errorf("overdue refreshDelay = %v, want 0", d)
+	}
+}
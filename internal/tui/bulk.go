@@ -0,0 +1,165 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// bulkFunc applies one edit to a single issue as part of a bulk operation.
+type bulkFunc func(ctx context.Context, client *jira.Client, issueKey string) error
+
+// bulkOp tracks the progress of an edit applied to every selected issue.
+type bulkOp struct {
+	id     int
+	label  string // e.g. "Transition", shown in progress flashes
+	total  int
+	done   int
+	failed []string // "KEY: error" per failed issue
+}
+
+// bulkItemMsg reports the result of a bulk operation for one issue.
+type bulkItemMsg struct {
+	opID     int
+	issueKey string
+	issue    *jira.Issue // refreshed issue on success
+	err      error
+}
+
+// progress returns the flash text for the operation's current state.
+func (op *bulkOp) progress() string {
+	if op.done < op.total {
+		return fmt.Sprintf("%s: %d/%d…", op.label, op.done, op.total)
+	}
+	ok := op.total - len(op.failed)
+	if len(op.failed) == 0 {
+		return fmt.Sprintf("%s: %d/%d done", op.label, ok, op.total)
+	}
+	text := fmt.Sprintf("%s: %d/%d succeeded; %s", op.label, ok, op.total, op.failed[0])
+	if len(op.failed) > 1 {
+		text += fmt.Sprintf(" (+%d more failed)", len(op.failed)-1)
+	}
+	return text
+}
+
+// startBulk runs fn against every key concurrently, refreshing each issue
+// after a successful edit. Results arrive as bulkItemMsg and are aggregated
+// into a.bulk so the status bar can show progress and a final summary.
+func (a *App) startBulk(label string, keys []string, fn bulkFunc) tea.Cmd {
+	if a.client == nil || len(keys) == 0 {
+		return nil
+	}
+	a.bulkSeq++
+	a.bulk = &bulkOp{id: a.bulkSeq, label: label, total: len(keys)}
+	a.flash = a.bulk.progress()
+	a.flashIsErr = false
+
+	client := a.client
+	opID := a.bulkSeq
+	cmds := make([]tea.Cmd, len(keys))
+	for i, key := range keys {
+		cmds[i] = a.trackWrite(writeUpdate, key, func() tea.Msg {
+			ctx := context.Background()
+			if err := fn(ctx, client, key); err != nil {
+				return bulkItemMsg{opID: opID, issueKey: key, err: err}
+			}
+			issue, err := client.GetIssue(ctx, key)
+			if err != nil {
+				return bulkItemMsg{opID: opID, issueKey: key, err: fmt.Errorf("refresh: %w", err)}
+			}
+			return bulkItemMsg{opID: opID, issueKey: key, issue: issue}
+		})
+	}
+	a.inflight += len(keys) - 1
+	return a.startNetwork(tea.Batch(cmds...))
+}
+
+// handleBulkItem folds a single bulk result into the running operation.
+func (a *App) handleBulkItem(msg bulkItemMsg) {
+	a.inflight--
+	a.finishWrite(writeUpdate, msg.issueKey)
+	if msg.issue != nil {
+		a.applyIssueUpdate(msg.issueKey, msg.issue)
+	}
+	if a.bulk == nil || a.bulk.id != msg.opID {
+		return
+	}
+	a.bulk.done++
+	if msg.err != nil {
+		a.bulk.failed = append(a.bulk.failed, msg.issueKey+": "+msg.err.Error())
+	}
+	a.flash = a.bulk.progress()
+	a.flashIsErr = a.bulk.done == a.bulk.total && len(a.bulk.failed) > 0
+	if a.bulk.done == a.bulk.total {
+		a.bulk = nil
+	}
+}
+
+// bulkTransition transitions each issue using the transition with the given
+// name, since transition IDs can differ between workflows.
+func bulkTransition(name string) bulkFunc {
+	return func(ctx context.Context, client *jira.Client, issueKey string) error {
+		transitions, err := client.GetTransitions(ctx, issueKey)
+		if err != nil {
+			return fmt.Errorf("get transitions: %w", err)
+		}
+		for _, t := range transitions {
+			if strings.EqualFold(t.Name, name) {
+				if err := checkBulkTransition(t); err != nil {
+					return err
+				}
+				return client.TransitionIssue(ctx, issueKey, t.ID)
+			}
+		}
+		return fmt.Errorf("no %q transition", name)
+	}
+}
+
+// bulkUpdateFields applies the same field update to each issue.
+func bulkUpdateFields(fields map[string]interface{}) bulkFunc {
+	return func(ctx context.Context, client *jira.Client, issueKey string) error {
+		return client.UpdateIssue(ctx, issueKey, fields)
+	}
+}
+
+// bulkAddLabels adds labels to each issue without touching existing ones.
+func bulkAddLabels(labels []string) bulkFunc {
+	return func(ctx context.Context, client *jira.Client, issueKey string) error {
+		return client.UpdateIssueLabels(ctx, issueKey, labels, nil)
+	}
+}
+
+// bulkRemoveLabels removes labels from each issue, leaving the rest.
+func bulkRemoveLabels(labels []string) bulkFunc {
+	return func(ctx context.Context, client *jira.Client, issueKey string) error {
+		return client.UpdateIssueLabels(ctx, issueKey, nil, labels)
+	}
+}
+
+// bulkAssign assigns each issue to the given account.
+func bulkAssign(accountID string) bulkFunc {
+	return func(ctx context.Context, client *jira.Client, issueKey string) error {
+		return client.AssignIssue(ctx, issueKey, accountID)
+	}
+}
+
+// parseLabels splits comma- or space-separated label input. Jira labels
+// cannot contain spaces, so either separator is accepted.
+func parseLabels(input string) []string {
+	fields := strings.FieldsFunc(input, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	seen := make(map[string]bool, len(fields))
+	var labels []string
+	for _, f := range fields {
+		if f != "" && !seen[f] {
+			seen[f] = true
+			labels = append(labels, f)
+		}
+	}
+	return labels
+}
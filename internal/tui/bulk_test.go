@@ -0,0 +1,120 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestBulkOpProgress(t *testing.T) {
+	tests := []struct {
+		name string
+		op   bulkOp
+		want string
+	}{
+		{"running", bulkOp{label: "Transition", total: 3, done: 1}, "Transition: 1/3…"},
+		{"all ok", bulkOp{label: "Transition", total: 3, done: 3}, "Transition: 3/3 done"},
+		{"one failed", bulkOp{label: "Assign", total: 3, done: 3, failed: []string{"P-2: boom"}}, "Assign: 2/3 succeeded; P-2: boom"},
+		{"many failed", bulkOp{label: "Assign", total: 3, done: 3, failed: []string{"P-1: a", "P-2: b"}}, "Assign: 1/3 succeeded; P-1: a (+1 more failed)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.op.progress(); got != tt.want {
+				t.Errorf("progress() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBulkItemMsgAggregates(t *testing.T) {
+	app := testAppReady()
+	app.bulk = &bulkOp{id: 7, label: "Priority High", total: 2}
+	app.inflight = 2
+
+	model, _ := app.Update(bulkItemMsg{
+		opID:     7,
+		issueKey: "PROJ-1",
+		issue:    &jira.Issue{Key: "PROJ-1", Fields: jira.IssueFields{Summary: "Fix login page (edited)"}},
+	})
+	updated := model.(App)
+	if updated.flash != "Priority High: 1/2…" {
+		t.Errorf("unexpected progress flash: %q", updated.flash)
+	}
+	if updated.tabs[0].issues[0].Fields.Summary != "Fix login page (edited)" {
+		t.Error("expected refreshed issue to be applied to the tab")
+	}
+
+	model, _ = updated.Update(bulkItemMsg{opID: 7, issueKey: "PROJ-3", err: fmt.Errorf("forbidden")})
+	updated = model.(App)
+	if !updated.flashIsErr {
+		t.Error("expected error flash when any issue failed")
+	}
+	if !strings.Contains(updated.flash, "1/2 succeeded; PROJ-3: forbidden") {
+		t.Errorf("unexpected summary flash: %q", updated.flash)
+	}
+	if updated.bulk != nil {
+		t.Error("expected bulk op to be cleared when complete")
+	}
+}
+
+func TestBulkHotkeyOpensOverlayForSelection(t *testing.T) {
+	app := testAppReady()
+	app.client = jira.NewClient("https://fake.atlassian.net", "test@test.com", "token")
+	app.cachedPriorities = []jira.Priority{{ID: "2", Name: "High"}}
+
+	model, _ := app.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	app = model.(App)
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyDown})
+	app = model.(App)
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("V")})
+	app = model.(App)
+	if n := app.selectionCount(); n != 2 {
+		t.Fatalf("expected 2 selected issues, got %d", n)
+	}
+
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	updated := model.(App)
+	if len(updated.overlayKeys) != 2 {
+		t.Fatalf("expected overlay to target 2 issues, got %v", updated.overlayKeys)
+	}
+	if !strings.Contains(updated.View(), "Change Priority (2 issues)") {
+		t.Error("expected overlay title to show the selection size")
+	}
+
+	model, cmd := updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated = model.(App)
+	if cmd == nil {
+		t.Fatal("expected bulk command after selecting a priority")
+	}
+	if updated.bulk == nil || updated.bulk.total != 2 {
+		t.Fatalf("expected bulk op over 2 issues, got %+v", updated.bulk)
+	}
+	if len(updated.pending) != 2 {
+		t.Errorf("expected 2 pending writes, got %d", len(updated.pending))
+	}
+}
+
+func TestBulkEscClearsSelection(t *testing.T) {
+	app := testAppReady()
+	model, _ := app.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	app = model.(App)
+	if !strings.Contains(app.View(), "1 selected") {
+		t.Error("expected selection count in status bar")
+	}
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyEscape})
+	if n := model.(App).selectionCount(); n != 0 {
+		t.Errorf("expected esc to clear selection, got %d selected", n)
+	}
+}
+
+func TestParseLabels(t *testing.T) {
+	got := parseLabels("backend, urgent  backend,,ui")
+	want := []string{"backend", "urgent", "ui"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("parseLabels = %v, want %v", got, want)
+	}
+}
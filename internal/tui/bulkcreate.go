@@ -0,0 +1,258 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// Bulk create results sections.
+const (
+	sectionCreated = "Created"
+	sectionFailed  = "Failed"
+)
+
+// bulkCreateOp tracks issues being created from a pasted list, one at a
+// time so their keys follow the list's order.
+type bulkCreateOp struct {
+	parent  string // parent key, or "" for the default project
+	subtask bool   // parent takes subtask types
+	lines   []string
+	items   []bulkCreateItem
+	next    int // index of the item being created
+}
+
+// bulkCreateItem is one issue of a bulk create and its outcome.
+type bulkCreateItem struct {
+	summary  string
+	typeName string
+	key      string // set once created
+	err      error
+}
+
+// bulkCreateTypesMsg delivers the issue types a bulk create resolves
+// "Type:" prefixes against.
+type bulkCreateTypesMsg struct {
+	types []jira.IssueType
+	err   error
+}
+
+// bulkCreatedMsg reports the outcome of one bulk-created issue.
+type bulkCreatedMsg struct {
+	index int
+	key   string
+	err   error
+}
+
+// startBulkCreate opens the editor for a list of issues to create, in the
+// default project or, with a parent, as its children.
+func (a App) startBulkCreate(parent *jira.Issue) (tea.Model, tea.Cmd) {
+	if a.client == nil {
+		a.flash = "Not connected to Jira"
+		a.flashIsErr = true
+		return a, nil
+	}
+	if a.bulkCreate != nil {
+		a.flash = "A bulk create is already running"
+		a.flashIsErr = true
+		return a, nil
+	}
+	op := &bulkCreateOp{}
+	target := a.defaultProject
+	if parent != nil {
+		op.parent = parent.Key
+		op.subtask = !isEpic(parent)
+		target = parent.Key
+	} else if a.defaultProject == "" {
+		a.flash = "Set default_project in config to create issues"
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.bulkCreate = op
+	a.overlay = newTextEditorOverlay("Bulk Create in "+target+" — one summary per line, optionally \"Type: summary\"", "", a.width, a.height)
+	a.overlayAction = overlayActionBulkCreate
+	return a, nil
+}
+
+// handleBulkCreateText fetches the issue types for the entered list.
+func (a App) handleBulkCreateText(text string) (tea.Model, tea.Cmd) {
+	lines := bulkCreateLines(text)
+	if len(lines) == 0 || a.bulkCreate == nil {
+		a.bulkCreate = nil
+		a.flash = "Nothing to create"
+		a.flashIsErr = false
+		return a, nil
+	}
+	a.bulkCreate.lines = lines
+	a.flash = "Loading issue types..."
+	a.flashIsErr = false
+	fetch := a.cmdFetchIssueTypesFor(a.defaultProject, a.bulkCreate.parent, a.bulkCreate.subtask)
+	return a, a.startNetwork(func() tea.Msg {
+		msg := fetch().(issueTypesLoadedMsg)
+		return bulkCreateTypesMsg{types: msg.types, err: msg.err}
+	})
+}
+
+// handleBulkCreateTypes resolves each line's type and starts creating.
+func (a App) handleBulkCreateTypes(msg bulkCreateTypesMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	op := a.bulkCreate
+	if op == nil {
+		return a, nil
+	}
+	if msg.err == nil && len(msg.types) == 0 {
+		msg.err = fmt.Errorf("no issue types available")
+	}
+	if msg.err != nil {
+		a.bulkCreate = nil
+		a.flash = "Bulk create failed: " + msg.err.Error()
+		a.flashIsErr = true
+		return a, nil
+	}
+	op.items = parseBulkCreate(op.lines, msg.types)
+	cmd := a.createNextBulk()
+	return a, cmd
+}
+
+// createNextBulk creates the op's next issue.
+func (a *App) createNextBulk() tea.Cmd {
+	op := a.bulkCreate
+	item := op.items[op.next]
+	a.flash = fmt.Sprintf("Creating %d/%d…", op.next+1, len(op.items))
+	a.flashIsErr = false
+
+	client := a.client
+	project := a.defaultProject
+	if op.parent != "" {
+		project = projectKeyOf(op.parent)
+	}
+	var accountID string
+	if a.user != nil {
+		accountID = a.user.AccountID
+	}
+	index, parent := op.next, op.parent
+	return a.trackWrite(writeCreate, "", a.startNetwork(func() tea.Msg {
+		key, err := createIssue(context.Background(), client, project, parent, item.summary, item.typeName, nil, accountID)
+		return bulkCreatedMsg{index: index, key: key, err: err}
+	}))
+}
+
+// handleBulkCreated records one outcome and moves on to the next issue, or
+// shows the results once all are done.
+func (a App) handleBulkCreated(msg bulkCreatedMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	a.finishWrite(writeCreate, "")
+	op := a.bulkCreate
+	if op == nil || msg.index != op.next {
+		return a, nil
+	}
+	op.items[msg.index].key = msg.key
+	op.items[msg.index].err = msg.err
+	if op.next++; op.next < len(op.items) {
+		cmd := a.createNextBulk()
+		return a, cmd
+	}
+
+	a.bulkCreate = nil
+	created := 0
+	for _, item := range op.items {
+		if item.err == nil {
+			created++
+		}
+	}
+	a.flash = fmt.Sprintf("Created %d of %d issues", created, len(op.items))
+	a.flashIsErr = created < len(op.items)
+	if a.overlay == nil {
+		a.overlay = newSelectionOverlay(a.flash, op.results())
+		a.overlayIssue = ""
+		a.overlayAction = overlayActionDrillIn
+	}
+
+	var cmds []tea.Cmd
+	if dv := a.topDetail(op.parent); op.parent != "" && dv != nil {
+		dv.dirty = true
+		dv.childrenLoading = true
+		cmds = append(cmds, a.startNetwork(a.cmdFetchChildren(op.parent)), a.markDetailStale(dv))
+	}
+	if created > 0 && a.connected && a.activeTab < len(a.tabs) {
+		cmds = append(cmds, a.startNetwork(a.loadTab(a.activeTab)))
+	}
+	return a, tea.Batch(cmds...)
+}
+
+// results lists the outcome of each issue, created ones first so they can
+// be opened.
+func (op *bulkCreateOp) results() []selectionItem {
+	var created, failed []selectionItem
+	for _, item := range op.items {
+		if item.err != nil {
+			failed = append(failed, selectionItem{
+				Label:   item.summary,
+				Desc:    item.err.Error(),
+				Section: sectionFailed,
+			})
+			continue
+		}
+		created = append(created, selectionItem{
+			ID:      item.key,
+			Label:   item.key,
+			Desc:    item.typeName + " · " + item.summary,
+			Section: sectionCreated,
+		})
+	}
+	return append(created, failed...)
+}
+
+// bulkCreateLines returns the non-blank lines of a pasted list with list
+// bullets removed.
+func bulkCreateLines(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		for _, bullet := range []string{"- ", "* ", "• "} {
+			line = strings.TrimSpace(strings.TrimPrefix(line, bullet))
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// parseBulkCreate turns lines into issues. A line starting with the name
+// of one of types and a colon, like "Bug: Login fails", is created as that
+// type; any other line, colon or not, is a summary of the default type.
+func parseBulkCreate(lines []string, types []jira.IssueType) []bulkCreateItem {
+	fallback := defaultBulkType(types)
+	items := make([]bulkCreateItem, 0, len(lines))
+	for _, line := range lines {
+		item := bulkCreateItem{summary: line, typeName: fallback}
+		if prefix, rest, ok := strings.Cut(line, ":"); ok && strings.TrimSpace(rest) != "" {
+			for _, t := range types {
+				if strings.EqualFold(strings.TrimSpace(prefix), t.Name) {
+					item = bulkCreateItem{summary: strings.TrimSpace(rest), typeName: t.Name}
+					break
+				}
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// defaultBulkType picks the type for lines without a prefix: Task, else
+// Story, else the first type.
+func defaultBulkType(types []jira.IssueType) string {
+	for _, name := range []string{"Task", "Story"} {
+		for _, t := range types {
+			if strings.EqualFold(t.Name, name) {
+				return t.Name
+			}
+		}
+	}
+	return types[0].Name
+}
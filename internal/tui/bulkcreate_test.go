@@ -0,0 +1,94 @@
+package tui
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+var bulkTypes = []jira.IssueType{{ID: "1", Name: "Story"}, {ID: "2", Name: "Task"}, {ID: "3", Name: "Bug"}}
+
+func TestBulkCreateLines(t *testing.T) {
+	got := bulkCreateLines("- First\n\n  * Second  \n• Third\nFourth\n   ")
+	if want := []string{"First", "Second", "Third", "Fourth"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestParseBulkCreate(t *testing.T) {
+	items := parseBulkCreate([]string{"bug: Login fails", "API: handle 500s", "Write docs"}, bulkTypes)
+	want := []bulkCreateItem{
+		{summary: "Login fails", typeName: "Bug"},
+		{summary: "API: handle 500s", typeName: "Task"}, // not a type: part of the summary
+		{summary: "Write docs", typeName: "Task"},
+	}
+	if !reflect.DeepEqual(items, want) {
+		t.Errorf("got %+v, want %+v", items, want)
+	}
+}
+
+func TestBulkCreateFlow(t *testing.T) {
+	app := testAppConnected()
+	app.defaultProject = "PROJ"
+
+	model, _ := app.Update(keyMsg("b"))
+	app = model.(App)
+	if _, ok := app.overlay.(*textEditorOverlay); !ok || app.overlayAction != overlayActionBulkCreate {
+		t.Fatalf("expected the bulk create editor, got %T", app.overlay)
+	}
+	model, cmd := app.handleOverlayResult("Bug: Login fails\nWrite docs")
+	app = model.(App)
+	if cmd == nil || len(app.bulkCreate.lines) != 2 {
+		t.Fatal("expected the issue types to be fetched for two lines")
+	}
+
+	model, cmd = app.Update(bulkCreateTypesMsg{types: bulkTypes})
+	app = model.(App)
+	if cmd == nil || app.flash != "Creating 1/2…" {
+		t.Fatalf("expected the first create to start, flash %q", app.flash)
+	}
+	model, cmd = app.Update(bulkCreatedMsg{index: 0, key: "PROJ-10"})
+	app = model.(App)
+	if cmd == nil || app.flash != "Creating 2/2…" {
+		t.Fatalf("expected the second create to start, flash %q", app.flash)
+	}
+	model, _ = app.Update(bulkCreatedMsg{index: 1, err: errors.New("summary is required")})
+	app = model.(App)
+
+	if app.bulkCreate != nil {
+		t.Error("expected the bulk create to finish")
+	}
+	if app.flash != "Created 1 of 2 issues" || !app.flashIsErr {
+		t.Errorf("flash = %q (err %v)", app.flash, app.flashIsErr)
+	}
+	sel, ok := app.overlay.(*selectionOverlay)
+	if !ok || app.overlayAction != overlayActionDrillIn {
+		t.Fatalf("expected the results overlay, got %T", app.overlay)
+	}
+	if len(sel.items) != 2 || sel.items[0].ID != "PROJ-10" || sel.items[1].Section != sectionFailed {
+		t.Errorf("unexpected results %+v", sel.items)
+	}
+
+	// A failed issue can't be opened
+	model, cmd = app.handleOverlayResult(&sel.items[1])
+	app = model.(App)
+	if cmd != nil || len(app.viewStack) != 0 {
+		t.Error("expected selecting a failed issue to do nothing")
+	}
+}
+
+func TestBulkCreateCancel(t *testing.T) {
+	app := testAppConnected()
+	app.defaultProject = "PROJ"
+	model, _ := app.Update(keyMsg("b"))
+	app = model.(App)
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	app = model.(App)
+	if app.overlay != nil || app.bulkCreate != nil {
+		t.Error("expected esc to close the editor and drop the bulk create")
+	}
+}
@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"context"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// capability is an optional part of Jira that some features depend on.
+type capability int
+
+const (
+	capAgile     capability = iota // boards, sprints, and ranking
+	capDevStatus                   // the Development panel
+)
+
+// capabilityFeatures names what goes missing without each capability, for
+// the startup warning and the flash when one of its hotkeys is pressed.
+var capabilityFeatures = map[capability]string{
+	capAgile:     "sprints, ranking, and punting (no Jira Software)",
+	capDevStatus: "development info (no dev-status API)",
+}
+
+// capabilitiesMsg delivers the optional capabilities the instance lacks.
+type capabilitiesMsg struct {
+	unavailable []capability
+}
+
+// cmdProbeCapabilities checks which optional capabilities the instance
+// has. A probe that fails for any other reason than a missing API counts
+// as available, so a flaky network never hides a feature.
+func (a App) cmdProbeCapabilities() tea.Cmd {
+	client := a.client
+	return func() tea.Msg {
+		ctx := context.Background()
+		probes := []struct {
+			cap   capability
+			check func(context.Context) (bool, error)
+		}{
+			{capAgile, client.HasAgileAPI},
+			{capDevStatus, client.HasDevStatus},
+		}
+		var msg capabilitiesMsg
+		for _, p := range probes {
+			if ok, err := p.check(ctx); err == nil && !ok {
+				msg.unavailable = append(msg.unavailable, p.cap)
+			}
+		}
+		return msg
+	}
+}
+
+// handleCapabilities records what the instance lacks and says so in one
+// line among the startup warnings.
+func (a App) handleCapabilities(msg capabilitiesMsg) (tea.Model, tea.Cmd) {
+	if len(msg.unavailable) == 0 {
+		return a, nil
+	}
+	a.unavailable = make(map[capability]bool)
+	var features []string
+	for _, c := range msg.unavailable {
+		a.unavailable[c] = true
+		features = append(features, capabilityFeatures[c])
+	}
+	a.warnings = append(a.warnings, "Degraded: "+strings.Join(features, "; "))
+	return a, nil
+}
+
+// refuseUnavailable flashes why a feature's hotkey does nothing when the
+// instance lacks the capability it needs, and reports whether it did.
+func (a *App) refuseUnavailable(c capability) bool {
+	if !a.unavailable[c] {
+		return false
+	}
+	a.flash = "Not available on this instance: " + capabilityFeatures[c]
+	a.flashIsErr = true
+	return true
+}
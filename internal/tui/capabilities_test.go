@@ -0,0 +1,75 @@
+package tui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestProbeCapabilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/rest/agile/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	app := testAppReady()
+	app.client = jira.NewClient(server.URL, "test@test.com", "token")
+	msg := app.cmdProbeCapabilities()().(capabilitiesMsg)
+	if len(msg.unavailable) != 1 || msg.unavailable[0] != capAgile {
+		t.Errorf("unavailable = %v, want only the Agile API", msg.unavailable)
+	}
+}
+
+func TestCapabilitiesBanner(t *testing.T) {
+	app := testAppConnected()
+	app.SetStartupWarnings([]string{"unknown key \"colour\""})
+	model, _ := app.Update(capabilitiesMsg{unavailable: []capability{capAgile, capDevStatus}})
+	app = model.(App)
+	want := []string{"unknown key \"colour\"", "Degraded: " + capabilityFeatures[capAgile] + "; " + capabilityFeatures[capDevStatus]}
+	if !reflect.DeepEqual(app.warnings, want) {
+		t.Errorf("warnings = %q, want %q", app.warnings, want)
+	}
+
+	// A flash meanwhile doesn't hide it; the first keypress does
+	app.flash = "Loaded 3 issues"
+	if bar := app.renderStatusBar(); !strings.Contains(bar, "Degraded: ") || !strings.Contains(bar, "Loaded 3 issues") {
+		t.Errorf("status bar = %q, want the warning beside the flash", bar)
+	}
+	model, _ = app.Update(keyMsg("j"))
+	if got := model.(App); got.warnings != nil || strings.Contains(got.renderStatusBar(), "Degraded: ") {
+		t.Errorf("warnings = %q after a keypress, want none", got.warnings)
+	}
+
+	// Nothing missing, nothing said
+	app = testAppConnected()
+	model, _ = app.Update(capabilitiesMsg{})
+	if got := model.(App); got.warnings != nil || got.unavailable != nil {
+		t.Errorf("warnings = %q, unavailable = %v", got.warnings, got.unavailable)
+	}
+}
+
+func TestUnavailableHotkeys(t *testing.T) {
+	app := testAppConnected()
+	app.unavailable = map[capability]bool{capAgile: true, capDevStatus: true}
+	for _, key := range []string{"S", "J", "K", "N"} {
+		model, cmd := app.Update(keyMsg(key))
+		got := model.(App)
+		if cmd != nil || got.overlay != nil || !got.flashIsErr || !strings.Contains(got.flash, "sprints") {
+			t.Errorf("%s: should be refused without the Agile API, flash = %q", key, got.flash)
+		}
+	}
+	if app.cmdFetchCreateSprints() != nil {
+		t.Error("the create form shouldn't look for sprints without the Agile API")
+	}
+	if app.cmdFetchDevInfo(jira.Issue{ID: "10001", Key: "PROJ-1"}) != nil {
+		t.Error("development info shouldn't be fetched without dev-status")
+	}
+}
@@ -0,0 +1,186 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// highlightDuration is how long rows changed by a refresh stay marked.
+const highlightDuration = 5 * time.Second
+
+// changedMarker prefixes the first cell of rows a refresh added or changed.
+const changedMarker = "✦ "
+
+// issueChanges is what a refresh changed compared to the previous results.
+type issueChanges struct {
+	added         []string // keys that weren't in the list before
+	statusChanged []string // keys whose status moved
+	updated       []string // keys edited in some other way
+	removed       []string // keys that dropped out of the list
+
+	issues     map[string]jira.Issue // changed issues as last seen, removed ones included
+	fromStatus map[string]string     // status before the refresh, for statusChanged keys
+}
+
+// highlightExpiredMsg clears a tab's change marks. seq must match the tab's
+// current highlight; a later refresh restarts the timer.
+type highlightExpiredMsg struct {
+	tabIndex int
+	tabID    int
+	seq      int
+}
+
+// diffIssues compares two result sets by key.
+func diffIssues(before, after []jira.Issue) issueChanges {
+	prev := make(map[string]jira.Issue, len(before))
+	for _, issue := range before {
+		prev[issue.Key] = issue
+	}
+	c := issueChanges{issues: make(map[string]jira.Issue), fromStatus: make(map[string]string)}
+	for _, issue := range after {
+		old, ok := prev[issue.Key]
+		delete(prev, issue.Key)
+		switch {
+		case !ok:
+			c.added = append(c.added, issue.Key)
+		case statusName(old) != statusName(issue):
+			c.statusChanged = append(c.statusChanged, issue.Key)
+			c.fromStatus[issue.Key] = statusName(old)
+		case old.Fields.Updated != issue.Fields.Updated:
+			c.updated = append(c.updated, issue.Key)
+		default:
+			continue
+		}
+		c.issues[issue.Key] = issue
+	}
+	for _, issue := range before {
+		if _, gone := prev[issue.Key]; gone {
+			c.removed = append(c.removed, issue.Key)
+			c.issues[issue.Key] = issue
+		}
+	}
+	return c
+}
+
+// statusName returns the issue's status, or "" when it wasn't fetched.
+func statusName(issue jira.Issue) string {
+	if issue.Fields.Status == nil {
+		return ""
+	}
+	return issue.Fields.Status.Name
+}
+
+// empty reports whether the refresh changed nothing.
+func (c issueChanges) empty() bool {
+	return len(c.added) == 0 && len(c.statusChanged) == 0 && len(c.updated) == 0 &&
+		len(c.removed) == 0
+}
+
+// keys returns the changed issue keys still in the list.
+func (c issueChanges) keys() []string {
+	keys := append([]string(nil), c.added...)
+	keys = append(keys, c.statusChanged...)
+	return append(keys, c.updated...)
+}
+
+// summary describes the changes, e.g. "2 new, 1 status changed".
+func (c issueChanges) summary() string {
+	var parts []string
+	if n := len(c.added); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d new", n))
+	}
+	if n := len(c.statusChanged); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d status changed", n))
+	}
+	if n := len(c.updated); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d updated", n))
+	}
+	if n := len(c.removed); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d removed", n))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// items lists the changes for the changes overlay, a section per kind.
+func (c issueChanges) items() []selectionItem {
+	var items []selectionItem
+	add := func(section string, keys []string, desc func(jira.Issue) string) {
+		for _, key := range keys {
+			issue := c.issues[key]
+			items = append(items, selectionItem{
+				ID:      key,
+				Label:   key + "  " + issue.Fields.Summary,
+				Desc:    desc(issue),
+				Section: section,
+			})
+		}
+	}
+	add("New", c.added, statusName)
+	add("Status changed", c.statusChanged, func(issue jira.Issue) string {
+		return c.fromStatus[issue.Key] + " → " + statusName(issue)
+	})
+	add("Updated", c.updated, func(issue jira.Issue) string { return formatDate(issue.Fields.Updated) })
+	add("Removed", c.removed, statusName)
+	return items
+}
+
+// showChanges lists what the active tab's last refresh changed; enter
+// opens the issue.
+func (a App) showChanges() (tea.Model, tea.Cmd) {
+	if a.activeTab >= len(a.tabs) {
+		return a, nil
+	}
+	t := a.tabs[a.activeTab]
+	if t.lastChanges.empty() {
+		a.flash = "No changes from the last refresh of " + t.config.Label
+		a.flashIsErr = false
+		return a, nil
+	}
+	title := fmt.Sprintf("%s: %s", t.config.Label, t.lastChanges.summary())
+	a.overlay = newSelectionOverlay(title, t.lastChanges.items())
+	a.overlayIssue = ""
+	a.overlayAction = overlayActionDrillIn
+	return a, nil
+}
+
+// highlight marks the given rows as changed until the returned tick
+// clears them.
+func (a *App) highlight(index int, keys []string) tea.Cmd {
+	t := &a.tabs[index]
+	t.changed = make(map[string]bool, len(keys))
+	for _, key := range keys {
+		t.changed[key] = true
+	}
+	t.changedSeq++
+	t.refreshRows()
+	seq, id := t.changedSeq, t.id
+	return tea.Tick(highlightDuration, func(time.Time) tea.Msg {
+		return highlightExpiredMsg{tabIndex: index, tabID: id, seq: seq}
+	})
+}
+
+// handleHighlightExpired removes the change marks from a tab.
+func (a *App) handleHighlightExpired(msg highlightExpiredMsg) {
+	msg.tabIndex = a.tabIndexFor(msg.tabIndex, msg.tabID)
+	if msg.tabIndex < 0 || msg.tabIndex >= len(a.tabs) {
+		return
+	}
+	t := &a.tabs[msg.tabIndex]
+	if t.changedSeq != msg.seq || t.changed == nil {
+		return
+	}
+	t.changed = nil
+	if t.state == tabReady {
+		t.refreshRows()
+	}
+}
+
+// colorizeChanged colors the change markers in a rendered table.
+func colorizeChanged(rendered string) string {
+	return strings.ReplaceAll(rendered, changedMarker, ansiColorText(changedMarker, "11"))
+}
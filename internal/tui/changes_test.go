@@ -0,0 +1,116 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func changeIssue(key, status, updated string) jira.Issue {
+	return jira.Issue{Key: key, Fields: jira.IssueFields{
+		Summary: key, Status: &jira.Status{Name: status}, Updated: updated,
+	}}
+}
+
+func TestDiffIssues(t *testing.T) {
+	before := []jira.Issue{
+		changeIssue("PROJ-1", "Open", "t1"),
+		changeIssue("PROJ-2", "Open", "t1"),
+		changeIssue("PROJ-3", "Open", "t1"),
+		changeIssue("PROJ-4", "Open", "t1"),
+	}
+	after := []jira.Issue{
+		changeIssue("PROJ-1", "Open", "t1"),
+		changeIssue("PROJ-2", "In Progress", "t2"),
+		changeIssue("PROJ-3", "Open", "t2"),
+		changeIssue("PROJ-5", "Open", "t2"),
+		changeIssue("PROJ-6", "Open", "t2"),
+	}
+	c := diffIssues(before, after)
+	if got := c.summary(); got != "2 new, 1 status changed, 1 updated, 1 removed" {
+		t.Errorf("summary = %q", got)
+	}
+	if got := strings.Join(c.keys(), ","); got != "PROJ-5,PROJ-6,PROJ-2,PROJ-3" {
+		t.Errorf("keys = %s", got)
+	}
+	if !diffIssues(before, before).empty() {
+		t.Error("identical results should have no changes")
+	}
+}
+
+func TestRefreshHighlightsChangedRows(t *testing.T) {
+	app := testAppReady()
+	reloaded := append([]jira.Issue{
+		{Key: "PROJ-9", Fields: jira.IssueFields{Summary: "Incoming", Status: &jira.Status{Name: "Open"}}},
+	}, app.tabs[0].issues...)
+
+	model, cmd := app.Update(tabDataMsg{tabIndex: 0, issues: reloaded})
+	app = model.(App)
+	if app.flash != "Sprint: 1 new · w: what changed" {
+		t.Errorf("flash = %q, want change summary", app.flash)
+	}
+	if cmd == nil || !app.tabs[0].changed["PROJ-9"] {
+		t.Fatal("new issue should be highlighted with an expiry tick")
+	}
+	if row := app.tabs[0].table.Rows()[0]; row[0] != changedMarker+"PROJ-9" {
+		t.Errorf("first cell = %q, want the change marker", row[0])
+	}
+
+	// An expiry from an earlier highlight leaves the marks alone
+	model, _ = app.Update(highlightExpiredMsg{tabIndex: 0, seq: app.tabs[0].changedSeq - 1})
+	app = model.(App)
+	if app.tabs[0].changed == nil {
+		t.Error("superseded expiry should be ignored")
+	}
+
+	model, _ = app.Update(highlightExpiredMsg{tabIndex: 0, seq: app.tabs[0].changedSeq})
+	app = model.(App)
+	if app.tabs[0].changed != nil || app.tabs[0].table.Rows()[0][0] != "PROJ-9" {
+		t.Error("marks should clear when the highlight expires")
+	}
+}
+
+func TestFirstLoadHasNoChangeSummary(t *testing.T) {
+	app := testAppReady() // tab 0 loaded from scratch
+	if app.flash != "" || app.tabs[0].changed != nil {
+		t.Errorf("first load should not report changes, flash = %q", app.flash)
+	}
+}
+
+func TestChangesOverlay(t *testing.T) {
+	app := testAppConnected()
+	model, _ := app.Update(keyMsg("w"))
+	app = model.(App)
+	if app.overlay != nil || !strings.Contains(app.flash, "No changes") {
+		t.Fatalf("flash = %q; nothing has changed yet", app.flash)
+	}
+
+	reloaded := []jira.Issue{
+		{Key: "PROJ-9", Fields: jira.IssueFields{Summary: "Incoming", Status: &jira.Status{Name: "Open"}}},
+		app.tabs[0].issues[0],
+		{Key: "PROJ-2", Fields: jira.IssueFields{Summary: "Update dashboard", Status: &jira.Status{Name: "Reopened"}}},
+	}
+	model, _ = app.Update(tabDataMsg{tabIndex: 0, issues: reloaded})
+	app = model.(App)
+	model, _ = app.Update(keyMsg("w"))
+	app = model.(App)
+	s, ok := app.overlay.(*selectionOverlay)
+	if !ok {
+		t.Fatalf("expected the changes overlay, got %T", app.overlay)
+	}
+	var got []string
+	for _, item := range s.items {
+		got = append(got, item.Section+": "+item.ID+" "+item.Desc)
+	}
+	want := "New: PROJ-9 Open|Status changed: PROJ-2 Done → Reopened|Removed: PROJ-3 Open"
+	if strings.Join(got, "|") != want {
+		t.Errorf("items = %q, want %q", strings.Join(got, "|"), want)
+	}
+
+	model, cmd := app.handleOverlayResult(&s.items[0])
+	app = model.(App)
+	if cmd == nil || app.topDetail("PROJ-9") == nil {
+		t.Error("enter should open the changed issue")
+	}
+}
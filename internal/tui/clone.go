@@ -0,0 +1,108 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// cloneSourceMsg delivers the full issue the clone hotkey copies.
+type cloneSourceMsg struct {
+	issue *jira.Issue
+	err   error
+}
+
+// cmdFetchCloneSource fetches issueKey with the fields a clone copies,
+// which list rows don't carry.
+func (a App) cmdFetchCloneSource(issueKey string) tea.Cmd {
+	client := a.client
+	return func() tea.Msg {
+		issue, err := client.GetIssue(context.Background(), issueKey)
+		if err != nil {
+			return cloneSourceMsg{err: fmt.Errorf("load %s to clone: %w", issueKey, err)}
+		}
+		return cloneSourceMsg{issue: issue}
+	}
+}
+
+// handleCloneSource opens the create form in the source's project, and
+// under its parent, pre-filled with its summary, description, labels,
+// type and priority. Components and links are offered as rows to keep
+// or drop.
+func (a App) handleCloneSource(msg cloneSourceMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	if msg.err != nil {
+		a.flash = msg.err.Error()
+		a.flashIsErr = true
+		return a, nil
+	}
+	if a.overlay != nil {
+		return a, nil
+	}
+	src := msg.issue
+	a.flash = ""
+	a.resetCreate()
+	a.createIn = projectKeyOf(src.Key)
+	if p := src.Fields.Parent; p != nil {
+		a.createParent = p.Key
+		a.createSubtask = p.Fields == nil || !isEpic(&jira.Issue{Fields: *p.Fields})
+	}
+	model, cmd := a.startCreate("Clone " + src.Key)
+	a = model.(App)
+	prefillClone(a.overlay.(*createFormOverlay), src)
+	return a, cmd
+}
+
+// prefillClone copies src into a freshly opened create form.
+func prefillClone(form *createFormOverlay, src *jira.Issue) {
+	form.field("summary").input.SetValue("CLONE - " + src.Fields.Summary)
+	form.field("description").area.SetValue(extractADFText(src.Fields.Description))
+	form.field("labels").input.SetValue(strings.Join(src.Fields.Labels, ", "))
+	if src.Fields.IssueType != nil {
+		form.preferType = src.Fields.IssueType.Name
+	}
+	if src.Fields.Priority != nil {
+		form.preferPriority = src.Fields.Priority.ID
+	}
+
+	if len(src.Fields.Components) > 0 {
+		var names []string
+		var ids []map[string]string
+		for _, c := range src.Fields.Components {
+			names = append(names, c.Name)
+			ids = append(ids, map[string]string{"id": c.ID})
+		}
+		form.fields = append(form.fields, &formField{
+			key: "components", label: "Components", kind: formChoice, value: ids,
+			choices: []selectionItem{{ID: "keep", Label: strings.Join(names, ", ")}, {Label: "None"}},
+		})
+	}
+	if n := len(src.Fields.IssueLinks); n > 0 {
+		form.fields = append(form.fields, &formField{
+			key: "links", label: "Links", kind: formChoice, value: src.Fields.IssueLinks,
+			choices: []selectionItem{{Label: "Don't copy"}, {ID: "copy", Label: fmt.Sprintf("Copy all %d", n)}},
+		})
+	}
+}
+
+// copyLinks gives newKey each of links, pointing the same way it did from
+// the issue they were copied from.
+func copyLinks(ctx context.Context, client *jira.Client, newKey string, links []jira.IssueLink) error {
+	for _, l := range links {
+		var err error
+		switch {
+		case l.OutwardIssue != nil:
+			err = client.CreateIssueLink(ctx, l.Type.Name, newKey, l.OutwardIssue.Key)
+		case l.InwardIssue != nil:
+			err = client.CreateIssueLink(ctx, l.Type.Name, l.InwardIssue.Key, newKey)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package tui
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestCloneIssue(t *testing.T) {
+	app := testAppConnected()
+	model, cmd := app.Update(keyMsg("Y"))
+	app = model.(App)
+	if cmd == nil || app.flash != "Loading PROJ-1..." {
+		t.Fatalf("Y should load the issue, flash = %q", app.flash)
+	}
+
+	src := &jira.Issue{Key: "PROJ-1", Fields: jira.IssueFields{
+		Summary:    "Login fails",
+		IssueType:  &jira.Named{ID: "2", Name: "Bug"},
+		Priority:   &jira.Named{ID: "2", Name: "High"},
+		Labels:     []string{"ui", "login"},
+		Components: []jira.Named{{ID: "10", Name: "Web"}},
+		IssueLinks: []jira.IssueLink{{Type: jira.LinkType{Name: "Blocks"}, OutwardIssue: &jira.Issue{Key: "PROJ-9"}}},
+	}}
+	model, _ = app.Update(cloneSourceMsg{issue: src})
+	app = model.(App)
+	form, ok := app.overlay.(*createFormOverlay)
+	if !ok || form.title != "Clone PROJ-1" || app.createIn != "PROJ" {
+		t.Fatalf("overlay = %T, createIn = %q", app.overlay, app.createIn)
+	}
+	if got := form.field("summary").input.Value(); got != "CLONE - Login fails" {
+		t.Errorf("summary = %q", got)
+	}
+
+	model, _ = app.Update(issueTypesLoadedMsg{types: createTypes})
+	app = model.(App)
+	form.setMeta(createMetaLoadedMsg{issueTypeID: "2", fields: createMeta[:2]})
+	focusField(t, form, "links")
+	form.Update(keyMsg("enter"))
+	form.Update(keyMsg("down"))
+	form.Update(keyMsg("enter"))
+
+	form.Update(keyMsg("ctrl+s"))
+	if !form.isDone {
+		t.Fatalf("form should submit, err=%q", form.errMsg)
+	}
+	res := form.result.(createFormResult)
+	if res.issueType != "Bug" || len(res.links) != 1 {
+		t.Errorf("result = %+v, want a Bug with the link", res)
+	}
+	want := map[string]string{
+		"priority":   "map[id:2]",
+		"labels":     "[ui login]",
+		"components": "[map[id:10]]",
+	}
+	for k, v := range want {
+		if got := fmt.Sprint(res.fields[k]); got != v {
+			t.Errorf("%s = %s, want %s", k, got, v)
+		}
+	}
+}
+
+func TestCloneSubtask(t *testing.T) {
+	app := testAppConnected()
+	app.inflight = 1
+	src := &jira.Issue{Key: "PROJ-5", Fields: jira.IssueFields{
+		Summary: "Write tests",
+		Parent:  &jira.ParentIssue{Key: "PROJ-4", Fields: &jira.IssueFields{IssueType: &jira.Named{Name: "Story"}}},
+	}}
+	model, _ := app.Update(cloneSourceMsg{issue: src})
+	app = model.(App)
+	form := app.overlay.(*createFormOverlay)
+	if !app.createSubtask || form.field("parent").note != "PROJ-4" || form.field("components") != nil {
+		t.Errorf("subtask=%v parent=%q", app.createSubtask, form.field("parent").note)
+	}
+}
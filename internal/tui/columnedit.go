@@ -0,0 +1,141 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+)
+
+// columnsSavedMsg reports writing a tab's columns to config.yaml.
+type columnsSavedMsg struct {
+	label string
+	err   error
+}
+
+// SetConfigPath tells the app where config.yaml lives so column changes
+// can be saved to it.
+func (a *App) SetConfigPath(path string) {
+	a.configPath = path
+}
+
+// startColumnEdit opens the column overlay for the active tab.
+func (a App) startColumnEdit() (tea.Model, tea.Cmd) {
+	if a.activeTab >= len(a.tabs) {
+		return a, nil
+	}
+	t := a.tabs[a.activeTab]
+	a.overlay = newColumnsOverlay("Columns · "+t.config.Label, t.columns, t.widths, columnChoices)
+	a.overlayIssue = ""
+	a.overlayAction = overlayActionColumns
+	return a, nil
+}
+
+// applyColumns shows the chosen columns on the active tab, reloading it in
+// the background when a new column needs fields that weren't fetched, and
+// saves them if asked.
+func (a App) applyColumns(res columnsResult) (tea.Model, tea.Cmd) {
+	index := a.activeTab
+	if index >= len(a.tabs) {
+		return a, nil
+	}
+	t := &a.tabs[index]
+	reload := needsFields(t.columns, res.columns)
+	t.setColumns(res.columns, res.widths)
+	a.flash = "Columns updated"
+	a.flashIsErr = false
+
+	var cmds []tea.Cmd
+	if reload && a.connected && t.hasData() {
+		cmds = append(cmds, a.startNetwork(a.loadTab(index)))
+	}
+	cmds = append(cmds, a.loadWorkflows())
+	if res.save {
+		switch {
+		case t.temporary:
+			a.flash = "Search tabs aren't saved to config.yaml"
+			a.flashIsErr = true
+		case a.configPath == "":
+			a.flash = "No config file to save to"
+			a.flashIsErr = true
+		default:
+			cmds = append(cmds, cmdSaveColumns(a.configPath, t.config))
+		}
+	}
+	return a, tea.Batch(cmds...)
+}
+
+// cmdSaveColumns writes a tab's columns and widths to config.yaml.
+func cmdSaveColumns(path string, tab config.TabConfig) tea.Cmd {
+	return func() tea.Msg {
+		return columnsSavedMsg{label: tab.Label, err: config.SaveTabColumns(path, tab)}
+	}
+}
+
+// handleColumnsSaved reports the outcome of saving columns.
+func (a App) handleColumnsSaved(msg columnsSavedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		a.flash = "Saving columns failed: " + msg.err.Error()
+		a.flashIsErr = true
+	} else {
+		a.flash = "Saved " + msg.label + " columns to config.yaml"
+		a.flashIsErr = false
+	}
+	return a, nil
+}
+
+// needsFields reports whether the new columns need fields the old ones
+// didn't fetch.
+func needsFields(old, columns []string) bool {
+	fetched := make(map[string]bool)
+	for _, f := range mergeSearchFields(old) {
+		fetched[f] = true
+	}
+	for _, f := range mergeSearchFields(columns) {
+		if !fetched[f] {
+			return true
+		}
+	}
+	return hasColumn(columns, "rank") && !hasColumn(old, "rank")
+}
+
+// setColumns replaces the visible columns and their widths. The sort
+// follows its column, or is dropped with it; the cursor stays on the same
+// issue.
+func (t *tab) setColumns(columns []string, widths map[string]int) {
+	selectedKey := ""
+	if sel := t.selectedIssue(); sel != nil {
+		selectedKey = sel.Key
+	}
+	sorted := ""
+	if t.sortCol > 0 {
+		sorted = t.columns[t.sortCol-1]
+	}
+	if len(widths) == 0 {
+		widths = nil
+	}
+	t.columns = columns
+	t.widths = widths
+	t.config.Columns = columns
+	t.config.Widths = widths
+
+	t.sortCol = 0
+	for i, c := range columns {
+		if c == sorted {
+			t.sortCol = i + 1
+		}
+	}
+	if sorted != "" && t.sortCol == 0 {
+		t.sortDesc = false
+		t.sortIssues()
+	}
+	if t.quickFilter.isActive() {
+		t.quickFilter.updateQuery(t.issues, t.columns)
+	}
+
+	// Rows must never have more cells than the table has columns
+	t.table.SetRows(nil)
+	t.layoutColumns()
+	if t.state == tabReady {
+		t.applyFilterKeepCursor(selectedKey)
+	}
+}
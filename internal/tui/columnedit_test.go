@@ -0,0 +1,142 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestColumnsOverlay(t *testing.T) {
+	o := newColumnsOverlay("Columns", []string{"key", "summary", "status"},
+		map[string]int{"key": 12}, columnChoices)
+	if len(o.rows) != len(columnChoices) {
+		t.Fatalf("rows = %d, want %d", len(o.rows), len(columnChoices))
+	}
+
+	var ov overlay = o
+	for _, k := range []string{"down", "down", " ", "down", "down", " ", "K", "l"} {
+		ov = updateOverlay(ov, keyMsg(k))
+	}
+	// status hidden, assignee shown and moved above priority, then widened
+	ov = updateOverlay(ov, keyMsg("enter"))
+	done, result := ov.done()
+	if !done {
+		t.Fatal("overlay should be done after enter")
+	}
+	res := result.(columnsResult)
+	if got := strings.Join(res.columns, ","); got != "key,summary,assignee" {
+		t.Errorf("columns = %s, want key,summary,assignee", got)
+	}
+	if res.widths["key"] != 12 || res.widths["assignee"] == 0 {
+		t.Errorf("widths = %v, want key and assignee fixed", res.widths)
+	}
+	if res.save {
+		t.Error("enter should not save")
+	}
+}
+
+func TestColumnsOverlayNeedsVisibleColumn(t *testing.T) {
+	var ov overlay = newColumnsOverlay("Columns", []string{"key"}, nil, []string{"key"})
+	ov = updateOverlay(ov, keyMsg(" "))
+	ov = updateOverlay(ov, keyMsg("enter"))
+	if done, _ := ov.done(); done {
+		t.Fatal("overlay should stay open with no visible columns")
+	}
+	if !strings.Contains(ov.View(80, 30), "At least one column") {
+		t.Error("view should explain why it stayed open")
+	}
+}
+
+func TestColumnsOverlayShrinkToAuto(t *testing.T) {
+	o := newColumnsOverlay("Columns", []string{"key"}, map[string]int{"key": 5}, nil)
+	o.resize(-columnWidthStep)
+	if o.rows[0].width != 0 {
+		t.Errorf("width = %d, want auto", o.rows[0].width)
+	}
+	o.resize(-columnWidthStep)
+	if o.rows[0].width != 0 {
+		t.Errorf("shrinking auto should stay auto, got %d", o.rows[0].width)
+	}
+}
+
+func TestApplyColumnsUpdatesTable(t *testing.T) {
+	app := testAppReady()
+	app.tabs[0].table.SetCursor(2) // PROJ-3
+	app.tabs[0].cycleSort(3)       // status
+
+	model, _ := app.Update(keyMsg("C"))
+	app = model.(App)
+	if app.overlayAction != overlayActionColumns {
+		t.Fatal("C should open the column overlay")
+	}
+
+	model, _ = app.handleOverlayResult(columnsResult{
+		columns: []string{"status", "key"},
+		widths:  map[string]int{"key": 14},
+	})
+	app = model.(App)
+	tab := &app.tabs[0]
+
+	cols := tab.table.Columns()
+	if len(cols) != 2 || !strings.HasPrefix(cols[0].Title, "Status") || cols[1].Width != 14 {
+		t.Errorf("columns = %+v, want Status then a 14-wide Key", cols)
+	}
+	if tab.sortCol != 1 {
+		t.Errorf("sortCol = %d, want the sort to follow status to 1", tab.sortCol)
+	}
+	for _, row := range tab.table.Rows() {
+		if len(row) != 2 {
+			t.Fatalf("row has %d cells, want 2", len(row))
+		}
+	}
+	if sel := tab.selectedIssue(); sel == nil || sel.Key != "PROJ-3" {
+		t.Errorf("cursor should stay on PROJ-3, got %v", sel)
+	}
+
+	tab.setColumns([]string{"key"}, nil)
+	if tab.sortCol != 0 {
+		t.Errorf("sortCol = %d, want the sort dropped with its column", tab.sortCol)
+	}
+}
+
+func TestApplyColumnsSavesToConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content := "tabs:\n  - label: Sprint\n    filter_id: 111\n    columns: [key, summary, status]\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	app := testAppReady()
+	app.SetConfigPath(path)
+
+	model, cmd := app.applyColumns(columnsResult{columns: []string{"key", "summary"}, save: true})
+	app = model.(App)
+	if cmd == nil {
+		t.Fatal("saving should return a command")
+	}
+	msg, ok := cmd().(columnsSavedMsg)
+	if !ok {
+		t.Fatalf("command returned %T, want columnsSavedMsg", cmd())
+	}
+	model, _ = app.Update(msg)
+	app = model.(App)
+	if app.flashIsErr || !strings.Contains(app.flash, "Saved Sprint columns") {
+		t.Errorf("flash = %q", app.flash)
+	}
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), "columns: [key, summary]") {
+		t.Errorf("config not updated:\n%s", data)
+	}
+}
+
+func TestNeedsFields(t *testing.T) {
+	if needsFields([]string{"key", "summary"}, []string{"summary", "key"}) {
+		t.Error("reordering should not need a reload")
+	}
+	if needsFields([]string{"key", "summary"}, []string{"key", "reporter"}) {
+		t.Error("reporter is always fetched for the detail view")
+	}
+	if !needsFields([]string{"key", "summary"}, []string{"key", "rank"}) {
+		t.Error("a new rank column needs a reload")
+	}
+}
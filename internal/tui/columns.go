@@ -11,29 +11,46 @@ type columnDef struct {
 
 // knownColumns maps config column names to display metadata.
 var knownColumns = map[string]columnDef{
-	"key":      {title: "Key", minWidth: 12},
-	"summary":  {title: "Summary", minWidth: 20, flex: true},
-	"status":   {title: "Status", minWidth: 14},
-	"priority": {title: "Priority", minWidth: 10},
-	"assignee": {title: "Assignee", minWidth: 14},
-	"reporter": {title: "Reporter", minWidth: 14},
-	"type":     {title: "Type", minWidth: 10},
-	"project":  {title: "Project", minWidth: 10},
-	"created":  {title: "Created", minWidth: 12},
-	"updated":  {title: "Updated", minWidth: 12},
+	"key":         {title: "Key", minWidth: 12},
+	"summary":     {title: "Summary", minWidth: 20, flex: true},
+	"description": {title: "Description", minWidth: 20, flex: true},
+	"status":      {title: "Status", minWidth: 14},
+	"priority":    {title: "Priority", minWidth: 10},
+	"assignee":    {title: "Assignee", minWidth: 14},
+	"reporter":    {title: "Reporter", minWidth: 14},
+	"type":        {title: "Type", minWidth: 10},
+	"project":     {title: "Project", minWidth: 10},
+	"created":     {title: "Created", minWidth: 12},
+	"updated":     {title: "Updated", minWidth: 12},
+	"duedate":     {title: "Due", minWidth: 14},
+	"rank":        {title: "Rank", minWidth: 6},
+	nextColumn:    {title: "Next", minWidth: 16},
+}
+
+// columnChoices are the known columns in the order the column overlay
+// offers them.
+var columnChoices = []string{
+	"key", "summary", "status", "priority", "assignee", "reporter",
+	"type", "project", "created", "updated", "duedate", "description", "rank", nextColumn,
 }
 
 // buildColumns creates bubbles table columns from config column names,
 // auto-sizing to the given total width.
 func buildColumns(names []string, totalWidth int) []table.Column {
+	return sizedColumns(names, nil, totalWidth)
+}
+
+// sizedColumns is buildColumns with fixed widths for some columns. A fixed
+// width turns a flex column into a fixed one.
+func sizedColumns(names []string, widths map[string]int, totalWidth int) []table.Column {
 	cols := make([]table.Column, len(names))
 	fixedTotal := 0
 	flexCount := 0
 
 	for i, name := range names {
-		def, ok := knownColumns[name]
-		if !ok {
-			def = columnDef{title: name, minWidth: 12}
+		def := columnDefFor(name)
+		if w := widths[name]; w > 0 {
+			def.minWidth, def.flex = w, false
 		}
 		cols[i] = table.Column{Title: def.title, Width: def.minWidth}
 		if def.flex {
@@ -56,8 +73,7 @@ func buildColumns(names []string, totalWidth int) []table.Column {
 			perFlex = 20
 		}
 		for i, name := range names {
-			def := knownColumns[name]
-			if def.flex {
+			if knownColumns[name].flex && widths[name] == 0 {
 				cols[i].Width = perFlex
 			}
 		}
@@ -65,3 +81,12 @@ func buildColumns(names []string, totalWidth int) []table.Column {
 
 	return cols
 }
+
+// columnDefFor returns the display metadata for a column, with a default
+// for columns jira-tui doesn't know.
+func columnDefFor(name string) columnDef {
+	if def, ok := knownColumns[name]; ok {
+		return def
+	}
+	return columnDef{title: name, minWidth: 12}
+}
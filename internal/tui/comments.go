@@ -0,0 +1,81 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// loadOlderComments fetches the page of comments past those shown, or
+// every remaining page when all is set, then scrolls to the oldest.
+func (a App) loadOlderComments(dv *issueDetailView, all bool) (tea.Model, tea.Cmd) {
+	if dv.commentsLoading || dv.olderLoading {
+		return a, nil
+	}
+	if len(dv.comments) >= dv.commentsTotal {
+		if all {
+			dv.viewport.GotoBottom()
+		} else {
+			a.flash = "All comments are loaded"
+			a.flashIsErr = false
+		}
+		return a, nil
+	}
+	if a.client == nil {
+		a.flash = "Not connected to Jira"
+		a.flashIsErr = true
+		return a, nil
+	}
+	dv.olderLoading = true
+	client := a.client
+	issueKey := dv.issue.Key
+	startAt := len(dv.comments)
+	cmd := a.startNetwork(func() tea.Msg {
+		var comments []jira.Comment
+		total := 0
+		for {
+			page, err := client.GetCommentsPage(context.Background(), issueKey, startAt+len(comments))
+			if err != nil {
+				return commentsLoadedMsg{issueKey: issueKey, older: true, err: err}
+			}
+			comments = append(comments, page.Comments...)
+			total = page.Total
+			if !all || len(page.Comments) == 0 || startAt+len(comments) >= total {
+				break
+			}
+		}
+		return commentsLoadedMsg{issueKey: issueKey, comments: comments, total: total, older: true, jump: all}
+	})
+	return a, tea.Batch(cmd, a.markDetailStale(dv))
+}
+
+// handleOlderComments appends older comments to the detail view. Comments
+// added since the first page shift the pages, so any already shown are
+// skipped.
+func (a App) handleOlderComments(dv *issueDetailView, msg commentsLoadedMsg) (tea.Model, tea.Cmd) {
+	dv.olderLoading = false
+	if msg.err != nil {
+		a.flash = fmt.Sprintf("Loading older comments failed: %v", msg.err)
+		a.flashIsErr = true
+		return a, a.markDetailStale(dv)
+	}
+	shown := make(map[string]bool, len(dv.comments))
+	for _, c := range dv.comments {
+		shown[c.ID] = true
+	}
+	for _, c := range msg.comments {
+		if !shown[c.ID] {
+			dv.comments = append(dv.comments, c)
+		}
+	}
+	dv.commentsTotal = max(msg.total, len(dv.comments))
+	if len(msg.comments) == 0 {
+		// The rest were deleted meanwhile
+		dv.commentsTotal = len(dv.comments)
+	}
+	dv.gotoOldest = msg.jump
+	return a, a.markDetailStale(dv)
+}
@@ -0,0 +1,101 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// testComments returns n comments with IDs from first down, newest first.
+func testComments(first, n int) []jira.Comment {
+	comments := make([]jira.Comment, n)
+	for i := range comments {
+		comments[i] = jira.Comment{ID: fmt.Sprint(first - i)}
+	}
+	return comments
+}
+
+func TestCommentsShowTotal(t *testing.T) {
+	app := testAppConnected()
+	issue := testDetailIssue()
+	dv := newIssueDetailView(issue, "", app.width, app.height)
+	app.viewStack = append(app.viewStack, &dv)
+
+	model, _ := app.Update(commentsLoadedMsg{issueKey: issue.Key, comments: testComments(120, 50), total: 120})
+	app = model.(App)
+	dv.rebuild()
+	content := dv.renderContent()
+	if !strings.Contains(content, "Comments (50 of 120)") {
+		t.Error("expected the loaded and total counts")
+	}
+	if !strings.Contains(content, "70 older comments · O: load 50 more") {
+		t.Error("expected the load-older hint")
+	}
+}
+
+func TestLoadOlderComments(t *testing.T) {
+	app := testAppConnected()
+	issue := testDetailIssue()
+	dv := newIssueDetailView(issue, "", app.width, app.height)
+	dv.commentsLoading = false
+	dv.comments = testComments(120, 50)
+	dv.commentsTotal = 120
+	app.viewStack = append(app.viewStack, &dv)
+
+	model, cmd := app.Update(keyMsg("O"))
+	app = model.(App)
+	if cmd == nil || !dv.olderLoading {
+		t.Fatal("expected older comments to be fetched")
+	}
+	if !strings.Contains(dv.renderContent(), "Loading older comments") {
+		t.Error("expected a loading note")
+	}
+
+	// A comment added meanwhile shifts the page by one: 71 is a repeat
+	model, _ = app.Update(commentsLoadedMsg{issueKey: issue.Key, comments: testComments(71, 50), total: 121, older: true})
+	app = model.(App)
+	if dv.olderLoading || len(dv.comments) != 99 || dv.commentsTotal != 121 {
+		t.Errorf("loading=%v comments=%d total=%d", dv.olderLoading, len(dv.comments), dv.commentsTotal)
+	}
+	if last := dv.comments[len(dv.comments)-1].ID; last != "22" {
+		t.Errorf("expected the oldest loaded comment to be 22, got %s", last)
+	}
+}
+
+func TestLoadAllCommentsJumpsToOldest(t *testing.T) {
+	app := testAppConnected()
+	issue := testDetailIssue()
+	dv := newIssueDetailView(issue, "", app.width, app.height)
+	dv.commentsLoading = false
+	dv.comments = testComments(70, 50)
+	dv.commentsTotal = 70
+	app.viewStack = append(app.viewStack, &dv)
+
+	model, cmd := app.Update(keyMsg("G"))
+	app = model.(App)
+	if cmd == nil {
+		t.Fatal("expected the remaining comments to be fetched")
+	}
+	model, _ = app.Update(commentsLoadedMsg{issueKey: issue.Key, comments: testComments(20, 20), total: 70, older: true, jump: true})
+	app = model.(App)
+	model, _ = app.Update(detailRebuildMsg{})
+	app = model.(App)
+	if len(dv.comments) != 70 {
+		t.Errorf("expected all 70 comments, got %d", len(dv.comments))
+	}
+	if !dv.viewport.AtBottom() {
+		t.Error("expected the view to jump to the oldest comment")
+	}
+	if strings.Contains(dv.renderContent(), "older comments") {
+		t.Error("expected no load-older hint once all are loaded")
+	}
+
+	// Everything is loaded: O only says so
+	model, cmd = app.Update(keyMsg("O"))
+	app = model.(App)
+	if cmd != nil || app.flash != "All comments are loaded" {
+		t.Errorf("cmd=%v flash=%q", cmd != nil, app.flash)
+	}
+}
@@ -0,0 +1,172 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+)
+
+// crashLogSize is the number of recent messages kept for crash reports.
+const crashLogSize = 50
+
+// crashMsg carries a panic recovered inside a command goroutine back to
+// Update so it can be reported like a panic in Update itself.
+type crashMsg struct {
+	value interface{}
+	stack []byte
+}
+
+// crashRecorder keeps a ring buffer of recent messages and, after a panic,
+// the path of the crash report. It is shared by pointer between copies of
+// App so main can find the report whichever model Run returns.
+type crashRecorder struct {
+	mu      sync.Mutex
+	dir     string // report directory; empty means the config dir
+	entries []string
+	next    int
+	path    string // written crash report, empty if none
+	err     error  // writing the report failed
+}
+
+func newCrashRecorder() *crashRecorder {
+	return &crashRecorder{entries: make([]string, 0, crashLogSize)}
+}
+
+// record appends a one-line description of msg to the log. Only the type
+// and, for keys, the key name are kept so reports don't carry issue data.
+func (c *crashRecorder) record(msg tea.Msg) {
+	if c == nil {
+		return
+	}
+	line := fmt.Sprintf("%s %T", time.Now().Format("15:04:05.000"), msg)
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		line += " " + msg.String()
+	case tea.WindowSizeMsg:
+		line += fmt.Sprintf(" %dx%d", msg.Width, msg.Height)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) < crashLogSize {
+		c.entries = append(c.entries, line)
+		return
+	}
+	c.entries[c.next] = line
+	c.next = (c.next + 1) % crashLogSize
+}
+
+// recent returns the logged messages, oldest first.
+func (c *crashRecorder) recent() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make([]string, 0, len(c.entries))
+	out = append(out, c.entries[c.next:]...)
+	return append(out, c.entries[:c.next]...)
+}
+
+// report writes a crash report for the recovered panic value. Only the
+// first panic is written; later ones are ignored.
+func (c *crashRecorder) report(value interface{}, stack []byte) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	done := c.path != "" || c.err != nil
+	dir := c.dir
+	c.mu.Unlock()
+	if done {
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "jira-tui crash report — %s\n\n", time.Now().Format(time.RFC3339))
+	fmt.Fprintf(&b, "panic: %v\n\n%s\n", value, stack)
+	b.WriteString("Recent messages (oldest first):\n")
+	for _, line := range c.recent() {
+		b.WriteString("  " + line + "\n")
+	}
+
+	path, err := writeCrashReport(dir, b.String())
+	c.mu.Lock()
+	c.path, c.err = path, err
+	c.mu.Unlock()
+}
+
+// writeCrashReport saves report to a timestamped file in dir, defaulting
+// to the config dir, and returns its path.
+func writeCrashReport(dir, report string) (string, error) {
+	if dir == "" {
+		d, err := config.DefaultConfigDir()
+		if err != nil {
+			return "", err
+		}
+		dir = d
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("creating config dir: %w", err)
+	}
+	name := "crash-" + time.Now().Format("20060102-150405") + ".log"
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(report), 0o644); err != nil {
+		return "", fmt.Errorf("writing crash report: %w", err)
+	}
+	return path, nil
+}
+
+// guardCmd wraps cmd so a panic while it runs is delivered as a crashMsg
+// instead of killing the program with the terminal still in raw mode.
+// Batched commands are wrapped individually as they are unpacked.
+func guardCmd(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() (msg tea.Msg) {
+		defer func() {
+			if r := recover(); r != nil {
+				msg = crashMsg{value: r, stack: debug.Stack()}
+			}
+		}()
+		msg = cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			guarded := make(tea.BatchMsg, len(batch))
+			for i, c := range batch {
+				guarded[i] = guardCmd(c)
+			}
+			return guarded
+		}
+		return msg
+	}
+}
+
+// crashed writes the crash report and quits so Bubble Tea restores the
+// terminal on its normal exit path.
+func (a App) crashed(value interface{}, stack []byte) (tea.Model, tea.Cmd) {
+	a.crash.report(value, stack)
+	return a, tea.Quit
+}
+
+// CrashReport returns the path of the crash report written after a panic,
+// or an error if it could not be written. Both are empty without a crash.
+func (a App) CrashReport() (string, error) {
+	if a.crash == nil {
+		return "", nil
+	}
+	a.crash.mu.Lock()
+	defer a.crash.mu.Unlock()
+	return a.crash.path, a.crash.err
+}
+
+// Crashed reports whether the session ended in a panic.
+func (a App) Crashed() bool {
+	path, err := a.CrashReport()
+	return path != "" || err != nil
+}
@@ -0,0 +1,81 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCrashRecorderKeepsRecentMessages(t *testing.T) {
+	c := newCrashRecorder()
+	for i := 0; i < crashLogSize+5; i++ {
+		c.record(tea.WindowSizeMsg{Width: i, Height: 1})
+	}
+	got := c.recent()
+	if len(got) != crashLogSize {
+		t.Fatalf("len = %d, want %d", len(got), crashLogSize)
+	}
+	if !strings.HasSuffix(got[0], " 5x1") {
+		t.Errorf("oldest = %q, want the 6th message", got[0])
+	}
+	if !strings.HasSuffix(got[len(got)-1], fmt.Sprintf(" %dx1", crashLogSize+4)) {
+		t.Errorf("newest = %q, want the last message", got[len(got)-1])
+	}
+}
+
+func TestGuardCmdRecoversPanics(t *testing.T) {
+	boom := func() tea.Msg { panic("boom") }
+
+	msg := guardCmd(boom)()
+	c, ok := msg.(crashMsg)
+	if !ok {
+		t.Fatalf("expected crashMsg, got %T", msg)
+	}
+	if c.value != "boom" || len(c.stack) == 0 {
+		t.Errorf("crashMsg = %v with %d-byte stack", c.value, len(c.stack))
+	}
+
+	// Commands inside a batch are guarded too
+	batch := guardCmd(tea.Batch(boom, boom))().(tea.BatchMsg)
+	for _, cmd := range batch {
+		if _, ok := cmd().(crashMsg); !ok {
+			t.Error("expected batched cmd to be guarded")
+		}
+	}
+}
+
+func TestCrashMsgWritesReportAndQuits(t *testing.T) {
+	app := testAppReady()
+	app.crash.dir = t.TempDir()
+	app.Update(keyMsg("j"))
+
+	model, cmd := app.Update(crashMsg{value: "boom", stack: []byte("goroutine 1")})
+	if cmd == nil {
+		t.Fatal("expected quit cmd")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("expected tea.QuitMsg")
+	}
+
+	path, err := model.(App).CrashReport()
+	if err != nil {
+		t.Fatalf("CrashReport error: %v", err)
+	}
+	// The original model shares the recorder, as main relies on
+	if p, _ := app.CrashReport(); p != path {
+		t.Errorf("original model path = %q, want %q", p, path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading report: %v", err)
+	}
+	report := string(data)
+	for _, want := range []string{"panic: boom", "goroutine 1", "tea.KeyMsg j"} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report missing %q:\n%s", want, report)
+		}
+	}
+}
@@ -0,0 +1,638 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// formKind is how a create form row is edited.
+type formKind int
+
+const (
+	formText   formKind = iota // one line of text
+	formLong                   // several lines of text
+	formChoice                 // one of a list, picked in a selection overlay
+	formFixed                  // shown but not editable
+)
+
+// formField is one row of the create form.
+type formField struct {
+	key      string // Jira field key, e.g. "summary" or "customfield_10020"
+	label    string
+	kind     formKind
+	required bool
+	input    textinput.Model // formText
+	area     textarea.Model  // formLong
+	choices  []selectionItem // formChoice
+	choice   int             // index into choices
+	meta     *jira.FieldMeta // required custom fields found through createmeta
+	note     string          // shown in place of the value, e.g. while loading
+	value    interface{}     // sent as is while a choice with an ID is picked, e.g. cloned components
+}
+
+// formLabelWidth is the width of the create form's label column.
+const formLabelWidth = 13
+
+// createFormResult is what the create form submits.
+type createFormResult struct {
+	summary   string
+	issueType string                 // type name
+	fields    map[string]interface{} // everything else, in create API shape
+	sprintID  int                    // sprint to add the issue to, 0 for none
+	links     []jira.IssueLink       // another issue's links to copy to the new one
+	comment   interface{}            // ADF comment added with a transition, nil for none
+}
+
+// createSprintsMsg delivers the active and future sprints the create form
+// offers.
+type createSprintsMsg struct {
+	sprints []jira.Sprint
+	err     error
+}
+
+// createFormOverlay edits every field of a new issue at once: summary,
+// type, description, priority, assignee, labels, parent, sprint, and the
+// required fields the project adds for the chosen type.
+type createFormOverlay struct {
+	title  string
+	verb   string // what ctrl+s does, for the hint
+	fields []*formField
+	focus  int
+	errMsg string
+	isDone bool
+	result interface{} // createFormResult or nil
+
+	// picker chooses the value of a formChoice row while it's open
+	picker  *selectionOverlay
+	picking *formField
+
+	// loadMeta fetches the create-screen fields of an issue type; the
+	// result comes back through setMeta
+	loadMeta    func(issueTypeID string) tea.Cmd
+	metaType    string // issue type the fields were loaded for
+	metaLoading bool
+	metaErr     error
+
+	// fields added by createmeta, kept by key across type changes so
+	// their values survive
+	extra map[string]*formField
+
+	// preferType and preferPriority are picked when they load instead
+	// of the first type and the default priority, e.g. when cloning
+	preferType     string // type name
+	preferPriority string // priority ID
+}
+
+// newCreateForm returns a form for a new issue. A non-empty parent is
+// shown fixed; otherwise a parent or epic can be typed. Sprints are only
+// offered when withSprint is set, since subtasks follow their parent.
+func newCreateForm(title string, assignees []selectionItem, parent string, withSprint bool) *createFormOverlay {
+	f := &createFormOverlay{title: title, verb: "create", extra: make(map[string]*formField)}
+
+	f.fields = append(f.fields,
+		&formField{key: "summary", label: "Summary", kind: formText, required: true, input: formInput("", 255)},
+		&formField{key: "issuetype", label: "Type", kind: formChoice, note: "Loading..."},
+	)
+
+	f.fields = append(f.fields,
+		&formField{key: "description", label: "Description", kind: formLong, area: formArea()},
+		&formField{key: "assignee", label: "Assignee", kind: formChoice, choices: assignees},
+	)
+
+	f.fields = append(f.fields, &formField{
+		key: "labels", label: "Labels", kind: formText, input: formInput("comma or space separated", 500),
+	})
+
+	if parent != "" {
+		f.fields = append(f.fields, &formField{key: "parent", label: "Parent", kind: formFixed, note: parent})
+	} else {
+		f.fields = append(f.fields, &formField{
+			key: "parent", label: "Parent / Epic", kind: formText, input: formInput("issue key", 30),
+		})
+	}
+	if withSprint {
+		f.fields = append(f.fields, &formField{
+			key: "sprint", label: "Sprint", kind: formChoice,
+			choices: []selectionItem{{Label: "None"}}, note: "Loading...",
+		})
+	}
+	f.setFocus(0)
+	return f
+}
+
+// formInput returns a create form text input.
+func formInput(placeholder string, limit int) textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = ""
+	ti.Placeholder = placeholder
+	ti.CharLimit = limit
+	ti.Width = 50
+	return ti
+}
+
+// formArea returns a create form textarea, where enter starts a new line.
+func formArea() textarea.Model {
+	ta := textarea.New()
+	ta.ShowLineNumbers = false
+	ta.Prompt = ""
+	ta.SetWidth(50)
+	ta.SetHeight(4)
+	ta.KeyMap.InsertNewline.SetKeys("enter")
+	return ta
+}
+
+// field returns the row for key, or nil.
+func (f *createFormOverlay) field(key string) *formField {
+	for _, ff := range f.fields {
+		if ff.key == key {
+			return ff
+		}
+	}
+	return nil
+}
+
+// setFocus moves the focus to row i, focusing its input.
+func (f *createFormOverlay) setFocus(i int) {
+	for j, ff := range f.fields {
+		switch {
+		case ff.kind == formText && j == i:
+			ff.input.Focus()
+		case ff.kind == formText:
+			ff.input.Blur()
+		case ff.kind == formLong && j == i:
+			ff.area.Focus()
+		case ff.kind == formLong:
+			ff.area.Blur()
+		}
+	}
+	f.focus = i
+}
+
+// move moves the focus by d rows, wrapping around.
+func (f *createFormOverlay) move(d int) {
+	f.setFocus((f.focus + d + len(f.fields)) % len(f.fields))
+}
+
+// setTypes fills the type row, picking the first type, and loads its
+// fields.
+func (f *createFormOverlay) setTypes(types []jira.IssueType) tea.Cmd {
+	row := f.field("issuetype")
+	row.note = ""
+	row.choices = make([]selectionItem, len(types))
+	for i, t := range types {
+		row.choices[i] = selectionItem{ID: t.ID, Label: t.Name}
+		if strings.EqualFold(t.Name, f.preferType) {
+			row.choice = i
+		}
+	}
+	if len(types) == 0 {
+		row.note = "No issue types"
+		return nil
+	}
+	return f.typeChanged()
+}
+
+// typeChanged loads the create-screen fields of the chosen type.
+func (f *createFormOverlay) typeChanged() tea.Cmd {
+	id := f.field("issuetype").choices[f.field("issuetype").choice].ID
+	f.metaType = id
+	f.metaErr = nil
+	if f.loadMeta == nil {
+		return nil
+	}
+	f.metaLoading = true
+	return f.loadMeta(id)
+}
+
+// setSprints fills the sprint row with active sprints first, then
+// future ones.
+func (f *createFormOverlay) setSprints(sprints []jira.Sprint) {
+	row := f.field("sprint")
+	if row == nil {
+		return
+	}
+	row.note = ""
+	sort.SliceStable(sprints, func(i, j int) bool {
+		return sprints[i].State == "active" && sprints[j].State != "active"
+	})
+	for _, s := range sprints {
+		row.choices = append(row.choices, selectionItem{ID: strconv.Itoa(s.ID), Label: s.Name, Desc: s.State})
+	}
+}
+
+// createFormCovers are the fields the form has rows of its own for.
+var createFormCovers = map[string]bool{
+	"project": true, "summary": true, "issuetype": true, "description": true,
+	"priority": true, "assignee": true, "labels": true, "parent": true,
+}
+
+// setMeta adds a priority row when the type's screen has priorities, and
+// a row for each required field the form doesn't cover. Results for a
+// type no longer chosen are dropped.
+func (f *createFormOverlay) setMeta(msg createMetaLoadedMsg) {
+	if msg.issueTypeID != f.metaType {
+		return
+	}
+	f.metaLoading = false
+	f.metaErr = msg.err
+	focused := f.fields[f.focus]
+
+	var rows []*formField
+	for _, ff := range f.fields {
+		if ff.key != "priority" && ff.meta == nil {
+			rows = append(rows, ff)
+		}
+	}
+	for i := range msg.fields {
+		m := msg.fields[i]
+		if m.Key != "priority" || len(m.AllowedValues) == 0 {
+			continue
+		}
+		row := f.extraField(m, func() *formField {
+			row := &formField{key: "priority", label: "Priority", kind: formChoice, choices: []selectionItem{{Label: "Default"}}}
+			for _, v := range m.AllowedValues {
+				if v.ID == f.preferPriority {
+					row.choice = len(row.choices)
+				}
+				row.choices = append(row.choices, selectionItem{ID: v.ID, Label: v.Label()})
+			}
+			return row
+		})
+		// Priority goes after the type and description
+		rows = append(rows[:3], append([]*formField{row}, rows[3:]...)...)
+	}
+	for _, m := range missingRequired(msg.fields, createFormCovers) {
+		rows = append(rows, f.extraField(m, func() *formField { return requiredRow(m) }))
+	}
+	f.fields = rows
+
+	f.focus = 0
+	for i, ff := range f.fields {
+		if ff == focused {
+			f.focus = i
+		}
+	}
+	f.setFocus(f.focus)
+}
+
+// requiredRow returns a row asking for the required field m: a picker for
+// fields with allowed values, a text input for strings and numbers, and
+// otherwise a note that the web UI has to set it.
+func requiredRow(m jira.FieldMeta) *formField {
+	row := &formField{key: m.Key, label: m.Name, required: true, meta: &m}
+	switch {
+	case len(m.AllowedValues) > 0:
+		row.kind = formChoice
+		for _, v := range m.AllowedValues {
+			row.choices = append(row.choices, selectionItem{ID: v.ID, Label: v.Label()})
+		}
+	case promptable(m):
+		row.kind = formText
+		row.input = formInput("", 500)
+	default:
+		row.kind = formFixed
+		row.note = "set in the web UI"
+	}
+	return row
+}
+
+// extraField returns the row kept for m.Key, making it with newRow the
+// first time.
+func (f *createFormOverlay) extraField(m jira.FieldMeta, newRow func() *formField) *formField {
+	if row, ok := f.extra[m.Key]; ok {
+		return row
+	}
+	row := newRow()
+	f.extra[m.Key] = row
+	return row
+}
+
+// submit checks the form and, when it's complete, finishes with its
+// result; otherwise it focuses the first row in need and says why.
+func (f *createFormOverlay) submit() {
+	res := createFormResult{fields: make(map[string]interface{})}
+	var unsupported []string
+	for i, ff := range f.fields {
+		switch ff.key {
+		case "summary":
+			res.summary = strings.TrimSpace(ff.input.Value())
+			if res.summary == "" {
+				f.fail(i, "Summary cannot be empty")
+				return
+			}
+		case "issuetype":
+			if len(ff.choices) == 0 {
+				f.fail(i, "No issue type to create")
+				return
+			}
+			res.issueType = ff.choices[ff.choice].Label
+		case jira.CommentField:
+			if strings.TrimSpace(ff.area.Value()) == "" {
+				f.fail(i, "Comment is required")
+				return
+			}
+			res.comment = makeADFDocument(ff.area.Value())
+		case "description":
+			if text := strings.TrimSpace(ff.area.Value()); text != "" {
+				res.fields["description"] = makeADFDocument(ff.area.Value())
+			}
+		case "priority":
+			if id := ff.choices[ff.choice].ID; id != "" {
+				res.fields["priority"] = map[string]interface{}{"id": id}
+			}
+		case "assignee":
+			if len(ff.choices) == 0 {
+				continue
+			}
+			if id := ff.choices[ff.choice].ID; id != "" {
+				res.fields["assignee"] = map[string]interface{}{"accountId": id}
+			} else {
+				res.fields["assignee"] = nil
+			}
+		case "labels":
+			if labels := parseLabels(ff.input.Value()); len(labels) > 0 {
+				res.fields["labels"] = labels
+			}
+		case "parent":
+			if ff.kind == formText {
+				if key := strings.ToUpper(strings.TrimSpace(ff.input.Value())); key != "" {
+					res.fields["parent"] = map[string]interface{}{"key": key}
+				}
+			}
+		case "sprint":
+			if id := ff.choices[ff.choice].ID; id != "" {
+				res.sprintID, _ = strconv.Atoi(id)
+			}
+		case "links":
+			if ff.choices[ff.choice].ID != "" {
+				res.links = ff.value.([]jira.IssueLink)
+			}
+		default:
+			if ff.value != nil && ff.choices[ff.choice].ID != "" {
+				res.fields[ff.key] = ff.value
+			}
+			if ff.meta == nil {
+				continue
+			}
+			var input interface{}
+			switch ff.kind {
+			case formFixed:
+				unsupported = append(unsupported, ff.label)
+				continue
+			case formChoice:
+				input = &ff.choices[ff.choice]
+			default:
+				input = ff.input.Value()
+			}
+			value, err := fieldInputValue(*ff.meta, input)
+			if err != nil {
+				f.fail(i, err.Error())
+				return
+			}
+			res.fields[ff.key] = value
+		}
+	}
+	if len(unsupported) > 0 {
+		f.errMsg = "Can't " + f.verb + " here — required fields need the web UI: " + strings.Join(unsupported, ", ")
+		return
+	}
+	f.isDone = true
+	f.result = res
+}
+
+// fail focuses row i and shows why the form can't be submitted.
+func (f *createFormOverlay) fail(i int, msg string) {
+	f.errMsg = msg
+	f.setFocus(i)
+}
+
+func (f *createFormOverlay) Update(msg tea.Msg) (overlay, tea.Cmd) {
+	if f.picker != nil {
+		f.picker.Update(msg)
+		if done, result := f.picker.done(); done {
+			row := f.picking
+			f.picker, f.picking = nil, nil
+			if item, ok := result.(*selectionItem); ok {
+				before := row.choice
+				for i := range row.choices {
+					if &row.choices[i] == item {
+						row.choice = i
+					}
+				}
+				if row.key == "issuetype" && row.choice != before {
+					return f, f.typeChanged()
+				}
+			}
+		}
+		return f, nil
+	}
+
+	row := f.fields[f.focus]
+	if km, ok := msg.(tea.KeyMsg); ok {
+		f.errMsg = ""
+		switch km.String() {
+		case "esc":
+			f.isDone = true
+			f.result = nil
+			return f, nil
+		case "ctrl+s":
+			f.submit()
+			return f, nil
+		case "tab":
+			f.move(1)
+			return f, nil
+		case "shift+tab":
+			f.move(-1)
+			return f, nil
+		case "up", "down":
+			if row.kind != formLong {
+				f.move(map[string]int{"up": -1, "down": 1}[km.String()])
+				return f, nil
+			}
+		case "enter", " ":
+			switch row.kind {
+			case formChoice:
+				if len(row.choices) > 0 {
+					f.picker = newSelectionOverlay(row.label, row.choices)
+					f.picker.cursor = row.choice
+					f.picking = row
+				}
+				return f, nil
+			case formText, formFixed:
+				if km.String() == "enter" {
+					f.move(1)
+					return f, nil
+				}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	switch row.kind {
+	case formText:
+		row.input, cmd = row.input.Update(msg)
+	case formLong:
+		row.area, cmd = row.area.Update(msg)
+	}
+	return f, cmd
+}
+
+func (f *createFormOverlay) View(width, height int) string {
+	if f.picker != nil {
+		return f.picker.View(width, height)
+	}
+
+	var b strings.Builder
+	b.WriteString(overlayTitleStyle.Render(f.title))
+	b.WriteString("\n")
+	for i, ff := range f.fields {
+		label := ff.label
+		if ff.required {
+			label += " *"
+		}
+		label = fmt.Sprintf("%-*s", formLabelWidth, label)
+		if i == f.focus {
+			b.WriteString(overlaySelectedStyle.Render(label))
+		} else {
+			b.WriteString(overlayFilterStyle.Render(label))
+		}
+		b.WriteString(" ")
+		b.WriteString(f.renderValue(ff, i == f.focus))
+		b.WriteString("\n")
+	}
+	if f.metaLoading {
+		b.WriteString(overlayFilterStyle.Render("Checking required fields..."))
+		b.WriteString("\n")
+	} else if f.metaErr != nil {
+		b.WriteString(overlayFilterStyle.Render("Couldn't check required fields; Jira will say what's missing"))
+		b.WriteString("\n")
+	}
+	if f.errMsg != "" {
+		b.WriteString(errorStyle.Render(f.errMsg))
+		b.WriteString("\n")
+	}
+	b.WriteString(overlayHintStyle.Render("tab/shift+tab: next/previous field  enter: choose\nctrl+s: " + f.verb + "  esc: cancel"))
+
+	content := overlayBorderStyle.Width(min(max(width-10, 30), 75)).Render(b.String())
+	return lipgloss.Place(width, height-2, lipgloss.Center, lipgloss.Center, content)
+}
+
+// renderValue draws a row's value, indenting a description's later lines
+// under the first.
+func (f *createFormOverlay) renderValue(ff *formField, focused bool) string {
+	switch {
+	case ff.note != "":
+		return overlayFilterStyle.Render(ff.note)
+	case ff.kind == formText:
+		return ff.input.View()
+	case ff.kind == formLong:
+		indent := strings.Repeat(" ", formLabelWidth+1)
+		return strings.ReplaceAll(ff.area.View(), "\n", "\n"+indent)
+	case ff.kind == formChoice && len(ff.choices) > 0:
+		value := ff.choices[ff.choice].Label + " ▾"
+		if focused {
+			return value
+		}
+		return overlayFilterStyle.Render(value)
+	}
+	return ""
+}
+
+func (f *createFormOverlay) done() (bool, interface{}) {
+	return f.isDone, f.result
+}
+
+// startCreate opens the create form for a new issue in the default
+// project, or for a child of createParent when that's set, and loads the
+// issue types and sprints it offers.
+func (a App) startCreate(title string) (tea.Model, tea.Cmd) {
+	form := newCreateForm(title, a.createAssignees(), a.createParent, !a.createSubtask)
+	form.loadMeta = a.cmdFetchCreateMeta
+	a.overlay = form
+	a.overlayAction = overlayActionCreate
+	cmds := []tea.Cmd{a.startNetwork(a.cmdFetchIssueTypes())}
+	if !a.createSubtask {
+		cmds = append(cmds, a.startNetwork(a.cmdFetchCreateSprints()))
+	}
+	return a, tea.Batch(cmds...)
+}
+
+// createAssignees lists the create form's assignees: the current user,
+// who new issues go to by default, nobody, then the cached users.
+func (a App) createAssignees() []selectionItem {
+	var items []selectionItem
+	var me string
+	if a.user != nil {
+		me = a.user.AccountID
+		items = append(items, selectionItem{ID: me, Label: "Me"})
+	}
+	items = append(items, selectionItem{Label: "Unassigned"})
+	for _, item := range a.assigneeItems(a.cachedUsers) {
+		if item.ID != me {
+			item.Section = ""
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// cmdFetchCreateSprints fetches the active and future sprints of the
+// create project's scrum boards, unless the instance has none.
+func (a App) cmdFetchCreateSprints() tea.Cmd {
+	if a.client == nil || a.unavailable[capAgile] {
+		return nil
+	}
+	client := a.client
+	project := a.createProject()
+	return func() tea.Msg {
+		ctx := context.Background()
+		boards, err := client.GetBoards(ctx, project, "scrum")
+		if err != nil {
+			return createSprintsMsg{err: err}
+		}
+		var sprints []jira.Sprint
+		seen := make(map[int]bool)
+		for _, b := range boards {
+			list, err := client.GetBoardSprints(ctx, b.ID, "active,future")
+			if err != nil {
+				return createSprintsMsg{err: err}
+			}
+			for _, s := range list {
+				if !seen[s.ID] {
+					seen[s.ID] = true
+					sprints = append(sprints, s)
+				}
+			}
+		}
+		return createSprintsMsg{sprints: sprints}
+	}
+}
+
+// handleCreateSprints fills the create form's sprint row. Failing to load
+// sprints leaves only "None".
+func (a App) handleCreateSprints(msg createSprintsMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	if form, ok := a.overlay.(*createFormOverlay); ok {
+		form.setSprints(msg.sprints)
+	}
+	return a, nil
+}
+
+// submitCreate sends the create request for the form's result.
+func (a App) submitCreate(res createFormResult) (tea.Model, tea.Cmd) {
+	cmd := a.cmdCreateIssue(res)
+	a.resetCreate()
+	a.flash = "Creating issue..."
+	a.flashIsErr = false
+	return a, a.trackWrite(writeCreate, "", cmd)
+}
@@ -0,0 +1,215 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+var (
+	createTypes = []jira.IssueType{{ID: "1", Name: "Task"}, {ID: "2", Name: "Bug"}}
+	createMeta  = []jira.FieldMeta{
+		{Key: "summary", Name: "Summary", Required: true, Schema: jira.FieldSchema{Type: "string"}},
+		{Key: "priority", Name: "Priority", Schema: jira.FieldSchema{Type: "priority"},
+			AllowedValues: []jira.AllowedValue{{ID: "2", Name: "High"}, {ID: "3", Name: "Medium"}}},
+		{Key: "customfield_1", Name: "Team", Required: true, Schema: jira.FieldSchema{Type: "option"},
+			AllowedValues: []jira.AllowedValue{{ID: "11", Value: "Core"}, {ID: "12", Value: "Web"}}},
+		{Key: "customfield_2", Name: "Severity", Required: true, Schema: jira.FieldSchema{Type: "string"}},
+	}
+)
+
+// testCreateForm is a create form with types and createmeta loaded,
+// recording the types it loads metadata for.
+func testCreateForm(loaded *[]string) *createFormOverlay {
+	assignees := []selectionItem{{ID: "me", Label: "Me"}, {Label: "Unassigned"}}
+	form := newCreateForm("New Issue", assignees, "", true)
+	form.loadMeta = func(id string) tea.Cmd {
+		*loaded = append(*loaded, id)
+		return func() tea.Msg { return nil }
+	}
+	form.setTypes(createTypes)
+	form.setMeta(createMetaLoadedMsg{issueTypeID: "1", fields: createMeta})
+	form.setSprints([]jira.Sprint{{ID: 7, Name: "Sprint 7", State: "future"}, {ID: 6, Name: "Sprint 6", State: "active"}})
+	return form
+}
+
+// focusField tabs to the row for key.
+func focusField(t *testing.T, form *createFormOverlay, key string) {
+	t.Helper()
+	for range form.fields {
+		if form.fields[form.focus].key == key {
+			return
+		}
+		form.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	t.Fatalf("no %s row", key)
+}
+
+func TestCreateFormRows(t *testing.T) {
+	var loaded []string
+	form := testCreateForm(&loaded)
+	var keys []string
+	for _, f := range form.fields {
+		keys = append(keys, f.key)
+	}
+	want := "summary,issuetype,description,priority,assignee,labels,parent,sprint,customfield_1,customfield_2"
+	if got := strings.Join(keys, ","); got != want {
+		t.Errorf("rows = %s, want %s", got, want)
+	}
+	if len(loaded) != 1 || loaded[0] != "1" {
+		t.Errorf("loaded meta for %v, want the first type", loaded)
+	}
+	if got := form.field("sprint").choices[1].Label; got != "Sprint 6" {
+		t.Errorf("first sprint = %s, want the active one", got)
+	}
+}
+
+func TestCreateFormSubmit(t *testing.T) {
+	var loaded []string
+	form := testCreateForm(&loaded)
+
+	form.Update(keyMsg("ctrl+s"))
+	if form.isDone || form.errMsg != "Summary cannot be empty" || form.focus != 0 {
+		t.Fatalf("empty summary: err=%q focus=%d", form.errMsg, form.focus)
+	}
+	typeText(form, "Login fails")
+
+	focusField(t, form, "description")
+	typeText(form, "Steps")
+	focusField(t, form, "priority")
+	form.Update(keyMsg("enter"))
+	form.Update(keyMsg("down"))
+	form.Update(keyMsg("enter"))
+	focusField(t, form, "labels")
+	typeText(form, "ui, login")
+	focusField(t, form, "parent")
+	typeText(form, "proj-4")
+	focusField(t, form, "sprint")
+	form.Update(keyMsg("enter"))
+	form.Update(keyMsg("down"))
+	form.Update(keyMsg("enter"))
+
+	form.Update(keyMsg("ctrl+s"))
+	if form.isDone || form.errMsg != "Severity is required" || form.fields[form.focus].key != "customfield_2" {
+		t.Fatalf("missing Severity: err=%q", form.errMsg)
+	}
+	typeText(form, "S2")
+	form.Update(keyMsg("ctrl+s"))
+	if !form.isDone {
+		t.Fatalf("form should submit, err=%q", form.errMsg)
+	}
+
+	res := form.result.(createFormResult)
+	if res.summary != "Login fails" || res.issueType != "Task" || res.sprintID != 6 {
+		t.Errorf("result = %+v", res)
+	}
+	want := map[string]string{
+		"priority":      "map[id:2]",
+		"assignee":      "map[accountId:me]",
+		"labels":        "[ui login]",
+		"parent":        "map[key:PROJ-4]",
+		"customfield_1": "map[id:11]",
+		"customfield_2": "S2",
+	}
+	for k, v := range want {
+		if got := fmt.Sprint(res.fields[k]); got != v {
+			t.Errorf("%s = %s, want %s", k, got, v)
+		}
+	}
+	if _, ok := res.fields["description"]; !ok {
+		t.Error("description should be sent")
+	}
+}
+
+func TestCreateFormTypeChange(t *testing.T) {
+	var loaded []string
+	form := testCreateForm(&loaded)
+	focusField(t, form, "customfield_2")
+	typeText(form, "S1")
+
+	focusField(t, form, "issuetype")
+	form.Update(keyMsg("enter"))
+	form.Update(keyMsg("down"))
+	_, cmd := form.Update(keyMsg("enter"))
+	if cmd == nil || len(loaded) != 2 || loaded[1] != "2" {
+		t.Fatalf("picking Bug should load its fields, loaded %v", loaded)
+	}
+
+	// A late answer for Task is dropped
+	form.setMeta(createMetaLoadedMsg{issueTypeID: "1"})
+	if !form.metaLoading || form.field("customfield_2") == nil {
+		t.Error("stale metadata should be ignored")
+	}
+	form.setMeta(createMetaLoadedMsg{issueTypeID: "2", fields: createMeta[2:]})
+	if form.field("priority") != nil {
+		t.Error("Bug's screen has no priority")
+	}
+	if got := form.field("customfield_2").input.Value(); got != "S1" {
+		t.Errorf("Severity = %q, want the value typed for Task", got)
+	}
+}
+
+func TestCreateFormUnsupportedField(t *testing.T) {
+	var loaded []string
+	form := testCreateForm(&loaded)
+	form.setMeta(createMetaLoadedMsg{issueTypeID: "1", fields: []jira.FieldMeta{
+		{Key: "customfield_9", Name: "Start date", Required: true, Schema: jira.FieldSchema{Type: "date"}},
+	}})
+	typeText(form, "Thing")
+	form.Update(keyMsg("ctrl+s"))
+	if form.isDone || !strings.Contains(form.errMsg, "web UI: Start date") {
+		t.Errorf("err = %q, want the field named", form.errMsg)
+	}
+}
+
+// openCreateForm presses c and picks the first project.
+func openCreateForm(t *testing.T, app App) (App, tea.Cmd) {
+	t.Helper()
+	app.cachedProjects = []jira.Project{{Key: "PROJ", Name: "Project"}}
+	model, _ := app.Update(keyMsg("c"))
+	app = model.(App)
+	model, cmd := app.Update(keyMsg("enter"))
+	app = model.(App)
+	if _, ok := app.overlay.(*createFormOverlay); !ok {
+		t.Fatalf("c should open the form, overlay = %T", app.overlay)
+	}
+	return app, cmd
+}
+
+func TestCreateFromList(t *testing.T) {
+	app := testAppConnected()
+	app.user = &jira.User{AccountID: "me"}
+	app, cmd := openCreateForm(t, app)
+	form := app.overlay.(*createFormOverlay)
+	if cmd == nil {
+		t.Fatal("the form should load types")
+	}
+	if got := form.field("assignee").choices[0].Label; got != "Me" {
+		t.Errorf("default assignee = %s, want Me", got)
+	}
+
+	model, _ := app.Update(issueTypesLoadedMsg{types: createTypes})
+	app = model.(App)
+	typeText(form, "New thing")
+	model, cmd = app.Update(keyMsg("ctrl+s"))
+	app = model.(App)
+	if app.overlay != nil || cmd == nil {
+		t.Fatalf("ctrl+s should submit, overlay = %T", app.overlay)
+	}
+	if len(app.pending) != 1 || app.pending[0].kind != writeCreate {
+		t.Errorf("pending = %+v, want one create", app.pending)
+	}
+}
+
+func TestCreateFormCancel(t *testing.T) {
+	app, _ := openCreateForm(t, testAppConnected())
+	model, _ := app.Update(keyMsg("esc"))
+	app = model.(App)
+	if app.overlay != nil || app.flash != "Create cancelled" || len(app.pending) != 0 {
+		t.Errorf("overlay=%T flash=%q pending=%d", app.overlay, app.flash, len(app.pending))
+	}
+}
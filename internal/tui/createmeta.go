@@ -0,0 +1,83 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// createMetaLoadedMsg delivers the create-screen fields for the chosen
+// project and issue type.
+type createMetaLoadedMsg struct {
+	issueTypeID string
+	fields      []jira.FieldMeta
+	err         error
+}
+
+// missingRequired returns the required fields without a default that the
+// create flow doesn't already fill in.
+func missingRequired(fields []jira.FieldMeta, provided map[string]bool) []jira.FieldMeta {
+	var missing []jira.FieldMeta
+	for _, f := range fields {
+		if f.Required && !f.HasDefaultValue && !provided[f.Key] {
+			missing = append(missing, f)
+		}
+	}
+	return missing
+}
+
+// promptable reports whether the create form can ask for f inline: a
+// picker for fields with allowed values, or a text input for strings and
+// numbers.
+func promptable(f jira.FieldMeta) bool {
+	if len(f.AllowedValues) > 0 {
+		return true
+	}
+	return f.Schema.Type == "string" || f.Schema.Type == "number"
+}
+
+// fieldInputValue converts a picked value or typed text into the JSON shape
+// the create and edit APIs expect for f.
+func fieldInputValue(f jira.FieldMeta, result interface{}) (interface{}, error) {
+	if item, ok := result.(*selectionItem); ok {
+		return f.IDValue(item.ID), nil
+	}
+	return f.InputValue(result.(string))
+}
+
+// createProject returns the project the create flow targets.
+func (a App) createProject() string {
+	if a.createParent != "" {
+		return projectKeyOf(a.createParent)
+	}
+	if a.createIn != "" {
+		return a.createIn
+	}
+	return a.defaultProject
+}
+
+// cmdFetchCreateMeta fetches the create-screen fields for issueTypeID.
+func (a App) cmdFetchCreateMeta(issueTypeID string) tea.Cmd {
+	if a.client == nil {
+		return nil
+	}
+	client := a.client
+	project := a.createProject()
+	return func() tea.Msg {
+		fields, err := client.GetCreateFieldMeta(context.Background(), project, issueTypeID)
+		if err != nil {
+			return createMetaLoadedMsg{issueTypeID: issueTypeID, err: fmt.Errorf("get create metadata: %w", err)}
+		}
+		return createMetaLoadedMsg{issueTypeID: issueTypeID, fields: fields}
+	}
+}
+
+// resetCreate clears the create state.
+func (a *App) resetCreate() {
+	a.createIn = ""
+	a.createParent = ""
+	a.createSubtask = false
+}
@@ -0,0 +1,54 @@
+package tui
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestMissingRequired(t *testing.T) {
+	fields := []jira.FieldMeta{
+		{Key: "summary", Name: "Summary", Required: true},
+		{Key: "project", Name: "Project", Required: true},
+		{Key: "priority", Name: "Priority", Required: true, HasDefaultValue: true},
+		{Key: "customfield_1", Name: "Team", Required: true},
+		{Key: "customfield_2", Name: "Notes"},
+	}
+	provided := map[string]bool{"summary": true, "project": true}
+
+	missing := missingRequired(fields, provided)
+	if len(missing) != 1 || missing[0].Key != "customfield_1" {
+		t.Errorf("missing = %+v, want only Team", missing)
+	}
+}
+
+func TestCreateFieldValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   jira.FieldMeta
+		result  interface{}
+		want    string
+		wantErr bool
+	}{
+		{"option", jira.FieldMeta{Name: "Severity", Schema: jira.FieldSchema{Type: "option"}},
+			&selectionItem{ID: "10", Label: "High"}, "map[id:10]", false},
+		{"array of options", jira.FieldMeta{Name: "Teams", Schema: jira.FieldSchema{Type: "array"}},
+			&selectionItem{ID: "7", Label: "Core"}, "[map[id:7]]", false},
+		{"string", jira.FieldMeta{Name: "Env", Schema: jira.FieldSchema{Type: "string"}}, " prod ", "prod", false},
+		{"number", jira.FieldMeta{Name: "Points", Schema: jira.FieldSchema{Type: "number"}}, "3.5", "3.5", false},
+		{"bad number", jira.FieldMeta{Name: "Points", Schema: jira.FieldSchema{Type: "number"}}, "lots", "", true},
+		{"empty", jira.FieldMeta{Name: "Env", Schema: jira.FieldSchema{Type: "string"}}, "  ", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fieldInputValue(tt.field, tt.result)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && fmt.Sprint(got) != tt.want {
+				t.Errorf("value = %s, want %s", fmt.Sprint(got), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// editMetaLoadedMsg delivers the edit metadata of a configured custom
+// field on one issue.
+type editMetaLoadedMsg struct {
+	issueKey string
+	field    config.CustomFieldConfig
+	meta     jira.FieldMeta
+	found    bool // false if the field isn't on the issue's edit screen
+	err      error
+}
+
+// SetCustomFields sets the select-type custom fields that get quick-edit
+// overlays on the 'f' hotkey.
+func (a *App) SetCustomFields(fields []config.CustomFieldConfig) {
+	a.customFields = fields
+}
+
+// startCustomFieldEdit begins the 'f' flow: pick a configured field (skipped
+// when there's only one), then pick its value.
+func (a App) startCustomFieldEdit(issueKey string) (tea.Model, tea.Cmd) {
+	switch len(a.customFields) {
+	case 0:
+		a.flash = "No custom fields configured (add custom_fields to config.yaml)"
+		a.flashIsErr = true
+		return a, nil
+	case 1:
+		return a.fetchCustomFieldOptions(issueKey, a.customFields[0])
+	}
+	items := make([]selectionItem, len(a.customFields))
+	for i, f := range a.customFields {
+		items[i] = selectionItem{ID: f.FieldID, Label: f.Name}
+	}
+	a.overlay = newSelectionOverlay(a.overlayTitle("Edit Field"), items)
+	a.overlayIssue = issueKey
+	a.overlayAction = overlayActionCustomField
+	return a, nil
+}
+
+// customField returns the configured custom field with the given id.
+func (a App) customField(fieldID string) (config.CustomFieldConfig, bool) {
+	for _, f := range a.customFields {
+		if f.FieldID == fieldID {
+			return f, true
+		}
+	}
+	return config.CustomFieldConfig{}, false
+}
+
+// fetchCustomFieldOptions loads the field's allowed values for issueKey.
+// The value overlay opens when editMetaLoadedMsg arrives.
+func (a App) fetchCustomFieldOptions(issueKey string, field config.CustomFieldConfig) (tea.Model, tea.Cmd) {
+	a.overlayIssue = issueKey
+	a.overlayAction = overlayActionCustomFieldValue
+	a.flash = "Loading " + field.Name + " options..."
+	a.flashIsErr = false
+	return a, a.startNetwork(a.cmdFetchEditMeta(issueKey, field))
+}
+
+// cmdFetchEditMeta fetches the edit metadata for field on issueKey.
+func (a App) cmdFetchEditMeta(issueKey string, field config.CustomFieldConfig) tea.Cmd {
+	client := a.client
+	return func() tea.Msg {
+		fields, err := client.GetEditFieldMeta(context.Background(), issueKey)
+		if err != nil {
+			return editMetaLoadedMsg{issueKey: issueKey, field: field, err: fmt.Errorf("get edit metadata: %w", err)}
+		}
+		meta, ok := fields[field.FieldID]
+		return editMetaLoadedMsg{issueKey: issueKey, field: field, meta: meta, found: ok}
+	}
+}
+
+// handleEditMeta opens the value overlay for a custom field. Fields that
+// aren't editable on the issue or have no options are reported instead.
+func (a App) handleEditMeta(msg editMetaLoadedMsg) (tea.Model, tea.Cmd) {
+	if a.overlayAction != overlayActionCustomFieldValue || a.overlayIssue != msg.issueKey {
+		return a, nil // user moved on
+	}
+	fail := func(text string) (tea.Model, tea.Cmd) {
+		a.flash = text
+		a.flashIsErr = true
+		a.overlayIssue = ""
+		a.overlayAction = overlayActionNone
+		a.overlayKeys = nil
+		return a, nil
+	}
+	switch {
+	case msg.err != nil:
+		return fail(msg.err.Error())
+	case !msg.found:
+		return fail(fmt.Sprintf("%s can't be edited on %s", msg.field.Name, msg.issueKey))
+	case len(msg.meta.AllowedValues) == 0:
+		return fail(msg.field.Name + " isn't a select field")
+	}
+
+	items := make([]selectionItem, 0, len(msg.meta.AllowedValues)+1)
+	for _, v := range msg.meta.AllowedValues {
+		items = append(items, selectionItem{ID: v.ID, Label: v.Label()})
+	}
+	if !msg.meta.Required {
+		items = append(items, selectionItem{Label: "None"})
+	}
+	a.editField = msg.field
+	a.editFieldMeta = msg.meta
+	a.flash = ""
+	a.overlay = newSelectionOverlay(a.overlayTitle("Set "+msg.field.Name), items)
+	return a, nil
+}
+
+// applyCustomFieldValue saves the picked value on the target issue, or on
+// every selected issue in bulk mode.
+func (a App) applyCustomFieldValue(issueKey string, bulkKeys []string, item *selectionItem) (tea.Model, tea.Cmd) {
+	field, meta := a.editField, a.editFieldMeta
+	var value interface{}
+	if item.ID != "" {
+		value, _ = fieldInputValue(meta, item)
+	}
+	fields := map[string]interface{}{field.FieldID: value}
+	if len(bulkKeys) > 0 {
+		return a, a.startBulk(field.Name+" "+item.Label, bulkKeys, bulkUpdateFields(fields))
+	}
+	a.flash = "Setting " + field.Name + " on " + issueKey + "..."
+	a.flashIsErr = false
+	return a, a.trackWrite(writeUpdate, issueKey, a.cmdUpdateField(issueKey, fields))
+}
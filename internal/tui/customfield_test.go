@@ -0,0 +1,126 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+var severityField = config.CustomFieldConfig{Name: "Severity", FieldID: "customfield_100"}
+
+func severityMeta(required bool) jira.FieldMeta {
+	return jira.FieldMeta{
+		Key:      "customfield_100",
+		Name:     "Severity",
+		Required: required,
+		Schema:   jira.FieldSchema{Type: "option"},
+		AllowedValues: []jira.AllowedValue{
+			{ID: "1", Value: "Sev 1"},
+			{ID: "2", Value: "Sev 2"},
+		},
+	}
+}
+
+func TestCustomFieldHotkeyNotConfigured(t *testing.T) {
+	app := testAppReady()
+	app.client = jira.NewClient("https://fake.atlassian.net", "test@test.com", "token")
+
+	model, _ := app.Update(keyMsg("f"))
+	app = model.(App)
+	if !app.flashIsErr || !strings.Contains(app.flash, "custom_fields") {
+		t.Errorf("flash = %q, want hint about custom_fields", app.flash)
+	}
+}
+
+func TestCustomFieldHotkeyPicksField(t *testing.T) {
+	app := testAppReady()
+	app.client = jira.NewClient("https://fake.atlassian.net", "test@test.com", "token")
+
+	// One field goes straight to loading its options
+	app.SetCustomFields([]config.CustomFieldConfig{severityField})
+	model, cmd := app.Update(keyMsg("f"))
+	got := model.(App)
+	if cmd == nil || got.overlayAction != overlayActionCustomFieldValue || got.overlayIssue != "PROJ-1" {
+		t.Errorf("expected editmeta fetch for PROJ-1, action=%v issue=%q", got.overlayAction, got.overlayIssue)
+	}
+
+	// Several fields open a picker first
+	app.SetCustomFields([]config.CustomFieldConfig{severityField, {Name: "Team", FieldID: "customfield_200"}})
+	model, _ = app.Update(keyMsg("f"))
+	got = model.(App)
+	sel, ok := got.overlay.(*selectionOverlay)
+	if !ok || len(sel.items) != 2 || got.overlayAction != overlayActionCustomField {
+		t.Fatalf("expected field picker, got %T", got.overlay)
+	}
+	model, cmd = got.Update(keyMsg("enter"))
+	got = model.(App)
+	if cmd == nil || got.overlayAction != overlayActionCustomFieldValue {
+		t.Errorf("expected editmeta fetch after picking a field, action=%v", got.overlayAction)
+	}
+}
+
+func TestEditMetaOpensValueOverlay(t *testing.T) {
+	tests := []struct {
+		name      string
+		required  bool
+		wantItems int
+	}{
+		{"optional field offers None", false, 3},
+		{"required field has no None", true, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := testAppReady()
+			app.overlayIssue = "PROJ-1"
+			app.overlayAction = overlayActionCustomFieldValue
+
+			model, _ := app.Update(editMetaLoadedMsg{issueKey: "PROJ-1", field: severityField, meta: severityMeta(tt.required), found: true})
+			app = model.(App)
+			sel, ok := app.overlay.(*selectionOverlay)
+			if !ok {
+				t.Fatalf("expected selectionOverlay, got %T", app.overlay)
+			}
+			if sel.title != "Set Severity" || len(sel.items) != tt.wantItems {
+				t.Errorf("title=%q items=%d, want %d", sel.title, len(sel.items), tt.wantItems)
+			}
+		})
+	}
+}
+
+func TestEditMetaFieldNotEditable(t *testing.T) {
+	app := testAppReady()
+	app.overlayIssue = "PROJ-1"
+	app.overlayAction = overlayActionCustomFieldValue
+
+	model, _ := app.Update(editMetaLoadedMsg{issueKey: "PROJ-1", field: severityField})
+	app = model.(App)
+	if app.overlay != nil || !app.flashIsErr || !strings.Contains(app.flash, "can't be edited") {
+		t.Errorf("overlay=%T flash=%q", app.overlay, app.flash)
+	}
+	if app.overlayAction != overlayActionNone {
+		t.Error("overlay action should be reset")
+	}
+}
+
+func TestCustomFieldValueSaves(t *testing.T) {
+	app := testAppReady()
+	app.client = jira.NewClient("https://fake.atlassian.net", "test@test.com", "token")
+	app.overlayIssue = "PROJ-1"
+	app.overlayAction = overlayActionCustomFieldValue
+	model, _ := app.Update(editMetaLoadedMsg{issueKey: "PROJ-1", field: severityField, meta: severityMeta(false), found: true})
+	app = model.(App)
+
+	model, cmd := app.Update(keyMsg("enter"))
+	app = model.(App)
+	if cmd == nil {
+		t.Fatal("expected update command")
+	}
+	if len(app.pending) != 1 || app.pending[0].issueKey != "PROJ-1" {
+		t.Errorf("pending = %+v", app.pending)
+	}
+	if !strings.Contains(app.flash, "Severity") {
+		t.Errorf("flash = %q", app.flash)
+	}
+}
@@ -0,0 +1,142 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+// debugLogLines is how many of the latest log lines the viewer keeps.
+const debugLogLines = 1000
+
+// DebugLogBuffer keeps the latest lines written to the --debug log for
+// the in-app viewer. It is safe for concurrent use.
+type DebugLogBuffer struct {
+	mu      sync.Mutex
+	lines   []string
+	partial string // a line written without its newline yet
+}
+
+// NewDebugLogBuffer returns an empty buffer.
+func NewDebugLogBuffer() *DebugLogBuffer {
+	return &DebugLogBuffer{}
+}
+
+// Write adds the complete lines in p, dropping the oldest past
+// debugLogLines.
+func (b *DebugLogBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	text := b.partial + string(p)
+	lines := strings.Split(text, "\n")
+	b.partial = lines[len(lines)-1]
+	b.lines = append(b.lines, lines[:len(lines)-1]...)
+	if over := len(b.lines) - debugLogLines; over > 0 {
+		b.lines = append(b.lines[:0:0], b.lines[over:]...)
+	}
+	return len(p), nil
+}
+
+// Lines returns a copy of the kept lines, oldest first.
+func (b *DebugLogBuffer) Lines() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.lines...)
+}
+
+// SetDebugLog turns on the ctrl+l log viewer over buf, the lines --debug
+// also writes to the file at path.
+func (a *App) SetDebugLog(buf *DebugLogBuffer, path string) {
+	a.debugLog = buf
+	a.debugLogPath = path
+}
+
+// openDebugLog shows the latest --debug log lines.
+func (a App) openDebugLog() (tea.Model, tea.Cmd) {
+	if a.debugLog == nil {
+		a.flash = "Start jira-tui with --debug (or JIRA_TUI_DEBUG=1) to log requests"
+		a.flashIsErr = false
+		return a, nil
+	}
+	a.overlay = newLogViewerOverlay(a.debugLog.Lines(), a.debugLogPath)
+	a.overlayIssue = ""
+	a.overlayAction = overlayActionNone
+	return a, nil
+}
+
+// logViewerOverlay scrolls through log lines, newest at the bottom. Lines
+// are cut to the screen width; the file has them in full.
+type logViewerOverlay struct {
+	lines  []string
+	path   string
+	offset int // lines scrolled up from the bottom
+	height int // lines shown in the last View
+	isDone bool
+}
+
+func newLogViewerOverlay(lines []string, path string) *logViewerOverlay {
+	return &logViewerOverlay{lines: lines, path: path}
+}
+
+func (o *logViewerOverlay) Update(msg tea.Msg) (overlay, tea.Cmd) {
+	km, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return o, nil
+	}
+	page := max(1, o.height-1)
+	switch km.String() {
+	case "esc", "q", "ctrl+l":
+		o.isDone = true
+	case "up", "k":
+		o.scroll(1)
+	case "down", "j":
+		o.scroll(-1)
+	case "pgup", "ctrl+u":
+		o.scroll(page)
+	case "pgdown", "ctrl+d":
+		o.scroll(-page)
+	case "home", "g":
+		o.scroll(len(o.lines))
+	case "end", "G":
+		o.offset = 0
+	}
+	return o, nil
+}
+
+// scroll moves the view up by n lines, or down for negative n.
+func (o *logViewerOverlay) scroll(n int) {
+	o.offset = max(0, min(o.offset+n, len(o.lines)-o.height))
+}
+
+func (o *logViewerOverlay) View(width, height int) string {
+	w := max(20, width-8)
+	o.height = max(1, height-10)
+	o.offset = min(o.offset, max(0, len(o.lines)-o.height))
+	end := len(o.lines) - o.offset
+	start := max(0, end-o.height)
+
+	var b strings.Builder
+	b.WriteString(overlayTitleStyle.Render("Debug log") + "  " + detailTypeStyle.Render(o.path) + "\n\n")
+	if len(o.lines) == 0 {
+		b.WriteString(detailTypeStyle.Render("No requests logged yet") + "\n")
+	}
+	for _, line := range o.lines[start:end] {
+		b.WriteString(runewidth.Truncate(line, w, "…") + "\n")
+	}
+	hint := "↑/↓ pgup/pgdn: scroll · g/G: oldest/newest · esc: close"
+	if o.offset > 0 {
+		hint = fmt.Sprintf("%d newer lines below · %s", o.offset, hint)
+	}
+	b.WriteString("\n" + overlayHintStyle.Render(hint))
+
+	content := overlayBorderStyle.Width(w + 4).Render(b.String())
+	return lipgloss.Place(width, height-2, lipgloss.Center, lipgloss.Center, content)
+}
+
+func (o *logViewerOverlay) done() (bool, interface{}) {
+	return o.isDone, nil
+}
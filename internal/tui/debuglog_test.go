@@ -0,0 +1,58 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestDebugLogBuffer(t *testing.T) {
+	b := NewDebugLogBuffer()
+	b.Write([]byte("one\ntw"))
+	b.Write([]byte("o\n"))
+	if got := strings.Join(b.Lines(), ","); got != "one,two" {
+		t.Errorf("lines = %q, want a line split across writes joined", got)
+	}
+	for i := range debugLogLines {
+		fmt.Fprintf(b, "line %d\n", i)
+	}
+	lines := b.Lines()
+	if len(lines) != debugLogLines || lines[0] != "line 0" {
+		t.Errorf("kept %d lines starting %q, want the latest %d", len(lines), lines[0], debugLogLines)
+	}
+}
+
+func TestDebugLogViewer(t *testing.T) {
+	app := testAppReady()
+	model, _ := app.Update(tea.KeyMsg{Type: tea.KeyCtrlL})
+	app = model.(App)
+	if app.overlay != nil || !strings.Contains(app.flash, "--debug") {
+		t.Fatalf("without --debug ctrl+l should explain, flash = %q", app.flash)
+	}
+
+	buf := NewDebugLogBuffer()
+	for i := range 50 {
+		fmt.Fprintf(buf, "request %d\n", i)
+	}
+	app.SetDebugLog(buf, "/tmp/debug.log")
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyCtrlL})
+	app = model.(App)
+	o, ok := app.overlay.(*logViewerOverlay)
+	if !ok {
+		t.Fatalf("overlay = %T, want the log viewer", app.overlay)
+	}
+	view := o.View(100, 30)
+	if !strings.Contains(view, "request 49") || strings.Contains(view, "request 0") {
+		t.Errorf("the viewer should open on the newest lines:\n%s", view)
+	}
+	o.Update(keyMsg("g"))
+	if view = o.View(100, 30); !strings.Contains(view, "request 0") || !strings.Contains(view, "newer lines below") {
+		t.Errorf("g should scroll to the oldest line:\n%s", view)
+	}
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if model.(App).overlay != nil {
+		t.Error("esc should close the viewer")
+	}
+}
@@ -3,6 +3,7 @@ package tui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
@@ -60,26 +61,11 @@ var (
 // statusColor returns a lipgloss style colored by status category.
 func statusColor(status *jira.Status) lipgloss.Style {
 	s := detailStatusStyle
-	if status == nil || status.StatusCategory == nil {
+	if status == nil {
 		return s
 	}
-	// Override specific status names before falling through to category.
-	switch strings.ToLower(status.Name) {
-	case "backlog":
-		return s.Foreground(lipgloss.Color("240")) // dark gray
-	case "triage":
-		return s.Foreground(lipgloss.Color("248")) // light gray
-	}
-	switch status.StatusCategory.Key {
-	case "new":
-		return s.Foreground(lipgloss.Color("12")) // blue
-	case "indeterminate":
-		return s.Foreground(lipgloss.Color("11")) // yellow
-	case "done":
-		return s.Foreground(lipgloss.Color("10")) // green
-	default:
-		return s.Foreground(lipgloss.Color("252")) // light gray
-	}
+	// The name's own color or the category's, as in the table
+	return s.Foreground(lipgloss.Color(statusColorCode(status)))
 }
 
 // issueTypeColor returns a lipgloss style colored by issue type name.
@@ -97,6 +83,13 @@ func issueTypeColor(name string) lipgloss.Style {
 	}
 }
 
+// detailFrame is how long detail rebuilds are deferred so fetch results
+// arriving together re-render the viewport once.
+const detailFrame = time.Second / 60
+
+// detailRebuildMsg fires one frame after a detail view was marked stale.
+type detailRebuildMsg struct{}
+
 // issueDetailView is the full detail view for a single issue.
 type issueDetailView struct {
 	issue           jira.Issue
@@ -107,8 +100,19 @@ type issueDetailView struct {
 	dirty           bool // true if the issue was edited while this view was open
 	comments        []jira.Comment
 	commentsLoading bool
+	commentsTotal   int          // comments on the issue; more than len(comments) when older ones aren't loaded
+	olderLoading    bool         // older comments are being fetched
+	gotoOldest      bool         // scroll to the bottom, where the oldest comment is, on the next rebuild
 	children        []jira.Issue // child issues (parent = this issue)
 	childrenLoading bool
+	stale           bool                // data changed; rebuild on the next detailRebuildMsg
+	people          mentionContext      // resolves @mentions in descriptions and comments
+	lastChange      *jira.ChangeHistory // most recent changelog entry, nil until loaded
+	watchers        *jira.Watchers      // who watches the issue, nil until loaded
+	remoteLinks     []jira.RemoteLink   // links to pages outside Jira
+	dev             *jira.DevInfo       // linked pull requests, branches, and commits; nil until loaded
+	clock           Clock               // tells the time for "updated 2h ago"; nil = wall clock
+	projects        map[string]bool     // projects whose keys in the text are linked; nil = any
 	width           int
 	height          int
 }
@@ -141,9 +145,15 @@ func (v issueDetailView) title() string {
 	return v.issue.Key
 }
 
-// buildViewport creates the viewport with rendered content.
+// buildViewport creates the viewport with rendered content. Rebuilding a
+// ready viewport keeps its scroll offset, clamped to the new content, so
+// late-arriving comments or refreshes don't jump back to the top.
 func (v *issueDetailView) buildViewport() {
 	content := v.renderContent()
+	offset := 0
+	if v.ready {
+		offset = v.viewport.YOffset
+	}
 
 	// Height available for the viewport: total height minus tab bar (2) and status bar (1)
 	vpHeight := v.height - 3
@@ -156,10 +166,21 @@ func (v *issueDetailView) buildViewport() {
 	// Use j/k for scrolling
 	vp.KeyMap.Up.SetKeys("up", "k")
 	vp.KeyMap.Down.SetKeys("down", "j")
+	vp.SetYOffset(offset)
 	v.viewport = vp
 	v.ready = true
 }
 
+// rebuild re-renders a view marked stale.
+func (v *issueDetailView) rebuild() {
+	v.buildViewport()
+	v.stale = false
+	if v.gotoOldest {
+		v.viewport.GotoBottom()
+		v.gotoOldest = false
+	}
+}
+
 // renderContent builds the full detail text.
 func (v *issueDetailView) renderContent() string {
 	issue := v.issue
@@ -194,7 +215,7 @@ func (v *issueDetailView) renderContent() string {
 		meta = append(meta, issueTypeColor(fields.IssueType.Name).Render(fields.IssueType.Name))
 	}
 	if fields.Status != nil {
-		meta = append(meta, statusColor(fields.Status).Render(fields.Status.Name)+detailHintStyle.Render("(s)"))
+		meta = append(meta, statusColor(fields.Status).Render(statusLabel(fields.Status.Name))+detailHintStyle.Render("(s)"))
 	}
 	if fields.Priority != nil {
 		meta = append(meta, priorityLabel(fields.Priority.Name)+detailHintStyle.Render("(p)"))
@@ -204,6 +225,12 @@ func (v *issueDetailView) renderContent() string {
 		b.WriteString("\n")
 	}
 
+	// Last updated by X 2h ago (field: status)
+	if line := lastUpdatedLine(v.lastChange, fields.Updated, clockNow(v.clock)); line != "" {
+		b.WriteString(detailTypeStyle.Render(line))
+		b.WriteString("\n")
+	}
+
 	b.WriteString("\n")
 
 	// Description (e)
@@ -211,7 +238,7 @@ func (v *issueDetailView) renderContent() string {
 		b.WriteString(detailSectionStyle.Render("Description") + " " + detailHintStyle.Render("(e)") + "\n")
 		b.WriteString(detailTypeStyle.Render("Loading…") + "\n")
 	} else {
-		desc := extractADFText(fields.Description)
+		desc := renderADF(fields.Description, adfOptions{width: maxWidth, people: v.people, styled: true, projects: v.projects})
 		if desc != "" {
 			b.WriteString(detailSectionStyle.Render("Description") + " " + detailHintStyle.Render("(e)") + "\n")
 			b.WriteString(desc)
@@ -239,6 +266,9 @@ func (v *issueDetailView) renderContent() string {
 	if fields.DueDate != "" {
 		b.WriteString(renderFieldStyled("Due Date", formatDetailDate(fields.DueDate), detailDueDateStyle))
 	}
+	if v.watchers != nil {
+		b.WriteString(renderFieldHint("Watchers", watchersValue(v.watchers, v.people.me), "W"))
+	}
 
 	// Subtasks (only available from full fetch)
 	if v.loading {
@@ -309,6 +339,18 @@ func (v *issueDetailView) renderContent() string {
 		}
 	}
 
+	// Web Links (w)
+	if len(v.remoteLinks) > 0 {
+		b.WriteString("\n")
+		b.WriteString(renderRemoteLinks(v.remoteLinks, maxWidth))
+	}
+
+	// Development: pull requests, branches, commits
+	if !v.dev.Empty() {
+		b.WriteString("\n")
+		b.WriteString(renderDevInfo(v.dev, maxWidth))
+	}
+
 	// Parent (standalone section if not shown in header, only from full fetch)
 	if !v.loading && fields.Parent != nil {
 		b.WriteString("\n")
@@ -327,7 +369,11 @@ func (v *issueDetailView) renderContent() string {
 		b.WriteString(detailTypeStyle.Render("  Loading…") + "\n")
 	} else if len(v.comments) > 0 {
 		b.WriteString("\n")
-		b.WriteString(renderSection(fmt.Sprintf("Comments (%d)", len(v.comments)), maxWidth))
+		heading := fmt.Sprintf("Comments (%d)", len(v.comments))
+		if v.commentsTotal > len(v.comments) {
+			heading = fmt.Sprintf("Comments (%d of %d)", len(v.comments), v.commentsTotal)
+		}
+		b.WriteString(renderSection(heading, maxWidth))
 		for i, c := range v.comments {
 			author := "Unknown"
 			if c.Author != nil {
@@ -338,7 +384,7 @@ func (v *issueDetailView) renderContent() string {
 				lipgloss.NewStyle().Bold(true).Render(author),
 				detailTypeStyle.Render(date),
 			))
-			body := extractADFText(c.Body)
+			body := renderADF(c.Body, adfOptions{width: maxWidth - 2, people: v.people, styled: true, projects: v.projects})
 			if body != "" {
 				// Indent comment body
 				for _, line := range strings.Split(body, "\n") {
@@ -349,6 +395,13 @@ func (v *issueDetailView) renderContent() string {
 				b.WriteString("\n")
 			}
 		}
+		if older := v.commentsTotal - len(v.comments); v.olderLoading {
+			b.WriteString("\n" + detailTypeStyle.Render("  Loading older comments…") + "\n")
+		} else if older > 0 {
+			b.WriteString("\n" + detailHintStyle.Render(fmt.Sprintf(
+				"  %d older comments · O: load %d more  G: load all, jump to the oldest",
+				older, min(older, jira.CommentsPageSize))) + "\n")
+		}
 	}
 
 	return b.String()
@@ -467,6 +520,69 @@ func formatDetailDate(s string) string {
 	return s
 }
 
+// lastUpdatedLine describes the most recent change, falling back to the
+// issue's updated timestamp until the changelog has loaded.
+func lastUpdatedLine(change *jira.ChangeHistory, updated string, now time.Time) string {
+	if change == nil {
+		if t, ok := parseJiraTime(updated); ok {
+			return "Updated " + timeAgo(t, now)
+		}
+		return ""
+	}
+
+	line := "Last updated"
+	if change.Author != nil && change.Author.DisplayName != "" {
+		line += " by " + change.Author.DisplayName
+	}
+	if t, ok := parseJiraTime(change.Created); ok {
+		line += " " + timeAgo(t, now)
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, item := range change.Items {
+		if item.Field != "" && !seen[item.Field] {
+			seen[item.Field] = true
+			names = append(names, item.Field)
+		}
+	}
+	switch len(names) {
+	case 0:
+	case 1:
+		line += " (field: " + names[0] + ")"
+	default:
+		line += " (fields: " + strings.Join(names, ", ") + ")"
+	}
+	return line
+}
+
+// parseJiraTime parses Jira's ISO 8601 timestamps, e.g.
+// "2025-07-01T10:23:45.000+0000".
+func parseJiraTime(s string) (time.Time, bool) {
+	t, err := time.Parse("2006-01-02T15:04:05.000-0700", s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// timeAgo formats the time since t in the largest whole unit ("5m ago",
+// "2h ago", "3d ago"), switching to a date after a month.
+func timeAgo(t, now time.Time) string {
+	d := now.Sub(t)
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	case d < 30*24*time.Hour:
+		return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	}
+	return "on " + t.Format("2006-01-02")
+}
+
 // Relation tag styles for the related-issues picker.
 var (
 	relParentStyle = lipgloss.NewStyle().
@@ -476,6 +592,8 @@ var (
 			Foreground(lipgloss.Color("10")) // green
 	relLinkStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("11")) // yellow
+	relRefStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("245")) // gray
 )
 
 // relationTag renders a colored bracketed label.
@@ -552,7 +670,34 @@ func (v *issueDetailView) relatedIssues() []selectionItem {
 		}
 	}
 
+	// 5. Issue keys in the description and comments
+	listed := map[string]bool{v.issue.Key: true}
+	for _, item := range items {
+		listed[item.ID] = true
+	}
+	for _, key := range v.mentionedKeys() {
+		if listed[key] {
+			continue
+		}
+		listed[key] = true
+		items = append(items, selectionItem{
+			ID:      key,
+			Label:   key + " Referenced",
+			Icon:    relRefStyle.Render("#"),
+			Display: relationTag("Referenced", relRefStyle) + "  " + detailKeyStyle.Render(key),
+			Desc:    "Referenced",
+		})
+	}
+
 	return items
 }
 
-
+// mentionedKeys returns the issue keys written in the description and the
+// loaded comments.
+func (v *issueDetailView) mentionedKeys() []string {
+	texts := []string{extractADFText(v.issue.Fields.Description)}
+	for _, c := range v.comments {
+		texts = append(texts, extractADFText(c.Body))
+	}
+	return issueKeysIn(strings.Join(texts, "\n"), v.projects)
+}
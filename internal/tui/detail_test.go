@@ -3,6 +3,7 @@ package tui
 import (
 	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 
@@ -14,11 +15,11 @@ func testDetailIssue() jira.Issue {
 	return jira.Issue{
 		Key: "TEST-42",
 		Fields: jira.IssueFields{
-			Summary:  "Fix the widget",
-			Status:   &jira.Status{Name: "In Progress", StatusCategory: &jira.StatusCategory{Key: "indeterminate"}},
-			Assignee: &jira.User{DisplayName: "Alice"},
-			Reporter: &jira.User{DisplayName: "Bob"},
-			Priority: &jira.Named{Name: "High"},
+			Summary:   "Fix the widget",
+			Status:    &jira.Status{Name: "In Progress", StatusCategory: &jira.StatusCategory{Key: "indeterminate"}},
+			Assignee:  &jira.User{DisplayName: "Alice"},
+			Reporter:  &jira.User{DisplayName: "Bob"},
+			Priority:  &jira.Named{Name: "High"},
 			IssueType: &jira.Named{Name: "Bug"},
 			Project:   &jira.Named{Name: "Test Project"},
 			Labels:    []string{"backend", "urgent"},
@@ -391,3 +392,189 @@ func TestRelatedIssuesEmpty(t *testing.T) {
 		t.Errorf("expected 0 related issues, got %d", len(items))
 	}
 }
+
+func TestRelatedIssuesReferencedKeys(t *testing.T) {
+	issue := testDetailIssue()
+	issue.Fields.Description = "Follow-up to " + issue.Key + " and PROJ-7, see LINK-1"
+	issue.Fields.IssueLinks = []jira.IssueLink{{
+		Type:         jira.LinkType{Outward: "blocks"},
+		OutwardIssue: &jira.Issue{Key: "LINK-1", Fields: jira.IssueFields{Summary: "Blocked issue"}},
+	}}
+	dv := newIssueDetailViewReady(issue, 80, 24)
+	dv.comments = []jira.Comment{{ID: "1", Body: "Also PROJ-8 and OTHER-1"}}
+	dv.projects = map[string]bool{"PROJ": true, "LINK": true}
+
+	var got []string
+	for _, item := range dv.relatedIssues() {
+		got = append(got, item.ID+" "+item.Desc)
+	}
+	// The issue itself and already-listed links aren't repeated; other
+	// projects' keys aren't links
+	want := []string{"LINK-1 blocks", "PROJ-7 Referenced", "PROJ-8 Referenced"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestDetailViewRebuildKeepsScroll(t *testing.T) {
+	issue := testDetailIssue()
+	dv := newIssueDetailViewReady(issue, 80, 10)
+	dv.comments = make([]jira.Comment, 20)
+	dv.rebuild()
+	dv.viewport.SetYOffset(5)
+
+	dv.stale = true
+	dv.rebuild()
+	if dv.viewport.YOffset != 5 {
+		t.Errorf("YOffset = %d, want 5", dv.viewport.YOffset)
+	}
+	if dv.stale {
+		t.Error("expected stale to be cleared")
+	}
+
+	// Shorter content clamps instead of scrolling past the end
+	dv.comments = nil
+	dv.rebuild()
+	if dv.viewport.PastBottom() {
+		t.Error("expected offset to be clamped to the new content")
+	}
+}
+
+func TestDetailFetchResultsCoalesce(t *testing.T) {
+	app := testAppReady()
+	issue := testDetailIssue()
+	dv := newIssueDetailView(issue, "", app.width, app.height)
+	app.viewStack = append(app.viewStack, &dv)
+
+	model, cmd := app.Update(issueDetailMsg{issueKey: issue.Key, issue: &issue})
+	app = model.(App)
+	if cmd == nil {
+		t.Fatal("expected a rebuild tick for the first result")
+	}
+	model, cmd = app.Update(commentsLoadedMsg{issueKey: issue.Key, comments: []jira.Comment{{ID: "1"}}})
+	app = model.(App)
+	if cmd != nil {
+		t.Error("expected no second tick while a rebuild is pending")
+	}
+	model, _ = app.Update(childrenLoadedMsg{issueKey: issue.Key})
+	app = model.(App)
+	if !dv.stale || dv.loading || dv.commentsLoading || dv.childrenLoading {
+		t.Errorf("stale=%v loading=%v/%v/%v", dv.stale, dv.loading, dv.commentsLoading, dv.childrenLoading)
+	}
+
+	model, _ = app.Update(detailRebuildMsg{})
+	app = model.(App)
+	if dv.stale || app.rebuildScheduled {
+		t.Error("expected the frame to rebuild and clear the schedule")
+	}
+	if strings.Contains(dv.View(), "Loading") {
+		t.Error("expected rebuilt content without loading placeholders")
+	}
+}
+
+func TestDetailViewUpdatesKeepScroll(t *testing.T) {
+	issue := testDetailIssue()
+	dv := newIssueDetailViewReady(issue, 80, 10)
+	dv.comments = make([]jira.Comment, 20)
+	dv.buildViewport()
+	dv.viewport.SetYOffset(6)
+
+	dv.updateIssue(issue)
+	if dv.viewport.YOffset != 6 {
+		t.Errorf("after updateIssue YOffset = %d, want 6", dv.viewport.YOffset)
+	}
+
+	dv.setSize(80, 12)
+	if dv.viewport.YOffset != 6 {
+		t.Errorf("after setSize YOffset = %d, want 6", dv.viewport.YOffset)
+	}
+
+	// Content shrinking below the offset clamps to the last page
+	dv.comments = nil
+	dv.buildViewport()
+	if dv.viewport.PastBottom() {
+		t.Errorf("YOffset %d is past the end of the content", dv.viewport.YOffset)
+	}
+}
+
+func TestNewDetailViewStartsAtTop(t *testing.T) {
+	dv := newIssueDetailViewReady(testDetailIssue(), 80, 10)
+	if dv.viewport.YOffset != 0 {
+		t.Errorf("YOffset = %d, want 0", dv.viewport.YOffset)
+	}
+}
+
+func TestLastUpdatedLine(t *testing.T) {
+	now := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	twoHoursAgo := "2025-07-01T10:00:00.000+0000"
+	tests := []struct {
+		name    string
+		change  *jira.ChangeHistory
+		updated string
+		want    string
+	}{
+		{"no changelog yet", nil, twoHoursAgo, "Updated 2h ago"},
+		{"nothing known", nil, "", ""},
+		{
+			"single field",
+			&jira.ChangeHistory{
+				Author:  &jira.User{DisplayName: "Alice"},
+				Created: twoHoursAgo,
+				Items:   []jira.ChangeItem{{Field: "status"}},
+			},
+			"", "Last updated by Alice 2h ago (field: status)",
+		},
+		{
+			"several fields",
+			&jira.ChangeHistory{
+				Author:  &jira.User{DisplayName: "Bob"},
+				Created: "2025-07-01T11:55:00.000+0000",
+				Items:   []jira.ChangeItem{{Field: "status"}, {Field: "resolution"}, {Field: "status"}},
+			},
+			"", "Last updated by Bob 5m ago (fields: status, resolution)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lastUpdatedLine(tt.change, tt.updated, now); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeAgo(t *testing.T) {
+	now := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		ago  time.Duration
+		want string
+	}{
+		{10 * time.Second, "just now"},
+		{5 * time.Minute, "5m ago"},
+		{3 * time.Hour, "3h ago"},
+		{50 * time.Hour, "2d ago"},
+		{60 * 24 * time.Hour, "on 2025-05-02"},
+	}
+	for _, tt := range tests {
+		if got := timeAgo(now.Add(-tt.ago), now); got != tt.want {
+			t.Errorf("timeAgo(-%s) = %q, want %q", tt.ago, got, tt.want)
+		}
+	}
+}
+
+func TestLastChangeLoadedUpdatesDetail(t *testing.T) {
+	app := testAppReady()
+	dv := newIssueDetailViewReady(testDetailIssue(), app.width, app.height)
+	app.viewStack = append(app.viewStack, &dv)
+
+	change := &jira.ChangeHistory{Author: &jira.User{DisplayName: "Carol"}, Items: []jira.ChangeItem{{Field: "assignee"}}}
+	model, cmd := app.Update(lastChangeLoadedMsg{issueKey: "TEST-42", change: change})
+	app = model.(App)
+	if cmd == nil || dv.lastChange != change {
+		t.Fatal("expected the change to be stored and a rebuild scheduled")
+	}
+	app.Update(detailRebuildMsg{})
+	if !strings.Contains(dv.View(), "Last updated by Carol (field: assignee)") {
+		t.Errorf("expected last-updated line in view:\n%s", dv.View())
+	}
+}
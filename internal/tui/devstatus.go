@@ -0,0 +1,113 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// maxDevCommits is how many commits the Development section lists before
+// summarizing the rest.
+const maxDevCommits = 5
+
+var (
+	prOpenStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("10")) // green
+	prMergedStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("13")) // magenta
+	prDeclinedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))  // red
+)
+
+// devInfoLoadedMsg delivers the development work linked to an issue.
+type devInfoLoadedMsg struct {
+	issueKey string
+	info     *jira.DevInfo
+	err      error
+}
+
+// cmdFetchDevInfo fetches the pull requests, branches, and commits linked
+// to an issue for the detail view.
+func (a App) cmdFetchDevInfo(issue jira.Issue) tea.Cmd {
+	if a.client == nil || issue.ID == "" || a.unavailable[capDevStatus] {
+		return nil
+	}
+	client := a.client
+	return func() tea.Msg {
+		info, err := client.GetDevInfo(context.Background(), issue.ID)
+		return devInfoLoadedMsg{issueKey: issue.Key, info: info, err: err}
+	}
+}
+
+// prStatusLabel renders a pull request's status, colored by outcome.
+func prStatusLabel(status string) string {
+	switch strings.ToUpper(status) {
+	case "OPEN":
+		return prOpenStyle.Render("OPEN")
+	case "MERGED":
+		return prMergedStyle.Render("MERGED")
+	case "DECLINED":
+		return prDeclinedStyle.Render("DECLINED")
+	}
+	return detailTypeStyle.Render(strings.ToUpper(status))
+}
+
+// renderDevInfo renders the Development section: pull requests with their
+// state and branches, then branches, then the latest commits.
+func renderDevInfo(info *jira.DevInfo, maxWidth int) string {
+	var b strings.Builder
+	b.WriteString(renderSection("Development", maxWidth))
+	if len(info.PullRequests) > 0 {
+		b.WriteString(detailTypeStyle.Render(fmt.Sprintf("  Pull requests (%d)", len(info.PullRequests))) + "\n")
+		for _, pr := range info.PullRequests {
+			line := fmt.Sprintf("    %s %s %s", prStatusLabel(pr.Status), detailKeyStyle.Render(pr.ID), pr.Name)
+			if pr.Source.Branch != "" && pr.Destination.Branch != "" {
+				line += "  " + detailHintStyle.Render(pr.Source.Branch+" → "+pr.Destination.Branch)
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+	if len(info.Branches) > 0 {
+		b.WriteString(detailTypeStyle.Render(fmt.Sprintf("  Branches (%d)", len(info.Branches))) + "\n")
+		for _, br := range info.Branches {
+			line := "    " + br.Name
+			if br.Repository.Name != "" {
+				line += "  " + detailHintStyle.Render(br.Repository.Name)
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+	if len(info.Commits) > 0 {
+		b.WriteString(detailTypeStyle.Render(fmt.Sprintf("  Commits (%d)", len(info.Commits))) + "\n")
+		for i, c := range info.Commits {
+			if i == maxDevCommits {
+				b.WriteString(detailHintStyle.Render(fmt.Sprintf("    +%d more", len(info.Commits)-maxDevCommits)) + "\n")
+				break
+			}
+			// The first line of the message is its subject
+			subject, _, _ := strings.Cut(c.Message, "\n")
+			line := fmt.Sprintf("    %s %s", detailKeyStyle.Render(c.DisplayID), truncateRunes(subject, maxWidth-20))
+			if c.Author.Name != "" {
+				line += "  " + detailHintStyle.Render(c.Author.Name)
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+	return b.String()
+}
+
+// pullRequestLinks lists the pull requests for the web link picker.
+func pullRequestLinks(info *jira.DevInfo) []selectionItem {
+	if info == nil {
+		return nil
+	}
+	var items []selectionItem
+	for _, pr := range info.PullRequests {
+		if pr.URL != "" {
+			items = append(items, selectionItem{ID: pr.URL, Label: pr.ID + " " + pr.Name, Desc: "Pull request · " + strings.ToLower(pr.Status)})
+		}
+	}
+	return items
+}
@@ -0,0 +1,63 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func testDevInfo() *jira.DevInfo {
+	info := &jira.DevInfo{
+		PullRequests: []jira.DevPullRequest{{ID: "#12", Name: "Fix widget", Status: "MERGED", URL: "https://github.com/o/r/pull/12"}},
+		Branches:     []jira.DevBranch{{Name: "PROJ-1-fix-widget"}},
+	}
+	info.PullRequests[0].Source.Branch = "PROJ-1-fix-widget"
+	info.PullRequests[0].Destination.Branch = "main"
+	info.Branches[0].Repository.Name = "o/r"
+	for i := 0; i < 7; i++ {
+		info.Commits = append(info.Commits, jira.DevCommit{DisplayID: fmt.Sprintf("abc%d", i), Message: "Commit " + fmt.Sprint(i) + "\n\nbody"})
+	}
+	return info
+}
+
+func TestRenderDevInfo(t *testing.T) {
+	out := renderDevInfo(testDevInfo(), 80)
+	for _, want := range []string{
+		"Development", "Pull requests (1)", "MERGED", "#12", "Fix widget", "PROJ-1-fix-widget → main",
+		"Branches (1)", "o/r", "Commits (7)", "abc0", "Commit 4", "+2 more",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("section missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Commit 5") || strings.Contains(out, "body") {
+		t.Errorf("only the first five commit subjects should show:\n%s", out)
+	}
+}
+
+func TestDevInfoInDetail(t *testing.T) {
+	app := testAppConnected()
+	issue := app.tabs[0].issues[0]
+	issue.ID = "10001"
+	before := app.inflight
+	if cmd := app.openDetail(issue); cmd == nil || app.inflight != before+7 {
+		t.Errorf("inflight = %d, want the dev info counted too", app.inflight-before)
+	}
+	dv := app.topDetail(issue.Key)
+	if strings.Contains(dv.renderContent(), "Development") {
+		t.Error("no Development section before anything is linked")
+	}
+
+	model, _ := app.Update(devInfoLoadedMsg{issueKey: issue.Key, info: testDevInfo()})
+	app = model.(App)
+	if !strings.Contains(dv.renderContent(), "Pull requests (1)") {
+		t.Error("the detail view should show the linked pull requests")
+	}
+
+	// The pull request opens from the web link picker
+	if items := pullRequestLinks(dv.dev); len(items) != 1 || items[0].ID != "https://github.com/o/r/pull/12" {
+		t.Errorf("links = %+v, want the pull request", items)
+	}
+}
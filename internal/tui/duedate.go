@@ -0,0 +1,52 @@
+package tui
+
+import (
+	"regexp"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// overdueMarker prefixes the due date of overdue issues in the list.
+const overdueMarker = "! "
+
+// overduePattern matches a marked due date in a rendered table.
+var overduePattern = regexp.MustCompile(regexp.QuoteMeta(overdueMarker) + `\d{4}-\d{2}-\d{2}`)
+
+// isOverdue reports whether an issue that isn't done was due before today.
+func isOverdue(issue jira.Issue, now time.Time) bool {
+	due := formatDate(issue.Fields.DueDate)
+	return due != "" && due < now.Format(dateLayout) && categoryRank(issue.Fields.Status) != doneRank
+}
+
+// colorizeOverdue colors the marked due dates in a rendered table red.
+func colorizeOverdue(rendered string) string {
+	return overduePattern.ReplaceAllStringFunc(rendered, func(s string) string {
+		return ansiColorText(s, "9")
+	})
+}
+
+// startDueDateEdit opens the date input on an issue's due date.
+func (a App) startDueDateEdit(issue *jira.Issue) (tea.Model, tea.Cmd) {
+	a.overlay = newDateInputOverlay("Due Date of "+issue.Key, formatDate(issue.Fields.DueDate), a.now())
+	a.overlayIssue = issue.Key
+	a.overlayAction = overlayActionDueDate
+	return a, nil
+}
+
+// setDueDate saves the picked due date, or clears it when date is "".
+func (a App) setDueDate(issueKey, date string) (tea.Model, tea.Cmd) {
+	var value interface{}
+	if date == "" {
+		a.flash = "Clearing due date of " + issueKey + "..."
+	} else {
+		value = date
+		a.flash = "Setting due date of " + issueKey + " to " + date + "..."
+	}
+	a.flashIsErr = false
+	return a, a.trackWrite(writeUpdate, issueKey, a.cmdUpdateField(issueKey, map[string]interface{}{
+		"duedate": value,
+	}))
+}
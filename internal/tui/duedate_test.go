@@ -0,0 +1,114 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestDateInputValidates(t *testing.T) {
+	today := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	d := newDateInputOverlay("Due Date", "", today)
+	typeText(d, "2026-13-01")
+	d.Update(keyMsg("enter"))
+	if isDone, _ := d.done(); isDone || d.errMsg == "" {
+		t.Fatalf("an invalid date should stay open with an error, err = %q", d.errMsg)
+	}
+	if !strings.Contains(d.View(80, 30), "YYYY-MM-DD") {
+		t.Error("the error should name the format")
+	}
+
+	d.input.SetValue("2026-11-03")
+	d.Update(keyMsg("enter"))
+	if isDone, result := d.done(); !isDone || result != "2026-11-03" {
+		t.Errorf("done = %v, %v; want the typed date", isDone, result)
+	}
+
+	d = newDateInputOverlay("Due Date", "2026-10-20", today)
+	d.input.SetValue("")
+	d.Update(keyMsg("enter"))
+	if _, result := d.done(); result != "" {
+		t.Errorf("result = %v, want \"\" to clear the date", result)
+	}
+}
+
+func TestDateInputShifts(t *testing.T) {
+	d := newDateInputOverlay("Due Date", "", time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC))
+	d.Update(keyMsg("down"))
+	if got := d.input.Value(); got != "2026-10-17" {
+		t.Errorf("down from empty = %q, want tomorrow", got)
+	}
+	d.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	if got := d.input.Value(); got != "2026-10-24" {
+		t.Errorf("pgdown = %q, want a week later", got)
+	}
+	d.Update(keyMsg("up"))
+	if got := d.input.Value(); got != "2026-10-23" {
+		t.Errorf("up = %q, want a day earlier", got)
+	}
+	cal := d.calendar()
+	if !strings.Contains(cal, "October 2026") || !strings.Contains(cal, "31") {
+		t.Errorf("calendar should show the month:\n%s", cal)
+	}
+}
+
+func TestDueDateHotkey(t *testing.T) {
+	app := testAppConnected()
+	app.tabs[0].issues[0].Fields.DueDate = "2026-10-20"
+	app.tabs[0].setIssues(app.tabs[0].issues)
+
+	model, _ := app.Update(keyMsg("U"))
+	app = model.(App)
+	d, ok := app.overlay.(*dateInputOverlay)
+	if !ok || app.overlayAction != overlayActionDueDate {
+		t.Fatalf("overlay = %T, want the date input", app.overlay)
+	}
+	if d.input.Value() != "2026-10-20" {
+		t.Errorf("input = %q, want the current due date", d.input.Value())
+	}
+
+	model, cmd := app.handleOverlayResult("2026-10-27")
+	app = model.(App)
+	if cmd == nil || app.flash != "Setting due date of PROJ-1 to 2026-10-27..." {
+		t.Errorf("flash = %q, want the update started", app.flash)
+	}
+
+	app.overlayIssue, app.overlayAction = "PROJ-1", overlayActionDueDate
+	model, cmd = app.handleOverlayResult("")
+	app = model.(App)
+	if cmd == nil || app.flash != "Clearing due date of PROJ-1..." {
+		t.Errorf("flash = %q, want the date cleared", app.flash)
+	}
+}
+
+func TestOverdueDueDates(t *testing.T) {
+	tab := newTab(config.TabConfig{Label: "D", JQL: "x", Columns: []string{"key", "duedate"}})
+	tab.clock = &fakeClock{t: time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)}
+	tab.setSize(100, 20)
+	tab.setIssues([]jira.Issue{
+		{Key: "D-1", Fields: jira.IssueFields{DueDate: "2026-10-15", Status: statusToDo}},
+		{Key: "D-2", Fields: jira.IssueFields{DueDate: "2026-10-15", Status: statusDone}},
+		{Key: "D-3", Fields: jira.IssueFields{DueDate: "2026-10-16", Status: statusProgress}},
+		{Key: "D-4"},
+	})
+	rows := tab.table.Rows()
+	want := []string{"! 2026-10-15", "2026-10-15", "2026-10-16", ""}
+	for i, w := range want {
+		if rows[i][1] != w {
+			t.Errorf("row %d due = %q, want %q", i, rows[i][1], w)
+		}
+	}
+
+	rendered := colorizeOverdue(tab.table.View())
+	if !strings.Contains(rendered, ansiColorText("! 2026-10-15", "9")) {
+		t.Errorf("the overdue date should be red:\n%q", rendered)
+	}
+	if strings.Count(rendered, "\x1b[38;5;9m") != 1 {
+		t.Error("only the overdue date should be red")
+	}
+}
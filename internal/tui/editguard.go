@@ -0,0 +1,61 @@
+package tui
+
+import (
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// SetConfirmEdits makes transitions, assignments, and priority changes
+// ask before they are sent, like deletes do.
+func (a *App) SetConfirmEdits(on bool) {
+	a.confirmEdits = on
+}
+
+// SetReadOnly turns off every action that would change Jira. The client
+// refuses writes too; this stops them before any overlay opens.
+func (a *App) SetReadOnly(on bool) {
+	a.readOnly = on
+}
+
+// refuseReadOnly reports whether a write is off in read-only mode,
+// saying so.
+func (a *App) refuseReadOnly() bool {
+	if !a.readOnly {
+		return false
+	}
+	a.flash = "Read-only mode: edits are off (--read-only)"
+	a.flashIsErr = true
+	return true
+}
+
+// confirmEdit runs apply now, or once question is answered yes when
+// confirm_edits is on.
+func (a App) confirmEdit(question string, apply func(App) (tea.Model, tea.Cmd)) (tea.Model, tea.Cmd) {
+	if !a.confirmEdits {
+		return apply(a)
+	}
+	a.overlay = newConfirmOverlay(question)
+	a.overlayAction = overlayActionConfirmEdit
+	a.confirmApply = apply
+	return a, nil
+}
+
+// handleEditConfirmed applies the edit the user said yes to.
+func (a App) handleEditConfirmed() (tea.Model, tea.Cmd) {
+	apply := a.confirmApply
+	a.confirmApply = nil
+	if apply == nil {
+		return a, nil
+	}
+	return apply(a)
+}
+
+// editSubject names what an edit applies to in its confirmation: the
+// issue, or how many are selected.
+func editSubject(issueKey string, bulkKeys []string) string {
+	if len(bulkKeys) > 0 {
+		return strconv.Itoa(len(bulkKeys)) + " issues"
+	}
+	return issueKey
+}
@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestConfirmEdits(t *testing.T) {
+	app := testAppConnected()
+	app.SetConfirmEdits(true)
+	app.overlayIssue = "PROJ-1"
+	app.overlayAction = overlayActionAssignee
+	model, cmd := app.handleOverlayResult(&selectionItem{ID: "ana", Label: "Ana"})
+	app = model.(App)
+	if _, ok := app.overlay.(*confirmOverlay); !ok || cmd != nil || len(app.pending) != 0 {
+		t.Fatalf("assigning should ask first, overlay = %T", app.overlay)
+	}
+
+	// No leaves the issue alone
+	model, _ = app.Update(keyMsg("n"))
+	app = model.(App)
+	if app.overlay != nil || len(app.pending) != 0 || app.confirmApply != nil {
+		t.Fatalf("cancel should drop the edit, pending = %d", len(app.pending))
+	}
+
+	app.overlayIssue = "PROJ-1"
+	app.overlayAction = overlayActionAssignee
+	model, _ = app.handleOverlayResult(&selectionItem{ID: "ana", Label: "Ana"})
+	app = model.(App)
+	model, cmd = app.Update(keyMsg("y"))
+	app = model.(App)
+	if cmd == nil || len(app.pending) != 1 || app.flash != "Assigning PROJ-1..." {
+		t.Errorf("yes should assign, flash = %q", app.flash)
+	}
+}
+
+func TestConfirmEditsOff(t *testing.T) {
+	app := testAppConnected()
+	app.user = &jira.User{AccountID: "me"}
+	model, cmd := app.Update(keyMsg("i"))
+	app = model.(App)
+	if cmd == nil || app.overlay != nil {
+		t.Errorf("without confirm_edits i should assign at once, overlay = %T", app.overlay)
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	app := testAppConnected()
+	app.SetReadOnly(true)
+	for _, key := range []string{"s", "i", "c", "J"} {
+		model, cmd := app.Update(keyMsg(key))
+		got := model.(App)
+		if cmd != nil || got.overlay != nil || !got.flashIsErr {
+			t.Errorf("%s: should be refused in read-only mode, flash = %q", key, got.flash)
+		}
+	}
+	model, _ := app.Update(keyMsg("y"))
+	if got := model.(App); got.flashIsErr && got.flash == "Read-only mode: edits are off (--read-only)" {
+		t.Error("copying the key should still work")
+	}
+}
+
+func TestConfirmEditsBulkHotkeys(t *testing.T) {
+	for _, key := range []string{"d", "i"} {
+		t.Run(key, func(t *testing.T) {
+			app := testAppConnected()
+			app.user = &jira.User{AccountID: "me"}
+			app.SetConfirmEdits(true)
+			keys := []string{"PROJ-1", "PROJ-2"}
+
+			model, cmd := app.handleBulkHotkey(keyMsg(key), keys)
+			app = model.(App)
+			c, ok := app.overlay.(*confirmOverlay)
+			if !ok || cmd != nil || app.bulk != nil {
+				t.Fatalf("bulk %s should ask first, overlay = %T", key, app.overlay)
+			}
+			if !strings.Contains(c.message, "2 issues") {
+				t.Errorf("question = %q, want it to name the selection size", c.message)
+			}
+
+			model, cmd = app.Update(keyMsg("y"))
+			app = model.(App)
+			if cmd == nil || app.bulk == nil || app.bulk.total != 2 {
+				t.Errorf("yes should start the bulk edit, bulk = %+v", app.bulk)
+			}
+		})
+	}
+}
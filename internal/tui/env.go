@@ -0,0 +1,63 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/atotto/clipboard"
+)
+
+// Clipboard receives the text the TUI copies: issue keys, URLs, exports.
+type Clipboard interface {
+	WriteAll(text string) error
+}
+
+// Clock tells the time for relative dates ("updated 2m ago"), cache ages,
+// and refresh schedules.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClipboard is the OS clipboard.
+type systemClipboard struct{}
+
+func (systemClipboard) WriteAll(text string) error { return clipboard.WriteAll(text) }
+
+// systemClock is the wall clock.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// SetClipboard replaces the OS clipboard, e.g. to copy over the terminal in
+// remote sessions. Tests use it to capture what is copied.
+func (a *App) SetClipboard(c Clipboard) {
+	a.clipboard = c
+}
+
+// SetClock replaces the wall clock, so tests render fixed relative dates.
+func (a *App) SetClock(c Clock) {
+	a.clock = c
+	for i := range a.tabs {
+		a.tabs[i].clock = c
+	}
+}
+
+// copyText writes text to the clipboard.
+func (a App) copyText(text string) error {
+	if a.clipboard == nil {
+		return systemClipboard{}.WriteAll(text)
+	}
+	return a.clipboard.WriteAll(text)
+}
+
+// now returns the current time from the clock.
+func (a App) now() time.Time {
+	return clockNow(a.clock)
+}
+
+// clockNow returns c's time, or the wall clock's when c is nil.
+func clockNow(c Clock) time.Time {
+	if c == nil {
+		return time.Now()
+	}
+	return c.Now()
+}
@@ -0,0 +1,66 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// fakeClock is a Clock that only moves when told to.
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.t }
+
+// fakeClipboard is a Clipboard that records what was copied.
+type fakeClipboard struct{ copied []string }
+
+func (c *fakeClipboard) WriteAll(text string) error {
+	c.copied = append(c.copied, text)
+	return nil
+}
+
+func TestClockDrivesRelativeTimes(t *testing.T) {
+	clock := &fakeClock{t: time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)}
+	app := testAppWithTabs()
+	app.SetClock(clock)
+	model, _ := app.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	app = model.(App)
+	issues := []jira.Issue{{Key: "PROJ-1", Fields: jira.IssueFields{Summary: "Fix login page"}}}
+	model, _ = app.Update(tabDataMsg{tabIndex: 0, issues: issues})
+	app = model.(App)
+	app.tabs[0].refreshEvery = time.Minute
+
+	if !app.tabs[0].fetchedAt.Equal(clock.t) {
+		t.Errorf("expected the load to be stamped %v, got %v", clock.t, app.tabs[0].fetchedAt)
+	}
+	if !app.isFresh("PROJ-1") {
+		t.Error("expected a just-loaded issue to be fresh")
+	}
+
+	clock.t = clock.t.Add(5 * time.Minute)
+	if !strings.Contains(app.View(), "updated 5m ago") {
+		t.Error("expected the status bar to age with the clock")
+	}
+	if app.isFresh("PROJ-1") {
+		t.Error("expected the issue to go stale as the clock moves")
+	}
+}
+
+func TestClipboardReceivesCopies(t *testing.T) {
+	var board fakeClipboard
+	app := testAppReady()
+	app.SetClipboard(&board)
+
+	model, _ := app.Update(keyMsg("y"))
+	app = model.(App)
+	if len(board.copied) != 1 || board.copied[0] != "PROJ-1" {
+		t.Errorf("expected PROJ-1 to be copied, got %q", board.copied)
+	}
+	if app.flash != "Copied PROJ-1" {
+		t.Errorf("unexpected flash %q", app.flash)
+	}
+}
@@ -0,0 +1,138 @@
+package tui
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// exportChoices are the export overlay's options; IDs are "format:target".
+var exportChoices = []selectionItem{
+	{ID: "csv:file", Label: "CSV file"},
+	{ID: "markdown:file", Label: "Markdown file"},
+	{ID: "csv:clipboard", Label: "CSV to clipboard"},
+	{ID: "markdown:clipboard", Label: "Markdown table to clipboard"},
+}
+
+// exportExtensions are the file extensions of the export formats.
+var exportExtensions = map[string]string{"csv": ".csv", "markdown": ".md"}
+
+// exportIssues returns the issues the active tab shows, quick filter
+// applied and in display order, including those in collapsed groups.
+func (a App) exportIssues() []jira.Issue {
+	if a.activeTab >= len(a.tabs) {
+		return nil
+	}
+	t := a.tabs[a.activeTab]
+	return t.quickFilter.visibleIssues(t.issues)
+}
+
+// startExport asks how to export the active tab's visible issues.
+func (a App) startExport() (tea.Model, tea.Cmd) {
+	if a.activeTab >= len(a.tabs) || a.tabs[a.activeTab].state != tabReady {
+		return a, nil
+	}
+	n := len(a.exportIssues())
+	if n == 0 {
+		a.flash = "Nothing to export"
+		a.flashIsErr = false
+		return a, nil
+	}
+	title := fmt.Sprintf("Export %s (%d issues)", a.tabs[a.activeTab].config.Label, n)
+	a.overlay = newSelectionOverlay(title, exportChoices)
+	a.overlayIssue = ""
+	a.overlayAction = overlayActionExport
+	return a, nil
+}
+
+// handleExportChoice copies the export to the clipboard, or asks for the
+// file to write it to.
+func (a App) handleExportChoice(item *selectionItem) (tea.Model, tea.Cmd) {
+	format, target, _ := strings.Cut(item.ID, ":")
+	if target == "file" {
+		a.exportFormat = format
+		name := exportFileName(a.tabs[a.activeTab].config.Label) + exportExtensions[format]
+		a.overlay = newTextInputOverlay("Export to file", name)
+		a.overlayAction = overlayActionExportFile
+		return a, nil
+	}
+
+	var b bytes.Buffer
+	issues := a.exportIssues()
+	if err := WriteIssues(&b, issues, a.tabs[a.activeTab].columns, format); err != nil {
+		a.flash = "Export failed: " + err.Error()
+		a.flashIsErr = true
+		return a, nil
+	}
+	if err := a.copyText(b.String()); err != nil {
+		a.flash = "Clipboard unavailable"
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.flash = fmt.Sprintf("Copied %d issues as %s", len(issues), formatName(format))
+	a.flashIsErr = false
+	return a, nil
+}
+
+// exportToFile writes the export to path, relative to the working
+// directory. An existing file is replaced.
+func (a App) exportToFile(path string) (tea.Model, tea.Cmd) {
+	path = strings.TrimSpace(path)
+	format := a.exportFormat
+	a.exportFormat = ""
+	if path == "" || a.activeTab >= len(a.tabs) {
+		return a, nil
+	}
+	var b bytes.Buffer
+	issues := a.exportIssues()
+	err := WriteIssues(&b, issues, a.tabs[a.activeTab].columns, format)
+	if err == nil {
+		err = os.WriteFile(path, b.Bytes(), 0o644)
+	}
+	if err != nil {
+		a.flash = "Export failed: " + err.Error()
+		a.flashIsErr = true
+		return a, nil
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	a.flash = fmt.Sprintf("Exported %d issues to %s", len(issues), path)
+	a.flashIsErr = false
+	return a, nil
+}
+
+// exportFileName turns a tab label into a file name, e.g. "My Sprint" →
+// "my-sprint".
+func exportFileName(label string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		}
+		return '-'
+	}, label)
+	for strings.Contains(name, "--") {
+		name = strings.ReplaceAll(name, "--", "-")
+	}
+	if name = strings.Trim(name, "-"); name == "" {
+		return "issues"
+	}
+	return name
+}
+
+// formatName is how the flash names an export format.
+func formatName(format string) string {
+	if format == "csv" {
+		return "CSV"
+	}
+	return "Markdown"
+}
@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExportFileName(t *testing.T) {
+	tests := map[string]string{
+		"My Sprint":      "my-sprint",
+		"Open Bugs (P1)": "open-bugs-p1",
+		"✦✦":             "issues",
+	}
+	for label, want := range tests {
+		if got := exportFileName(label); got != want {
+			t.Errorf("exportFileName(%q) = %q, want %q", label, got, want)
+		}
+	}
+}
+
+func TestExportVisibleIssuesToFile(t *testing.T) {
+	app := testAppReady()
+	app.tabs[0].quickFilter.activate()
+	app.tabs[0].quickFilter.input.SetValue("fix")
+	app.tabs[0].quickFilter.apply(app.tabs[0].issues, app.tabs[0].columns)
+
+	model, _ := app.Update(keyMsg("E"))
+	app = model.(App)
+	if app.overlayAction != overlayActionExport {
+		t.Fatalf("expected the export overlay, got action %v", app.overlayAction)
+	}
+	if !strings.Contains(app.overlay.View(100, 30), "(2 issues)") {
+		t.Error("the export should cover the 2 filtered issues")
+	}
+
+	model, _ = app.handleOverlayResult(&selectionItem{ID: "markdown:file"})
+	app = model.(App)
+	input, ok := app.overlay.(*textInputOverlay)
+	if !ok || input.input.Value() != "sprint.md" {
+		t.Fatalf("expected the file name prompt with sprint.md, got %T", app.overlay)
+	}
+
+	path := filepath.Join(t.TempDir(), "out.md")
+	model, _ = app.handleOverlayResult(path)
+	app = model.(App)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (flash %q)", err, app.flash)
+	}
+	out := string(data)
+	if !strings.Contains(out, "| PROJ-1 | Fix login page |") || strings.Contains(out, "PROJ-2 ") {
+		t.Errorf("export =\n%s", out)
+	}
+	if !strings.Contains(app.flash, "Exported 2 issues") {
+		t.Errorf("flash = %q", app.flash)
+	}
+}
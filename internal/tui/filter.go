@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/jbeckham/jira-tui/internal/config"
 	"github.com/jbeckham/jira-tui/internal/jira"
 )
 
@@ -24,6 +25,8 @@ type issueFilter struct {
 	total    int    // total issues before filtering
 	matched  int    // issues after filtering
 	filtered []jira.Issue
+	fuzzy    bool                   // fzf-style matching instead of substrings
+	matches  map[string]cellMatches // issue key → where the query matched
 }
 
 // newIssueFilter creates an inactive filter.
@@ -56,7 +59,7 @@ func (f *issueFilter) apply(allIssues []jira.Issue, columns []string) {
 	f.query = q
 	f.state = filterApplied
 	f.input.Blur()
-	f.filtered = filterIssues(allIssues, columns, q)
+	f.filtered, f.matches = matchIssues(allIssues, columns, q, f.fuzzy)
 	f.total = len(allIssues)
 	f.matched = len(f.filtered)
 }
@@ -68,6 +71,7 @@ func (f *issueFilter) clear() {
 	f.input.SetValue("")
 	f.input.Blur()
 	f.filtered = nil
+	f.matches = nil
 	f.total = 0
 	f.matched = 0
 }
@@ -78,10 +82,11 @@ func (f *issueFilter) updateQuery(allIssues []jira.Issue, columns []string) {
 	f.query = q
 	if q == "" {
 		f.filtered = allIssues
+		f.matches = nil
 		f.total = len(allIssues)
 		f.matched = len(allIssues)
 	} else {
-		f.filtered = filterIssues(allIssues, columns, q)
+		f.filtered, f.matches = matchIssues(allIssues, columns, q, f.fuzzy)
 		f.total = len(allIssues)
 		f.matched = len(f.filtered)
 	}
@@ -105,18 +110,61 @@ func (f *issueFilter) visibleIssues(allIssues []jira.Issue) []jira.Issue {
 	return f.filtered
 }
 
-// filterIssues returns issues where any visible field contains the query (case-insensitive).
-func filterIssues(issues []jira.Issue, columns []string, query string) []jira.Issue {
-	q := strings.ToLower(query)
-	var result []jira.Issue
-	for _, issue := range issues {
-		for _, col := range columns {
-			val := fieldValue(issue, col)
-			if strings.Contains(strings.ToLower(val), q) {
-				result = append(result, issue)
-				break
+// toggleFuzzy switches between substring and fuzzy matching and reruns
+// the query.
+func (f *issueFilter) toggleFuzzy(allIssues []jira.Issue, columns []string) {
+	f.fuzzy = !f.fuzzy
+	if f.isActive() && f.query != "" {
+		f.filtered, f.matches = matchIssues(allIssues, columns, f.query, f.fuzzy)
+		f.matched = len(f.filtered)
+	}
+}
+
+// modeName names the matching mode for the filter bar.
+func (f *issueFilter) modeName() string {
+	if f.fuzzy {
+		return "fuzzy"
+	}
+	return "substring"
+}
+
+// SetQuickFilter applies the quick_filter settings: fuzzy or substring
+// matching, and whether each tab keeps its filter when left or reloaded.
+func (a *App) SetQuickFilter(cfg config.QuickFilterConfig) {
+	a.fuzzyFilter = cfg.Mode == "fuzzy"
+	a.persistFilters = cfg.Persist
+	for i := range a.tabs {
+		a.tabs[i].quickFilter.fuzzy = a.fuzzyFilter
+		a.tabs[i].keepFilter = cfg.Persist
+	}
+}
+
+// toggleFilterMode switches every tab's quick filter between substring and
+// fuzzy matching and reapplies the filters in use.
+func (a App) toggleFilterMode() App {
+	a.fuzzyFilter = !a.fuzzyFilter
+	for i := range a.tabs {
+		t := &a.tabs[i]
+		if t.quickFilter.fuzzy != a.fuzzyFilter {
+			t.quickFilter.toggleFuzzy(t.issues, t.columns)
+			if t.quickFilter.isActive() {
+				t.applyFilter()
 			}
 		}
 	}
+	return a
+}
+
+// leaveTab clears the active tab's quick filter as another tab is shown,
+// unless quick filters persist.
+func (a *App) leaveTab() {
+	if a.activeTab < len(a.tabs) && !a.persistFilters {
+		a.tabs[a.activeTab].clearFilter()
+	}
+}
+
+// filterIssues returns issues where any visible field contains the query (case-insensitive).
+func filterIssues(issues []jira.Issue, columns []string, query string) []jira.Issue {
+	result, _ := matchIssues(issues, columns, query, false)
 	return result
 }
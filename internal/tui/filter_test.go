@@ -159,3 +159,69 @@ func TestIssueFilterVisibleIssuesWhenInactive(t *testing.T) {
 		t.Errorf("expected all issues when filter inactive, got %d", len(visible))
 	}
 }
+
+func TestFuzzyMatch(t *testing.T) {
+	score, pos, ok := fuzzyMatch("Update dashboard", "upd")
+	if !ok || len(pos) != 3 || pos[0] != 0 || pos[2] != 2 {
+		t.Fatalf("fuzzyMatch = %d, %v, %v", score, pos, ok)
+	}
+	if _, _, ok := fuzzyMatch("Update dashboard", "dpu"); ok {
+		t.Error("characters out of order should not match")
+	}
+
+	// The tightest window wins: "log" matches inside "login", not spread
+	// across "la...o...g".
+	_, pos, _ = fuzzyMatch("la foo login", "log")
+	if pos[0] != 7 {
+		t.Errorf("positions = %v, want the match in login", pos)
+	}
+}
+
+func TestFuzzyMatchRanksTightMatchesFirst(t *testing.T) {
+	issues := []jira.Issue{
+		{Key: "PROJ-1", Fields: jira.IssueFields{Summary: "Fix all old logs"}},
+		{Key: "PROJ-2", Fields: jira.IssueFields{Summary: "Fix logout"}},
+		{Key: "PROJ-3", Fields: jira.IssueFields{Summary: "Nothing here"}},
+	}
+	result, matches := matchIssues(issues, []string{"summary"}, "flog", true)
+	if len(result) != 2 || result[0].Key != "PROJ-2" {
+		t.Fatalf("result = %v, want PROJ-2 ranked first and PROJ-3 dropped", result)
+	}
+	if got := matches["PROJ-2"][0]; len(got) != 4 || got[1] != 4 {
+		t.Errorf("matched positions = %v", got)
+	}
+}
+
+func TestFuzzyMatchTermsAcrossColumns(t *testing.T) {
+	result, _ := matchIssues(testIssues, testColumns, "dash done", true)
+	if len(result) != 1 || result[0].Key != "PROJ-2" {
+		t.Errorf("result = %v, want every term matched somewhere", result)
+	}
+	result, _ = matchIssues(testIssues, testColumns, "dash todo", true)
+	if len(result) != 0 {
+		t.Errorf("result = %v, want no match", result)
+	}
+}
+
+func TestSubstringMatchPositions(t *testing.T) {
+	_, matches := matchIssues(testIssues, testColumns, "PAGE", false)
+	if got := matches["PROJ-1"][1]; len(got) != 4 || got[0] != 10 {
+		t.Errorf("positions = %v, want 10-13 of the summary", got)
+	}
+}
+
+func TestHighlightCell(t *testing.T) {
+	plain, marked := highlightCell("Fix login", []int{4, 5}, 0, 12)
+	if plain != "Fix login   " {
+		t.Errorf("plain = %q", plain)
+	}
+	if want := "Fix " + ansiHighlight("l") + ansiHighlight("o") + "gin   "; marked != want {
+		t.Errorf("marked = %q, want %q", marked, want)
+	}
+
+	// Truncated cells keep their ellipsis unmarked
+	_, marked = highlightCell("Fix login page", []int{0}, 0, 6)
+	if want := ansiHighlight("F") + "ix l…"; marked != want {
+		t.Errorf("marked = %q, want %q", marked, want)
+	}
+}
@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// fieldTerm is a "field:value" qualifier in a quick filter query.
+type fieldTerm struct {
+	field string // column name, e.g. "status"
+	value string // matched as a case-insensitive substring
+}
+
+// filterQuery is a parsed quick filter: field qualifiers every issue must
+// satisfy, and free text matched against the visible columns.
+type filterQuery struct {
+	fields []fieldTerm
+	text   string
+}
+
+// qualifierFields are the fields a quick filter qualifier can name, with
+// their shorter aliases.
+var qualifierFields = map[string]string{
+	"key": "key", "summary": "summary", "description": "description",
+	"status": "status", "priority": "priority", "assignee": "assignee",
+	"reporter": "reporter", "type": "type", "project": "project",
+	"created": "created", "updated": "updated", "due": "due",
+	"s": "status", "p": "priority", "a": "assignee", "t": "type",
+}
+
+// priorityLevels maps the p0–p5 shorthands to the priority names they
+// stand for; an issue whose priority is literally named "P1" matches too.
+var priorityLevels = map[string][]string{
+	"p0": {"Blocker", "Blocked"},
+	"p1": {"Highest", "Critical"},
+	"p2": {"High"},
+	"p3": {"Medium"},
+	"p4": {"Low"},
+	"p5": {"Lowest"},
+}
+
+// parseFilterQuery splits a quick filter into qualifiers like status:done
+// or assignee:"Jane Doe", the p0–p5 priority shorthands, and free text.
+// Words with an unknown field or no value are free text.
+func parseFilterQuery(query string) filterQuery {
+	var q filterQuery
+	var text []string
+	for _, word := range splitFilterWords(query) {
+		lower := strings.ToLower(word)
+		if _, ok := priorityLevels[lower]; ok {
+			q.fields = append(q.fields, fieldTerm{field: "priority", value: lower})
+			continue
+		}
+		name, value, found := strings.Cut(word, ":")
+		field, known := qualifierFields[strings.ToLower(name)]
+		if !found || !known || value == "" {
+			text = append(text, strings.ReplaceAll(word, `"`, ""))
+			continue
+		}
+		q.fields = append(q.fields, fieldTerm{field: field, value: strings.Trim(value, `"`)})
+	}
+	if len(q.fields) == 0 {
+		q.text = query // no qualifiers: match the query as typed
+	} else {
+		q.text = strings.Join(text, " ")
+	}
+	return q
+}
+
+// splitFilterWords splits on spaces outside double quotes, so
+// assignee:"Jane Doe" stays one word.
+func splitFilterWords(s string) []string {
+	var words []string
+	var b strings.Builder
+	quoted := false
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			b.WriteRune(r)
+		case r == ' ' && !quoted:
+			if b.Len() > 0 {
+				words = append(words, b.String())
+				b.Reset()
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+	if b.Len() > 0 {
+		words = append(words, b.String())
+	}
+	return words
+}
+
+// matches reports whether an issue satisfies the qualifier.
+func (f fieldTerm) matches(issue jira.Issue) bool {
+	value := fieldValue(issue, f.field)
+	if names, ok := priorityLevels[f.value]; ok && f.field == "priority" {
+		for _, name := range names {
+			if strings.EqualFold(value, name) {
+				return true
+			}
+		}
+		return strings.EqualFold(value, f.value)
+	}
+	return strings.Contains(strings.ToLower(value), strings.ToLower(f.value))
+}
+
+// positions returns where the qualifier matched in a column showing its
+// field, for highlighting.
+func (f fieldTerm) positions(issue jira.Issue, column string) []int {
+	if column != f.field {
+		return nil
+	}
+	_, pos, _ := substringMatch(fieldValue(issue, column), f.value)
+	return pos
+}
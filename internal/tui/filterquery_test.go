@@ -0,0 +1,78 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestParseFilterQuery(t *testing.T) {
+	q := parseFilterQuery(`status:done assignee:"Jane Doe" p1 login page http://x`)
+	want := []fieldTerm{
+		{field: "status", value: "done"},
+		{field: "assignee", value: "Jane Doe"},
+		{field: "priority", value: "p1"},
+	}
+	if len(q.fields) != len(want) {
+		t.Fatalf("fields = %v, want %v", q.fields, want)
+	}
+	for i := range want {
+		if q.fields[i] != want[i] {
+			t.Errorf("fields[%d] = %v, want %v", i, q.fields[i], want[i])
+		}
+	}
+	if q.text != "login page http://x" {
+		t.Errorf("text = %q, unknown fields should stay free text", q.text)
+	}
+
+	if q := parseFilterQuery("fix  login"); len(q.fields) != 0 || q.text != "fix  login" {
+		t.Errorf("plain query = %+v, want it kept as typed", q)
+	}
+	if q := parseFilterQuery("s:open"); len(q.fields) != 1 || q.fields[0].field != "status" {
+		t.Errorf("alias = %+v", q)
+	}
+}
+
+func TestQualifiedFilter(t *testing.T) {
+	issues := []jira.Issue{
+		{Key: "PROJ-1", Fields: jira.IssueFields{
+			Summary: "Fix login", Status: &jira.Status{Name: "Done"},
+			Assignee: &jira.User{DisplayName: "Alice Smith"}, Priority: &jira.Named{Name: "Highest"},
+		}},
+		{Key: "PROJ-2", Fields: jira.IssueFields{
+			Summary: "Fix logout", Status: &jira.Status{Name: "Open"},
+			Assignee: &jira.User{DisplayName: "Alice Smith"}, Priority: &jira.Named{Name: "Low"},
+		}},
+		{Key: "PROJ-3", Fields: jira.IssueFields{
+			Summary: "Done with login", Status: &jira.Status{Name: "Open"},
+			Priority: &jira.Named{Name: "P1"},
+		}},
+	}
+	columns := []string{"key", "summary", "status"}
+	keys := func(query string) string {
+		result, _ := matchIssues(issues, columns, query, false)
+		var out string
+		for _, issue := range result {
+			out += issue.Key + " "
+		}
+		return out
+	}
+
+	if got := keys("status:done"); got != "PROJ-1 " {
+		t.Errorf("status:done = %s, want only the Done status, not a summary mention", got)
+	}
+	if got := keys("assignee:alice fix"); got != "PROJ-1 PROJ-2 " {
+		t.Errorf("assignee:alice fix = %s (assignee isn't a visible column)", got)
+	}
+	if got := keys("p1"); got != "PROJ-1 PROJ-3 " {
+		t.Errorf("p1 = %s, want Highest and a priority named P1", got)
+	}
+	if got := keys("s:open login"); got != "PROJ-3 " {
+		t.Errorf("s:open login = %s", got)
+	}
+
+	_, matches := matchIssues(issues, columns, "status:don", false)
+	if got := matches["PROJ-1"][2]; len(got) != 3 || got[0] != 0 {
+		t.Errorf("status positions = %v, want the qualifier highlighted", got)
+	}
+}
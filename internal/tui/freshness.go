@@ -0,0 +1,139 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// freshnessWindow is how old the local copy of an issue may be before the
+// title and description editors re-fetch it for their pre-fill.
+const freshnessWindow = 30 * time.Second
+
+// freshIssueMsg delivers an issue re-fetched before opening an editor.
+type freshIssueMsg struct {
+	issueKey string
+	action   overlayAction
+	issue    *jira.Issue
+	err      error
+}
+
+// markFetched records that these issues were just loaded from Jira.
+func (a *App) markFetched(issues ...jira.Issue) {
+	a.markFetchedAt(a.now(), issues...)
+}
+
+// markFetchedAt records when these issues were loaded from Jira.
+func (a *App) markFetchedAt(at time.Time, issues ...jira.Issue) {
+	if a.fetchedAt == nil {
+		a.fetchedAt = make(map[string]time.Time)
+	}
+	for _, issue := range issues {
+		a.fetchedAt[issue.Key] = at
+	}
+}
+
+// isFresh reports whether issueKey was loaded within freshnessWindow.
+func (a App) isFresh(issueKey string) bool {
+	t, ok := a.fetchedAt[issueKey]
+	return ok && a.now().Sub(t) < freshnessWindow
+}
+
+// editWithFreshIssue opens the title or description editor for issue. A
+// stale copy is re-fetched first so the pre-fill matches what's in Jira;
+// the editor opens when freshIssueMsg arrives.
+func (a App) editWithFreshIssue(issue *jira.Issue, action overlayAction) (tea.Model, tea.Cmd) {
+	if a.client == nil || a.isFresh(issue.Key) {
+		a.openTextEditor(*issue, action)
+		return a, nil
+	}
+	a.overlayIssue = issue.Key
+	a.overlayAction = action
+	return a, a.startNetwork(a.cmdFetchFreshIssue(issue.Key, action))
+}
+
+// cmdFetchFreshIssue re-fetches an issue ahead of opening an editor.
+func (a App) cmdFetchFreshIssue(issueKey string, action overlayAction) tea.Cmd {
+	client := a.client
+	return func() tea.Msg {
+		issue, err := client.GetIssue(context.Background(), issueKey)
+		if err != nil {
+			return freshIssueMsg{issueKey: issueKey, action: action, err: err}
+		}
+		return freshIssueMsg{issueKey: issueKey, action: action, issue: issue}
+	}
+}
+
+// handleFreshIssue opens the pending editor with the re-fetched issue. If
+// the fetch failed the editor still opens, pre-filled from the local copy.
+func (a App) handleFreshIssue(msg freshIssueMsg) (tea.Model, tea.Cmd) {
+	if msg.issue != nil {
+		a.applyIssueUpdate(msg.issueKey, msg.issue)
+	}
+	if a.overlay != nil || a.overlayIssue != msg.issueKey || a.overlayAction != msg.action {
+		return a, nil // user moved on
+	}
+	issue := msg.issue
+	if issue == nil {
+		issue = a.findIssue(msg.issueKey)
+		if issue == nil {
+			a.overlayIssue = ""
+			a.overlayAction = overlayActionNone
+			a.flash = fmt.Sprintf("Failed to load %s: %v", msg.issueKey, msg.err)
+			a.flashIsErr = true
+			return a, nil
+		}
+		a.flash = "Couldn't refresh " + msg.issueKey + "; editing the local copy"
+		a.flashIsErr = true
+	}
+	a.openTextEditor(*issue, msg.action)
+	return a, nil
+}
+
+// openTextEditor opens the title or description editor pre-filled from issue.
+func (a *App) openTextEditor(issue jira.Issue, action overlayAction) {
+	switch action {
+	case overlayActionTitle:
+		// The list edits the summary in its row
+		if len(a.viewStack) == 0 && a.activeTab < len(a.tabs) && a.tabs[a.activeTab].canEditInline() {
+			a.tabs[a.activeTab].startInlineEdit(issue)
+			a.overlayIssue = ""
+			a.overlayAction = overlayActionNone
+			return
+		}
+		a.overlay = newTextInputOverlay("Edit Title", issue.Fields.Summary)
+	case overlayActionDescription:
+		desc := extractADFText(issue.Fields.Description)
+		if strings.TrimSpace(desc) == "" && a.offerTemplate(issue) {
+			return
+		}
+		a.overlay = newTextEditorOverlay("Edit Description", desc, a.width, a.height)
+	default:
+		return
+	}
+	a.overlayIssue = issue.Key
+	a.overlayAction = action
+}
+
+// findIssue returns the local copy of an issue from the detail stack or
+// the tabs, or nil if it isn't loaded.
+func (a App) findIssue(issueKey string) *jira.Issue {
+	for i := len(a.viewStack) - 1; i >= 0; i-- {
+		if dv, ok := a.viewStack[i].(*issueDetailView); ok && dv.issue.Key == issueKey {
+			return &dv.issue
+		}
+	}
+	for ti := range a.tabs {
+		for ii := range a.tabs[ti].issues {
+			if a.tabs[ti].issues[ii].Key == issueKey {
+				return &a.tabs[ti].issues[ii]
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func testAppConnected() App {
+	app := testAppReady()
+	app.client = jira.NewClient("https://fake.atlassian.net", "test@test.com", "token")
+	return app
+}
+
+// titleEditor returns the pre-fill of the open title editor: the inline
+// one in the list, or the overlay elsewhere.
+func titleEditor(app App) (string, bool) {
+	if app.activeTab < len(app.tabs) && app.tabs[app.activeTab].inline != nil {
+		return app.tabs[app.activeTab].inline.input.Value(), true
+	}
+	if ti, ok := app.overlay.(*textInputOverlay); ok {
+		return ti.input.Value(), true
+	}
+	return "", false
+}
+
+func TestEditOpensImmediatelyWhenFresh(t *testing.T) {
+	app := testAppConnected() // tab data was just loaded
+
+	model, cmd := app.Update(keyMsg("t"))
+	app = model.(App)
+	value, ok := titleEditor(app)
+	if !ok {
+		t.Fatalf("expected a title editor, got %T", app.overlay)
+	}
+	if cmd != nil || value != "Fix login page" {
+		t.Errorf("pre-fill = %q, cmd = %v", value, cmd)
+	}
+}
+
+func TestEditRefetchesStaleIssue(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    string
+		action overlayAction
+	}{
+		{"title", "t", overlayActionTitle},
+		{"description", "e", overlayActionDescription},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := testAppConnected()
+			app.fetchedAt["PROJ-1"] = time.Now().Add(-30 * time.Minute)
+
+			model, cmd := app.Update(keyMsg(tt.key))
+			app = model.(App)
+			if app.overlay != nil || app.tabs[0].inline != nil || cmd == nil {
+				t.Fatalf("expected a refresh before the editor, overlay=%T", app.overlay)
+			}
+
+			fresh := &jira.Issue{Key: "PROJ-1", Fields: jira.IssueFields{Summary: "Fix login page (renamed)"}}
+			model, _ = app.Update(freshIssueMsg{issueKey: "PROJ-1", action: tt.action, issue: fresh})
+			app = model.(App)
+			_, inline := titleEditor(app)
+			if !inline && (app.overlay == nil || app.overlayAction != tt.action) {
+				t.Fatalf("expected editor after refresh, got %T", app.overlay)
+			}
+			if got := app.tabs[0].issues[0].Fields.Summary; got != "Fix login page (renamed)" {
+				t.Errorf("tab copy not updated: %q", got)
+			}
+			if !app.isFresh("PROJ-1") {
+				t.Error("issue should be fresh after the refresh")
+			}
+		})
+	}
+}
+
+func TestEditRefreshUsesFreshSummary(t *testing.T) {
+	app := testAppConnected()
+	app.fetchedAt["PROJ-1"] = time.Time{}
+	model, _ := app.Update(keyMsg("t"))
+	app = model.(App)
+
+	fresh := &jira.Issue{Key: "PROJ-1", Fields: jira.IssueFields{Summary: "Renamed elsewhere"}}
+	model, _ = app.Update(freshIssueMsg{issueKey: "PROJ-1", action: overlayActionTitle, issue: fresh})
+	app = model.(App)
+	if value, _ := titleEditor(app); value != "Renamed elsewhere" {
+		t.Errorf("pre-fill = %q", value)
+	}
+}
+
+func TestEditRefreshFailureFallsBack(t *testing.T) {
+	app := testAppConnected()
+	app.fetchedAt["PROJ-1"] = time.Time{}
+	model, _ := app.Update(keyMsg("t"))
+	app = model.(App)
+
+	model, _ = app.Update(freshIssueMsg{issueKey: "PROJ-1", action: overlayActionTitle, err: errors.New("boom")})
+	app = model.(App)
+	if value, ok := titleEditor(app); !ok || value != "Fix login page" {
+		t.Fatalf("expected editor with local copy, got %T", app.overlay)
+	}
+	if !app.flashIsErr {
+		t.Error("expected a warning that the copy may be stale")
+	}
+}
+
+func TestEditRefreshIgnoredAfterMovingOn(t *testing.T) {
+	app := testAppConnected()
+	app.fetchedAt["PROJ-1"] = time.Time{}
+	model, _ := app.Update(keyMsg("t"))
+	app = model.(App)
+	app.overlayIssue = ""
+	app.overlayAction = overlayActionNone
+
+	fresh := &jira.Issue{Key: "PROJ-1", Fields: jira.IssueFields{Summary: "New"}}
+	model, _ = app.Update(freshIssueMsg{issueKey: "PROJ-1", action: overlayActionTitle, issue: fresh})
+	app = model.(App)
+	if _, ok := titleEditor(app); ok {
+		t.Errorf("editor should not open, got %T", app.overlay)
+	}
+}
@@ -0,0 +1,252 @@
+package tui
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/mattn/go-runewidth"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// cellMatches records where a quick filter query matched an issue:
+// column index → rune positions in that column's value.
+type cellMatches map[int][]int
+
+// Fuzzy match scoring, loosely after fzf: every matched character scores,
+// runs of consecutive characters and characters starting a word score
+// extra, and gaps between matched characters cost.
+const (
+	scoreMatch       = 16
+	bonusConsecutive = 8
+	bonusBoundary    = 8
+	penaltyGapStart  = 3
+	penaltyGapExtend = 1
+)
+
+// matchIssues returns the issues a query matches, with the positions it
+// matched. Qualifiers like status:done must all hold; the rest of the
+// query is matched in any visible column. Substring mode keeps list order.
+// Fuzzy mode requires every space-separated word to match, and ranks the
+// best matches first.
+func matchIssues(issues []jira.Issue, columns []string, query string, fuzzy bool) ([]jira.Issue, map[string]cellMatches) {
+	parsed := parseFilterQuery(query)
+	terms := []string{parsed.text}
+	if fuzzy || parsed.text == "" {
+		terms = strings.Fields(parsed.text)
+	}
+	type ranked struct {
+		issue jira.Issue
+		score int
+	}
+	var results []ranked
+	matches := make(map[string]cellMatches)
+	for _, issue := range issues {
+		if !matchesFields(issue, parsed.fields) {
+			continue
+		}
+		cells := make(cellMatches)
+		for _, f := range parsed.fields {
+			for j, col := range columns {
+				cells[j] = append(cells[j], f.positions(issue, col)...)
+			}
+		}
+		total := 0
+		for _, term := range terms {
+			best, found := 0, false
+			for j, col := range columns {
+				score, pos, ok := matchTerm(fieldValue(issue, col), term, fuzzy)
+				if !ok {
+					continue
+				}
+				cells[j] = append(cells[j], pos...)
+				if !found || score > best {
+					best, found = score, true
+				}
+			}
+			if !found {
+				total = -1
+				break
+			}
+			total += best
+		}
+		if total < 0 {
+			continue
+		}
+		results = append(results, ranked{issue: issue, score: total})
+		matches[issue.Key] = cells
+	}
+	if fuzzy {
+		sort.SliceStable(results, func(i, j int) bool { return results[i].score > results[j].score })
+	}
+	out := make([]jira.Issue, len(results))
+	for i, r := range results {
+		out[i] = r.issue
+	}
+	return out, matches
+}
+
+// matchesFields reports whether an issue satisfies every qualifier.
+func matchesFields(issue jira.Issue, fields []fieldTerm) bool {
+	for _, f := range fields {
+		if !f.matches(issue) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchTerm matches one term against a value, case-insensitively.
+func matchTerm(value, term string, fuzzy bool) (int, []int, bool) {
+	if fuzzy {
+		return fuzzyMatch(value, term)
+	}
+	return substringMatch(value, term)
+}
+
+// substringMatch finds term as a contiguous run in value.
+func substringMatch(value, term string) (int, []int, bool) {
+	text := []rune(strings.ToLower(value))
+	pattern := []rune(strings.ToLower(term))
+	for start := 0; start+len(pattern) <= len(text); start++ {
+		if string(text[start:start+len(pattern)]) != string(pattern) {
+			continue
+		}
+		pos := make([]int, len(pattern))
+		for i := range pos {
+			pos[i] = start + i
+		}
+		return len(pattern) * scoreMatch, pos, true
+	}
+	return 0, nil, false
+}
+
+// fuzzyMatch finds term's characters in order in value. Like fzf it takes
+// the first place the whole term fits, then walks back from its end to
+// the shortest window, which favors tight matches.
+func fuzzyMatch(value, term string) (int, []int, bool) {
+	text := []rune(strings.ToLower(value))
+	pattern := []rune(strings.ToLower(term))
+	if len(pattern) == 0 {
+		return 0, nil, true
+	}
+
+	// Forward: where does the first full match end?
+	end, p := -1, 0
+	for i, r := range text {
+		if r == pattern[p] {
+			p++
+			if p == len(pattern) {
+				end = i
+				break
+			}
+		}
+	}
+	if end < 0 {
+		return 0, nil, false
+	}
+
+	// Backward: the latest start that still fits the term before end
+	pos := make([]int, len(pattern))
+	p = len(pattern) - 1
+	for i := end; i >= 0 && p >= 0; i-- {
+		if text[i] == pattern[p] {
+			pos[p] = i
+			p--
+		}
+	}
+
+	score := 0
+	for i, at := range pos {
+		score += scoreMatch
+		if at == 0 || !isWordRune(text[at-1]) {
+			score += bonusBoundary
+		}
+		if i > 0 {
+			if gap := at - pos[i-1] - 1; gap == 0 {
+				score += bonusConsecutive
+			} else {
+				score -= penaltyGapStart + (gap-1)*penaltyGapExtend
+			}
+		}
+	}
+	return score, pos, true
+}
+
+// isWordRune reports whether r continues a word rather than separating
+// words.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// ansiHighlight marks matched characters bold and colored, resetting only
+// what it set so the selected row keeps its background.
+func ansiHighlight(text string) string {
+	return "\x1b[1;38;5;214m" + text + "\x1b[22;39m"
+}
+
+// highlightMatches marks the characters the quick filter matched in the
+// rendered table. Cells are replaced whole, padded to their column width,
+// so short values don't match inside longer ones.
+func (t *tab) highlightMatches(rendered string) string {
+	f := &t.quickFilter
+	if !f.isActive() || f.query == "" || len(f.matches) == 0 {
+		return rendered
+	}
+	cols := t.table.Columns()
+	rows := t.table.Rows()
+	var pairs []string
+	for i, e := range t.entries {
+		if e.issue == nil || i >= len(rows) {
+			continue
+		}
+		for j, positions := range f.matches[e.issue.Key] {
+			if j >= len(cols) || j >= len(rows[i]) {
+				continue
+			}
+			value := fieldValue(*e.issue, t.columns[j])
+			cell := rows[i][j]
+			if !strings.HasSuffix(cell, value) {
+				continue // rendered differently, e.g. a priority icon
+			}
+			offset := len([]rune(cell)) - len([]rune(value))
+			plain, marked := highlightCell(cell, positions, offset, cols[j].Width)
+			if plain != marked {
+				pairs = append(pairs, plain, marked)
+			}
+		}
+	}
+	if len(pairs) == 0 {
+		return rendered
+	}
+	return strings.NewReplacer(pairs...).Replace(rendered)
+}
+
+// highlightCell returns a cell as the table renders it, truncated and
+// padded to width, and the same with the matched runes highlighted.
+func highlightCell(cell string, positions []int, offset, width int) (string, string) {
+	truncated := runewidth.Truncate(cell, width, "…")
+	plain := runewidth.FillRight(truncated, width)
+	hit := make(map[int]bool, len(positions))
+	for _, p := range positions {
+		hit[p+offset] = true
+	}
+	runes := []rune(truncated)
+	if truncated != cell {
+		runes = runes[:len(runes)-1] // the ellipsis isn't part of the value
+	}
+	var b strings.Builder
+	for i, r := range runes {
+		if hit[i] {
+			b.WriteString(ansiHighlight(string(r)))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	if truncated != cell {
+		b.WriteString("…")
+	}
+	b.WriteString(plain[len(truncated):])
+	return plain, b.String()
+}
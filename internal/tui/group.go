@@ -0,0 +1,245 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/charmbracelet/bubbles/table"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// listEntry is one row of a tab's table: an issue, or a group header when
+// the tab is grouped.
+type listEntry struct {
+	issue *jira.Issue // nil for a group header
+	group string      // group the row belongs to
+	count int         // issues in the group, for headers
+	depth int         // nesting level in the tree view
+	kids  int         // children shown under the issue in the tree view
+}
+
+// issueGroup is a run of issues sharing a group_by value.
+type issueGroup struct {
+	name   string
+	issues []jira.Issue
+}
+
+// Group names for issues without a value.
+const (
+	noAssignee = "Unassigned"
+	noPriority = "No priority"
+	noEpic     = "No epic"
+)
+
+// groupName returns the group an issue belongs to under group_by field.
+func groupName(issue jira.Issue, field string) string {
+	f := issue.Fields
+	switch field {
+	case "status":
+		if f.Status != nil {
+			return f.Status.Name
+		}
+		return "No status"
+	case "assignee":
+		if f.Assignee != nil {
+			return f.Assignee.DisplayName
+		}
+		return noAssignee
+	case "priority":
+		if f.Priority != nil && f.Priority.Name != "" {
+			return f.Priority.Name
+		}
+		return noPriority
+	case "epic":
+		return epicName(f.Parent)
+	}
+	return ""
+}
+
+// epicName names the epic an issue's parent is, or noEpic when the parent
+// is missing or another kind of issue (a subtask's story).
+func epicName(parent *jira.ParentIssue) string {
+	if parent == nil {
+		return noEpic
+	}
+	if parent.Fields == nil {
+		return parent.Key
+	}
+	if parent.Fields.IssueType != nil && parent.Fields.IssueType.Name != "Epic" {
+		return noEpic
+	}
+	return parent.Key + " " + parent.Fields.Summary
+}
+
+// groupRank orders groups: statuses from to do through done, priorities
+// from highest, and otherwise list order with the "none" group last.
+func groupRank(issue jira.Issue, field string) int {
+	switch field {
+	case "status":
+		return categoryRank(issue.Fields.Status)
+	case "priority":
+		if issue.Fields.Priority == nil || issue.Fields.Priority.Name == "" {
+			return 100
+		}
+		if order, ok := priorityOrder[issue.Fields.Priority.Name]; ok {
+			return order
+		}
+		return 50 // unknown priorities between the known ones and none
+	}
+	switch groupName(issue, field) {
+	case noAssignee, noEpic:
+		return 1
+	}
+	return 0
+}
+
+// groupIssues splits issues into groups by field, keeping the list order
+// within each group.
+func groupIssues(issues []jira.Issue, field string) []issueGroup {
+	var groups []issueGroup
+	index := make(map[string]int)
+	for _, issue := range issues {
+		name := groupName(issue, field)
+		i, ok := index[name]
+		if !ok {
+			i = len(groups)
+			index[name] = i
+			groups = append(groups, issueGroup{name: name})
+		}
+		groups[i].issues = append(groups[i].issues, issue)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groupRank(groups[i].issues[0], field) < groupRank(groups[j].issues[0], field)
+	})
+	return groups
+}
+
+// layout lists the table rows for the visible issues: the issues as they
+// are, or under a header per group with collapsed groups' issues left out.
+func (t *tab) layout(visible []jira.Issue) []listEntry {
+	entries := make([]listEntry, 0, len(visible))
+	if t.groupBy == "" {
+		for i := range visible {
+			entries = append(entries, listEntry{issue: &visible[i]})
+		}
+		return entries
+	}
+	for _, g := range groupIssues(visible, t.groupBy) {
+		entries = append(entries, listEntry{group: g.name, count: len(g.issues)})
+		if t.collapsed[g.name] {
+			continue
+		}
+		for i := range g.issues {
+			entries = append(entries, listEntry{issue: &g.issues[i], group: g.name})
+		}
+	}
+	return entries
+}
+
+// setRows lays out the visible issues and shows them in the table.
+func (t *tab) setRows(visible []jira.Issue) {
+	if t.tree {
+		t.setTreeRows(visible)
+		return
+	}
+	t.entries = t.layout(visible)
+	if t.groupBy == "" {
+		t.table.SetRows(t.rows(visible))
+		return
+	}
+	var issues []jira.Issue
+	for _, e := range t.entries {
+		if e.issue != nil {
+			issues = append(issues, *e.issue)
+		}
+	}
+	issueRows := t.rows(issues)
+	rows := make([]table.Row, 0, len(t.entries))
+	for _, e := range t.entries {
+		if e.issue == nil {
+			rows = append(rows, t.groupHeaderRow(e))
+			continue
+		}
+		rows = append(rows, issueRows[0])
+		issueRows = issueRows[1:]
+	}
+	t.table.SetRows(rows)
+}
+
+// groupHeaderRow renders a group header, e.g. "▾ In Progress (3)", in the
+// widest column so the name isn't cut short.
+func (t *tab) groupHeaderRow(e listEntry) table.Row {
+	row := make(table.Row, len(t.columns))
+	widest := 0
+	for i, c := range t.table.Columns() {
+		if i < len(row) && c.Width > t.table.Columns()[widest].Width {
+			widest = i
+		}
+	}
+	arrow := "▾"
+	if t.collapsed[e.group] {
+		arrow = "▸"
+	}
+	if len(row) > 0 {
+		row[widest] = fmt.Sprintf("%s %s (%d)", arrow, e.group, e.count)
+	}
+	return row
+}
+
+// cursorGroup returns the group of the row under the cursor, or "" when
+// the tab isn't grouped.
+func (t *tab) cursorGroup() string {
+	idx := t.table.Cursor()
+	if t.groupBy == "" || t.tree || t.state != tabReady || idx < 0 || idx >= len(t.entries) {
+		return ""
+	}
+	return t.entries[idx].group
+}
+
+// toggleGroup collapses or expands the group under the cursor and moves
+// the cursor to its header.
+func (t *tab) toggleGroup() {
+	group := t.cursorGroup()
+	if group == "" {
+		return
+	}
+	if t.collapsed == nil {
+		t.collapsed = make(map[string]bool)
+	}
+	t.collapsed[group] = !t.collapsed[group]
+	t.refreshRows()
+	t.gotoGroup(group)
+}
+
+// toggleAllGroups collapses every group, or expands them all when they
+// already are collapsed.
+func (t *tab) toggleAllGroups() {
+	if t.groupBy == "" || t.tree {
+		return
+	}
+	group := t.cursorGroup()
+	groups := groupIssues(t.quickFilter.visibleIssues(t.issues), t.groupBy)
+	allCollapsed := true
+	for _, g := range groups {
+		allCollapsed = allCollapsed && t.collapsed[g.name]
+	}
+	t.collapsed = make(map[string]bool)
+	if !allCollapsed {
+		for _, g := range groups {
+			t.collapsed[g.name] = true
+		}
+	}
+	t.refreshRows()
+	t.gotoGroup(group)
+}
+
+// gotoGroup puts the cursor on a group's header.
+func (t *tab) gotoGroup(group string) {
+	for i, e := range t.entries {
+		if e.issue == nil && e.group == group {
+			t.table.SetCursor(i)
+			return
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func groupedIssues() []jira.Issue {
+	return []jira.Issue{
+		{Key: "PROJ-1", Fields: jira.IssueFields{Summary: "Ship it", Status: statusDone}},
+		{Key: "PROJ-2", Fields: jira.IssueFields{Summary: "Build it", Status: statusProgress}},
+		{Key: "PROJ-3", Fields: jira.IssueFields{Summary: "Plan it", Status: statusToDo}},
+		{Key: "PROJ-4", Fields: jira.IssueFields{Summary: "Test it", Status: statusProgress}},
+	}
+}
+
+func testAppGrouped() App {
+	tabs := []config.TabConfig{
+		{Label: "Sprint", FilterID: "111", Columns: []string{"key", "summary", "status"}, GroupBy: "status"},
+	}
+	app := NewApp(nil, tabs, "")
+	model, _ := app.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	app = model.(App)
+	model, _ = app.Update(tabDataMsg{tabIndex: 0, issues: groupedIssues()})
+	return model.(App)
+}
+
+func rowLabels(tab *tab) string {
+	var labels []string
+	for i, row := range tab.table.Rows() {
+		if tab.entries[i].issue == nil {
+			labels = append(labels, strings.TrimSpace(strings.Join(row, "")))
+		} else {
+			labels = append(labels, row[0])
+		}
+	}
+	return strings.Join(labels, ",")
+}
+
+func TestGroupIssues(t *testing.T) {
+	groups := groupIssues(groupedIssues(), "status")
+	var names []string
+	for _, g := range groups {
+		names = append(names, g.name)
+	}
+	if got := strings.Join(names, ","); got != "To Do,In Progress,Done" {
+		t.Errorf("groups = %s, want status categories in order", got)
+	}
+	if len(groups[1].issues) != 2 || groups[1].issues[0].Key != "PROJ-2" {
+		t.Errorf("In Progress = %v, want PROJ-2 then PROJ-4", groups[1].issues)
+	}
+
+	assignees := groupIssues([]jira.Issue{
+		{Key: "PROJ-1"},
+		{Key: "PROJ-2", Fields: jira.IssueFields{Assignee: &jira.User{DisplayName: "Ann"}}},
+	}, "assignee")
+	if assignees[0].name != "Ann" || assignees[1].name != noAssignee {
+		t.Errorf("assignee groups = %v, want Unassigned last", assignees)
+	}
+}
+
+func TestEpicName(t *testing.T) {
+	epic := &jira.ParentIssue{Key: "PROJ-9", Fields: &jira.IssueFields{
+		Summary: "Checkout", IssueType: &jira.Named{Name: "Epic"},
+	}}
+	story := &jira.ParentIssue{Key: "PROJ-8", Fields: &jira.IssueFields{
+		Summary: "Story", IssueType: &jira.Named{Name: "Story"},
+	}}
+	if got := epicName(epic); got != "PROJ-9 Checkout" {
+		t.Errorf("epicName(epic) = %q", got)
+	}
+	if got := epicName(story); got != noEpic {
+		t.Errorf("a subtask's story is not an epic, got %q", got)
+	}
+	if got := epicName(nil); got != noEpic {
+		t.Errorf("epicName(nil) = %q", got)
+	}
+}
+
+func TestGroupedTabShowsHeaders(t *testing.T) {
+	app := testAppGrouped()
+	tab := &app.tabs[0]
+
+	want := "▾ To Do (1),PROJ-3,▾ In Progress (2),PROJ-2,PROJ-4,▾ Done (1),PROJ-1"
+	if got := rowLabels(tab); got != want {
+		t.Errorf("rows = %s\nwant   %s", got, want)
+	}
+	if tab.selectedIssue() != nil {
+		t.Error("a group header has no selected issue")
+	}
+	tab.table.SetCursor(3)
+	if sel := tab.selectedIssue(); sel == nil || sel.Key != "PROJ-2" {
+		t.Errorf("selected = %v, want PROJ-2", sel)
+	}
+}
+
+func TestGroupCollapse(t *testing.T) {
+	app := testAppGrouped()
+	app.tabs[0].table.SetCursor(4) // PROJ-4, in progress
+
+	model, _ := app.Update(keyMsg("z"))
+	app = model.(App)
+	tab := &app.tabs[0]
+	if got := rowLabels(tab); got != "▾ To Do (1),PROJ-3,▸ In Progress (2),▾ Done (1),PROJ-1" {
+		t.Errorf("after z: rows = %s", got)
+	}
+	if tab.table.Cursor() != 2 {
+		t.Errorf("cursor = %d, want the collapsed header", tab.table.Cursor())
+	}
+
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	app = model.(App)
+	if got := rowLabels(&app.tabs[0]); !strings.Contains(got, "▾ In Progress (2),PROJ-2") {
+		t.Errorf("enter on a header should expand it, rows = %s", got)
+	}
+
+	model, _ = app.Update(keyMsg("Z"))
+	app = model.(App)
+	if got := rowLabels(&app.tabs[0]); got != "▸ To Do (1),▸ In Progress (2),▸ Done (1)" {
+		t.Errorf("after Z: rows = %s", got)
+	}
+	model, _ = app.Update(keyMsg("Z"))
+	app = model.(App)
+	if got := len(app.tabs[0].table.Rows()); got != 7 {
+		t.Errorf("second Z should expand all, got %d rows", got)
+	}
+}
+
+func TestGroupedRefreshKeepsCursorAndCollapse(t *testing.T) {
+	app := testAppGrouped()
+	tab := &app.tabs[0]
+	tab.table.SetCursor(0)
+	tab.toggleGroup()      // collapse To Do
+	tab.table.SetCursor(3) // PROJ-4
+
+	issues := groupedIssues()
+	issues = append(issues, jira.Issue{Key: "PROJ-5", Fields: jira.IssueFields{Summary: "Start it", Status: statusProgress}})
+	tab.refreshIssues(issues)
+
+	if sel := tab.selectedIssue(); sel == nil || sel.Key != "PROJ-4" {
+		t.Errorf("selected = %v, want PROJ-4", sel)
+	}
+	if got := rowLabels(tab); !strings.HasPrefix(got, "▸ To Do (1),▾ In Progress (3)") {
+		t.Errorf("rows = %s, want To Do still collapsed", got)
+	}
+}
+
+func TestCollapseNeedsGroupBy(t *testing.T) {
+	app := testAppReady()
+	model, _ := app.Update(keyMsg("z"))
+	app = model.(App)
+	if !app.flashIsErr || !strings.Contains(app.flash, "group_by") {
+		t.Errorf("flash = %q", app.flash)
+	}
+}
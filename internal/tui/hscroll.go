@@ -0,0 +1,111 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// scrollFlexWidth is how wide a scrolling tab draws its flexible columns,
+// such as the summary, however narrow the window.
+const scrollFlexWidth = 40
+
+// scrolls reports whether the tab keeps its columns at a readable width
+// and pans, rather than squeezing them into the window.
+func (t *tab) scrolls() bool {
+	return t.config.Overflow == "scroll"
+}
+
+// layoutColumns sizes the columns for the tab's width. A scrolling tab's
+// table is laid out as wide as its columns need; pan crops it to the
+// window.
+func (t *tab) layoutColumns() {
+	width := t.width
+	if t.scrolls() {
+		width = max(width, scrollWidth(t.columns, t.widths))
+	}
+	t.table.SetColumns(t.headerColumns(width))
+	t.table.SetWidth(width)
+	t.hscroll = min(t.hscroll, t.maxScroll())
+}
+
+// scrollWidth is the width columns need with each flexible one given
+// scrollFlexWidth.
+func scrollWidth(names []string, widths map[string]int) int {
+	total := len(names) * 2
+	for _, name := range names {
+		def := columnDefFor(name)
+		switch {
+		case widths[name] > 0:
+			total += widths[name]
+		case def.flex:
+			total += max(def.minWidth, scrollFlexWidth)
+		default:
+			total += def.minWidth
+		}
+	}
+	return total
+}
+
+// maxScroll is how many columns past the first can be panned past before
+// the rest fit the window.
+func (t *tab) maxScroll() int {
+	cols := t.table.Columns()
+	if !t.scrolls() || len(cols) < 2 {
+		return 0
+	}
+	avail := t.width - cols[0].Width
+	rest := 0
+	for _, c := range cols[1:] {
+		rest += c.Width
+	}
+	n := 0
+	for rest > avail && n < len(cols)-2 {
+		rest -= cols[1+n].Width
+		n++
+	}
+	return n
+}
+
+// pan crops a scrolling tab's rendered table to the window, keeping the
+// first column, usually the key, in place.
+func (t *tab) pan(rendered string) string {
+	cols := t.table.Columns()
+	if !t.scrolls() || len(cols) == 0 {
+		return rendered
+	}
+	pinned := cols[0].Width
+	start := pinned
+	for _, c := range cols[1 : 1+min(t.hscroll, len(cols)-1)] {
+		start += c.Width
+	}
+	lines := strings.Split(rendered, "\n")
+	for i, line := range lines {
+		if start == pinned {
+			lines[i] = ansi.Truncate(line, t.width, "")
+			continue
+		}
+		lines[i] = ansi.Truncate(line, pinned, "") + ansi.Cut(line, start, start+t.width-pinned)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// panTab moves a scrolling tab's columns one to the left or right.
+func (a App) panTab(right bool) (tea.Model, tea.Cmd) {
+	if a.activeTab >= len(a.tabs) || !a.tabs[a.activeTab].hasData() {
+		return a, nil
+	}
+	t := &a.tabs[a.activeTab]
+	if !t.scrolls() {
+		a.flash = "This tab fits its columns to the window; set overflow: scroll to pan"
+		a.flashIsErr = true
+		return a, nil
+	}
+	if right {
+		t.hscroll = min(t.hscroll+1, t.maxScroll())
+	} else {
+		t.hscroll = max(t.hscroll-1, 0)
+	}
+	return a, nil
+}
@@ -0,0 +1,82 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func testAppScrolling() App {
+	app := NewApp(nil, []config.TabConfig{{
+		Label:    "Wide",
+		FilterID: "111",
+		Columns:  []string{"key", "summary", "status", "assignee", "priority"},
+		Overflow: "scroll",
+	}}, "")
+	model, _ := app.Update(tea.WindowSizeMsg{Width: 60, Height: 20})
+	model, _ = model.(App).Update(tabDataMsg{tabIndex: 0, issues: []jira.Issue{
+		{Key: "PROJ-1", Fields: jira.IssueFields{Summary: "Fix login page", Status: &jira.Status{Name: "Open"}}},
+	}})
+	return model.(App)
+}
+
+func TestScrollingTabKeepsColumnsReadable(t *testing.T) {
+	app := testAppScrolling()
+	tb := &app.tabs[0]
+	if tb.table.Width() <= 60 {
+		t.Fatalf("table width = %d, want wider than the window", tb.table.Width())
+	}
+	if w := tb.table.Columns()[1].Width; w != scrollFlexWidth {
+		t.Errorf("summary width = %d, want %d", w, scrollFlexWidth)
+	}
+	for _, line := range strings.Split(tb.pan(tb.table.View()), "\n") {
+		if lipgloss.Width(line) > 60 {
+			t.Errorf("line %q is wider than the window", line)
+		}
+	}
+}
+
+func TestPanScrollingTab(t *testing.T) {
+	app := testAppScrolling()
+
+	model, _ := app.Update(keyMsg(">"))
+	app = model.(App)
+	tb := &app.tabs[0]
+	if tb.hscroll != 1 {
+		t.Fatalf("hscroll = %d, want 1", tb.hscroll)
+	}
+	view := tb.pan(tb.table.View())
+	if !strings.Contains(view, "PROJ-1") || strings.Contains(view, "Summary") || !strings.Contains(view, "Assignee") {
+		t.Errorf("panned view should keep the key and show the columns past the summary:\n%s", view)
+	}
+
+	for range 5 {
+		model, _ = app.Update(keyMsg(">"))
+		app = model.(App)
+	}
+	if got, want := app.tabs[0].hscroll, app.tabs[0].maxScroll(); got != want {
+		t.Errorf("hscroll = %d, want it stopped at %d", got, want)
+	}
+
+	for range 5 {
+		model, _ = app.Update(keyMsg("<"))
+		app = model.(App)
+	}
+	if app.tabs[0].hscroll != 0 {
+		t.Errorf("hscroll = %d, want 0", app.tabs[0].hscroll)
+	}
+}
+
+func TestPanFittingTabExplains(t *testing.T) {
+	app := testAppReady()
+	model, _ := app.Update(keyMsg(">"))
+	app = model.(App)
+	if !app.flashIsErr || !strings.Contains(app.flash, "overflow: scroll") {
+		t.Errorf("flash = %q, want a pointer to overflow: scroll", app.flash)
+	}
+}
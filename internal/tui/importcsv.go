@@ -0,0 +1,170 @@
+package tui
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+)
+
+// importColumns maps the CSV headers 'jira-tui import' reads, lowercased,
+// to the field they fill.
+var importColumns = map[string]string{
+	"summary":        "summary",
+	"description":    "description",
+	"labels":         "labels",
+	"label":          "labels",
+	"assignee":       "assignee",
+	"assignee email": "assignee",
+	"type":           "type",
+	"issue type":     "type",
+	"issuetype":      "type",
+}
+
+// ImportRow is one issue read from an import CSV.
+type ImportRow struct {
+	Line        int // line in the file, for error reports
+	Summary     string
+	Type        string // issue type name; "" = the import's default
+	Description string
+	Labels      []string
+	Assignee    string // email
+}
+
+// ImportIssue is a row ready to create.
+type ImportIssue struct {
+	Row    ImportRow
+	Fields map[string]interface{}
+}
+
+// ReadImportCSV reads issues from CSV with a header row naming the columns:
+// summary (required), description, labels (comma- or space-separated),
+// assignee (email), and type. Other columns are reported in the warnings
+// and ignored.
+func ReadImportCSV(r io.Reader) ([]ImportRow, []string, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("the file is empty")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var warnings []string
+	fields := make([]string, len(header))
+	hasSummary := false
+	for i, h := range header {
+		// Excel starts UTF-8 files with a byte order mark
+		name := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+		f, ok := importColumns[name]
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("ignoring column %q", h))
+			continue
+		}
+		fields[i] = f
+		hasSummary = hasSummary || f == "summary"
+	}
+	if !hasSummary {
+		return nil, warnings, fmt.Errorf("no summary column in the header")
+	}
+
+	var rows []ImportRow
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, warnings, err
+		}
+		line, _ := cr.FieldPos(0)
+		row := ImportRow{Line: line}
+		blank := true
+		for i, value := range record {
+			if i >= len(fields) {
+				break
+			}
+			value = strings.TrimSpace(value)
+			blank = blank && value == ""
+			switch fields[i] {
+			case "summary":
+				row.Summary = value
+			case "description":
+				row.Description = value
+			case "labels":
+				row.Labels = parseLabels(value)
+			case "assignee":
+				row.Assignee = value
+			case "type":
+				row.Type = value
+			}
+		}
+		if !blank {
+			rows = append(rows, row)
+		}
+	}
+	return rows, warnings, nil
+}
+
+// PlanImport builds the create fields for each row in project, with
+// defaultType for rows without a type. Assignees are looked up by email in
+// users. Rows that can't be created are reported as problems instead.
+func PlanImport(rows []ImportRow, project, defaultType string, users []config.CachedUser) ([]ImportIssue, []string) {
+	var issues []ImportIssue
+	var problems []string
+	for _, row := range rows {
+		if row.Summary == "" {
+			problems = append(problems, fmt.Sprintf("line %d: the summary is empty", row.Line))
+			continue
+		}
+		if row.Type == "" {
+			row.Type = defaultType
+		}
+		fields := map[string]interface{}{
+			"project":   map[string]interface{}{"key": project},
+			"summary":   row.Summary,
+			"issuetype": map[string]interface{}{"name": row.Type},
+		}
+		if row.Description != "" {
+			fields["description"] = makeADFDocument(row.Description)
+		}
+		if len(row.Labels) > 0 {
+			fields["labels"] = row.Labels
+		}
+		if row.Assignee != "" {
+			accountID := ""
+			for _, u := range users {
+				if strings.EqualFold(u.Email, row.Assignee) {
+					accountID = u.AccountID
+					break
+				}
+			}
+			if accountID == "" {
+				problems = append(problems, fmt.Sprintf("line %d: no user with email %s", row.Line, row.Assignee))
+				continue
+			}
+			fields["assignee"] = map[string]interface{}{"accountId": accountID}
+		}
+		issues = append(issues, ImportIssue{Row: row, Fields: fields})
+	}
+	return issues, problems
+}
+
+// WriteImportPreview prints the issues an import will create as a table.
+func WriteImportPreview(w io.Writer, issues []ImportIssue) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "LINE\tTYPE\tSUMMARY\tLABELS\tASSIGNEE")
+	for _, issue := range issues {
+		r := issue.Row
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\n", r.Line, r.Type, truncateRunes(r.Summary, 60),
+			strings.Join(r.Labels, ","), r.Assignee)
+	}
+	return tw.Flush()
+}
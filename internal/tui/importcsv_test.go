@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+)
+
+const importCSV = "\ufeffSummary,Description,Labels,Assignee Email,Owner\n" +
+	"Fix login,\"Steps:\n1. open\",\"auth, urgent\",jane@example.com,x\n" +
+	",,,,\n" +
+	"Write docs,,,,\n" +
+	",no summary,,,\n"
+
+func TestReadImportCSV(t *testing.T) {
+	rows, warnings, err := ReadImportCSV(strings.NewReader(importCSV))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "Owner") {
+		t.Errorf("expected a warning about the Owner column, got %q", warnings)
+	}
+	want := []ImportRow{
+		{Line: 2, Summary: "Fix login", Description: "Steps:\n1. open", Labels: []string{"auth", "urgent"}, Assignee: "jane@example.com"},
+		{Line: 5, Summary: "Write docs"},
+		{Line: 6, Description: "no summary"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("got %+v\nwant %+v", rows, want)
+	}
+}
+
+func TestReadImportCSVNeedsSummary(t *testing.T) {
+	if _, _, err := ReadImportCSV(strings.NewReader("title,labels\nx,y\n")); err == nil {
+		t.Error("expected an error without a summary column")
+	}
+	if _, _, err := ReadImportCSV(strings.NewReader("")); err == nil {
+		t.Error("expected an error for an empty file")
+	}
+}
+
+func TestPlanImport(t *testing.T) {
+	users := []config.CachedUser{{AccountID: "u1", DisplayName: "Jane", Email: "Jane@example.com"}}
+	rows := []ImportRow{
+		{Line: 2, Summary: "Fix login", Labels: []string{"auth"}, Assignee: "jane@example.com", Type: "Bug"},
+		{Line: 3, Summary: "Write docs", Description: "Cover setup"},
+		{Line: 4, Description: "no summary"},
+		{Line: 5, Summary: "Ask Bob", Assignee: "bob@example.com"},
+	}
+	issues, problems := PlanImport(rows, "PROJ", "Task", users)
+	if len(issues) != 2 {
+		t.Fatalf("expected 2 issues to create, got %d", len(issues))
+	}
+	first := issues[0].Fields
+	if first["issuetype"].(map[string]interface{})["name"] != "Bug" ||
+		first["assignee"].(map[string]interface{})["accountId"] != "u1" ||
+		!reflect.DeepEqual(first["labels"], []string{"auth"}) {
+		t.Errorf("unexpected fields %+v", first)
+	}
+	second := issues[1].Fields
+	if second["issuetype"].(map[string]interface{})["name"] != "Task" || second["description"] == nil {
+		t.Errorf("expected the default type and a description, got %+v", second)
+	}
+	want := []string{"line 4: the summary is empty", "line 5: no user with email bob@example.com"}
+	if !reflect.DeepEqual(problems, want) {
+		t.Errorf("problems = %q, want %q", problems, want)
+	}
+
+	var b bytes.Buffer
+	if err := WriteImportPreview(&b, issues); err != nil {
+		t.Fatal(err)
+	}
+	if out := b.String(); !strings.Contains(out, "SUMMARY") || !strings.Contains(out, "Fix login") {
+		t.Errorf("unexpected preview:\n%s", out)
+	}
+}
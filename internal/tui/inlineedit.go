@@ -0,0 +1,80 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// inlineCursor marks the insertion point in a summary edited in place.
+const inlineCursor = "▏"
+
+// inlineEdit is a summary being edited in place in its table row.
+type inlineEdit struct {
+	issueKey string
+	original string
+	input    textinput.Model
+}
+
+// canEditInline reports whether the tab can edit a summary in its row,
+// which needs a summary column to edit in.
+func (t *tab) canEditInline() bool {
+	return t.state == tabReady && hasColumn(t.columns, "summary")
+}
+
+// startInlineEdit replaces the issue's summary cell with an editor.
+func (t *tab) startInlineEdit(issue jira.Issue) {
+	ti := textinput.New()
+	ti.SetValue(issue.Fields.Summary)
+	ti.CharLimit = 500
+	ti.Focus()
+	t.inline = &inlineEdit{issueKey: issue.Key, original: issue.Fields.Summary, input: ti}
+	t.refreshRows()
+}
+
+// stopInlineEdit puts the summary cell back.
+func (t *tab) stopInlineEdit() {
+	t.inline = nil
+	t.refreshRows()
+}
+
+// cell renders the value with a cursor, scrolled so the cursor stays
+// within width.
+func (e *inlineEdit) cell(width int) string {
+	value := []rune(e.input.Value())
+	pos := min(e.input.Position(), len(value))
+	runes := append(append(append([]rune{}, value[:pos]...), []rune(inlineCursor)...), value[pos:]...)
+	if width <= 0 || len(runes) <= width {
+		return string(runes)
+	}
+	start := max(0, min(pos-width/2, len(runes)-width))
+	return string(runes[start : start+width])
+}
+
+// handleInlineEditKey edits the summary under the cursor: enter saves it
+// through the same update as the title overlay, esc puts it back.
+func (a App) handleInlineEditKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	tab := &a.tabs[a.activeTab]
+	edit := tab.inline
+	switch msg.String() {
+	case "esc":
+		tab.stopInlineEdit()
+		return a, nil
+	case "enter":
+		tab.stopInlineEdit()
+		title := strings.TrimSpace(edit.input.Value())
+		if title == "" || title == edit.original {
+			return a, nil
+		}
+		a.overlayIssue = edit.issueKey
+		a.overlayAction = overlayActionTitle
+		return a.handleOverlayResult(title)
+	}
+	var cmd tea.Cmd
+	edit.input, cmd = edit.input.Update(msg)
+	tab.refreshRows()
+	return a, cmd
+}
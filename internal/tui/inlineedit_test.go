@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestInlineTitleEdit(t *testing.T) {
+	app := testAppConnected()
+
+	model, _ := app.Update(keyMsg("t"))
+	app = model.(App)
+	for _, k := range []string{"e", "d"} {
+		model, _ = app.Update(keyMsg(k))
+		app = model.(App)
+	}
+	if got := app.tabs[0].table.Rows()[0][1]; got != "Fix login pageed"+inlineCursor {
+		t.Errorf("summary cell = %q, want the edited text with a cursor", got)
+	}
+
+	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	app = model.(App)
+	if cmd == nil || app.flash != "Updating title of PROJ-1..." {
+		t.Errorf("flash = %q, cmd = %v", app.flash, cmd)
+	}
+	if app.tabs[0].inline != nil {
+		t.Error("editor should close on enter")
+	}
+	if len(app.pending) != 1 {
+		t.Errorf("pending = %d, want the update tracked", len(app.pending))
+	}
+}
+
+func TestInlineTitleEditCancel(t *testing.T) {
+	app := testAppConnected()
+
+	model, _ := app.Update(keyMsg("t"))
+	app = model.(App)
+	model, _ = app.Update(keyMsg("x"))
+	app = model.(App)
+	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEscape})
+	app = model.(App)
+	if cmd != nil || app.tabs[0].inline != nil {
+		t.Errorf("esc should cancel, inline = %v, cmd = %v", app.tabs[0].inline, cmd)
+	}
+	if got := app.tabs[0].table.Rows()[0][1]; got != "Fix login page" {
+		t.Errorf("summary cell = %q, want it restored", got)
+	}
+
+	// Saving without changes sends nothing
+	model, _ = app.Update(keyMsg("t"))
+	app = model.(App)
+	_, cmd = app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		t.Error("an unchanged title should not be saved")
+	}
+}
+
+func TestInlineEditCellScrolls(t *testing.T) {
+	e := &inlineEdit{}
+	e.input.SetValue("abcdefghij")
+	e.input.SetCursor(10)
+	if got := e.cell(5); got != "ghij"+inlineCursor {
+		t.Errorf("cell at end = %q", got)
+	}
+	e.input.SetCursor(0)
+	if got := e.cell(5); got != inlineCursor+"abcd" {
+		t.Errorf("cell at start = %q", got)
+	}
+}
+
+func TestTitleUsesOverlayWithoutSummaryColumn(t *testing.T) {
+	app := testAppConnected()
+	tab := &app.tabs[0]
+	tab.setColumns([]string{"key", "status"}, nil)
+
+	model, _ := app.Update(keyMsg("t"))
+	app = model.(App)
+	if _, ok := app.overlay.(*textInputOverlay); !ok || app.tabs[0].inline != nil {
+		t.Errorf("expected the title overlay, got %T", app.overlay)
+	}
+}
+
+func TestTitleUsesOverlayInDetailView(t *testing.T) {
+	app := testAppConnected()
+	model, _ := app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	app = model.(App)
+	app.markFetched(jira.Issue{Key: "PROJ-1"})
+
+	model, _ = app.Update(keyMsg("t"))
+	app = model.(App)
+	if _, ok := app.overlay.(*textInputOverlay); !ok {
+		t.Errorf("expected the title overlay, got %T", app.overlay)
+	}
+}
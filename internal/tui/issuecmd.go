@@ -0,0 +1,77 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// viewWidth is the column the description and comments are wrapped to by
+// WriteIssue.
+const viewWidth = 80
+
+// viewFields are the fields WriteIssue lists under the summary, in order.
+var viewFields = []struct{ label, column string }{
+	{"Status", "status"},
+	{"Type", "type"},
+	{"Priority", "priority"},
+	{"Assignee", "assignee"},
+	{"Reporter", "reporter"},
+	{"Created", "created"},
+	{"Updated", "updated"},
+	{"Due", "due"},
+}
+
+// WriteIssue prints an issue as plain text: its fields, description, and
+// the given comments, for 'jira-tui view'. Empty fields are left out.
+func WriteIssue(w io.Writer, issue jira.Issue, comments []jira.Comment) error {
+	fmt.Fprintf(w, "%s: %s\n\n", issue.Key, issue.Fields.Summary)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, f := range viewFields {
+		if value := fieldValue(issue, f.column); value != "" {
+			fmt.Fprintf(tw, "%s:\t%s\n", f.label, value)
+		}
+	}
+	if len(issue.Fields.Labels) > 0 {
+		fmt.Fprintf(tw, "Labels:\t%s\n", strings.Join(issue.Fields.Labels, ", "))
+	}
+	if p := issue.Fields.Parent; p != nil {
+		parent := p.Key
+		if p.Fields != nil {
+			parent += " " + p.Fields.Summary
+		}
+		fmt.Fprintf(tw, "Parent:\t%s\n", parent)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if desc := renderADF(issue.Fields.Description, adfOptions{width: viewWidth}); desc != "" {
+		fmt.Fprintf(w, "\n%s\n", desc)
+	}
+	if len(comments) > 0 {
+		fmt.Fprintf(w, "\nComments (%d)\n", len(comments))
+	}
+	for _, c := range comments {
+		author := "Unknown"
+		if c.Author != nil {
+			author = c.Author.DisplayName
+		}
+		fmt.Fprintf(w, "\n%s · %s\n", author, formatDate(c.Created))
+		body := renderADF(c.Body, adfOptions{width: viewWidth - 2})
+		for _, line := range strings.Split(body, "\n") {
+			fmt.Fprintf(w, "  %s\n", line)
+		}
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+// CommentBody converts plain text to the ADF document a comment is sent as.
+func CommentBody(text string) map[string]interface{} {
+	return makeADFDocument(text)
+}
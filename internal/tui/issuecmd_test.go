@@ -0,0 +1,43 @@
+package tui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestWriteIssue(t *testing.T) {
+	issue := jira.Issue{Key: "PROJ-1", Fields: jira.IssueFields{
+		Summary:     "Fix login",
+		Status:      &jira.Status{Name: "In Progress"},
+		Assignee:    &jira.User{DisplayName: "Alice"},
+		Labels:      []string{"auth", "web"},
+		Description: makeADFDocument("The button does nothing."),
+		Created:     "2024-03-01T10:00:00.000+0000",
+	}}
+	comments := []jira.Comment{{
+		Author:  &jira.User{DisplayName: "Bob"},
+		Body:    makeADFDocument("Reproduced on Safari."),
+		Created: "2024-03-02T09:00:00.000+0000",
+	}}
+
+	var b bytes.Buffer
+	if err := WriteIssue(&b, issue, comments); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	for _, want := range []string{
+		"PROJ-1: Fix login", "Status:    In Progress", "Assignee:  Alice",
+		"Labels:    auth, web", "Created:   2024-03-01", "The button does nothing.",
+		"Comments (1)", "Bob · 2024-03-02", "  Reproduced on Safari.",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output lacks %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Priority:") {
+		t.Errorf("empty fields should be left out:\n%s", out)
+	}
+}
@@ -0,0 +1,95 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// jqlPositionPattern finds where Jira's parser reports a syntax error, e.g.
+// "Expecting ')' before the end of the query. (line 1, character 33)".
+var jqlPositionPattern = regexp.MustCompile(`line (\d+), character (\d+)`)
+
+// invalidJQLError is a tab query Jira's parser rejected.
+type invalidJQLError struct {
+	query    string
+	problems []string
+}
+
+func (e *invalidJQLError) Error() string {
+	return "invalid JQL: " + strings.Join(e.problems, " ")
+}
+
+// detail lists each problem with the query line it points at and a marker
+// under the offending character.
+func (e *invalidJQLError) detail() string {
+	var b strings.Builder
+	b.WriteString("invalid JQL")
+	for _, p := range e.problems {
+		b.WriteString("\n  " + p)
+		if marked := markJQLPosition(e.query, p); marked != "" {
+			b.WriteString("\n" + marked)
+		}
+	}
+	return b.String()
+}
+
+// markJQLPosition returns the query line a problem's "line N, character M"
+// refers to with a caret under that character, or "" when the problem has
+// no position in the query.
+func markJQLPosition(query, problem string) string {
+	m := jqlPositionPattern.FindStringSubmatch(problem)
+	if m == nil {
+		return ""
+	}
+	line, _ := strconv.Atoi(m[1])
+	char, _ := strconv.Atoi(m[2])
+	lines := strings.Split(query, "\n")
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+	text := []rune(lines[line-1])
+	col := min(max(char-1, 0), len(text))
+	return "    " + string(text) + "\n    " + strings.Repeat(" ", col) + "^"
+}
+
+// searchTimedOut is what a tab whose search ran out of time shows, told
+// apart from other failures since retrying often works.
+const searchTimedOut = "Search timed out — press r to retry"
+
+// tabErrorText is what a tab that failed to load shows: the parser's
+// marked-up problems for invalid JQL, a retry hint for a timeout,
+// otherwise the error itself.
+func tabErrorText(err error) string {
+	var invalid *invalidJQLError
+	if errors.As(err, &invalid) {
+		return invalid.detail()
+	}
+	if jira.IsTimeout(err) {
+		return searchTimedOut
+	}
+	return err.Error()
+}
+
+// checkTabJQL validates a tab's query before its first search, so syntax
+// mistakes are reported precisely instead of as a failed search. A
+// validation request that fails isn't held against the query; the search
+// reports any problem instead.
+func checkTabJQL(ctx context.Context, client *jira.Client, query string) error {
+	results, err := client.ParseJQL(ctx, query)
+	if err != nil {
+		return nil
+	}
+	var problems []string
+	for _, r := range results {
+		problems = append(problems, r.Errors...)
+	}
+	if len(problems) > 0 {
+		return &invalidJQLError{query: query, problems: problems}
+	}
+	return nil
+}
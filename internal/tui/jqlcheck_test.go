@@ -0,0 +1,68 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestMarkJQLPosition(t *testing.T) {
+	query := "project = PROJ AND (status = Open"
+	got := markJQLPosition(query, "Expecting ')' before the end of the query. (line 1, character 34)")
+	want := "    " + query + "\n    " + strings.Repeat(" ", 33) + "^"
+	if got != want {
+		t.Errorf("marked =\n%s\nwant\n%s", got, want)
+	}
+
+	second := markJQLPosition("project = PROJ\nAND stauts = Open", "Field 'stauts' does not exist. (line 2, character 5)")
+	if !strings.HasPrefix(second, "    AND stauts = Open\n        ^") {
+		t.Errorf("line 2 marked = %q", second)
+	}
+
+	if got := markJQLPosition(query, "The value 'PROJ' does not exist for the field 'project'."); got != "" {
+		t.Errorf("a problem without a position should not be marked, got %q", got)
+	}
+}
+
+func TestTabErrorText(t *testing.T) {
+	err := &invalidJQLError{
+		query:    "projct = PROJ",
+		problems: []string{"Field 'projct' does not exist. (line 1, character 1)"},
+	}
+	text := tabErrorText(err)
+	if !strings.Contains(text, "projct = PROJ\n    ^") {
+		t.Errorf("detail = %q, want the query marked", text)
+	}
+	if strings.Contains(err.Error(), "\n") {
+		t.Error("Error() should stay on one line for the flash")
+	}
+	if got := tabErrorText(errors.New("boom")); got != "boom" {
+		t.Errorf("tabErrorText = %q", got)
+	}
+	timeout := fmt.Errorf("executing request: %w", context.DeadlineExceeded)
+	if got := tabErrorText(timeout); got != searchTimedOut {
+		t.Errorf("timeout text = %q, want the retry hint", got)
+	}
+}
+
+func TestInvalidJQLShownOnTab(t *testing.T) {
+	app := testAppWithTabs()
+	err := &invalidJQLError{query: "status = ", problems: []string{"Expecting a value (line 1, character 10)"}}
+
+	model, _ := app.Update(tabDataMsg{tabIndex: 0, err: err})
+	app = model.(App)
+	tab := &app.tabs[0]
+	if tab.state != tabError || !strings.Contains(tab.errMsg, "^") {
+		t.Errorf("state = %v, errMsg = %q", tab.state, tab.errMsg)
+	}
+	if tab.jqlChecked {
+		t.Error("an invalid query should be checked again on the next load")
+	}
+
+	model, _ = app.Update(tabDataMsg{tabIndex: 0, jqlValid: true})
+	if !model.(App).tabs[0].jqlChecked {
+		t.Error("a valid query should not be checked again")
+	}
+}
@@ -0,0 +1,120 @@
+package tui
+
+import (
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// diffLabels compares the labels an issue had with the labels the user chose
+// and returns the ones to add and remove, preserving their order.
+func diffLabels(before, after []string) (add, remove []string) {
+	had := make(map[string]bool, len(before))
+	for _, l := range before {
+		had[l] = true
+	}
+	want := make(map[string]bool, len(after))
+	for _, l := range after {
+		want[l] = true
+		if !had[l] {
+			add = append(add, l)
+		}
+	}
+	for _, l := range before {
+		if !want[l] {
+			remove = append(remove, l)
+		}
+	}
+	return add, remove
+}
+
+// labelSuggestions orders the labels offered when adding labels: those on
+// the tab's issues, most used first, then the rest of the instance's
+// labels. Labels in skip, the issue's own, are left out.
+func labelSuggestions(issues []jira.Issue, instance, skip []string) []string {
+	count := make(map[string]int)
+	for _, issue := range issues {
+		for _, l := range issue.Fields.Labels {
+			count[l]++
+		}
+	}
+	for _, l := range skip {
+		delete(count, l)
+	}
+	used := make([]string, 0, len(count))
+	for l := range count {
+		used = append(used, l)
+	}
+	sort.Slice(used, func(i, j int) bool {
+		if count[used[i]] != count[used[j]] {
+			return count[used[i]] > count[used[j]]
+		}
+		return used[i] < used[j]
+	})
+
+	skipped := make(map[string]bool, len(skip))
+	for _, l := range skip {
+		skipped[l] = true
+	}
+	suggestions := used
+	for _, l := range instance {
+		if count[l] == 0 && !skipped[l] {
+			suggestions = append(suggestions, l)
+		}
+	}
+	return suggestions
+}
+
+// addLabelsOverlay opens the label input with completions for issue, or
+// for the multi-selection when overlayKeys is set. The instance's labels
+// are fetched on first use and join the completions when they arrive.
+func (a *App) addLabelsOverlay(issue *jira.Issue) tea.Cmd {
+	var own []string
+	if len(a.overlayKeys) == 0 {
+		own = issue.Fields.Labels
+	}
+	var tabIssues []jira.Issue
+	if a.activeTab < len(a.tabs) {
+		tabIssues = a.tabs[a.activeTab].issues
+	}
+	input := newTextInputOverlay(a.overlayTitle("Add Labels"), "")
+	input.suggestions = labelSuggestions(tabIssues, a.cachedLabels, own)
+	a.overlay = input
+	a.overlayIssue = issue.Key
+	a.overlayAction = overlayActionAddLabels
+	a.labelsBefore = append([]string(nil), own...)
+	if len(a.cachedLabels) > 0 {
+		return nil
+	}
+	return a.startNetwork(a.cmdFetchLabels())
+}
+
+// removeLabelsOverlay opens a checklist of the labels on the selected issues
+// (overlayKeys), none checked; the checked ones are removed from all of them.
+func (a *App) removeLabelsOverlay(issue *jira.Issue) {
+	selected := make(map[string]bool, len(a.overlayKeys))
+	for _, k := range a.overlayKeys {
+		selected[k] = true
+	}
+	var labels []string
+	seen := make(map[string]bool)
+	if a.activeTab < len(a.tabs) {
+		for _, is := range a.tabs[a.activeTab].issues {
+			if !selected[is.Key] {
+				continue
+			}
+			for _, l := range is.Fields.Labels {
+				if !seen[l] {
+					seen[l] = true
+					labels = append(labels, l)
+				}
+			}
+		}
+	}
+	sort.Strings(labels)
+	a.overlay = newChecklistOverlay(a.overlayTitle("Remove Labels"), labels, nil)
+	a.overlayIssue = issue.Key
+	a.overlayAction = overlayActionRemoveLabels
+}
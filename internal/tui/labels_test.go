@@ -0,0 +1,209 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestDiffLabels(t *testing.T) {
+	tests := []struct {
+		name       string
+		before     []string
+		after      []string
+		wantAdd    []string
+		wantRemove []string
+	}{
+		{"unchanged", []string{"a", "b"}, []string{"b", "a"}, nil, nil},
+		{"add", []string{"a"}, []string{"a", "b"}, []string{"b"}, nil},
+		{"remove", []string{"a", "b"}, []string{"a"}, nil, []string{"b"}},
+		{"both", []string{"a"}, []string{"c"}, []string{"c"}, []string{"a"}},
+		{"from empty", nil, []string{"x"}, []string{"x"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			add, remove := diffLabels(tt.before, tt.after)
+			if !reflect.DeepEqual(add, tt.wantAdd) {
+				t.Errorf("add = %v, want %v", add, tt.wantAdd)
+			}
+			if !reflect.DeepEqual(remove, tt.wantRemove) {
+				t.Errorf("remove = %v, want %v", remove, tt.wantRemove)
+			}
+		})
+	}
+}
+
+func TestLabelHotkeyFetchesThenOpensOverlay(t *testing.T) {
+	app := testAppReady()
+	app.client = jira.NewClient("https://fake.atlassian.net", "test@test.com", "token")
+	app.tabs[0].issues[0].Fields.Labels = []string{"backend"}
+
+	model, cmd := app.Update(keyMsg("l"))
+	app = model.(App)
+	if cmd == nil {
+		t.Fatal("expected a cmd to fetch labels")
+	}
+	if app.overlay != nil {
+		t.Error("overlay should not open until labels load")
+	}
+
+	model, _ = app.Update(labelsLoadedMsg{labels: []string{"backend", "frontend"}})
+	app = model.(App)
+	cl, ok := app.overlay.(*checklistOverlay)
+	if !ok {
+		t.Fatalf("expected checklistOverlay, got %T", app.overlay)
+	}
+	if !cl.checked["backend"] || cl.checked["frontend"] {
+		t.Errorf("checked = %v, want only backend", cl.checked)
+	}
+	if len(app.cachedLabels) != 2 {
+		t.Errorf("expected labels to be cached, got %v", app.cachedLabels)
+	}
+}
+
+func TestLabelOverlayResultSendsDiff(t *testing.T) {
+	app := testAppReady()
+	app.client = jira.NewClient("https://fake.atlassian.net", "test@test.com", "token")
+	app.overlayIssue = "PROJ-1"
+	app.overlayAction = overlayActionLabels
+	app.labelsBefore = []string{"backend"}
+
+	model, cmd := app.handleOverlayResult([]string{"backend"})
+	if cmd != nil {
+		t.Error("expected no cmd when labels are unchanged")
+	}
+	if len(model.(App).pending) != 0 {
+		t.Error("expected no pending write when labels are unchanged")
+	}
+
+	app.overlayIssue = "PROJ-1"
+	app.overlayAction = overlayActionLabels
+	app.labelsBefore = []string{"backend"}
+	model, cmd = app.handleOverlayResult([]string{"frontend"})
+	if cmd == nil {
+		t.Fatal("expected a cmd for label update")
+	}
+	updated := model.(App)
+	if len(updated.pending) != 1 || updated.pending[0].issueKey != "PROJ-1" {
+		t.Errorf("pending = %v, want one write for PROJ-1", updated.pending)
+	}
+}
+
+func TestTabKeyCyclesTabs(t *testing.T) {
+	app := testAppReady()
+	model, _ := app.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if got := model.(App).activeTab; got != 1 {
+		t.Errorf("activeTab after tab = %d, want 1", got)
+	}
+	model, _ = model.(App).Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	if got := model.(App).activeTab; got != 0 {
+		t.Errorf("activeTab after shift+tab = %d, want 0", got)
+	}
+	model, _ = model.(App).Update(keyMsg("h"))
+	if got := model.(App).activeTab; got != len(app.tabs)-1 {
+		t.Errorf("activeTab after h = %d, want %d", got, len(app.tabs)-1)
+	}
+}
+
+func TestLabelSuggestions(t *testing.T) {
+	issues := []jira.Issue{
+		{Fields: jira.IssueFields{Labels: []string{"ui", "backend"}}},
+		{Fields: jira.IssueFields{Labels: []string{"backend"}}},
+		{Fields: jira.IssueFields{Labels: []string{"mine"}}},
+	}
+	got := labelSuggestions(issues, []string{"zeta", "backend", "alpha", "mine"}, []string{"mine"})
+	want := []string{"backend", "ui", "zeta", "alpha"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("suggestions = %v, want %v", got, want)
+	}
+}
+
+func TestAddLabelsCompletes(t *testing.T) {
+	app := testAppConnected()
+	app.tabs[0].issues[1].Fields.Labels = []string{"frontend"}
+	app.cachedLabels = []string{"backend", "frontend", "infra"}
+
+	model, cmd := app.Update(keyMsg("L"))
+	app = model.(App)
+	input, ok := app.overlay.(*textInputOverlay)
+	if !ok {
+		t.Fatalf("expected the label input, got %T", app.overlay)
+	}
+	if cmd != nil {
+		t.Error("labels are cached; nothing should be fetched")
+	}
+
+	o := updateOverlay(input, keyMsg("r"))
+	input = o.(*textInputOverlay)
+	// No label starts with "r": those containing it, the tab's first
+	if got := input.completions(); !reflect.DeepEqual(got, []string{"frontend", "infra"}) {
+		t.Fatalf("completions = %v", got)
+	}
+	o = updateOverlay(o, keyMsg("down"))
+	o = updateOverlay(o, tea.KeyMsg{Type: tea.KeyTab})
+	input = o.(*textInputOverlay)
+	if got := input.input.Value(); got != "infra, " {
+		t.Errorf("value = %q, want the second completion", got)
+	}
+	o = updateOverlay(o, keyMsg("f"))
+	if got := o.(*textInputOverlay).completions(); !reflect.DeepEqual(got, []string{"frontend"}) {
+		t.Errorf("completions = %v, entered labels should be left out", got)
+	}
+}
+
+func TestAddLabelsFetchesInstanceLabels(t *testing.T) {
+	app := testAppConnected()
+	model, cmd := app.Update(keyMsg("L"))
+	app = model.(App)
+	if cmd == nil {
+		t.Fatal("expected the instance labels to be fetched")
+	}
+	model, _ = app.Update(labelsLoadedMsg{labels: []string{"release"}})
+	app = model.(App)
+	input := app.overlay.(*textInputOverlay)
+	if !reflect.DeepEqual(input.suggestions, []string{"release"}) {
+		t.Errorf("suggestions = %v", input.suggestions)
+	}
+	if app.overlayAction != overlayActionAddLabels {
+		t.Error("the label input should stay open")
+	}
+}
+
+func TestBulkRemoveLabels(t *testing.T) {
+	app := testAppReady()
+	app.client = jira.NewClient("https://fake.atlassian.net", "test@test.com", "token")
+	app.tabs[0].issues[0].Fields.Labels = []string{"ui", "backend"}
+	app.tabs[0].issues[1].Fields.Labels = []string{"backend", "urgent"}
+
+	model, _ := app.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	app = model.(App)
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyDown})
+	app = model.(App)
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("V")})
+	app = model.(App)
+
+	model, _ = app.Update(keyMsg("l"))
+	app = model.(App)
+	cl, ok := app.overlay.(*checklistOverlay)
+	if !ok {
+		t.Fatalf("expected checklistOverlay, got %T", app.overlay)
+	}
+	if want := []string{"backend", "ui", "urgent"}; !reflect.DeepEqual(cl.items, want) {
+		t.Errorf("items = %v, want %v", cl.items, want)
+	}
+	if len(cl.checked) != 0 {
+		t.Errorf("checked = %v, want none", cl.checked)
+	}
+
+	model, cmd := app.handleOverlayResult([]string{"backend"})
+	if cmd == nil {
+		t.Fatal("expected a bulk command")
+	}
+	updated := model.(App)
+	if updated.bulk == nil || updated.bulk.total != 2 || updated.bulk.label != "Remove labels" {
+		t.Errorf("bulk = %+v, want Remove labels over 2 issues", updated.bulk)
+	}
+}
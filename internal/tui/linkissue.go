@@ -0,0 +1,193 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// linkPrefs steer the link picker. Names match a link type's name or
+// either of its descriptions, ignoring case.
+type linkPrefs struct {
+	def  string   // listed first
+	hide []string // never listed
+}
+
+// linkTypesMsg delivers the instance's link types, from the cache file or
+// Jira, for the link picker on issueKey.
+type linkTypesMsg struct {
+	issueKey string
+	types    []jira.LinkType
+	saveErr  error // the fetched types couldn't be cached
+	err      error
+}
+
+// linkChoice is the link type and direction picked for a new link.
+type linkChoice struct {
+	typeName string
+	phrase   string // e.g. "is blocked by"
+	outward  bool   // the issue is the link's inward side: "PROJ-1 blocks …"
+}
+
+// SetLinkTypes sets the link type 'I' lists first and those it never
+// lists.
+func (a *App) SetLinkTypes(def string, hide []string) {
+	a.linkPrefs = linkPrefs{def: def, hide: hide}
+}
+
+// linkTypeNamed reports whether t is named, or described by, one of names.
+func linkTypeNamed(t jira.LinkType, names ...string) bool {
+	for _, name := range names {
+		if strings.EqualFold(t.Name, name) || strings.EqualFold(t.Outward, name) || strings.EqualFold(t.Inward, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// linkItems lists the picker's choices: each link type's outward then
+// inward wording — once for a type that reads the same both ways, like
+// "relates to" — with the default type first and hidden types left out.
+// IDs are "out:" or "in:" and the type's name.
+func (p linkPrefs) linkItems(types []jira.LinkType) []selectionItem {
+	var first, rest []selectionItem
+	for _, t := range types {
+		if linkTypeNamed(t, p.hide...) {
+			continue
+		}
+		items := []selectionItem{{ID: "out:" + t.Name, Label: t.Outward, Desc: t.Name}}
+		if !strings.EqualFold(t.Inward, t.Outward) {
+			items = append(items, selectionItem{ID: "in:" + t.Name, Label: t.Inward, Desc: t.Name})
+		}
+		if p.def != "" && linkTypeNamed(t, p.def) {
+			first = append(first, items...)
+		} else {
+			rest = append(rest, items...)
+		}
+	}
+	return append(first, rest...)
+}
+
+// startLink asks how to link an issue to another, fetching the link types
+// the first time.
+func (a App) startLink(issueKey string) (tea.Model, tea.Cmd) {
+	if a.linkTypes == nil {
+		a.flash = "Loading link types..."
+		a.flashIsErr = false
+		return a, a.startNetwork(a.cmdFetchLinkTypes(issueKey))
+	}
+	return a.showLinkTypes(issueKey)
+}
+
+// showLinkTypes opens the link type picker on issueKey.
+func (a App) showLinkTypes(issueKey string) (tea.Model, tea.Cmd) {
+	items := a.linkPrefs.linkItems(a.linkTypes)
+	if len(items) == 0 {
+		a.flash = "No link types to offer (check link_types.hide)"
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.overlay = newSelectionOverlay("Link "+issueKey, items)
+	a.overlayIssue = issueKey
+	a.overlayAction = overlayActionLinkType
+	return a, nil
+}
+
+// cmdFetchLinkTypes reads the link types cached for the instance, fetching
+// and caching them when there are none.
+func (a App) cmdFetchLinkTypes(issueKey string) tea.Cmd {
+	client := a.client
+	return func() tea.Msg {
+		cache, _ := config.LoadLinkTypeCache()
+		if types := cache[client.BaseURL()]; len(types) > 0 {
+			return linkTypesMsg{issueKey: issueKey, types: types}
+		}
+		types, err := client.GetIssueLinkTypes(context.Background())
+		if err != nil {
+			return linkTypesMsg{issueKey: issueKey, err: err}
+		}
+		if cache == nil {
+			cache = config.LinkTypeCache{}
+		}
+		cache[client.BaseURL()] = types
+		return linkTypesMsg{issueKey: issueKey, types: types, saveErr: config.SaveLinkTypeCache(cache)}
+	}
+}
+
+// handleLinkTypes opens the picker with the loaded link types, unless
+// another overlay opened in the meantime.
+func (a App) handleLinkTypes(msg linkTypesMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	if msg.err != nil {
+		a.flash = msg.err.Error()
+		a.flashIsErr = true
+		return a, nil
+	}
+	if msg.saveErr != nil {
+		a.log.Warn("caching link types", "err", msg.saveErr)
+	}
+	a.linkTypes = msg.types
+	a.flash = ""
+	if a.overlay != nil {
+		return a, nil
+	}
+	return a.showLinkTypes(msg.issueKey)
+}
+
+// handleLinkTypePick asks for the issue on the link's other side.
+func (a App) handleLinkTypePick(issueKey string, item *selectionItem) (tea.Model, tea.Cmd) {
+	dir, name, _ := strings.Cut(item.ID, ":")
+	a.linkChoice = &linkChoice{typeName: name, phrase: item.Label, outward: dir == "out"}
+	a.overlay = newTextInputOverlay(issueKey+" "+item.Label, projectKeyOf(issueKey)+"-")
+	a.overlayIssue = issueKey
+	a.overlayAction = overlayActionLinkTarget
+	return a, nil
+}
+
+// handleLinkTarget links issueKey to the entered issue. A bare number is
+// taken as an issue in issueKey's project.
+func (a App) handleLinkTarget(issueKey, text string) (tea.Model, tea.Cmd) {
+	choice := a.linkChoice
+	a.linkChoice = nil
+	target := strings.ToUpper(strings.TrimSpace(text))
+	if issueKeyPattern.MatchString(projectKeyOf(issueKey) + "-" + target) {
+		target = projectKeyOf(issueKey) + "-" + target
+	}
+	if choice == nil || target == projectKeyOf(issueKey)+"-" || target == "" {
+		return a, nil
+	}
+	if !issueKeyPattern.MatchString(target) || target == issueKey {
+		a.flash = fmt.Sprintf("%q is not another issue's key", text)
+		a.flashIsErr = true
+		return a, nil
+	}
+	inward, outward := issueKey, target
+	if !choice.outward {
+		inward, outward = target, issueKey
+	}
+	a.flash = fmt.Sprintf("Linking: %s %s %s...", issueKey, choice.phrase, target)
+	a.flashIsErr = false
+	return a, a.trackWrite(writeUpdate, issueKey, a.cmdLinkIssues(issueKey, choice.typeName, inward, outward))
+}
+
+// cmdLinkIssues creates a link and refetches issueKey so its links show
+// the new one.
+func (a App) cmdLinkIssues(issueKey, typeName, inwardKey, outwardKey string) tea.Cmd {
+	client := a.client
+	return func() tea.Msg {
+		ctx := context.Background()
+		if err := client.CreateIssueLink(ctx, typeName, inwardKey, outwardKey); err != nil {
+			return issueUpdatedMsg{issueKey: issueKey, err: err}
+		}
+		issue, err := client.GetIssue(ctx, issueKey)
+		if err != nil {
+			return issueUpdatedMsg{issueKey: issueKey, err: fmt.Errorf("refresh: %w", err)}
+		}
+		return issueUpdatedMsg{issueKey: issueKey, issue: issue}
+	}
+}
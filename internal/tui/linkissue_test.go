@@ -0,0 +1,78 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+var testLinkTypes = []jira.LinkType{
+	{Name: "Cloners", Inward: "is cloned by", Outward: "clones"},
+	{Name: "Relates", Inward: "relates to", Outward: "relates to"},
+	{Name: "Blocks", Inward: "is blocked by", Outward: "blocks"},
+}
+
+func TestLinkItems(t *testing.T) {
+	items := linkPrefs{def: "is blocked by", hide: []string{"cloners"}}.linkItems(testLinkTypes)
+	var got []string
+	for _, item := range items {
+		got = append(got, item.ID+"="+item.Label)
+	}
+	want := "out:Blocks=blocks,in:Blocks=is blocked by,out:Relates=relates to"
+	if strings.Join(got, ",") != want {
+		t.Errorf("items = %v, want %s", got, want)
+	}
+}
+
+func TestLinkIssue(t *testing.T) {
+	app := testAppConnected()
+	model, cmd := app.Update(keyMsg("I"))
+	app = model.(App)
+	if cmd == nil || app.flash != "Loading link types..." {
+		t.Fatalf("I should load the link types first, flash = %q", app.flash)
+	}
+	model, _ = app.Update(linkTypesMsg{issueKey: "PROJ-1", types: testLinkTypes})
+	app = model.(App)
+	if _, ok := app.overlay.(*selectionOverlay); !ok || app.overlayAction != overlayActionLinkType {
+		t.Fatalf("overlay = %T, want the link type picker", app.overlay)
+	}
+
+	// "is cloned by" is the second choice, after "clones"
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyDown})
+	app = model.(App)
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	app = model.(App)
+	input, ok := app.overlay.(*textInputOverlay)
+	if !ok || input.input.Value() != "PROJ-" || input.title != "PROJ-1 is cloned by" {
+		t.Fatalf("overlay = %T, want the issue key input pre-filled with the project", app.overlay)
+	}
+
+	input.input.SetValue("3")
+	model, cmd = app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	app = model.(App)
+	if cmd == nil || len(app.pending) != 1 || app.linkChoice != nil {
+		t.Fatalf("pending = %d, want the link sent", len(app.pending))
+	}
+	if app.flash != "Linking: PROJ-1 is cloned by PROJ-3..." {
+		t.Errorf("flash = %q", app.flash)
+	}
+
+	// The types stay loaded for the next link
+	model, _ = app.Update(keyMsg("I"))
+	if model.(App).overlayAction != overlayActionLinkType {
+		t.Error("a second I should open the picker at once")
+	}
+}
+
+func TestLinkIssueRejectsBadKey(t *testing.T) {
+	app := testAppConnected()
+	app.linkChoice = &linkChoice{typeName: "Blocks", phrase: "blocks", outward: true}
+	model, cmd := app.handleLinkTarget("PROJ-1", "PROJ-1")
+	app = model.(App)
+	if cmd != nil || !app.flashIsErr || len(app.pending) != 0 {
+		t.Errorf("linking an issue to itself should be refused, flash = %q", app.flash)
+	}
+}
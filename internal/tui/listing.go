@@ -0,0 +1,190 @@
+package tui
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// ListFormats are the output formats WriteIssues accepts.
+var ListFormats = []string{"table", "json", "csv", "markdown"}
+
+// tabJQL returns a tab's query: its jql, or its saved filter's JQL along
+// with the filter.
+func tabJQL(ctx context.Context, client *jira.Client, cfg config.TabConfig) (string, *jira.Filter, error) {
+	switch {
+	case cfg.JQL != "":
+		// Direct JQL — no filter fetch needed
+		return cfg.JQL, nil, nil
+	case cfg.FilterID != "":
+		f, err := client.GetFilter(ctx, cfg.FilterID)
+		if err != nil {
+			return "", nil, err
+		}
+		return f.JQL, f, nil
+	}
+	return "", nil, fmt.Errorf("filter_url is not yet supported")
+}
+
+// tabFields lists the fields a tab's search requests.
+func tabFields(cfg config.TabConfig) []string {
+	fields := mergeSearchFields(cfg.Columns)
+	if fetchesParents(cfg) {
+		fields = append(fields, "parent")
+	}
+	return fields
+}
+
+// TabIssues runs a tab's query, sorted as the tab sorts it, and returns
+// every matching issue.
+func TabIssues(ctx context.Context, client *jira.Client, cfg config.TabConfig) ([]jira.Issue, error) {
+	jql, _, err := tabJQL(ctx, client, cfg)
+	if err != nil {
+		return nil, err
+	}
+	query := sortedJQL(jql, cfg.Sort)
+	if err := checkTabJQL(ctx, client, query); err != nil {
+		return nil, err
+	}
+	issues, _, err := searchPages(ctx, client, jira.SearchOptions{
+		JQL:        query,
+		Fields:     tabFields(cfg),
+		MaxResults: remainderPageSize,
+	}, true)
+	return issues, err
+}
+
+// GroupCount is how many issues fall in one group_by group.
+type GroupCount struct {
+	Name  string
+	Count int
+}
+
+// groupFields are the search fields each group_by value is read from.
+var groupFields = map[string]string{
+	"status":   "status",
+	"assignee": "assignee",
+	"priority": "priority",
+	"epic":     "parent",
+}
+
+// CountIssuesBy counts the issues matching jql in each group_by group,
+// ordered as a grouped tab orders its groups. Only the grouping field is
+// fetched, but every matching issue is, so large queries take a while.
+func CountIssuesBy(ctx context.Context, client *jira.Client, jql, field string) ([]GroupCount, error) {
+	issues, _, err := searchPages(ctx, client, jira.SearchOptions{
+		JQL:        jql,
+		Fields:     []string{groupFields[field]},
+		MaxResults: remainderPageSize,
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	groups := groupIssues(issues, field)
+	counts := make([]GroupCount, len(groups))
+	for i, g := range groups {
+		counts[i] = GroupCount{Name: g.name, Count: len(g.issues)}
+	}
+	return counts, nil
+}
+
+// markdownCellReplacer escapes the characters that would break a Markdown
+// table cell.
+var markdownCellReplacer = strings.NewReplacer("|", `\|`, "\n", " ")
+
+// listColumns drops the columns that only make sense in the app: next
+// needs the workflow, rank the board position.
+func listColumns(columns []string) []string {
+	var out []string
+	for _, c := range columns {
+		if c != nextColumn && c != "rank" {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// WriteIssues prints issues' column values as an aligned table, a JSON
+// array of objects keyed by column, CSV with a header row, or a Markdown
+// table.
+func WriteIssues(w io.Writer, issues []jira.Issue, columns []string, format string) error {
+	columns = listColumns(columns)
+	switch format {
+	case "json":
+		rows := make([]map[string]string, len(issues))
+		for i, issue := range issues {
+			rows[i] = make(map[string]string, len(columns))
+			for _, c := range columns {
+				rows[i][c] = fieldValue(issue, c)
+			}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write(columns)
+		for _, issue := range issues {
+			record := make([]string, len(columns))
+			for j, c := range columns {
+				record[j] = fieldValue(issue, c)
+			}
+			cw.Write(record)
+		}
+		cw.Flush()
+		return cw.Error()
+
+	case "markdown":
+		var b strings.Builder
+		row := func(values []string) {
+			for i, v := range values {
+				values[i] = markdownCellReplacer.Replace(v)
+			}
+			b.WriteString("| " + strings.Join(values, " | ") + " |\n")
+		}
+		titles := make([]string, len(columns))
+		for j, c := range columns {
+			titles[j] = columnDefFor(c).title
+		}
+		row(titles)
+		b.WriteString("|" + strings.Repeat("---|", len(columns)) + "\n")
+		for _, issue := range issues {
+			values := make([]string, len(columns))
+			for j, c := range columns {
+				values[j] = fieldValue(issue, c)
+			}
+			row(values)
+		}
+		_, err := io.WriteString(w, b.String())
+		return err
+
+	case "table", "":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for j, c := range columns {
+			if j > 0 {
+				fmt.Fprint(tw, "\t")
+			}
+			fmt.Fprint(tw, columnDefFor(c).title)
+		}
+		fmt.Fprintln(tw)
+		for _, issue := range issues {
+			for j, c := range columns {
+				if j > 0 {
+					fmt.Fprint(tw, "\t")
+				}
+				fmt.Fprint(tw, fieldValue(issue, c))
+			}
+			fmt.Fprintln(tw)
+		}
+		return tw.Flush()
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
@@ -0,0 +1,102 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func listIssues() []jira.Issue {
+	return []jira.Issue{
+		{Key: "PROJ-1", Fields: jira.IssueFields{Summary: "Fix login, again", Status: &jira.Status{Name: "Open"}, Priority: &jira.Named{Name: "High"}}},
+		{Key: "PROJ-22", Fields: jira.IssueFields{Summary: "Docs", Status: &jira.Status{Name: "Done"}}},
+	}
+}
+
+func TestWriteIssuesTable(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteIssues(&buf, listIssues(), []string{"key", "status", "priority", nextColumn}, "table"); err != nil {
+		t.Fatal(err)
+	}
+	want := "Key      Status  Priority\n" +
+		"PROJ-1   Open    High\n" +
+		"PROJ-22  Done    \n"
+	if buf.String() != want {
+		t.Errorf("table =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestWriteIssuesJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteIssues(&buf, listIssues(), []string{"key", "summary", "rank"}, "json"); err != nil {
+		t.Fatal(err)
+	}
+	var rows []map[string]string
+	if err := json.Unmarshal(buf.Bytes(), &rows); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if len(rows) != 2 || rows[0]["key"] != "PROJ-1" || rows[0]["summary"] != "Fix login, again" {
+		t.Errorf("rows = %v", rows)
+	}
+	if _, ok := rows[0]["rank"]; ok {
+		t.Error("app-only columns should be left out")
+	}
+}
+
+func TestWriteIssuesCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteIssues(&buf, listIssues(), []string{"key", "summary"}, "csv"); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || lines[0] != "key,summary" || lines[1] != `PROJ-1,"Fix login, again"` {
+		t.Errorf("csv = %q", lines)
+	}
+	if err := WriteIssues(&buf, nil, nil, "xml"); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
+
+func TestWriteIssuesMarkdown(t *testing.T) {
+	issues := listIssues()
+	issues[1].Fields.Summary = "Docs | guides"
+	var buf bytes.Buffer
+	if err := WriteIssues(&buf, issues, []string{"key", "summary"}, "markdown"); err != nil {
+		t.Fatal(err)
+	}
+	want := "| Key | Summary |\n|---|---|\n| PROJ-1 | Fix login, again |\n| PROJ-22 | Docs \\| guides |\n"
+	if buf.String() != want {
+		t.Errorf("markdown =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestCountIssuesBy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if fields, _ := body["fields"].([]interface{}); len(fields) != 1 || fields[0] != "status" {
+			t.Errorf("fields = %v, want only the grouping field", body["fields"])
+		}
+		w.Write([]byte(`{"isLast":true,"issues":[
+			{"key":"PROJ-1","fields":{"status":{"name":"Done","statusCategory":{"key":"done"}}}},
+			{"key":"PROJ-2","fields":{"status":{"name":"To Do","statusCategory":{"key":"new"}}}},
+			{"key":"PROJ-3","fields":{"status":{"name":"Done","statusCategory":{"key":"done"}}}}
+		]}`))
+	}))
+	defer server.Close()
+
+	counts, err := CountIssuesBy(context.Background(), jira.NewClient(server.URL, "test@test.com", "token"), "project = PROJ", "status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []GroupCount{{Name: "To Do", Count: 1}, {Name: "Done", Count: 2}}
+	if len(counts) != len(want) || counts[0] != want[0] || counts[1] != want[1] {
+		t.Errorf("counts = %v, want %v in status order", counts, want)
+	}
+}
@@ -0,0 +1,121 @@
+package tui
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// logLineMsg carries a log record to the status bar.
+type logLineMsg struct {
+	level slog.Level
+	text  string
+}
+
+// StatusLogHandler is a slog.Handler that shows records in the TUI's
+// status bar, for --verbose. Records logged before Attach are dropped.
+type StatusLogHandler struct {
+	sink  *logSink
+	level slog.Leveler
+	attrs []slog.Attr
+	group string
+}
+
+// logSink is shared by a handler and the handlers derived from it.
+type logSink struct {
+	mu   sync.Mutex
+	send func(tea.Msg)
+}
+
+// NewStatusLogHandler returns a handler for records at level and above.
+func NewStatusLogHandler(level slog.Leveler) *StatusLogHandler {
+	return &StatusLogHandler{sink: &logSink{}, level: level}
+}
+
+// Attach starts sending records to the program through send, usually
+// tea.Program.Send.
+func (h *StatusLogHandler) Attach(send func(tea.Msg)) {
+	h.sink.mu.Lock()
+	defer h.sink.mu.Unlock()
+	h.sink.send = send
+}
+
+// Enabled implements slog.Handler.
+func (h *StatusLogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+// Handle implements slog.Handler. The record is sent from a goroutine so
+// logging never waits on the program's event loop.
+func (h *StatusLogHandler) Handle(_ context.Context, r slog.Record) error {
+	h.sink.mu.Lock()
+	send := h.sink.send
+	h.sink.mu.Unlock()
+	if send == nil {
+		return nil
+	}
+	attrs := append([]slog.Attr(nil), h.attrs...)
+	r.Attrs(func(a slog.Attr) bool {
+		if h.group != "" {
+			a.Key = h.group + "." + a.Key
+		}
+		attrs = append(attrs, a)
+		return true
+	})
+	msg := logLineMsg{level: r.Level, text: formatLogLine(r.Message, attrs)}
+	go send(msg)
+	return nil
+}
+
+// WithAttrs implements slog.Handler.
+func (h *StatusLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	c := *h
+	c.attrs = append(append([]slog.Attr(nil), h.attrs...), attrs...)
+	return &c
+}
+
+// WithGroup implements slog.Handler.
+func (h *StatusLogHandler) WithGroup(name string) slog.Handler {
+	c := *h
+	if c.group != "" {
+		name = c.group + "." + name
+	}
+	c.group = name
+	return &c
+}
+
+// formatLogLine renders a record for the status bar, e.g. "saving the
+// user cache: permission denied (path=...)": the error after a colon,
+// other attributes in parentheses.
+func formatLogLine(msg string, attrs []slog.Attr) string {
+	var b strings.Builder
+	b.WriteString(msg)
+	var rest []string
+	for _, a := range attrs {
+		if a.Key == "err" {
+			b.WriteString(": " + a.Value.String())
+			continue
+		}
+		rest = append(rest, a.Key+"="+a.Value.String())
+	}
+	if len(rest) > 0 {
+		b.WriteString(" (" + strings.Join(rest, ", ") + ")")
+	}
+	return b.String()
+}
+
+// SetLogger sets where the TUI reports problems it works around, like a
+// user cache that can't be saved.
+func (a *App) SetLogger(l *slog.Logger) {
+	a.log = l
+}
+
+// handleLogLine shows a mirrored log record in the status bar.
+func (a App) handleLogLine(msg logLineMsg) (tea.Model, tea.Cmd) {
+	a.flash = msg.text
+	a.flashIsErr = msg.level >= slog.LevelWarn
+	return a, nil
+}
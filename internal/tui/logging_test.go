@@ -0,0 +1,45 @@
+package tui
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestStatusLogHandler(t *testing.T) {
+	h := NewStatusLogHandler(slog.LevelWarn)
+	logger := slog.New(h)
+	logger.Warn("dropped before Attach")
+
+	got := make(chan tea.Msg, 1)
+	h.Attach(func(msg tea.Msg) { got <- msg })
+	logger.Info("below the level")
+	logger.With("issue", "PROJ-1").Warn("loading comments", "err", errors.New("503"))
+
+	select {
+	case msg := <-got:
+		line, ok := msg.(logLineMsg)
+		if !ok || line.text != "loading comments: 503 (issue=PROJ-1)" || line.level != slog.LevelWarn {
+			t.Errorf("msg = %+v", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("warning was not sent")
+	}
+	select {
+	case msg := <-got:
+		t.Errorf("unexpected %+v", msg)
+	default:
+	}
+}
+
+func TestLogLineShownInStatusBar(t *testing.T) {
+	app := testAppReady()
+	model, _ := app.Update(logLineMsg{level: slog.LevelWarn, text: "saving the user cache: disk full"})
+	app = model.(App)
+	if app.flash != "saving the user cache: disk full" || !app.flashIsErr {
+		t.Errorf("flash = %q, err = %v", app.flash, app.flashIsErr)
+	}
+}
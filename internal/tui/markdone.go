@@ -0,0 +1,149 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// donePrefs steer which done-category transition 'd' takes. Names match
+// a transition or the status it leads to, ignoring case.
+type donePrefs struct {
+	prefer  []string // taken first, in order, when available
+	exclude []string // never taken, e.g. "Won't Do"
+}
+
+// doneTransitionsMsg delivers an issue's transitions for marking it done.
+type doneTransitionsMsg struct {
+	issueKey    string
+	transitions []jira.Transition
+	err         error
+}
+
+// SetDoneTransitions sets the transitions 'd' prefers and those it never
+// takes.
+func (a *App) SetDoneTransitions(prefer, exclude []string) {
+	a.donePrefs = donePrefs{prefer: prefer, exclude: exclude}
+}
+
+// transitionNamed reports whether t is named, or leads to a status
+// named, one of names.
+func transitionNamed(t jira.Transition, names []string) bool {
+	for _, name := range names {
+		if strings.EqualFold(t.Name, name) || (t.To != nil && strings.EqualFold(t.To.Name, name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// doneTransition picks the transition for marking done: the first
+// preferred one, or the only done-category transition left after
+// exclusions. When several are left and none is preferred it returns
+// nil with the candidates to choose from.
+func (p donePrefs) doneTransition(transitions []jira.Transition) (*jira.Transition, []jira.Transition) {
+	var candidates []jira.Transition
+	for _, t := range transitions {
+		if t.To != nil && t.To.StatusCategory != nil && t.To.StatusCategory.Key == "done" &&
+			!transitionNamed(t, p.exclude) {
+			candidates = append(candidates, t)
+		}
+	}
+	for _, name := range p.prefer {
+		for i, t := range candidates {
+			if transitionNamed(t, []string{name}) {
+				return &candidates[i], candidates
+			}
+		}
+	}
+	if len(candidates) == 1 {
+		return &candidates[0], candidates
+	}
+	return nil, candidates
+}
+
+// transitionNames lists the transitions' names for messages.
+func transitionNames(transitions []jira.Transition) string {
+	names := make([]string, len(transitions))
+	for i, t := range transitions {
+		names[i] = t.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// cmdFetchDoneTransitions fetches issueKey's transitions for 'd'.
+func (a App) cmdFetchDoneTransitions(issueKey string) tea.Cmd {
+	client := a.client
+	return func() tea.Msg {
+		transitions, err := client.GetTransitions(context.Background(), issueKey)
+		if err != nil {
+			err = fmt.Errorf("get transitions: %w", err)
+		}
+		return doneTransitionsMsg{issueKey: issueKey, transitions: transitions, err: err}
+	}
+}
+
+// handleDoneTransitions takes the done transition the preferences pick,
+// or asks which one when several are left.
+func (a App) handleDoneTransitions(msg doneTransitionsMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	if msg.err != nil {
+		a.flash = msg.err.Error()
+		a.flashIsErr = true
+		return a, nil
+	}
+	if issue := a.findIssue(msg.issueKey); issue != nil {
+		a.workflows.store(*issue, msg.transitions)
+		a.refreshNextColumns()
+	}
+	pick, candidates := a.donePrefs.doneTransition(msg.transitions)
+	switch {
+	case pick != nil:
+		key, t := msg.issueKey, *pick
+		return a.confirmEdit(fmt.Sprintf("%s %s?", pick.Name, key), func(a App) (tea.Model, tea.Cmd) {
+			return a.startTransition(key, t, "Marking "+key+" as done...")
+		})
+	case len(candidates) == 0:
+		a.flash = "No 'done' transition available for " + msg.issueKey
+		a.flashIsErr = true
+		return a, nil
+	}
+	items := make([]selectionItem, len(candidates))
+	for i, t := range candidates {
+		items[i] = selectionItem{ID: t.ID, Label: t.Name}
+		if t.To != nil && !strings.EqualFold(t.To.Name, t.Name) {
+			items[i].Desc = "→ " + t.To.Name
+		}
+	}
+	a.offered = candidates
+	a.overlay = newSelectionOverlay("Mark "+msg.issueKey+" Done", items)
+	a.overlayIssue = msg.issueKey
+	a.overlayAction = overlayActionTransition
+	return a, nil
+}
+
+// bulkMarkDone transitions each issue to done the way 'd' would, failing
+// issues where the choice is ambiguous rather than guessing.
+func bulkMarkDone(prefs donePrefs) bulkFunc {
+	return func(ctx context.Context, client *jira.Client, issueKey string) error {
+		transitions, err := client.GetTransitions(ctx, issueKey)
+		if err != nil {
+			return fmt.Errorf("get transitions: %w", err)
+		}
+		pick, candidates := prefs.doneTransition(transitions)
+		switch {
+		case pick != nil:
+			if err := checkBulkTransition(*pick); err != nil {
+				return err
+			}
+			return client.TransitionIssue(ctx, issueKey, pick.ID)
+		case len(candidates) == 0:
+			return fmt.Errorf("no 'done' transition available")
+		}
+		return fmt.Errorf("several done transitions (%s); set done_transitions.prefer", transitionNames(candidates))
+	}
+}
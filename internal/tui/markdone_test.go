@@ -0,0 +1,81 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+var doneTransitions = []jira.Transition{
+	{ID: "21", Name: "Start", To: statusProgress},
+	{ID: "51", Name: "Won't Do", To: workflowStatus("6", "Won't Do", "done")},
+	{ID: "41", Name: "Close", To: statusDone},
+	{ID: "61", Name: "Resolve", To: workflowStatus("7", "Resolved", "done")},
+}
+
+func TestDoneTransition(t *testing.T) {
+	tests := []struct {
+		name       string
+		prefs      donePrefs
+		transition []jira.Transition
+		want       string
+		candidates int
+	}{
+		{"ambiguous", donePrefs{}, doneTransitions, "", 3},
+		{"excluded", donePrefs{exclude: []string{"won't do", "Resolved"}}, doneTransitions, "41", 1},
+		{"preferred by status", donePrefs{prefer: []string{"Missing", "resolved"}}, doneTransitions, "61", 3},
+		{"preferred but excluded", donePrefs{prefer: []string{"Won't Do"}, exclude: []string{"Won't Do"}}, doneTransitions, "", 2},
+		{"none", donePrefs{}, doneTransitions[:1], "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pick, candidates := tt.prefs.doneTransition(tt.transition)
+			var got string
+			if pick != nil {
+				got = pick.ID
+			}
+			if got != tt.want || len(candidates) != tt.candidates {
+				t.Errorf("pick = %q with %d candidates, want %q with %d", got, len(candidates), tt.want, tt.candidates)
+			}
+		})
+	}
+}
+
+func TestMarkDoneAsks(t *testing.T) {
+	app := testAppConnected()
+	app.SetDoneTransitions(nil, []string{"Won't Do"})
+	model, cmd := app.Update(keyMsg("d"))
+	app = model.(App)
+	if cmd == nil || len(app.pending) != 0 {
+		t.Fatalf("d should load transitions before writing, pending = %d", len(app.pending))
+	}
+
+	model, _ = app.Update(doneTransitionsMsg{issueKey: "PROJ-1", transitions: doneTransitions})
+	app = model.(App)
+	sel, ok := app.overlay.(*selectionOverlay)
+	if !ok || len(sel.items) != 2 || app.overlayAction != overlayActionTransition {
+		t.Fatalf("expected a choice of Close and Resolve, got %T", app.overlay)
+	}
+	if sel.items[1].Desc != "→ Resolved" {
+		t.Errorf("Resolve desc = %q, want its target status", sel.items[1].Desc)
+	}
+
+	model, cmd = app.Update(keyMsg("enter"))
+	app = model.(App)
+	if cmd == nil || len(app.pending) != 1 || app.pending[0].issueKey != "PROJ-1" {
+		t.Errorf("picking should transition PROJ-1, pending = %+v", app.pending)
+	}
+}
+
+func TestMarkDonePreferred(t *testing.T) {
+	app := testAppConnected()
+	app.SetDoneTransitions([]string{"Close"}, nil)
+	model, _ := app.Update(doneTransitionsMsg{issueKey: "PROJ-1", transitions: doneTransitions})
+	app = model.(App)
+	if app.overlay != nil || len(app.pending) != 1 {
+		t.Errorf("Close should be taken without asking, overlay = %T", app.overlay)
+	}
+	if app.flash != "Marking PROJ-1 as done..." {
+		t.Errorf("flash = %q", app.flash)
+	}
+}
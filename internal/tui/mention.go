@@ -0,0 +1,268 @@
+package tui
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+)
+
+// handoffPhrases end a comment that hands the issue to the one person it
+// mentions, so jira-tui offers to assign it to them.
+var handoffPhrases = []string{"please take a look", "can you take a look", "ptal"}
+
+// maxMentionQuery is the longest text after an @ that is still completed as
+// a name; past it the @ is taken to be plain text.
+const maxMentionQuery = 30
+
+// commentDraft is the result of the comment editor: the text and the
+// people mentioned in it.
+type commentDraft struct {
+	text     string
+	mentions map[string]string // display name → account ID
+}
+
+// commentEditorOverlay is the text editor for comments. Typing @ lists the
+// cached users matching what follows, and picking one inserts a mention.
+type commentEditorOverlay struct {
+	*textEditorOverlay
+	users     []config.CachedUser
+	mentions  map[string]string   // mentions inserted so far, display name → account ID
+	matches   []config.CachedUser // completions for the @name before the cursor
+	matchPos  int                 // highlighted completion
+	dismissed string              // text before the cursor when esc closed the list
+}
+
+func newCommentEditorOverlay(title string, users []config.CachedUser, width, height int) *commentEditorOverlay {
+	return &commentEditorOverlay{
+		textEditorOverlay: newTextEditorOverlay(title, "", width, height),
+		users:             users,
+		mentions:          make(map[string]string),
+	}
+}
+
+func (c *commentEditorOverlay) Update(msg tea.Msg) (overlay, tea.Cmd) {
+	if km, ok := msg.(tea.KeyMsg); ok && len(c.matches) > 0 {
+		switch km.String() {
+		case "tab", "enter":
+			c.insertMention(c.matches[c.matchPos])
+			c.refresh()
+			return c, nil
+		case "up", "ctrl+p":
+			c.matchPos = max(c.matchPos-1, 0)
+			return c, nil
+		case "down", "ctrl+n":
+			c.matchPos = min(c.matchPos+1, len(c.matches)-1)
+			return c, nil
+		case "esc":
+			// Close the list, not the editor
+			c.dismissed = c.beforeCursor()
+			c.matches = nil
+			return c, nil
+		}
+	}
+	_, cmd := c.textEditorOverlay.Update(msg)
+	c.refresh()
+	return c, cmd
+}
+
+func (c *commentEditorOverlay) View(width, height int) string {
+	if len(c.matches) == 0 {
+		hint := "ctrl+s: save  esc: cancel"
+		if len(c.users) > 0 {
+			hint = "@: mention  " + hint
+		}
+		return c.render(width, height, "", hint)
+	}
+	var b strings.Builder
+	for i, u := range c.matches {
+		line := u.DisplayName
+		if u.Email != "" {
+			line += "  " + u.Email
+		}
+		if i == c.matchPos {
+			b.WriteString(overlaySelectedStyle.Render("> " + line))
+		} else {
+			b.WriteString(overlayFilterStyle.Render("  " + line))
+		}
+		b.WriteString("\n")
+	}
+	return c.render(width, height, b.String(), "tab: mention  ↑/↓: choose  esc: close list")
+}
+
+func (c *commentEditorOverlay) done() (bool, interface{}) {
+	ok, result := c.textEditorOverlay.done()
+	if text, saved := result.(string); saved {
+		return ok, commentDraft{text: text, mentions: c.mentions}
+	}
+	return ok, result
+}
+
+// beforeCursor returns the current line up to the cursor.
+func (c *commentEditorOverlay) beforeCursor() string {
+	lines := strings.Split(c.editor.Value(), "\n")
+	row := c.editor.Line()
+	if row >= len(lines) {
+		return ""
+	}
+	info := c.editor.LineInfo()
+	line := []rune(lines[row])
+	return string(line[:min(info.StartColumn+info.ColumnOffset, len(line))])
+}
+
+// refresh recomputes the completions for the text before the cursor.
+func (c *commentEditorOverlay) refresh() {
+	before := c.beforeCursor()
+	query, ok := mentionQuery(before)
+	if !ok || before == c.dismissed {
+		c.matches = nil
+		return
+	}
+	c.dismissed = ""
+	matches := mentionMatches(c.users, query)
+	if !sameUsers(matches, c.matches) {
+		c.matchPos = 0
+	}
+	c.matches = matches
+}
+
+// insertMention replaces the @name being typed with the user's full name.
+func (c *commentEditorOverlay) insertMention(u config.CachedUser) {
+	query, _ := mentionQuery(c.beforeCursor())
+	for range len([]rune(query)) + 1 {
+		c.editor, _ = c.editor.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	c.editor.InsertString("@" + u.DisplayName + " ")
+	c.mentions[u.DisplayName] = u.AccountID
+}
+
+// mentionQuery returns the name being typed after an @ at the end of
+// before. The @ must start a word, so email addresses aren't completed.
+func mentionQuery(before string) (string, bool) {
+	at := strings.LastIndex(before, "@")
+	if at < 0 {
+		return "", false
+	}
+	if at > 0 {
+		prev := []rune(before[:at])
+		if r := prev[len(prev)-1]; !unicode.IsSpace(r) && r != '(' {
+			return "", false
+		}
+	}
+	query := before[at+1:]
+	if len([]rune(query)) > maxMentionQuery || strings.HasPrefix(query, " ") {
+		return "", false
+	}
+	return query, true
+}
+
+// mentionMatches lists up to maxCompletions users for a query: those with a
+// name starting with it, or with a word of the name starting with it, then
+// those whose name or email contains it.
+func mentionMatches(users []config.CachedUser, query string) []config.CachedUser {
+	q := strings.ToLower(query)
+	var prefix, contains []config.CachedUser
+	for _, u := range users {
+		name := strings.ToLower(u.DisplayName)
+		switch {
+		case strings.HasPrefix(name, q) || strings.Contains(name, " "+q):
+			prefix = append(prefix, u)
+		case strings.Contains(name, q) || strings.Contains(strings.ToLower(u.Email), q):
+			contains = append(contains, u)
+		}
+	}
+	matches := append(prefix, contains...)
+	if len(matches) > maxCompletions {
+		matches = matches[:maxCompletions]
+	}
+	return matches
+}
+
+// sameUsers reports whether a and b list the same users in order.
+func sameUsers(a, b []config.CachedUser) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].AccountID != b[i].AccountID {
+			return false
+		}
+	}
+	return true
+}
+
+// handoffMention returns the person a comment hands the issue to: the one
+// user it mentions, when it ends with a handoff phrase like "please take a
+// look".
+func handoffMention(draft commentDraft) (name, accountID string, ok bool) {
+	tail := strings.ToLower(strings.TrimRight(strings.TrimSpace(draft.text), ".!?:) "))
+	if !endsWithHandoff(tail) {
+		return "", "", false
+	}
+	for n, id := range draft.mentions {
+		// Mentions deleted from the text while editing don't count
+		if !strings.Contains(draft.text, "@"+n) || id == accountID {
+			continue
+		}
+		if accountID != "" {
+			return "", "", false
+		}
+		name, accountID = n, id
+	}
+	return name, accountID, accountID != ""
+}
+
+// endsWithHandoff reports whether text ends with a whole handoff phrase.
+func endsWithHandoff(text string) bool {
+	for _, phrase := range handoffPhrases {
+		rest, found := strings.CutSuffix(text, phrase)
+		if !found {
+			continue
+		}
+		if r, _ := utf8.DecodeLastRuneInString(rest); rest == "" || !unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// handoffOp is an assignment a comment suggested, awaiting confirmation.
+type handoffOp struct {
+	issueKey  string
+	name      string
+	accountID string
+}
+
+// suggestHandoff asks whether to also assign the issue to the person a
+// comment hands it to, unless they already have it.
+func (a *App) suggestHandoff(issueKey string, draft commentDraft) {
+	name, accountID, ok := handoffMention(draft)
+	if !ok {
+		return
+	}
+	if issue := a.findIssue(issueKey); issue != nil && issue.Fields.Assignee != nil &&
+		issue.Fields.Assignee.AccountID == accountID {
+		return
+	}
+	a.handoff = &handoffOp{issueKey: issueKey, name: name, accountID: accountID}
+	a.overlay = newConfirmOverlay("Also assign " + issueKey + " to " + name + "?")
+	a.overlayIssue = issueKey
+	a.overlayAction = overlayActionHandoff
+}
+
+// assignHandoff assigns the issue to the person the comment handed it to.
+func (a App) assignHandoff() (tea.Model, tea.Cmd) {
+	op := a.handoff
+	a.handoff = nil
+	if op == nil {
+		return a, nil
+	}
+	a.flash = "Assigning " + op.issueKey + " to " + op.name + "..."
+	a.flashIsErr = false
+	return a, a.trackWrite(writeUpdate, op.issueKey, a.cmdUpdateField(op.issueKey, map[string]interface{}{
+		"assignee": map[string]interface{}{"accountId": op.accountID},
+	}))
+}
@@ -0,0 +1,174 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+var mentionUsers = []config.CachedUser{
+	{AccountID: "u1", DisplayName: "Jane Doe", Email: "jane@example.com"},
+	{AccountID: "u2", DisplayName: "John Smith", Email: "john@example.com"},
+	{AccountID: "u3", DisplayName: "Ann Janssen", Email: "ann@example.com"},
+}
+
+// typeText sends each rune of s to the overlay as a key press.
+func typeText(o overlay, s string) {
+	for _, r := range s {
+		o.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+func TestMentionQuery(t *testing.T) {
+	tests := []struct {
+		before string
+		query  string
+		ok     bool
+	}{
+		{"@", "", true},
+		{"thanks @ja", "ja", true},
+		{"(@Jane D", "Jane D", true},
+		{"mail jane@example", "", false},
+		{"@ jane", "", false},
+		{"no mention", "", false},
+	}
+	for _, tt := range tests {
+		query, ok := mentionQuery(tt.before)
+		if query != tt.query || ok != tt.ok {
+			t.Errorf("mentionQuery(%q) = %q, %v; want %q, %v", tt.before, query, ok, tt.query, tt.ok)
+		}
+	}
+}
+
+func TestMentionMatchesOrder(t *testing.T) {
+	var names []string
+	for _, u := range mentionMatches(mentionUsers, "jan") {
+		names = append(names, u.DisplayName)
+	}
+	// Name prefixes first, then names containing the query
+	if want := []string{"Jane Doe", "Ann Janssen"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got %v, want %v", names, want)
+	}
+}
+
+func TestCommentEditorInsertsMention(t *testing.T) {
+	c := newCommentEditorOverlay("Add Comment", mentionUsers, 100, 30)
+	typeText(c, "cc @jo")
+	if len(c.matches) != 1 || c.matches[0].AccountID != "u2" {
+		t.Fatalf("expected John Smith to be offered, got %+v", c.matches)
+	}
+	c.Update(tea.KeyMsg{Type: tea.KeyTab})
+	typeText(c, "please")
+	if got := c.editor.Value(); got != "cc @John Smith please" {
+		t.Errorf("editor = %q", got)
+	}
+	if len(c.matches) != 0 {
+		t.Error("expected the list to close after the mention")
+	}
+
+	c.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	done, result := c.done()
+	draft, ok := result.(commentDraft)
+	if !done || !ok {
+		t.Fatalf("expected a comment draft, got %#v", result)
+	}
+	doc := makeADFDocumentMentions(draft.text, draft.mentions)
+	inline := doc["content"].([]interface{})[0].(map[string]interface{})["content"].([]interface{})
+	if len(inline) != 3 {
+		t.Fatalf("expected text, mention, text; got %#v", inline)
+	}
+	mention := inline[1].(map[string]interface{})
+	attrs := mention["attrs"].(map[string]interface{})
+	if mention["type"] != "mention" || attrs["id"] != "u2" || attrs["text"] != "@John Smith" {
+		t.Errorf("unexpected mention node %#v", mention)
+	}
+}
+
+func TestCommentEditorEscClosesListFirst(t *testing.T) {
+	c := newCommentEditorOverlay("Add Comment", mentionUsers, 100, 30)
+	typeText(c, "@")
+	if len(c.matches) == 0 {
+		t.Fatal("expected @ to list users")
+	}
+	c.Update(keyMsg("esc"))
+	if done, _ := c.done(); done || len(c.matches) != 0 {
+		t.Error("expected esc to close the list and keep the editor open")
+	}
+	c.Update(keyMsg("esc"))
+	if done, result := c.done(); !done || result != nil {
+		t.Error("expected a second esc to cancel")
+	}
+}
+
+func TestADFInlineLongestMention(t *testing.T) {
+	nodes := adfInline("@Ann Lee and @Ann", map[string]string{"Ann": "a1", "Ann Lee": "a2"})
+	var ids []interface{}
+	for _, n := range nodes {
+		if m := n.(map[string]interface{}); m["type"] == "mention" {
+			ids = append(ids, m["attrs"].(map[string]interface{})["id"])
+		}
+	}
+	if want := []interface{}{"a2", "a1"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got mentions %v, want %v", ids, want)
+	}
+}
+
+func TestHandoffMention(t *testing.T) {
+	jane := map[string]string{"Jane Doe": "u1"}
+	both := map[string]string{"Jane Doe": "u1", "John Smith": "u2"}
+	tests := []struct {
+		text     string
+		mentions map[string]string
+		want     string
+	}{
+		{"@Jane Doe please take a look", jane, "u1"},
+		{"Fixed the query. @Jane Doe, please take a look!", jane, "u1"},
+		{"@Jane Doe PTAL", jane, "u1"},
+		{"@Jane Doe thanks", jane, ""},
+		{"@Jane Doe @John Smith please take a look", both, ""},
+		{"@Jane Doe please take a look", both, "u1"}, // John was deleted again
+		{"please take a look", nil, ""},
+		{"@Jane Doe see the screenshot, sptal", jane, ""},
+	}
+	for _, tt := range tests {
+		_, id, ok := handoffMention(commentDraft{text: tt.text, mentions: tt.mentions})
+		if id != tt.want || ok != (tt.want != "") {
+			t.Errorf("handoffMention(%q) = %q, %v; want %q", tt.text, id, ok, tt.want)
+		}
+	}
+}
+
+func TestCommentOffersHandoff(t *testing.T) {
+	app := testAppConnected()
+	app.overlayIssue, app.overlayAction = "PROJ-1", overlayActionAddComment
+	model, _ := app.handleOverlayResult(commentDraft{
+		text:     "@Jane Doe please take a look",
+		mentions: map[string]string{"Jane Doe": "u1"},
+	})
+	app = model.(App)
+	confirm, ok := app.overlay.(*confirmOverlay)
+	if !ok || confirm.message != "Also assign PROJ-1 to Jane Doe?" {
+		t.Fatalf("overlay = %T, want the assign prompt", app.overlay)
+	}
+
+	model, cmd := app.handleOverlayResult(true)
+	app = model.(App)
+	if cmd == nil || app.flash != "Assigning PROJ-1 to Jane Doe..." || app.handoff != nil {
+		t.Errorf("flash = %q, want the assignment started", app.flash)
+	}
+
+	// Already the assignee: no prompt
+	app.tabs[0].issues[0].Fields.Assignee = &jira.User{AccountID: "u1"}
+	app.overlayIssue, app.overlayAction = "PROJ-1", overlayActionAddComment
+	model, _ = app.handleOverlayResult(commentDraft{
+		text:     "@Jane Doe please take a look",
+		mentions: map[string]string{"Jane Doe": "u1"},
+	})
+	if app = model.(App); app.overlay != nil {
+		t.Errorf("overlay = %T, want none for the current assignee", app.overlay)
+	}
+}
@@ -0,0 +1,225 @@
+package tui
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// nextColumn is the pseudo-column showing each issue's likely next
+// transition.
+const nextColumn = "next"
+
+// workflowCache remembers the transitions available from each status of
+// each workflow, and which one was last taken from it, so the next column
+// can suggest a transition without a request per row.
+type workflowCache struct {
+	transitions map[string][]jira.Transition // workflow key → transitions
+	taken       map[string]string            // workflow key → last transition ID applied
+	loading     map[string]bool              // workflow keys being fetched
+}
+
+func newWorkflowCache() *workflowCache {
+	return &workflowCache{
+		transitions: make(map[string][]jira.Transition),
+		taken:       make(map[string]string),
+		loading:     make(map[string]bool),
+	}
+}
+
+// workflowLoadedMsg delivers the transitions available from one workflow
+// status, fetched for the next column.
+type workflowLoadedMsg struct {
+	key         string
+	transitions []jira.Transition
+	err         error
+}
+
+// workflowKey identifies the workflow status an issue is in. Transitions
+// depend on the project, issue type, and status, so issues sharing all
+// three share a cache entry. Returns "" when any of them is missing.
+func workflowKey(issue jira.Issue) string {
+	f := issue.Fields
+	if f.Project == nil || f.IssueType == nil || f.Status == nil {
+		return ""
+	}
+	return f.Project.ID + "/" + f.IssueType.ID + "/" + f.Status.ID
+}
+
+// known reports whether the transitions for the issue's status are cached.
+func (w *workflowCache) known(issue jira.Issue) bool {
+	if w == nil {
+		return false
+	}
+	_, ok := w.transitions[workflowKey(issue)]
+	return ok
+}
+
+// store caches the transitions available from the issue's status.
+func (w *workflowCache) store(issue jira.Issue, transitions []jira.Transition) {
+	if key := workflowKey(issue); w != nil && key != "" {
+		w.transitions[key] = transitions
+	}
+}
+
+// record remembers that a transition was applied from the issue's status,
+// making it the suggestion for issues in the same status.
+func (w *workflowCache) record(issue jira.Issue, transitionID string) {
+	if key := workflowKey(issue); w != nil && key != "" {
+		w.taken[key] = transitionID
+	}
+}
+
+// next returns the most likely next transition for an issue, or nil when
+// its workflow isn't cached or it has nowhere further to go.
+func (w *workflowCache) next(issue jira.Issue) *jira.Transition {
+	if w == nil {
+		return nil
+	}
+	key := workflowKey(issue)
+	transitions := w.transitions[key]
+	if id := w.taken[key]; id != "" {
+		for i := range transitions {
+			if transitions[i].ID == id {
+				return &transitions[i]
+			}
+		}
+	}
+	return likelyTransition(transitions, issue.Fields.Status)
+}
+
+// likelyTransition picks the transition that moves an issue forward: the
+// first, in Jira's order, into a later status category, or failing that
+// into another status of the same category. Done issues get none.
+func likelyTransition(transitions []jira.Transition, current *jira.Status) *jira.Transition {
+	from := categoryRank(current)
+	if from == doneRank {
+		return nil
+	}
+	var sideways *jira.Transition
+	for i, t := range transitions {
+		if t.To == nil || (current != nil && t.To.ID == current.ID) {
+			continue
+		}
+		switch to := categoryRank(t.To); {
+		case to > from:
+			return &transitions[i]
+		case to == from && sideways == nil:
+			sideways = &transitions[i]
+		}
+	}
+	return sideways
+}
+
+// doneRank is categoryRank of the done category.
+const doneRank = 2
+
+// categoryRank orders status categories from to do through done.
+func categoryRank(s *jira.Status) int {
+	if s == nil || s.StatusCategory == nil {
+		return 0
+	}
+	switch s.StatusCategory.Key {
+	case "indeterminate":
+		return 1
+	case "done":
+		return doneRank
+	}
+	return 0
+}
+
+// nextLabel renders the next column cell, e.g. "→ In Review".
+func (w *workflowCache) nextLabel(issue jira.Issue) string {
+	if t := w.next(issue); t != nil && t.To != nil {
+		return "→ " + t.To.Name
+	}
+	return ""
+}
+
+// loadWorkflows fetches the transitions for every workflow status shown
+// in a tab with the next column that isn't cached or already loading.
+func (a *App) loadWorkflows() tea.Cmd {
+	if a.client == nil || !a.connected {
+		return nil
+	}
+	var cmds []tea.Cmd
+	for _, t := range a.tabs {
+		if !hasColumn(t.columns, nextColumn) {
+			continue
+		}
+		for _, issue := range t.issues {
+			key := workflowKey(issue)
+			if key == "" || a.workflows.loading[key] || a.workflows.known(issue) {
+				continue
+			}
+			a.workflows.loading[key] = true
+			cmds = append(cmds, a.startNetwork(a.cmdLoadWorkflow(key, issue.Key)))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// cmdLoadWorkflow fetches the transitions of one issue on behalf of every
+// issue in the same workflow status.
+func (a App) cmdLoadWorkflow(key, issueKey string) tea.Cmd {
+	client := a.client
+	return func() tea.Msg {
+		transitions, err := client.GetTransitions(context.Background(), issueKey)
+		return workflowLoadedMsg{key: key, transitions: transitions, err: err}
+	}
+}
+
+// handleWorkflowLoaded caches fetched transitions and fills in the next
+// column. A failed fetch is only logged — the column is only a hint —
+// and is retried on the next load.
+func (a App) handleWorkflowLoaded(msg workflowLoadedMsg) (tea.Model, tea.Cmd) {
+	delete(a.workflows.loading, msg.key)
+	if msg.err != nil {
+		a.log.Warn("loading transitions for the next column", "err", msg.err)
+		return a, nil
+	}
+	a.workflows.transitions[msg.key] = msg.transitions
+	a.refreshNextColumns()
+	return a, nil
+}
+
+// refreshNextColumns re-renders the tabs showing the next column.
+func (a *App) refreshNextColumns() {
+	for i := range a.tabs {
+		if hasColumn(a.tabs[i].columns, nextColumn) && a.tabs[i].state == tabReady {
+			a.tabs[i].refreshRows()
+		}
+	}
+}
+
+// applyNextTransition applies the suggested next transition to an issue.
+// When the issue's workflow isn't cached yet it falls back to the status
+// overlay, whose result teaches the cache.
+func (a App) applyNextTransition(issue *jira.Issue) (tea.Model, tea.Cmd) {
+	t := a.workflows.next(*issue)
+	if t == nil && !a.workflows.known(*issue) {
+		a.overlayIssue = issue.Key
+		a.overlayAction = overlayActionTransition
+		a.flash = "Loading transitions..."
+		a.flashIsErr = false
+		return a, a.cmdFetchTransitions(issue.Key)
+	}
+	if t == nil {
+		a.flash = "No next transition for " + issue.Key
+		a.flashIsErr = true
+		return a, nil
+	}
+	target := t.Name
+	if t.To != nil {
+		target = t.To.Name
+	}
+	key, next := issue.Key, *t
+	return a.confirmEdit("Move "+key+" to "+target+"?", func(a App) (tea.Model, tea.Cmd) {
+		if issue := a.findIssue(key); issue != nil {
+			a.workflows.record(*issue, next.ID)
+		}
+		return a.startTransition(key, next, "Transitioning "+key+" to "+target+"...")
+	})
+}
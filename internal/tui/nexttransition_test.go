@@ -0,0 +1,160 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func workflowStatus(id, name, category string) *jira.Status {
+	return &jira.Status{ID: id, Name: name, StatusCategory: &jira.StatusCategory{Key: category}}
+}
+
+var (
+	statusToDo     = workflowStatus("1", "To Do", "new")
+	statusProgress = workflowStatus("3", "In Progress", "indeterminate")
+	statusReview   = workflowStatus("4", "In Review", "indeterminate")
+	statusDone     = workflowStatus("5", "Done", "done")
+)
+
+func workflowIssue(key string, status *jira.Status) jira.Issue {
+	return jira.Issue{Key: key, Fields: jira.IssueFields{
+		Summary:   "Issue " + key,
+		Status:    status,
+		Project:   &jira.Named{ID: "100", Name: "Project"},
+		IssueType: &jira.Named{ID: "10", Name: "Task"},
+	}}
+}
+
+func TestLikelyTransition(t *testing.T) {
+	all := []jira.Transition{
+		{ID: "11", Name: "Reopen", To: statusToDo},
+		{ID: "21", Name: "Start", To: statusProgress},
+		{ID: "31", Name: "Review", To: statusReview},
+		{ID: "41", Name: "Close", To: statusDone},
+	}
+	tests := []struct {
+		name    string
+		current *jira.Status
+		want    string
+	}{
+		{"to do moves into progress", statusToDo, "21"},
+		{"in progress moves to done", statusProgress, "41"},
+		{"done has no next", statusDone, ""},
+	}
+	for _, tt := range tests {
+		got := likelyTransition(all, tt.current)
+		switch {
+		case tt.want == "" && got != nil:
+			t.Errorf("%s: got %s, want none", tt.name, got.Name)
+		case tt.want != "" && (got == nil || got.ID != tt.want):
+			t.Errorf("%s: got %v, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	sideways := []jira.Transition{{ID: "21", To: statusProgress}, {ID: "31", To: statusReview}}
+	if got := likelyTransition(sideways, statusProgress); got == nil || got.ID != "31" {
+		t.Errorf("same-category fallback = %v, want 31", got)
+	}
+}
+
+func TestWorkflowCachePrefersTakenTransition(t *testing.T) {
+	w := newWorkflowCache()
+	issue := workflowIssue("PROJ-1", statusProgress)
+	w.store(issue, []jira.Transition{
+		{ID: "31", Name: "Review", To: statusReview},
+		{ID: "41", Name: "Close", To: statusDone},
+	})
+	if got := w.nextLabel(issue); got != "→ Done" {
+		t.Errorf("label = %q, want → Done", got)
+	}
+	w.record(issue, "31")
+	if got := w.nextLabel(workflowIssue("PROJ-2", statusProgress)); got != "→ In Review" {
+		t.Errorf("label after taking Review = %q, want → In Review", got)
+	}
+}
+
+func testAppWithNextColumn() App {
+	app := testAppConnected()
+	app.connected = true
+	tab := &app.tabs[0]
+	tab.setIssues([]jira.Issue{
+		workflowIssue("PROJ-1", statusToDo),
+		workflowIssue("PROJ-2", statusToDo),
+		workflowIssue("PROJ-3", statusDone),
+	})
+	tab.setColumns([]string{"key", "summary", nextColumn}, nil)
+	return app
+}
+
+func TestNextColumnFillsWhenWorkflowLoads(t *testing.T) {
+	app := testAppWithNextColumn()
+
+	cmd := app.loadWorkflows()
+	if cmd == nil {
+		t.Fatal("expected workflow fetches")
+	}
+	// PROJ-1 and PROJ-2 share a workflow status, PROJ-3 has its own
+	if len(app.workflows.loading) != 2 {
+		t.Errorf("loading %d workflow statuses, want 2", len(app.workflows.loading))
+	}
+	if app.loadWorkflows() != nil {
+		t.Error("statuses already loading should not be fetched again")
+	}
+
+	model, _ := app.Update(workflowLoadedMsg{
+		key:         workflowKey(workflowIssue("PROJ-1", statusToDo)),
+		transitions: []jira.Transition{{ID: "21", Name: "Start", To: statusProgress}},
+	})
+	app = model.(App)
+	rows := app.tabs[0].table.Rows()
+	if rows[0][2] != "→ In Progress" || rows[1][2] != "→ In Progress" {
+		t.Errorf("next cells = %q, %q", rows[0][2], rows[1][2])
+	}
+	if rows[2][2] != "" {
+		t.Errorf("PROJ-3 next = %q, want empty until loaded", rows[2][2])
+	}
+}
+
+func TestNextHotkey(t *testing.T) {
+	app := testAppWithNextColumn()
+	app.workflows.store(workflowIssue("PROJ-1", statusToDo),
+		[]jira.Transition{{ID: "21", Name: "Start", To: statusProgress}})
+	app.workflows.store(workflowIssue("PROJ-3", statusDone),
+		[]jira.Transition{{ID: "11", Name: "Reopen", To: statusToDo}})
+
+	model, cmd := app.Update(keyMsg("n"))
+	app = model.(App)
+	if cmd == nil || app.flash != "Transitioning PROJ-1 to In Progress..." {
+		t.Errorf("flash = %q, cmd = %v", app.flash, cmd)
+	}
+	if len(app.pending) != 1 {
+		t.Errorf("pending = %d, want the transition tracked", len(app.pending))
+	}
+
+	app.tabs[0].table.SetCursor(2) // PROJ-3, done
+	model, cmd = app.Update(keyMsg("n"))
+	app = model.(App)
+	if cmd != nil || !app.flashIsErr {
+		t.Errorf("done issue: flash = %q, cmd = %v", app.flash, cmd)
+	}
+}
+
+func TestNextHotkeyFallsBackToStatusOverlay(t *testing.T) {
+	app := testAppWithNextColumn()
+
+	model, cmd := app.Update(keyMsg("n"))
+	app = model.(App)
+	if cmd == nil || app.overlayAction != overlayActionTransition || app.overlayIssue != "PROJ-1" {
+		t.Fatalf("unknown workflow should load the status overlay, action = %v", app.overlayAction)
+	}
+
+	model, _ = app.Update(transitionsLoadedMsg{
+		issueKey:    "PROJ-1",
+		transitions: []jira.Transition{{ID: "21", Name: "Start", To: statusProgress}},
+	})
+	app = model.(App)
+	if got := app.tabs[0].table.Rows()[0][2]; got != "→ In Progress" {
+		t.Errorf("status overlay transitions should fill the next column, got %q", got)
+	}
+}
@@ -0,0 +1,146 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// offlineProbeEvery is how often an offline session checks whether Jira
+// can be reached again.
+const offlineProbeEvery = 15 * time.Second
+
+// offlineNoCache is shown on a tab that has nothing cached to fall back on.
+const offlineNoCache = "Offline — no cached results for this tab yet"
+
+// offlineProbeMsg fires when it's time to check the connection again.
+type offlineProbeMsg struct{}
+
+// writeConflictMsg reports queued writes held back because their issue
+// changed in Jira while the session was offline.
+type writeConflictMsg struct {
+	writes []pendingWrite
+	issue  *jira.Issue // the issue as it is now
+}
+
+// goOffline notes that Jira can't be reached. Tabs keep their cached
+// results and failed writes wait in the retry queue until a probe gets
+// through. The returned command schedules that probe; it is nil when the
+// session was already offline.
+func (a *App) goOffline() tea.Cmd {
+	if a.offline {
+		return nil
+	}
+	a.offline = true
+	a.flash = "Offline — showing cached results; changes are queued until Jira is reachable"
+	a.flashIsErr = true
+	for i := range a.tabs {
+		if !a.tabs[i].hasData() {
+			a.tabs[i].setError(offlineNoCache)
+		}
+	}
+	return probeLater()
+}
+
+// probeLater schedules the next connection check.
+func probeLater() tea.Cmd {
+	return tea.Tick(offlineProbeEvery, func(time.Time) tea.Msg { return offlineProbeMsg{} })
+}
+
+// handleOfflineProbe checks the connection; the answer arrives as a
+// connStatusMsg like the one at startup.
+func (a App) handleOfflineProbe() (tea.Model, tea.Cmd) {
+	if !a.offline || a.client == nil {
+		return a, nil
+	}
+	return a, a.startNetwork(a.checkConnection())
+}
+
+// backOnline reloads every tab and sends the writes queued while offline.
+func (a App) backOnline() (tea.Model, tea.Cmd) {
+	a.offline = false
+	a.flash = "Back online"
+	a.flashIsErr = false
+	cmds := []tea.Cmd{a.syncQueued()}
+	for i := range a.tabs {
+		if !a.tabs[i].hasData() {
+			a.tabs[i].setLoading()
+		}
+		cmds = append(cmds, a.startNetwork(a.loadTab(i)))
+	}
+	return a, tea.Batch(cmds...)
+}
+
+// syncQueued sends the writes queued while offline, one issue at a time
+// in the order they were made. An issue that changed in Jira since its
+// writes were made is reported as a conflict and its writes are held back
+// for ctrl+r, so an offline edit doesn't silently overwrite someone else's.
+func (a *App) syncQueued() tea.Cmd {
+	if len(a.failed) == 0 {
+		return nil
+	}
+	var order []string
+	byIssue := map[string][]pendingWrite{}
+	for _, f := range a.failed {
+		w := f.write
+		w.attempt = 0
+		if _, ok := byIssue[w.issueKey]; !ok {
+			order = append(order, w.issueKey)
+		}
+		byIssue[w.issueKey] = append(byIssue[w.issueKey], w)
+	}
+	noun := "changes"
+	if len(a.failed) == 1 {
+		noun = "change"
+	}
+	a.flash = fmt.Sprintf("Back online — syncing %d queued %s", len(a.failed), noun)
+	a.failed = nil
+
+	client := a.client
+	var cmds []tea.Cmd
+	for _, key := range order {
+		writes := byIssue[key]
+		sends := make([]tea.Cmd, len(writes))
+		for i, w := range writes {
+			a.pending = append(a.pending, w)
+			sends[i] = a.startNetwork(w.cmd)
+		}
+		cmds = append(cmds, func() tea.Msg {
+			if since := writes[0].updated; key != "" && since != "" {
+				issue, err := client.GetIssue(context.Background(), key)
+				if err == nil && issue.Fields.Updated != since {
+					return writeConflictMsg{writes: writes, issue: issue}
+				}
+			}
+			return tea.Sequence(sends...)()
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleWriteConflict holds back writes whose issue changed while offline,
+// showing the issue as it is now.
+func (a App) handleWriteConflict(msg writeConflictMsg) (tea.Model, tea.Cmd) {
+	var what []string
+	for _, w := range msg.writes {
+		a.inflight--
+		a.finishWrite(w.kind, w.issueKey)
+		a.retrySeq++
+		a.failed = append(a.failed, failedWrite{id: a.retrySeq, write: w, err: errWriteConflict})
+		what = append(what, w.describe())
+	}
+	a.applyIssueUpdate(msg.issue.Key, msg.issue)
+	a.flash = fmt.Sprintf("%s changed in Jira while you were offline — held back %s; ctrl+r sends anyway",
+		msg.issue.Key, strings.Join(what, ", "))
+	a.flashIsErr = true
+	return a, nil
+}
+
+// errWriteConflict is the error of a write held back by a conflict.
+var errWriteConflict = errors.New("the issue changed in Jira while offline")
@@ -0,0 +1,122 @@
+package tui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// netError is a dropped connection.
+type netError struct{}
+
+func (*netError) Error() string   { return "connection refused" }
+func (*netError) Timeout() bool   { return false }
+func (*netError) Temporary() bool { return true }
+
+func TestOfflineKeepsCachedTabs(t *testing.T) {
+	app := testAppReady()
+	app.inflight = 1
+	model, cmd := app.Update(tabDataMsg{tabIndex: 0, err: &netError{}})
+	app = model.(App)
+	if !app.offline || cmd == nil {
+		t.Fatalf("offline = %v, want offline with a probe scheduled", app.offline)
+	}
+	if len(app.tabs[0].issues) != 3 {
+		t.Errorf("cached issues should stay, got %d", len(app.tabs[0].issues))
+	}
+	if len(app.tabs) > 1 && app.tabs[1].errMsg != offlineNoCache {
+		t.Errorf("a tab without results should say so, got %q", app.tabs[1].errMsg)
+	}
+	if !strings.Contains(app.renderStatusBar(), "offline · 0 queued") {
+		t.Errorf("status bar = %q", app.renderStatusBar())
+	}
+
+	// Writes queue without retrying against a dead connection
+	app.trackWrite(writeUpdate, "PROJ-1", func() tea.Msg { return nil })
+	model, cmd = app.Update(issueUpdatedMsg{issueKey: "PROJ-1", err: &netError{}})
+	app = model.(App)
+	if cmd != nil || len(app.failed) != 1 {
+		t.Errorf("failed = %d, want the write queued with no retry scheduled", len(app.failed))
+	}
+	if want := "Offline — update PROJ-1 is queued and syncs when Jira is reachable"; app.flash != want {
+		t.Errorf("flash = %q", app.flash)
+	}
+
+	// A probe that still can't get through waits for the next one
+	model, cmd = app.Update(connStatusMsg{err: &netError{}})
+	if !model.(App).offline || cmd == nil {
+		t.Error("should stay offline and probe again")
+	}
+}
+
+func TestBackOnlineReportsConflicts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jira.Issue{Key: "PROJ-1", Fields: jira.IssueFields{
+			Summary: "Fix login page",
+			Status:  &jira.Status{Name: "Done"},
+			Updated: "2026-10-16T10:00:00.000+0000",
+		}})
+	}))
+	defer server.Close()
+
+	app := testAppReady()
+	app.client = jira.NewClient(server.URL, "test@test.com", "token")
+	app.connected = true
+	app.offline = true
+	app.tabs[0].issues[0].Fields.Updated = "2026-10-16T09:00:00.000+0000"
+	sent := false
+	app.trackWrite(writeUpdate, "PROJ-1", func() tea.Msg { sent = true; return nil })
+	w := app.finishWrite(writeUpdate, "PROJ-1")
+	app.failed = []failedWrite{{id: 1, write: w, err: &netError{}}}
+
+	model, cmd := app.Update(connStatusMsg{user: &jira.User{AccountID: "me"}})
+	app = model.(App)
+	if app.offline || app.flash != "Back online — syncing 1 queued change" {
+		t.Fatalf("offline = %v, flash = %q", app.offline, app.flash)
+	}
+
+	// The sync finds PROJ-1 changed since the edit and holds it back
+	var conflict *writeConflictMsg
+	for _, msg := range collectMsgs(cmd) {
+		if c, ok := msg.(writeConflictMsg); ok {
+			conflict = &c
+		}
+	}
+	if conflict == nil || sent {
+		t.Fatalf("want a conflict and nothing sent, sent = %v", sent)
+	}
+	model, _ = app.Update(*conflict)
+	app = model.(App)
+	if len(app.failed) != 1 || len(app.pending) != 0 {
+		t.Errorf("failed = %d, pending = %d", len(app.failed), len(app.pending))
+	}
+	if !strings.HasPrefix(app.flash, "PROJ-1 changed in Jira while you were offline — held back update PROJ-1") {
+		t.Errorf("flash = %q", app.flash)
+	}
+	if got := statusName(*app.findIssue("PROJ-1")); got != "Done" {
+		t.Errorf("status = %q, want the issue as it is in Jira now", got)
+	}
+}
+
+// collectMsgs runs cmd, and the commands of any batch it returns, and
+// returns the messages they produce.
+func collectMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, collectMsgs(c)...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
@@ -0,0 +1,51 @@
+package tui
+
+import (
+	"encoding/base64"
+	"io"
+	"os"
+	"strings"
+)
+
+// osc52Clipboard copies by sending the terminal an OSC 52 escape sequence,
+// which reaches the local clipboard even over SSH when the terminal
+// emulator supports it.
+type osc52Clipboard struct {
+	w    io.Writer
+	tmux bool // wrap the sequence so tmux passes it to the outer terminal
+}
+
+func (c osc52Clipboard) WriteAll(text string) error {
+	seq := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\x07"
+	if c.tmux {
+		seq = "\x1bPtmux;" + strings.ReplaceAll(seq, "\x1b", "\x1b\x1b") + "\x1b\\"
+	}
+	_, err := io.WriteString(c.w, seq)
+	return err
+}
+
+// fallbackClipboard tries primary and, if it fails, fallback.
+type fallbackClipboard struct {
+	primary, fallback Clipboard
+}
+
+func (c fallbackClipboard) WriteAll(text string) error {
+	if err := c.primary.WriteAll(text); err == nil {
+		return nil
+	}
+	return c.fallback.WriteAll(text)
+}
+
+// NewClipboard returns the clipboard for a config clipboard mode: "system"
+// uses the OS clipboard, "osc52" the terminal, and "auto" (or "") the OS
+// clipboard with the terminal as a fallback for headless and SSH sessions.
+func NewClipboard(mode string) Clipboard {
+	terminal := osc52Clipboard{w: os.Stdout, tmux: os.Getenv("TMUX") != ""}
+	switch mode {
+	case "system":
+		return systemClipboard{}
+	case "osc52":
+		return terminal
+	}
+	return fallbackClipboard{primary: systemClipboard{}, fallback: terminal}
+}
@@ -0,0 +1,48 @@
+package tui
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// failingClipboard is a Clipboard that is never available.
+type failingClipboard struct{}
+
+func (failingClipboard) WriteAll(string) error { return errors.New("no clipboard") }
+
+func TestOSC52Clipboard(t *testing.T) {
+	var out bytes.Buffer
+	if err := (osc52Clipboard{w: &out}).WriteAll("PROJ-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := out.String(), "\x1b]52;c;UFJPSi0x\x07"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	out.Reset()
+	(osc52Clipboard{w: &out, tmux: true}).WriteAll("PROJ-1")
+	if got, want := out.String(), "\x1bPtmux;\x1b\x1b]52;c;UFJPSi0x\x07\x1b\\"; got != want {
+		t.Errorf("got %q, want %q in tmux", got, want)
+	}
+}
+
+func TestFallbackClipboard(t *testing.T) {
+	var out bytes.Buffer
+	var board fakeClipboard
+	terminal := osc52Clipboard{w: &out}
+
+	if err := (fallbackClipboard{primary: &board, fallback: terminal}).WriteAll("a"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(board.copied) != 1 || out.Len() != 0 {
+		t.Error("expected a working primary to be used alone")
+	}
+
+	if err := (fallbackClipboard{primary: failingClipboard{}, fallback: terminal}).WriteAll("a"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Len() == 0 {
+		t.Error("expected the terminal to be used when the primary fails")
+	}
+}
@@ -3,6 +3,7 @@ package tui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
@@ -56,6 +57,7 @@ type selectionItem struct {
 	Desc    string // optional secondary text (used for filtering)
 	Display string // optional pre-rendered label (overrides Label+Desc for display)
 	Icon    string // optional pre-rendered icon (rendered outside of highlight)
+	Section string // optional heading; items are listed grouped by section
 }
 
 // selectionOverlay is a filterable selection list.
@@ -67,6 +69,9 @@ type selectionOverlay struct {
 	filter   textinput.Model
 	isDone   bool
 	result   interface{} // *selectionItem or nil
+
+	// onlyFirst hides every section but the first (tab toggles it)
+	onlyFirst bool
 }
 
 func newSelectionOverlay(title string, items []selectionItem) *selectionOverlay {
@@ -88,6 +93,9 @@ func (s *selectionOverlay) applyFilter() {
 	query := strings.ToLower(s.filter.Value())
 	s.filtered = nil
 	for i, item := range s.items {
+		if s.onlyFirst && item.Section != s.items[0].Section {
+			continue
+		}
 		if query == "" || strings.Contains(strings.ToLower(item.Label), query) ||
 			strings.Contains(strings.ToLower(item.Desc), query) {
 			s.filtered = append(s.filtered, i)
@@ -122,6 +130,12 @@ func (s *selectionOverlay) Update(msg tea.Msg) (overlay, tea.Cmd) {
 				s.cursor++
 			}
 			return s, nil
+		case "tab":
+			if s.sectioned() {
+				s.onlyFirst = !s.onlyFirst
+				s.applyFilter()
+			}
+			return s, nil
 		}
 	}
 
@@ -157,6 +171,10 @@ func (s *selectionOverlay) View(width, height int) string {
 	for i := start; i < len(s.filtered) && i < start+maxVisible; i++ {
 		idx := s.filtered[i]
 		item := s.items[idx]
+		if item.Section != "" && (i == start || s.items[s.filtered[i-1]].Section != item.Section) {
+			b.WriteString(overlayFilterStyle.Render(item.Section))
+			b.WriteString("\n")
+		}
 		var line string
 		if item.Display != "" {
 			line = item.Display
@@ -187,7 +205,15 @@ func (s *selectionOverlay) View(width, height int) string {
 		b.WriteString("\n")
 	}
 
-	b.WriteString(overlayHintStyle.Render("↑/↓: navigate  enter: select  esc: cancel"))
+	hint := "↑/↓: navigate  enter: select  esc: cancel"
+	if s.sectioned() {
+		if s.onlyFirst {
+			hint += "  tab: show all"
+		} else {
+			hint += "  tab: " + strings.ToLower(s.items[0].Section) + " only"
+		}
+	}
+	b.WriteString(overlayHintStyle.Render(hint))
 
 	boxWidth := width - 10
 	if boxWidth < 30 {
@@ -203,20 +229,236 @@ func (s *selectionOverlay) View(width, height int) string {
 	return lipgloss.Place(width, height-2, lipgloss.Center, lipgloss.Center, content)
 }
 
+// sectioned reports whether the items are split into more than one section.
+func (s *selectionOverlay) sectioned() bool {
+	for _, item := range s.items {
+		if item.Section != s.items[0].Section {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *selectionOverlay) done() (bool, interface{}) {
 	return s.isDone, s.result
 }
 
+// --- Checklist Overlay ---
+
+// checklistOverlay is a filterable multi-select list. When the filter text
+// matches no existing item exactly, a "create" row is offered so new values
+// can be added.
+type checklistOverlay struct {
+	title    string
+	items    []string
+	checked  map[string]bool
+	filtered []string // visible items; a create row is represented by createRow
+	cursor   int
+	filter   textinput.Model
+	isDone   bool
+	result   interface{} // []string (checked items) or nil
+}
+
+// createRow marks the synthetic "create new item" row in filtered.
+const createRow = "\x00create"
+
+func newChecklistOverlay(title string, items, checked []string) *checklistOverlay {
+	ti := textinput.New()
+	ti.Placeholder = "Type to filter or create..."
+	ti.CharLimit = 255
+	ti.Focus()
+
+	c := &checklistOverlay{
+		title:   title,
+		checked: make(map[string]bool, len(checked)),
+		filter:  ti,
+	}
+	// Checked items first so the current values are visible without scrolling
+	seen := make(map[string]bool)
+	for _, item := range checked {
+		c.checked[item] = true
+		if !seen[item] {
+			seen[item] = true
+			c.items = append(c.items, item)
+		}
+	}
+	for _, item := range items {
+		if !seen[item] {
+			seen[item] = true
+			c.items = append(c.items, item)
+		}
+	}
+	c.applyFilter()
+	return c
+}
+
+func (c *checklistOverlay) applyFilter() {
+	query := strings.TrimSpace(c.filter.Value())
+	lower := strings.ToLower(query)
+	c.filtered = nil
+	exact := false
+	for _, item := range c.items {
+		if query == "" || strings.Contains(strings.ToLower(item), lower) {
+			c.filtered = append(c.filtered, item)
+		}
+		if item == query {
+			exact = true
+		}
+	}
+	if query != "" && !exact {
+		c.filtered = append([]string{createRow}, c.filtered...)
+	}
+	if c.cursor >= len(c.filtered) {
+		c.cursor = max(0, len(c.filtered)-1)
+	}
+}
+
+// toggle flips the item under the cursor, creating it first if needed.
+func (c *checklistOverlay) toggle() {
+	if len(c.filtered) == 0 {
+		return
+	}
+	item := c.filtered[c.cursor]
+	if item == createRow {
+		item = strings.TrimSpace(c.filter.Value())
+		c.items = append([]string{item}, c.items...)
+		c.checked[item] = true
+		c.filter.SetValue("")
+		c.cursor = 0
+		c.applyFilter()
+		return
+	}
+	c.checked[item] = !c.checked[item]
+}
+
+// selected returns the checked items in display order.
+func (c *checklistOverlay) selected() []string {
+	out := []string{}
+	for _, item := range c.items {
+		if c.checked[item] {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+func (c *checklistOverlay) Update(msg tea.Msg) (overlay, tea.Cmd) {
+	if km, ok := msg.(tea.KeyMsg); ok {
+		switch km.String() {
+		case "esc":
+			c.isDone = true
+			c.result = nil
+			return c, nil
+		case "enter":
+			c.isDone = true
+			c.result = c.selected()
+			return c, nil
+		case " ", "tab":
+			c.toggle()
+			return c, nil
+		case "up", "ctrl+p":
+			if c.cursor > 0 {
+				c.cursor--
+			}
+			return c, nil
+		case "down", "ctrl+n":
+			if c.cursor < len(c.filtered)-1 {
+				c.cursor++
+			}
+			return c, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	c.filter, cmd = c.filter.Update(msg)
+	c.applyFilter()
+	return c, cmd
+}
+
+func (c *checklistOverlay) View(width, height int) string {
+	var b strings.Builder
+
+	b.WriteString(overlayTitleStyle.Render(c.title))
+	b.WriteString("\n")
+	b.WriteString(c.filter.View())
+	b.WriteString("\n\n")
+
+	maxVisible := height - 12
+	if maxVisible > 15 {
+		maxVisible = 15
+	}
+	if maxVisible < 3 {
+		maxVisible = 3
+	}
+	start := 0
+	if c.cursor >= maxVisible {
+		start = c.cursor - maxVisible + 1
+	}
+
+	for i := start; i < len(c.filtered) && i < start+maxVisible; i++ {
+		item := c.filtered[i]
+		var line string
+		if item == createRow {
+			line = "+ create \"" + strings.TrimSpace(c.filter.Value()) + "\""
+		} else if c.checked[item] {
+			line = "[x] " + item
+		} else {
+			line = "[ ] " + item
+		}
+		if i == c.cursor {
+			b.WriteString(overlaySelectedStyle.Render("> " + line))
+		} else {
+			b.WriteString("  " + line)
+		}
+		b.WriteString("\n")
+	}
+
+	if len(c.filtered) == 0 {
+		b.WriteString(overlayFilterStyle.Render("  No matches"))
+		b.WriteString("\n")
+	}
+
+	b.WriteString(overlayHintStyle.Render("space: toggle  enter: save  esc: cancel"))
+
+	boxWidth := width - 10
+	if boxWidth < 30 {
+		boxWidth = 30
+	}
+	if boxWidth > 70 {
+		boxWidth = 70
+	}
+
+	content := overlayBorderStyle.Width(boxWidth).Render(b.String())
+	return lipgloss.Place(width, height-2, lipgloss.Center, lipgloss.Center, content)
+}
+
+func (c *checklistOverlay) done() (bool, interface{}) {
+	return c.isDone, c.result
+}
+
 // --- Text Input Overlay ---
 
-// textInputOverlay is a single-line text input.
+// textInputOverlay is a single-line text input, optionally with up/down
+// recall of previous entries and an error line.
 type textInputOverlay struct {
-	title  string
-	input  textinput.Model
-	isDone bool
-	result interface{} // string or nil
+	title   string
+	input   textinput.Model
+	history []string // previous entries, newest first
+	histPos int      // index into history, -1 while editing the draft
+	draft   string   // text typed before browsing history
+	errMsg  string   // shown under the input, e.g. a validation error
+	isDone  bool
+	result  interface{} // string or nil
+
+	// suggestions complete the word being typed; words are separated by
+	// commas or spaces (see parseLabels)
+	suggestions []string
+	suggestPos  int // highlighted completion
 }
 
+// maxCompletions is how many completions the text input lists at once.
+const maxCompletions = 5
+
 func newTextInputOverlay(title, initial string) *textInputOverlay {
 	ti := textinput.New()
 	ti.SetValue(initial)
@@ -225,13 +467,92 @@ func newTextInputOverlay(title, initial string) *textInputOverlay {
 	ti.Focus()
 
 	return &textInputOverlay{
-		title: title,
-		input: ti,
+		title:   title,
+		input:   ti,
+		histPos: -1,
+	}
+}
+
+// recall moves through history by delta (+1 older, -1 newer). Moving past
+// the newest entry restores the draft.
+func (t *textInputOverlay) recall(delta int) {
+	pos := t.histPos + delta
+	if pos < -1 || pos >= len(t.history) {
+		return
+	}
+	if t.histPos == -1 {
+		t.draft = t.input.Value()
+	}
+	t.histPos = pos
+	if pos == -1 {
+		t.input.SetValue(t.draft)
+	} else {
+		t.input.SetValue(t.history[pos])
 	}
+	t.input.CursorEnd()
+}
+
+// currentWord splits the input into the text before the word being typed
+// and that word.
+func (t *textInputOverlay) currentWord() (string, string) {
+	value := t.input.Value()
+	i := strings.LastIndexAny(value, ", ") + 1
+	return value[:i], value[i:]
+}
+
+// completions lists the suggestions matching the word being typed, those
+// starting with it first, leaving out words already entered.
+func (t *textInputOverlay) completions() []string {
+	before, word := t.currentWord()
+	if word == "" || len(t.suggestions) == 0 {
+		return nil
+	}
+	entered := make(map[string]bool)
+	for _, w := range parseLabels(before) {
+		entered[w] = true
+	}
+	lower := strings.ToLower(word)
+	var prefixed, contained []string
+	for _, s := range t.suggestions {
+		l := strings.ToLower(s)
+		switch {
+		case entered[s] || s == word:
+		case strings.HasPrefix(l, lower):
+			prefixed = append(prefixed, s)
+		case strings.Contains(l, lower):
+			contained = append(contained, s)
+		}
+	}
+	matches := append(prefixed, contained...)
+	if len(matches) > maxCompletions {
+		matches = matches[:maxCompletions]
+	}
+	return matches
+}
+
+// complete replaces the word being typed with the highlighted completion.
+func (t *textInputOverlay) complete(matches []string) {
+	before, _ := t.currentWord()
+	t.input.SetValue(before + matches[min(t.suggestPos, len(matches)-1)] + ", ")
+	t.input.CursorEnd()
+	t.suggestPos = 0
 }
 
 func (t *textInputOverlay) Update(msg tea.Msg) (overlay, tea.Cmd) {
 	if km, ok := msg.(tea.KeyMsg); ok {
+		if matches := t.completions(); len(matches) > 0 {
+			switch km.String() {
+			case "tab":
+				t.complete(matches)
+				return t, nil
+			case "up", "ctrl+p":
+				t.suggestPos = max(t.suggestPos-1, 0)
+				return t, nil
+			case "down", "ctrl+n":
+				t.suggestPos = min(t.suggestPos+1, len(matches)-1)
+				return t, nil
+			}
+		}
 		switch km.String() {
 		case "esc":
 			t.isDone = true
@@ -241,11 +562,25 @@ func (t *textInputOverlay) Update(msg tea.Msg) (overlay, tea.Cmd) {
 			t.isDone = true
 			t.result = t.input.Value()
 			return t, nil
+		case "up":
+			if len(t.history) > 0 {
+				t.recall(1)
+				return t, nil
+			}
+		case "down":
+			if len(t.history) > 0 {
+				t.recall(-1)
+				return t, nil
+			}
 		}
 	}
 
 	var cmd tea.Cmd
+	before := t.input.Value()
 	t.input, cmd = t.input.Update(msg)
+	if t.input.Value() != before {
+		t.suggestPos = 0
+	}
 	return t, cmd
 }
 
@@ -256,7 +591,26 @@ func (t *textInputOverlay) View(width, height int) string {
 	b.WriteString("\n")
 	b.WriteString(t.input.View())
 	b.WriteString("\n")
-	b.WriteString(overlayHintStyle.Render("enter: save  esc: cancel"))
+	matches := t.completions()
+	for i, m := range matches {
+		if i == min(t.suggestPos, len(matches)-1) {
+			b.WriteString(overlaySelectedStyle.Render("> " + m))
+		} else {
+			b.WriteString(overlayFilterStyle.Render("  " + m))
+		}
+		b.WriteString("\n")
+	}
+	if t.errMsg != "" {
+		b.WriteString(errorStyle.Render(t.errMsg))
+		b.WriteString("\n")
+	}
+	hint := "enter: save  esc: cancel"
+	if len(matches) > 0 {
+		hint += "  tab: complete  ↑/↓: choose"
+	} else if len(t.history) > 0 {
+		hint += "  ↑/↓: history"
+	}
+	b.WriteString(overlayHintStyle.Render(hint))
 
 	boxWidth := width - 10
 	if boxWidth < 30 {
@@ -274,6 +628,150 @@ func (t *textInputOverlay) done() (bool, interface{}) {
 	return t.isDone, t.result
 }
 
+// --- Date Input Overlay ---
+
+// dateLayout is the form dates are typed and stored in.
+const dateLayout = "2006-01-02"
+
+// dateInputOverlay edits a YYYY-MM-DD date above a calendar of its month.
+// An empty input clears the date.
+type dateInputOverlay struct {
+	title  string
+	input  textinput.Model
+	today  time.Time
+	errMsg string
+	isDone bool
+	result interface{} // string ("" to clear) or nil
+}
+
+func newDateInputOverlay(title, initial string, today time.Time) *dateInputOverlay {
+	ti := textinput.New()
+	ti.SetValue(initial)
+	ti.Placeholder = "YYYY-MM-DD"
+	ti.CharLimit = len(dateLayout)
+	ti.Width = len(dateLayout) + 1
+	ti.Focus()
+
+	return &dateInputOverlay{
+		title: title,
+		input: ti,
+		today: dateOnly(today),
+	}
+}
+
+// date returns the typed date, or today while the input isn't a date.
+func (d *dateInputOverlay) date() (time.Time, bool) {
+	t, err := time.Parse(dateLayout, strings.TrimSpace(d.input.Value()))
+	if err != nil {
+		return d.today, false
+	}
+	return t, true
+}
+
+// shift moves the date by days, starting from today when none is typed.
+func (d *dateInputOverlay) shift(days int) {
+	t, _ := d.date()
+	d.input.SetValue(t.AddDate(0, 0, days).Format(dateLayout))
+	d.input.CursorEnd()
+	d.errMsg = ""
+}
+
+func (d *dateInputOverlay) Update(msg tea.Msg) (overlay, tea.Cmd) {
+	if km, ok := msg.(tea.KeyMsg); ok {
+		switch km.String() {
+		case "esc":
+			d.isDone = true
+			d.result = nil
+			return d, nil
+		case "enter":
+			value := strings.TrimSpace(d.input.Value())
+			if t, ok := d.date(); ok {
+				d.isDone = true
+				d.result = t.Format(dateLayout)
+			} else if value == "" {
+				d.isDone = true
+				d.result = ""
+			} else {
+				d.errMsg = "Enter a date as YYYY-MM-DD"
+			}
+			return d, nil
+		case "up":
+			d.shift(-1)
+			return d, nil
+		case "down":
+			d.shift(1)
+			return d, nil
+		case "pgup":
+			d.shift(-7)
+			return d, nil
+		case "pgdown":
+			d.shift(7)
+			return d, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	before := d.input.Value()
+	d.input, cmd = d.input.Update(msg)
+	if d.input.Value() != before {
+		d.errMsg = ""
+	}
+	return d, cmd
+}
+
+// calendar renders the month of the typed date, or of today, with the
+// typed date highlighted and today in yellow.
+func (d *dateInputOverlay) calendar() string {
+	picked, ok := d.date()
+	first := time.Date(picked.Year(), picked.Month(), 1, 0, 0, 0, 0, time.UTC)
+	var b strings.Builder
+	b.WriteString(overlayFilterStyle.Render(first.Format("January 2006")))
+	b.WriteString("\n")
+	b.WriteString(overlayFilterStyle.Render("Mo Tu We Th Fr Sa Su"))
+	b.WriteString("\n")
+	// Monday-first offset of the 1st
+	b.WriteString(strings.Repeat("   ", (int(first.Weekday())+6)%7))
+	for day := first; day.Month() == first.Month(); day = day.AddDate(0, 0, 1) {
+		cell := fmt.Sprintf("%2d", day.Day())
+		switch {
+		case ok && day.Equal(picked):
+			cell = overlaySelectedStyle.Render(cell)
+		case day.Equal(d.today):
+			cell = timelineTodayStyle.Render(cell)
+		}
+		b.WriteString(cell)
+		if day.Weekday() == time.Sunday {
+			b.WriteString("\n")
+		} else {
+			b.WriteString(" ")
+		}
+	}
+	return strings.TrimRight(b.String(), " \n")
+}
+
+func (d *dateInputOverlay) View(width, height int) string {
+	var b strings.Builder
+
+	b.WriteString(overlayTitleStyle.Render(d.title))
+	b.WriteString("\n")
+	b.WriteString(d.input.View())
+	b.WriteString("\n\n")
+	b.WriteString(d.calendar())
+	b.WriteString("\n")
+	if d.errMsg != "" {
+		b.WriteString(errorStyle.Render(d.errMsg))
+		b.WriteString("\n")
+	}
+	b.WriteString(overlayHintStyle.Render("enter: save (empty clears)  ↑/↓: day  pgup/pgdn: week  esc: cancel"))
+
+	content := overlayBorderStyle.Render(b.String())
+	return lipgloss.Place(width, height-2, lipgloss.Center, lipgloss.Center, content)
+}
+
+func (d *dateInputOverlay) done() (bool, interface{}) {
+	return d.isDone, d.result
+}
+
 // --- Text Editor Overlay ---
 
 // textEditorOverlay is a multi-line text editor (for description).
@@ -319,13 +817,19 @@ func (e *textEditorOverlay) Update(msg tea.Msg) (overlay, tea.Cmd) {
 }
 
 func (e *textEditorOverlay) View(width, height int) string {
+	return e.render(width, height, "", "ctrl+s: save  esc: cancel")
+}
+
+// render draws the editor with extra lines below it and a key hint.
+func (e *textEditorOverlay) render(width, height int, below, hint string) string {
 	var b strings.Builder
 
 	b.WriteString(overlayTitleStyle.Render(e.title))
 	b.WriteString("\n")
 	b.WriteString(e.editor.View())
 	b.WriteString("\n")
-	b.WriteString(overlayHintStyle.Render("ctrl+s: save  esc: cancel"))
+	b.WriteString(below)
+	b.WriteString(overlayHintStyle.Render(hint))
 
 	boxWidth := width - 10
 	if boxWidth < 30 {
@@ -386,6 +890,45 @@ func (c *confirmOverlay) done() (bool, interface{}) {
 	return c.isDone, c.result
 }
 
+// --- Info Overlay ---
+
+// infoOverlay shows read-only text until dismissed. It never produces a
+// result, so closing it is handled like a cancel.
+type infoOverlay struct {
+	title  string
+	body   string
+	isDone bool
+}
+
+func newInfoOverlay(title, body string) *infoOverlay {
+	return &infoOverlay{title: title, body: body}
+}
+
+func (o *infoOverlay) Update(msg tea.Msg) (overlay, tea.Cmd) {
+	if km, ok := msg.(tea.KeyMsg); ok {
+		switch km.String() {
+		case "esc", "enter", "q":
+			o.isDone = true
+		}
+	}
+	return o, nil
+}
+
+func (o *infoOverlay) View(width, height int) string {
+	content := overlayBorderStyle.Render(
+		fmt.Sprintf("%s\n\n%s\n\n%s",
+			overlayTitleStyle.Render(o.title),
+			o.body,
+			overlayHintStyle.Render("esc: close"),
+		),
+	)
+	return lipgloss.Place(width, height-2, lipgloss.Center, lipgloss.Center, content)
+}
+
+func (o *infoOverlay) done() (bool, interface{}) {
+	return o.isDone, nil
+}
+
 // min returns the smaller of a and b.
 func min(a, b int) int {
 	if a < b {
@@ -393,3 +936,177 @@ func min(a, b int) int {
 	}
 	return b
 }
+
+// --- Column Overlay ---
+
+// columnRow is one column in the column overlay.
+type columnRow struct {
+	name    string
+	visible bool
+	width   int // fixed width, 0 for auto
+}
+
+// columnsResult is the column overlay's result.
+type columnsResult struct {
+	columns []string       // visible columns in order
+	widths  map[string]int // fixed widths of visible columns
+	save    bool           // also write them to config.yaml
+}
+
+// columnWidthStep is how much one keypress changes a fixed width.
+const columnWidthStep = 2
+
+// columnsOverlay shows, hides, reorders, and sizes a tab's columns.
+type columnsOverlay struct {
+	title  string
+	rows   []columnRow
+	cursor int
+	errMsg string
+	isDone bool
+	result interface{} // columnsResult or nil
+}
+
+// newColumnsOverlay lists the visible columns in order followed by the
+// other known columns, hidden.
+func newColumnsOverlay(title string, columns []string, widths map[string]int, available []string) *columnsOverlay {
+	o := &columnsOverlay{title: title}
+	seen := make(map[string]bool)
+	for _, c := range columns {
+		seen[c] = true
+		o.rows = append(o.rows, columnRow{name: c, visible: true, width: widths[c]})
+	}
+	for _, c := range available {
+		if !seen[c] {
+			o.rows = append(o.rows, columnRow{name: c})
+		}
+	}
+	return o
+}
+
+// move swaps the row under the cursor with its neighbour in direction d.
+func (o *columnsOverlay) move(d int) {
+	o.cursor = swapRow(o.rows, o.cursor, d)
+}
+
+// swapRow swaps rows[i] with its neighbour in direction d and returns the
+// row's new index, or i if there is no neighbour that way.
+func swapRow[T any](rows []T, i, d int) int {
+	j := i + d
+	if i < 0 || i >= len(rows) || j < 0 || j >= len(rows) {
+		return i
+	}
+	rows[i], rows[j] = rows[j], rows[i]
+	return j
+}
+
+// resize changes the fixed width of the row under the cursor. Growing an
+// auto-sized column starts from its default width; shrinking below the
+// step returns it to auto.
+func (o *columnsOverlay) resize(d int) {
+	r := &o.rows[o.cursor]
+	if r.width == 0 {
+		if d < 0 {
+			return
+		}
+		r.width = columnDefFor(r.name).minWidth
+		return
+	}
+	r.width += d
+	if r.width < columnWidthStep*2 {
+		r.width = 0
+	}
+}
+
+// finish returns the visible columns, or keeps the overlay open when none
+// would be left.
+func (o *columnsOverlay) finish(save bool) {
+	res := columnsResult{widths: map[string]int{}, save: save}
+	for _, r := range o.rows {
+		if !r.visible {
+			continue
+		}
+		res.columns = append(res.columns, r.name)
+		if r.width > 0 {
+			res.widths[r.name] = r.width
+		}
+	}
+	if len(res.columns) == 0 {
+		o.errMsg = "At least one column must be visible"
+		return
+	}
+	o.isDone = true
+	o.result = res
+}
+
+func (o *columnsOverlay) Update(msg tea.Msg) (overlay, tea.Cmd) {
+	km, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return o, nil
+	}
+	o.errMsg = ""
+	switch km.String() {
+	case "esc":
+		o.isDone = true
+		o.result = nil
+	case "enter":
+		o.finish(false)
+	case "w":
+		o.finish(true)
+	case "up", "k":
+		if o.cursor > 0 {
+			o.cursor--
+		}
+	case "down", "j":
+		if o.cursor < len(o.rows)-1 {
+			o.cursor++
+		}
+	case "K", "shift+up":
+		o.move(-1)
+	case "J", "shift+down":
+		o.move(1)
+	case " ":
+		o.rows[o.cursor].visible = !o.rows[o.cursor].visible
+	case "left", "h", "-":
+		o.resize(-columnWidthStep)
+	case "right", "l", "+":
+		o.resize(columnWidthStep)
+	}
+	return o, nil
+}
+
+func (o *columnsOverlay) View(width, height int) string {
+	var b strings.Builder
+	b.WriteString(overlayTitleStyle.Render(o.title))
+	b.WriteString("\n")
+
+	for i, r := range o.rows {
+		mark := "[ ]"
+		if r.visible {
+			mark = "[x]"
+		}
+		size := "auto"
+		if r.width > 0 {
+			size = fmt.Sprint(r.width)
+		}
+		line := fmt.Sprintf("%s %-12s %5s", mark, columnDefFor(r.name).title, size)
+		if i == o.cursor {
+			b.WriteString(overlaySelectedStyle.Render("> " + line))
+		} else {
+			b.WriteString("  " + line)
+		}
+		b.WriteString("\n")
+	}
+
+	if o.errMsg != "" {
+		b.WriteString(errorStyle.Render(o.errMsg))
+		b.WriteString("\n")
+	}
+	b.WriteString(overlayHintStyle.Render("space: show/hide  J/K: move  ←/→: width\nenter: apply  w: apply & save  esc: cancel"))
+
+	content := overlayBorderStyle.Width(min(max(width-10, 30), 50)).Render(b.String())
+	return lipgloss.Place(width, height-2, lipgloss.Center, lipgloss.Center, content)
+}
+
+func (o *columnsOverlay) done() (bool, interface{}) {
+	return o.isDone, o.result
+}
@@ -298,3 +298,104 @@ func TestSelectionOverlayWithDescriptions(t *testing.T) {
 		t.Error("expected description in view")
 	}
 }
+
+func TestChecklistOverlayToggleAndSave(t *testing.T) {
+	var o overlay = newChecklistOverlay("Labels", []string{"backend", "frontend", "urgent"}, []string{"urgent"})
+
+	// Checked items are listed first: urgent, backend, frontend
+	o = updateOverlay(o, keyMsg(" ")) // uncheck urgent
+	o = updateOverlay(o, keyMsg("down"))
+	o = updateOverlay(o, keyMsg(" ")) // check backend
+	o = updateOverlay(o, keyMsg("enter"))
+
+	done, result := o.done()
+	if !done {
+		t.Fatal("expected overlay to be done")
+	}
+	got := result.([]string)
+	if len(got) != 1 || got[0] != "backend" {
+		t.Errorf("result = %v, want [backend]", got)
+	}
+}
+
+func TestChecklistOverlayCreatesNewItem(t *testing.T) {
+	var o overlay = newChecklistOverlay("Labels", []string{"backend"}, nil)
+	for _, r := range "tech-debt" {
+		o = updateOverlay(o, keyMsg(string(r)))
+	}
+	if view := o.View(80, 30); !strings.Contains(view, `+ create "tech-debt"`) {
+		t.Errorf("expected create row in view, got:\n%s", view)
+	}
+
+	o = updateOverlay(o, keyMsg(" "))
+	o = updateOverlay(o, keyMsg("enter"))
+
+	_, result := o.done()
+	got := result.([]string)
+	if len(got) != 1 || got[0] != "tech-debt" {
+		t.Errorf("result = %v, want [tech-debt]", got)
+	}
+}
+
+func TestChecklistOverlayExactMatchHidesCreate(t *testing.T) {
+	o := newChecklistOverlay("Labels", []string{"backend", "backend-api"}, nil)
+	for _, r := range "backend" {
+		updateOverlay(o, keyMsg(string(r)))
+	}
+	if len(o.filtered) != 2 || o.filtered[0] == createRow {
+		t.Errorf("filtered = %q, want both labels and no create row", o.filtered)
+	}
+}
+
+func TestChecklistOverlayEscCancels(t *testing.T) {
+	var o overlay = newChecklistOverlay("Labels", []string{"backend"}, nil)
+	o = updateOverlay(o, keyMsg("esc"))
+	done, result := o.done()
+	if !done {
+		t.Error("expected overlay to be done")
+	}
+	if result != nil {
+		t.Errorf("expected nil result, got %v", result)
+	}
+}
+
+func TestTextInputOverlayHistoryRecall(t *testing.T) {
+	o := newTextInputOverlay("JQL", "")
+	o.history = []string{"newest", "older"}
+	o.input.SetValue("draft")
+
+	updateOverlay(o, keyMsg("up"))
+	if o.input.Value() != "newest" {
+		t.Errorf("after up: %q, want newest", o.input.Value())
+	}
+	updateOverlay(o, keyMsg("up"))
+	updateOverlay(o, keyMsg("up")) // stays on the oldest entry
+	if o.input.Value() != "older" {
+		t.Errorf("after up x3: %q, want older", o.input.Value())
+	}
+	updateOverlay(o, keyMsg("down"))
+	updateOverlay(o, keyMsg("down"))
+	if o.input.Value() != "draft" {
+		t.Errorf("after returning: %q, want draft", o.input.Value())
+	}
+}
+
+func TestSwapRow(t *testing.T) {
+	tests := []struct {
+		i, d int
+		want int
+		rows string
+	}{
+		{0, 1, 1, "bac"},
+		{2, -1, 1, "acb"},
+		{0, -1, 0, "abc"},
+		{2, 1, 2, "abc"},
+		{3, -1, 3, "abc"},
+	}
+	for _, tt := range tests {
+		rows := []string{"a", "b", "c"}
+		if got := swapRow(rows, tt.i, tt.d); got != tt.want || strings.Join(rows, "") != tt.rows {
+			t.Errorf("swapRow(%d, %d) = %d, rows %q; want %d, %q", tt.i, tt.d, got, strings.Join(rows, ""), tt.want, tt.rows)
+		}
+	}
+}
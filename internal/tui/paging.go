@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// remainderPageSize is the page size used when loading the rest of a
+// truncated result.
+const remainderPageSize = 100
+
+// tabMoreMsg delivers the rest of a tab's truncated result.
+type tabMoreMsg struct {
+	tabIndex int
+	tabID    int
+	issues   []jira.Issue
+	err      error
+}
+
+// searchPages runs a search and returns its first page, or every page when
+// all is set, with the token for the results past those returned.
+func searchPages(ctx context.Context, client *jira.Client, opts jira.SearchOptions, all bool) ([]jira.Issue, string, error) {
+	result, err := client.SearchIssues(ctx, opts)
+	if err != nil {
+		return nil, "", err
+	}
+	issues := result.Issues
+	for all && !result.IsLast && result.NextPageToken != "" {
+		opts.NextPageToken = result.NextPageToken
+		opts.MaxResults = remainderPageSize
+		if result, err = client.SearchIssues(ctx, opts); err != nil {
+			return nil, "", err
+		}
+		issues = append(issues, result.Issues...)
+	}
+	if result.IsLast {
+		return issues, "", nil
+	}
+	return issues, result.NextPageToken, nil
+}
+
+// loadRemainder fetches the issues past the first page of the active tab.
+// Later reloads of the tab fetch every page too.
+func (a *App) loadRemainder() tea.Cmd {
+	if a.activeTab >= len(a.tabs) || a.client == nil {
+		return nil
+	}
+	t := &a.tabs[a.activeTab]
+	if t.nextPage == "" {
+		a.flash = fmt.Sprintf("All %d issues are loaded", len(t.issues))
+		a.flashIsErr = false
+		return nil
+	}
+	client := a.client
+	index, id := a.activeTab, t.id
+	opts := t.search
+	opts.NextPageToken = t.nextPage
+	opts.MaxResults = remainderPageSize
+	a.flash = "Loading the rest of " + t.config.Label + "..."
+	a.flashIsErr = false
+	return a.startNetwork(func() tea.Msg {
+		issues, _, err := searchPages(context.Background(), client, opts, true)
+		return tabMoreMsg{tabIndex: index, tabID: id, issues: issues, err: err}
+	})
+}
+
+// handleTabMore appends the remainder to the tab, keeping the cursor.
+func (a App) handleTabMore(msg tabMoreMsg) (tea.Model, tea.Cmd) {
+	msg.tabIndex = a.tabIndexFor(msg.tabIndex, msg.tabID)
+	if msg.tabIndex < 0 || msg.tabIndex >= len(a.tabs) {
+		return a, nil
+	}
+	t := &a.tabs[msg.tabIndex]
+	if msg.err != nil {
+		a.flash = fmt.Sprintf("Loading more of %s failed: %v", t.config.Label, msg.err)
+		a.flashIsErr = true
+		return a, nil
+	}
+	issues := append(append([]jira.Issue{}, t.issues...), msg.issues...)
+	t.nextPage = ""
+	t.fetchAll = true
+	t.refreshIssues(issues)
+	a.markFetched(msg.issues...)
+	a.tabCacheDirty = a.tabCacheDirty || (a.tabCacheOn && !t.temporary)
+	a.flash = fmt.Sprintf("%s: loaded %d more issues", t.config.Label, len(msg.issues))
+	a.flashIsErr = false
+	return a, a.loadWorkflows()
+}
@@ -0,0 +1,55 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestTruncatedResultShowsMore(t *testing.T) {
+	app := testAppWithTabs()
+	model, _ := app.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	app = model.(App)
+	model, _ = app.Update(tabDataMsg{tabIndex: 0, nextPage: "tok", issues: []jira.Issue{
+		{Key: "PROJ-1", Fields: jira.IssueFields{Summary: "One"}},
+		{Key: "PROJ-2", Fields: jira.IssueFields{Summary: "Two"}},
+	}})
+	app = model.(App)
+	if bar := app.renderStatusBar(); !strings.Contains(bar, "2 shown +more") {
+		t.Errorf("status bar should mark the result truncated: %q", bar)
+	}
+
+	app.tabs[0].table.SetCursor(1)
+	model, _ = app.Update(tabMoreMsg{tabIndex: 0, issues: []jira.Issue{{Key: "PROJ-3"}}})
+	app = model.(App)
+	tab := app.tabs[0]
+	if len(tab.issues) != 3 || tab.nextPage != "" || !tab.fetchAll {
+		t.Errorf("issues = %d, nextPage = %q, fetchAll = %v", len(tab.issues), tab.nextPage, tab.fetchAll)
+	}
+	if sel := tab.selectedIssue(); sel == nil || sel.Key != "PROJ-2" {
+		t.Errorf("selected = %v, want the cursor kept on PROJ-2", sel)
+	}
+	if strings.Contains(app.renderStatusBar(), "+more") {
+		t.Error("+more should go once everything is loaded")
+	}
+}
+
+func TestLoadRemainderWhenComplete(t *testing.T) {
+	app := testAppConnected()
+	model, cmd := app.Update(keyMsg("M"))
+	app = model.(App)
+	if cmd != nil || app.flash != "All 3 issues are loaded" {
+		t.Errorf("flash = %q, cmd = %v", app.flash, cmd)
+	}
+
+	app.tabs[0].nextPage = "tok"
+	before := app.inflight
+	model, cmd = app.Update(keyMsg("M"))
+	app = model.(App)
+	if cmd == nil || app.inflight != before+1 {
+		t.Errorf("M should fetch the remainder, inflight = %d", app.inflight)
+	}
+}
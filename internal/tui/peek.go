@@ -0,0 +1,169 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// peekWidth is the widest a peek box gets on a wide terminal.
+const peekWidth = 72
+
+// peekPassKeys close a peek and then act on the list as usual: the
+// table's movement keys, and enter to open the full detail view.
+var peekPassKeys = map[string]bool{
+	"up": true, "down": true, "j": true, "k": true,
+	"pgup": true, "pgdown": true, "ctrl+u": true, "ctrl+d": true,
+	"home": true, "end": true, "g": true, "G": true,
+	"enter": true,
+}
+
+// peekLoadedMsg carries what a peek fetches beyond the search result: the
+// description and the newest comment.
+type peekLoadedMsg struct {
+	issueKey string
+	issue    *jira.Issue
+	comment  *jira.Comment // nil when the issue has no comments
+	err      error
+}
+
+// peekOverlay is a condensed look at the selected row — status, assignee,
+// the description's first paragraph, and the last comment — cheaper than
+// opening the detail view. Any key closes it.
+type peekOverlay struct {
+	issue   jira.Issue
+	comment *jira.Comment
+	loading bool
+	err     error
+	isDone  bool
+}
+
+func newPeekOverlay(issue jira.Issue, loading bool) *peekOverlay {
+	return &peekOverlay{issue: issue, loading: loading}
+}
+
+func (o *peekOverlay) Update(msg tea.Msg) (overlay, tea.Cmd) {
+	if _, ok := msg.(tea.KeyMsg); ok {
+		o.isDone = true
+	}
+	return o, nil
+}
+
+func (o *peekOverlay) View(width, height int) string {
+	w := min(peekWidth, width-8)
+	f := o.issue.Fields
+
+	var b strings.Builder
+	b.WriteString(overlayTitleStyle.Render(o.issue.Key+" "+f.Summary) + "\n\n")
+	b.WriteString(renderField("Status", statusName(o.issue)))
+	b.WriteString(renderField("Assignee", userName(f.Assignee, "Unassigned")))
+	b.WriteString("\n")
+	switch {
+	case o.loading:
+		b.WriteString(detailTypeStyle.Render("Loading…") + "\n")
+	case o.err != nil:
+		b.WriteString(errorStyle.Render(o.err.Error()) + "\n")
+	default:
+		if desc := firstParagraph(f.Description, w); desc != "" {
+			b.WriteString(desc + "\n")
+		} else {
+			b.WriteString(detailTypeStyle.Render("No description") + "\n")
+		}
+		b.WriteString("\n")
+		if c := o.comment; c != nil {
+			author := "Unknown"
+			if c.Author != nil {
+				author = c.Author.DisplayName
+			}
+			b.WriteString(fmt.Sprintf("%s  %s\n",
+				lipgloss.NewStyle().Bold(true).Render(author),
+				detailTypeStyle.Render(formatDetailDate(c.Created)),
+			))
+			b.WriteString(firstParagraph(c.Body, w) + "\n")
+		} else {
+			b.WriteString(detailTypeStyle.Render("No comments") + "\n")
+		}
+	}
+	b.WriteString("\n" + overlayHintStyle.Render("enter: open · any key: close"))
+
+	content := overlayBorderStyle.Width(w + 4).Render(b.String())
+	return lipgloss.Place(width, height-2, lipgloss.Center, lipgloss.Center, content)
+}
+
+func (o *peekOverlay) done() (bool, interface{}) {
+	return o.isDone, nil
+}
+
+// firstParagraph renders the first block of an ADF document that has any
+// text, wrapped to width; for a plain string body, the text up to the
+// first blank line.
+func firstParagraph(doc interface{}, width int) string {
+	node, ok := doc.(map[string]interface{})
+	if !ok {
+		para, _, _ := strings.Cut(renderADF(doc, adfOptions{width: width}), "\n\n")
+		return para
+	}
+	for _, block := range adfContent(node) {
+		first := map[string]interface{}{"type": "doc", "content": []interface{}{block}}
+		if text := renderADF(first, adfOptions{width: width}); text != "" {
+			return text
+		}
+	}
+	return ""
+}
+
+// startPeek opens a peek at the selected row and fetches the parts a
+// search result leaves out.
+func (a App) startPeek() (tea.Model, tea.Cmd) {
+	if a.activeTab >= len(a.tabs) || a.tabs[a.activeTab].state != tabReady {
+		return a, nil
+	}
+	issue := a.tabs[a.activeTab].selectedIssue()
+	if issue == nil {
+		return a, nil
+	}
+	a.overlay = newPeekOverlay(*issue, a.client != nil)
+	a.overlayIssue = issue.Key
+	a.overlayAction = overlayActionNone
+	if a.client == nil {
+		return a, nil
+	}
+	return a, a.startNetwork(a.cmdFetchPeek(issue.Key))
+}
+
+// cmdFetchPeek fetches an issue and its newest comment for a peek.
+func (a App) cmdFetchPeek(issueKey string) tea.Cmd {
+	client := a.client
+	return func() tea.Msg {
+		ctx := context.Background()
+		issue, err := client.GetIssue(ctx, issueKey)
+		if err != nil {
+			return peekLoadedMsg{issueKey: issueKey, err: err}
+		}
+		msg := peekLoadedMsg{issueKey: issueKey, issue: issue}
+		// Comments are a bonus; the peek shows "No comments" without them
+		if page, err := client.GetCommentsPage(ctx, issueKey, 0); err == nil && len(page.Comments) > 0 {
+			msg.comment = &page.Comments[0]
+		}
+		return msg
+	}
+}
+
+// handlePeekLoaded fills in the peek if it is still open on the issue.
+func (a App) handlePeekLoaded(msg peekLoadedMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	if o, ok := a.overlay.(*peekOverlay); ok && o.issue.Key == msg.issueKey {
+		o.loading = false
+		o.err = msg.err
+		o.comment = msg.comment
+		if msg.issue != nil {
+			o.issue = *msg.issue
+		}
+	}
+	return a, nil
+}
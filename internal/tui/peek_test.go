@@ -0,0 +1,68 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestPeek(t *testing.T) {
+	app := testAppReady()
+	model, _ := app.Update(keyMsg("H"))
+	app = model.(App)
+	o, ok := app.overlay.(*peekOverlay)
+	if !ok || o.issue.Key != "PROJ-1" {
+		t.Fatalf("overlay = %T, want a peek at PROJ-1", app.overlay)
+	}
+
+	model, _ = app.Update(peekLoadedMsg{issueKey: "PROJ-1", issue: &jira.Issue{Key: "PROJ-1", Fields: jira.IssueFields{
+		Summary:     "First issue",
+		Status:      &jira.Status{Name: "Open"},
+		Description: "The login page times out.\n\nSteps: open it and wait.",
+	}}, comment: &jira.Comment{Author: &jira.User{DisplayName: "Ana"}, Body: "Seen on staging too"}})
+	app = model.(App)
+	view := app.overlay.View(100, 40)
+	for _, want := range []string{"The login page times out.", "Ana", "Seen on staging too", "Unassigned"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("peek should show %q:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "Steps:") {
+		t.Error("only the description's first paragraph belongs in a peek")
+	}
+
+	// Moving closes the peek and moves the cursor
+	model, _ = app.Update(keyMsg("down"))
+	app = model.(App)
+	if app.overlay != nil {
+		t.Fatalf("overlay = %T, want the peek closed", app.overlay)
+	}
+	if issue := app.tabs[0].selectedIssue(); issue == nil || issue.Key != "PROJ-2" {
+		t.Errorf("cursor should have moved to PROJ-2, got %v", issue)
+	}
+}
+
+func TestFirstParagraph(t *testing.T) {
+	doc := map[string]interface{}{
+		"type": "doc",
+		"content": []interface{}{
+			map[string]interface{}{"type": "paragraph"},
+			adfPara("The login page times out."),
+			adfPara("Steps: open it and wait."),
+		},
+	}
+	if got := firstParagraph(doc, 80); got != "The login page times out." {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestPeekClosesOnAnyKey(t *testing.T) {
+	app := testAppReady()
+	model, _ := app.Update(keyMsg("H"))
+	model, _ = model.(App).Update(keyMsg("s"))
+	app = model.(App)
+	if app.overlay != nil {
+		t.Errorf("overlay = %T, a key should only close the peek", app.overlay)
+	}
+}
@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// writeKind identifies the type of an in-flight write operation.
+type writeKind int
+
+const (
+	writeUpdate writeKind = iota // field edit, transition, assignment
+	writeDelete
+	writeComment
+	writeCreate
+)
+
+// pendingWrite is a mutation that has been sent to Jira but has not yet
+// completed. Quitting while writes are pending asks for confirmation so
+// optimistic changes aren't silently dropped.
+type pendingWrite struct {
+	kind     writeKind
+	issueKey string  // empty for creates (key not yet known)
+	cmd      tea.Cmd // sends the write, again if it is retried
+	attempt  int     // retries so far
+	updated  string  // the issue's updated time when the write was made, to spot conflicts
+}
+
+// describe returns a short human-readable label, e.g. "delete PROJ-1".
+func (w pendingWrite) describe() string {
+	switch w.kind {
+	case writeDelete:
+		return "delete " + w.issueKey
+	case writeComment:
+		return "comment on " + w.issueKey
+	case writeCreate:
+		return "create issue"
+	default:
+		return "update " + w.issueKey
+	}
+}
+
+// trackWrite records an in-flight mutation and returns cmd unchanged so it
+// can wrap the command at the dispatch site.
+func (a *App) trackWrite(kind writeKind, issueKey string, cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	w := pendingWrite{kind: kind, issueKey: issueKey, cmd: cmd}
+	if issue := a.findIssue(issueKey); issue != nil {
+		w.updated = issue.Fields.Updated
+	}
+	a.pending = append(a.pending, w)
+	a.forgetPrefetched(issueKey)
+	return cmd
+}
+
+// finishWrite removes the first pending write matching kind and issueKey
+// and returns it, so a failed one can be queued for retry.
+func (a *App) finishWrite(kind writeKind, issueKey string) pendingWrite {
+	for i, w := range a.pending {
+		if w.kind == kind && w.issueKey == issueKey {
+			rest := make([]pendingWrite, 0, len(a.pending)-1)
+			rest = append(rest, a.pending[:i]...)
+			a.pending = append(rest, a.pending[i+1:]...)
+			return w
+		}
+	}
+	return pendingWrite{}
+}
+
+// pendingSummary describes the outstanding writes for the quit prompt.
+func pendingSummary(pending []pendingWrite) string {
+	labels := make([]string, len(pending))
+	for i, w := range pending {
+		labels[i] = w.describe()
+	}
+	noun := "changes are"
+	if len(pending) == 1 {
+		noun = "change is"
+	}
+	return fmt.Sprintf("%d %s still saving (%s). Quit anyway?",
+		len(pending), noun, strings.Join(labels, ", "))
+}
+
+// quit shuts down when nothing is pending or waiting to be retried,
+// otherwise it opens a confirmation overlay listing the writes that would
+// be lost. A second ctrl+c while the prompt is open forces the quit.
+func (a App) quit(force bool) (tea.Model, tea.Cmd) {
+	if len(a.pending) == 0 && len(a.failed) == 0 || force {
+		return a.shutdown()
+	}
+	question := pendingSummary(a.pending)
+	if len(a.pending) == 0 {
+		question = failedSummary(a.failed)
+	}
+	a.overlay = newConfirmOverlay(question)
+	a.overlayIssue = ""
+	a.overlayAction = overlayActionQuit
+	return a, nil
+}
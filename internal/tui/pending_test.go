@@ -0,0 +1,105 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestQuitWithoutPendingWritesQuits(t *testing.T) {
+	app := testAppReady()
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	if cmd == nil {
+		t.Fatal("expected quit command, got nil")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("expected QuitMsg when nothing is pending")
+	}
+}
+
+func TestQuitWithPendingWritesPrompts(t *testing.T) {
+	app := testAppReady()
+	app.pending = []pendingWrite{
+		{kind: writeDelete, issueKey: "PROJ-2"},
+		{kind: writeComment, issueKey: "PROJ-1"},
+	}
+
+	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	updated := model.(App)
+	if cmd != nil {
+		t.Fatal("expected no quit command while writes are pending")
+	}
+	if updated.overlayAction != overlayActionQuit {
+		t.Fatalf("expected quit confirmation overlay, got action %d", updated.overlayAction)
+	}
+	view := updated.View()
+	for _, want := range []string{"delete PROJ-2", "comment on PROJ-1"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in quit prompt, got: %s", want, view)
+		}
+	}
+
+	// Confirming quits
+	_, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if cmd == nil {
+		t.Fatal("expected quit command after confirming")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("expected QuitMsg after confirming")
+	}
+}
+
+func TestQuitPromptCancelKeepsRunning(t *testing.T) {
+	app := testAppReady()
+	app.pending = []pendingWrite{{kind: writeUpdate, issueKey: "PROJ-1"}}
+
+	model, _ := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	model, cmd := model.(App).Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	updated := model.(App)
+	if cmd != nil {
+		t.Error("expected no command after cancelling quit")
+	}
+	if updated.overlay != nil {
+		t.Error("expected overlay to be dismissed")
+	}
+}
+
+func TestCtrlCTwiceForcesQuit(t *testing.T) {
+	app := testAppReady()
+	app.pending = []pendingWrite{{kind: writeUpdate, issueKey: "PROJ-1"}}
+
+	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	if cmd != nil {
+		t.Fatal("first ctrl+c should prompt, not quit")
+	}
+	_, cmd = model.(App).Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	if cmd == nil {
+		t.Fatal("expected second ctrl+c to quit")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("expected QuitMsg from second ctrl+c")
+	}
+}
+
+func TestFinishWriteClearsPending(t *testing.T) {
+	app := testAppReady()
+	app.pending = []pendingWrite{
+		{kind: writeUpdate, issueKey: "PROJ-1"},
+		{kind: writeDelete, issueKey: "PROJ-1"},
+	}
+	app.inflight = 1
+
+	model, _ := app.Update(issueDeletedMsg{issueKey: "PROJ-1"})
+	updated := model.(App)
+	if len(updated.pending) != 1 || updated.pending[0].kind != writeUpdate {
+		t.Errorf("expected only the update to remain pending, got %+v", updated.pending)
+	}
+}
+
+func TestPendingSummary(t *testing.T) {
+	got := pendingSummary([]pendingWrite{{kind: writeCreate}})
+	if !strings.HasPrefix(got, "1 change is still saving (create issue)") {
+		t.Errorf("unexpected summary: %s", got)
+	}
+}
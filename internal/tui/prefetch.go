@@ -0,0 +1,116 @@
+package tui
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// prefetchCount is how many of a tab's first visible issues are fetched in
+// full when it loads, so enter opens them complete at once.
+const prefetchCount = 10
+
+// prefetchWorkers bounds how many prefetch requests run at once, leaving
+// room for what the user asks for meanwhile.
+const prefetchWorkers = 3
+
+// maxPrefetched bounds how many prefetched issues are kept; past it the
+// oldest are evicted first.
+const maxPrefetched = 200
+
+// detailsPrefetchedMsg delivers the issues a prefetch fetched in full.
+// Issues that failed are left out; opening them fetches as usual.
+type detailsPrefetchedMsg struct {
+	issues []jira.Issue
+}
+
+// prefetchDetails fetches the first visible issues of tab i in full in the
+// background, skipping those already prefetched. It doesn't count as a
+// network operation, so the spinner stays for what the user asked for.
+func (a *App) prefetchDetails(i int) tea.Cmd {
+	if a.client == nil || i < 0 || i >= len(a.tabs) {
+		return nil
+	}
+	t := a.tabs[i]
+	var keys []string
+	for _, issue := range t.quickFilter.visibleIssues(t.issues) {
+		if len(keys) == prefetchCount {
+			break
+		}
+		if _, ok := a.prefetched[issue.Key]; !ok {
+			keys = append(keys, issue.Key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return cmdPrefetch(a.client, keys)
+}
+
+// cmdPrefetch fetches keys with prefetchWorkers workers.
+func cmdPrefetch(client *jira.Client, keys []string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		fetched := make([]*jira.Issue, len(keys))
+		next := make(chan int)
+		var wg sync.WaitGroup
+		for range min(prefetchWorkers, len(keys)) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range next {
+					// A prefetch that fails is simply not used
+					if issue, err := client.GetIssue(ctx, keys[i]); err == nil {
+						fetched[i] = issue
+					}
+				}
+			}()
+		}
+		for i := range keys {
+			next <- i
+		}
+		close(next)
+		wg.Wait()
+
+		var msg detailsPrefetchedMsg
+		for _, issue := range fetched {
+			if issue != nil {
+				msg.issues = append(msg.issues, *issue)
+			}
+		}
+		return msg
+	}
+}
+
+// handleDetailsPrefetched keeps the prefetched issues for openDetail.
+func (a App) handleDetailsPrefetched(msg detailsPrefetchedMsg) (tea.Model, tea.Cmd) {
+	if a.prefetched == nil {
+		a.prefetched = make(map[string]jira.Issue)
+	}
+	for _, issue := range msg.issues {
+		if _, ok := a.prefetched[issue.Key]; !ok {
+			a.prefetchOrder = append(a.prefetchOrder, issue.Key)
+		}
+		a.prefetched[issue.Key] = issue
+	}
+	for len(a.prefetchOrder) > maxPrefetched {
+		delete(a.prefetched, a.prefetchOrder[0])
+		a.prefetchOrder = a.prefetchOrder[1:]
+	}
+	return a, nil
+}
+
+// forgetPrefetched drops prefetched copies that no longer match Jira: an
+// issue being changed, or rows a refresh found changed.
+func (a *App) forgetPrefetched(keys ...string) {
+	for _, key := range keys {
+		delete(a.prefetched, key)
+	}
+	a.prefetchOrder = slices.DeleteFunc(a.prefetchOrder, func(key string) bool {
+		return slices.Contains(keys, key)
+	})
+}
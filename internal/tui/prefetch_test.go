@@ -0,0 +1,126 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestPrefetchDetails(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		key := strings.TrimPrefix(r.URL.Path, "/rest/api/3/issue/")
+		if key == "PROJ-2" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(jira.Issue{Key: key, Fields: jira.IssueFields{Summary: "Full " + key}})
+	}))
+	defer server.Close()
+
+	app := testAppReady()
+	app.client = jira.NewClient(server.URL, "test@test.com", "token")
+	app.prefetched = map[string]jira.Issue{"PROJ-1": {Key: "PROJ-1"}}
+
+	msg, ok := app.prefetchDetails(0)().(detailsPrefetchedMsg)
+	if !ok {
+		t.Fatal("prefetch returned no detailsPrefetchedMsg")
+	}
+	// PROJ-1 is already prefetched; PROJ-2 fails and is left out
+	if requests.Load() != 2 || len(msg.issues) != 1 || msg.issues[0].Fields.Summary != "Full PROJ-3" {
+		t.Fatalf("requests = %d, issues = %+v", requests.Load(), msg.issues)
+	}
+	inflight := app.inflight
+	model, _ := app.Update(msg)
+	app = model.(App)
+	if _, ok := app.prefetched["PROJ-3"]; !ok {
+		t.Error("PROJ-3 was not kept")
+	}
+	if app.inflight != inflight {
+		t.Errorf("inflight = %d, want prefetches not counted", app.inflight)
+	}
+}
+
+func TestPrefetchDetailsCapsCount(t *testing.T) {
+	app := testAppConnected()
+	var issues []jira.Issue
+	for i := range prefetchCount + 5 {
+		issues = append(issues, jira.Issue{Key: "PROJ-" + string(rune('A'+i))})
+	}
+	app.tabs[0].issues = issues
+	app.prefetched = map[string]jira.Issue{"PROJ-A": {}}
+	if app.prefetchDetails(0) == nil {
+		t.Fatal("expected a prefetch")
+	}
+
+	for _, issue := range issues {
+		app.prefetched[issue.Key] = issue
+	}
+	if app.prefetchDetails(0) != nil {
+		t.Error("expected no prefetch once every issue is prefetched")
+	}
+}
+
+func TestOpenDetailUsesPrefetched(t *testing.T) {
+	app := testAppConnected()
+	full := jira.Issue{Key: "PROJ-1", Fields: jira.IssueFields{Summary: "Fix login page", Description: "Steps to reproduce"}}
+	app.prefetched = map[string]jira.Issue{"PROJ-1": full}
+
+	app.openDetail(app.tabs[0].issues[0])
+	dv := app.topDetail("PROJ-1")
+	if dv == nil || dv.loading || dv.issue.Fields.Description != "Steps to reproduce" {
+		t.Fatalf("detail = %+v, want the prefetched issue shown at once", dv)
+	}
+
+	app.openDetail(app.tabs[0].issues[1])
+	if dv := app.topDetail("PROJ-2"); dv == nil || !dv.loading {
+		t.Error("an issue not prefetched should still load")
+	}
+}
+
+func TestWriteForgetsPrefetched(t *testing.T) {
+	app := testAppConnected()
+	app.prefetched = map[string]jira.Issue{"PROJ-1": {Key: "PROJ-1"}, "PROJ-2": {Key: "PROJ-2"}}
+	app.trackWrite(writeUpdate, "PROJ-1", func() tea.Msg { return nil })
+	if _, ok := app.prefetched["PROJ-1"]; ok {
+		t.Error("a changed issue's prefetch should be dropped")
+	}
+	if _, ok := app.prefetched["PROJ-2"]; !ok {
+		t.Error("other prefetches should be kept")
+	}
+}
+
+func TestPrefetchedEvictsOldestFirst(t *testing.T) {
+	app := testAppConnected()
+	var first, second detailsPrefetchedMsg
+	for i := range maxPrefetched {
+		first.issues = append(first.issues, jira.Issue{Key: fmt.Sprintf("OLD-%d", i)})
+	}
+	second.issues = []jira.Issue{{Key: "NEW-1"}, {Key: "NEW-2"}}
+	model, _ := app.handleDetailsPrefetched(first)
+	model, _ = model.(App).handleDetailsPrefetched(second)
+	app = model.(App)
+
+	if len(app.prefetched) != maxPrefetched {
+		t.Errorf("kept %d prefetched issues, want %d", len(app.prefetched), maxPrefetched)
+	}
+	for _, key := range []string{"OLD-0", "OLD-1"} {
+		if _, ok := app.prefetched[key]; ok {
+			t.Errorf("%s should have been evicted as the oldest", key)
+		}
+	}
+	for _, key := range []string{"OLD-2", "NEW-1", "NEW-2"} {
+		if _, ok := app.prefetched[key]; !ok {
+			t.Errorf("%s should have been kept", key)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// previewDelay is how long the cursor has to rest on an issue before the
+// preview fetches it in full, so moving down a list doesn't fetch every
+// row on the way.
+const previewDelay = 250 * time.Millisecond
+
+// splitStep is how much ctrl+left/right move the divider, in percent.
+const splitStep = 5
+
+// splitSaveDelay is how long resizing has to stop before the list width is
+// saved, so holding ctrl+right writes config.yaml once.
+const splitSaveDelay = 500 * time.Millisecond
+
+// previewPaneStyle sets the preview off from the list with a rule.
+var previewPaneStyle = lipgloss.NewStyle().
+	BorderStyle(lipgloss.NormalBorder()).
+	BorderLeft(true).
+	BorderForeground(lipgloss.Color("240")).
+	PaddingLeft(1)
+
+// previewTickMsg fires once the cursor has rested on an issue for
+// previewDelay; seq tells whether it has moved on since.
+type previewTickMsg struct {
+	seq int
+}
+
+// previewFetchedMsg delivers the issue the preview fetched in full.
+type previewFetchedMsg struct {
+	seq   int
+	issue *jira.Issue
+	err   error
+}
+
+// splitSaveTickMsg fires splitSaveDelay after a resize; seq tells whether
+// another resize has come since.
+type splitSaveTickMsg struct {
+	seq int
+}
+
+// splitSavedMsg reports writing the list width to config.yaml.
+type splitSavedMsg struct {
+	err error
+}
+
+// SetSplitPane starts with the preview shown or not, and sets the list's
+// share of the width in percent; 0 keeps the default.
+func (a *App) SetSplitPane(enabled bool, listWidth int) {
+	a.split = enabled
+	if listWidth == 0 {
+		listWidth = config.DefaultSplitListWidth
+	}
+	a.splitWidth = listWidth
+}
+
+// listWidth is how wide the issue list is drawn: all of the window, or its
+// share of it beside the preview.
+func (a App) listWidth() int {
+	if !a.split {
+		return a.width
+	}
+	return a.width * a.splitPercent() / 100
+}
+
+// splitPercent is the list's share of the width beside the preview.
+func (a App) splitPercent() int {
+	if a.splitWidth == 0 {
+		return config.DefaultSplitListWidth
+	}
+	return a.splitWidth
+}
+
+// resizeTabs lays every tab's table out for the list width.
+func (a *App) resizeTabs() {
+	tableH := a.tableHeight()
+	for i := range a.tabs {
+		a.tabs[i].setSize(a.listWidth(), tableH)
+	}
+}
+
+// toggleSplit shows or hides the preview beside the list.
+func (a App) toggleSplit() (tea.Model, tea.Cmd) {
+	a.split = !a.split
+	a.preview, a.previewRow = nil, ""
+	a.resizeTabs()
+	return a, nil
+}
+
+// resizeSplit moves the divider by delta percent and saves the new width
+// once resizing stops.
+func (a App) resizeSplit(delta int) (tea.Model, tea.Cmd) {
+	if !a.split {
+		return a, nil
+	}
+	a.splitWidth = max(config.MinSplitListWidth, min(config.MaxSplitListWidth, a.splitPercent()+delta))
+	a.resizeTabs()
+	if a.preview != nil {
+		a.preview.setSize(a.previewWidth(), a.height-1)
+	}
+	a.flash = fmt.Sprintf("List width: %d%%", a.splitWidth)
+	a.flashIsErr = false
+	if a.configPath == "" {
+		return a, nil
+	}
+	a.splitSaveSeq++
+	seq := a.splitSaveSeq
+	return a, tea.Tick(splitSaveDelay, func(time.Time) tea.Msg {
+		return splitSaveTickMsg{seq: seq}
+	})
+}
+
+// handleSplitSaveTick saves the list width unless the divider moved again
+// since the tick was set.
+func (a App) handleSplitSaveTick(msg splitSaveTickMsg) (tea.Model, tea.Cmd) {
+	if msg.seq != a.splitSaveSeq || a.configPath == "" {
+		return a, nil
+	}
+	return a, cmdSaveSplit(a.configPath, a.splitPercent())
+}
+
+func cmdSaveSplit(path string, percent int) tea.Cmd {
+	return func() tea.Msg {
+		return splitSavedMsg{err: config.SaveSplitListWidth(path, percent)}
+	}
+}
+
+// handleSplitSaved reports a list width that couldn't be saved.
+func (a App) handleSplitSaved(msg splitSavedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		a.flash = "Saving list width failed: " + msg.err.Error()
+		a.flashIsErr = true
+	}
+	return a, nil
+}
+
+// previewWidth is what the preview has to draw in beside the list, less
+// its rule and padding.
+func (a App) previewWidth() int {
+	return max(0, a.width-a.listWidth()-2)
+}
+
+// previewTarget returns the issue the preview should show: the one under
+// the cursor, while the list is showing.
+func (a App) previewTarget() *jira.Issue {
+	if !a.split || len(a.viewStack) > 0 || a.activeTab >= len(a.tabs) {
+		return nil
+	}
+	return a.tabs[a.activeTab].selectedIssue()
+}
+
+// syncPreview follows the cursor: it shows what the row knows about the
+// issue at once, or the prefetched issue, and otherwise fetches the issue
+// in full once the cursor rests on it. A row that changes, as after a
+// refresh, is previewed afresh.
+func (a App) syncPreview() (App, tea.Cmd) {
+	issue := a.previewTarget()
+	if issue == nil {
+		a.preview, a.previewRow = nil, ""
+		return a, nil
+	}
+	row := issue.Key + "@" + issue.Fields.Updated
+	if a.preview != nil && row == a.previewRow {
+		return a, nil
+	}
+	a.previewRow = row
+	a.previewSeq++
+
+	full, prefetched := a.prefetched[issue.Key]
+	shown := *issue
+	if prefetched {
+		shown = full
+	}
+	dv := newIssueDetailViewReady(shown, a.previewWidth(), a.height-1)
+	dv.baseURL = a.clientBaseURL()
+	dv.people = a.mentionContext()
+	dv.clock = a.clock
+	dv.projects = a.knownProjects(issue.Key)
+	// The row lacks the description and links until the fetch
+	dv.loading = !prefetched && a.client != nil
+	dv.buildViewport()
+	a.preview = &dv
+	if !dv.loading {
+		return a, nil
+	}
+	seq := a.previewSeq
+	return a, tea.Tick(previewDelay, func(time.Time) tea.Msg {
+		return previewTickMsg{seq: seq}
+	})
+}
+
+// handlePreviewTick fetches the previewed issue once the cursor has
+// rested on it. Like a prefetch, the fetch doesn't count as a network
+// operation: the spinner stays for what the user asked for.
+func (a App) handlePreviewTick(msg previewTickMsg) (tea.Model, tea.Cmd) {
+	if msg.seq != a.previewSeq || a.preview == nil || a.client == nil {
+		return a, nil
+	}
+	client, key, seq := a.client, a.preview.issue.Key, a.previewSeq
+	return a, func() tea.Msg {
+		issue, err := client.GetIssue(context.Background(), key)
+		return previewFetchedMsg{seq: seq, issue: issue, err: err}
+	}
+}
+
+// handlePreviewFetched fills in the preview, and keeps the issue so enter
+// opens it complete at once. A failed fetch is logged, and the preview
+// shows what the row has.
+func (a App) handlePreviewFetched(msg previewFetchedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		a.log.Warn("fetching preview failed", "err", msg.err)
+		if msg.seq == a.previewSeq && a.preview != nil {
+			a.preview.loading = false
+			a.preview.buildViewport()
+		}
+		return a, nil
+	}
+	if msg.seq == a.previewSeq && a.preview != nil {
+		a.preview.issue = *msg.issue
+		a.preview.loading = false
+		a.preview.buildViewport()
+	}
+	return a.handleDetailsPrefetched(detailsPrefetchedMsg{issues: []jira.Issue{*msg.issue}})
+}
+
+// renderSplit draws the list with the preview beside it.
+func (a App) renderSplit(list string) string {
+	left := lipgloss.NewStyle().Width(a.listWidth()).MaxWidth(a.listWidth()).Render(list)
+	right := emptyStyle.Render("No issue selected")
+	if a.preview != nil {
+		right = a.preview.View()
+	}
+	rest := a.width - a.listWidth()
+	pane := previewPaneStyle.Width(rest - 1).MaxWidth(rest).Render(right)
+	return lipgloss.JoinHorizontal(lipgloss.Top, left, pane)
+}
@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestSplitPreviewFollowsCursor(t *testing.T) {
+	app := testAppReady()
+
+	model, _ := app.Update(keyMsg("|"))
+	app = model.(App)
+	if !app.split || app.preview == nil || app.preview.issue.Key != "PROJ-1" {
+		t.Fatalf("split = %v, preview = %+v, want PROJ-1 previewed", app.split, app.preview)
+	}
+	if got := app.listWidth(); got != 50 {
+		t.Errorf("list width = %d, want half of 100", got)
+	}
+	if view := app.View(); !strings.Contains(view, "│ Fix login page") {
+		t.Errorf("view should show the preview beside the list:\n%s", view)
+	}
+
+	model, _ = app.Update(keyMsg("down"))
+	app = model.(App)
+	if app.preview == nil || app.preview.issue.Key != "PROJ-2" {
+		t.Fatalf("preview = %+v, want PROJ-2 after moving down", app.preview)
+	}
+
+	model, _ = app.Update(keyMsg("|"))
+	app = model.(App)
+	if app.split || app.preview != nil || app.listWidth() != 100 {
+		t.Errorf("split = %v, preview = %v, list width = %d after toggling off", app.split, app.preview, app.listWidth())
+	}
+}
+
+func TestSplitPreviewFetchesOnceCursorRests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/rest/api/3/issue/")
+		json.NewEncoder(w).Encode(jira.Issue{Key: key, Fields: jira.IssueFields{Summary: "Full " + key}})
+	}))
+	defer server.Close()
+
+	app := testAppReady()
+	app.client = jira.NewClient(server.URL, "test@test.com", "token")
+	app.SetSplitPane(true, 0)
+	app.prefetched = map[string]jira.Issue{"PROJ-2": {Key: "PROJ-2", Fields: jira.IssueFields{Summary: "Prefetched"}}}
+
+	model, _ := app.Update(keyMsg("k"))
+	app = model.(App)
+	if app.preview == nil || !app.preview.loading {
+		t.Fatalf("preview = %+v, want PROJ-1 from the row while fetching", app.preview)
+	}
+	stale := previewTickMsg{seq: app.previewSeq}
+
+	// Moving on to a prefetched issue shows it without a fetch
+	model, _ = app.Update(keyMsg("down"))
+	app = model.(App)
+	if app.preview.loading || app.preview.issue.Fields.Summary != "Prefetched" {
+		t.Fatalf("preview = %+v, want the prefetched PROJ-2", app.preview.issue)
+	}
+	if _, cmd := app.Update(stale); cmd != nil {
+		t.Error("a tick for an issue the cursor left should fetch nothing")
+	}
+
+	model, _ = app.Update(keyMsg("down"))
+	app = model.(App)
+	_, cmd := app.Update(previewTickMsg{seq: app.previewSeq})
+	if cmd == nil {
+		t.Fatal("expected a fetch once the cursor rested on PROJ-3")
+	}
+	model, _ = app.Update(cmd())
+	app = model.(App)
+	if app.preview.loading || app.preview.issue.Fields.Summary != "Full PROJ-3" {
+		t.Errorf("preview = %+v, want the fetched PROJ-3", app.preview.issue)
+	}
+	if _, ok := app.prefetched["PROJ-3"]; !ok {
+		t.Error("the fetched issue should be kept for enter")
+	}
+}
+
+func TestSplitResizeSavesListWidth(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("jira:\n  base_url: https://example.atlassian.net\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	app := testAppReady()
+	app.SetConfigPath(path)
+
+	if _, cmd := app.Update(tea.KeyMsg{Type: tea.KeyCtrlRight}); cmd != nil {
+		t.Error("ctrl+right without the preview should do nothing")
+	}
+	app.SetSplitPane(true, 75)
+	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyCtrlRight})
+	app = model.(App)
+	if app.splitWidth != 80 || cmd == nil {
+		t.Fatalf("list width = %d, want 80 and a save", app.splitWidth)
+	}
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyCtrlRight})
+	app = model.(App)
+	if app.splitWidth != config.MaxSplitListWidth {
+		t.Errorf("list width = %d, want it capped at %d", app.splitWidth, config.MaxSplitListWidth)
+	}
+
+	// Only the last resize saves
+	if _, save := app.Update(splitSaveTickMsg{seq: app.splitSaveSeq - 1}); save != nil {
+		t.Error("a resize followed by another shouldn't save")
+	}
+	_, save := app.Update(splitSaveTickMsg{seq: app.splitSaveSeq})
+	if save == nil {
+		t.Fatal("expected the last resize to save")
+	}
+	model, _ = app.Update(save())
+	app = model.(App)
+	if app.flashIsErr {
+		t.Fatalf("save failed: %s", app.flash)
+	}
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), "list_width: 80") {
+		t.Errorf("config = %s, want the list width saved", data)
+	}
+
+	model, _ = app.Update(tea.KeyMsg{Type: tea.KeyCtrlLeft})
+	app = model.(App)
+	if app.splitWidth != 75 || app.tabs[0].table.Width() != 75 {
+		t.Errorf("list width = %d, table width = %d after ctrl+left", app.splitWidth, app.tabs[0].table.Width())
+	}
+}
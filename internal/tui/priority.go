@@ -2,6 +2,7 @@ package tui
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -9,25 +10,45 @@ import (
 	"github.com/jbeckham/jira-tui/internal/jira"
 )
 
-// priorityDef holds the icon and color for a Jira priority level.
+// priorityDef holds the icon and color level for a Jira priority.
 type priorityDef struct {
 	icon  string
-	color lipgloss.Color
+	level string // key into priorityColors
+	color string // overrides the level's, from theme.priorities
+}
+
+// colorCode returns the priority's color, or "" for one with neither a
+// level nor a color of its own.
+func (d priorityDef) colorCode() string {
+	if d.color != "" {
+		return d.color
+	}
+	return priorityColors[d.level]
+}
+
+// priorityColors maps priority levels (config.PriorityLevels) to an ANSI
+// color number or "#RRGGBB", as the theme sets them.
+var priorityColors = map[string]string{
+	"highest":    "#FF5630",
+	"high":       "#FF7452",
+	"medium":     "#FFAB00",
+	"medium_low": "#6B778C",
+	"low":        "#2684FF",
 }
 
 // priorityMap maps priority names (case-sensitive, as returned by Jira) to their display definition.
 // Icons use universally-supported Unicode characters (arrows, math symbols)
 // that render correctly in all terminal fonts.
 var priorityMap = map[string]priorityDef{
-	"Blocked":     {icon: "⊘", color: lipgloss.Color("#FF5630")},
-	"Blocker":     {icon: "⊘", color: lipgloss.Color("#FF5630")},
-	"Critical":    {icon: "↑↑", color: lipgloss.Color("#FF5630")},
-	"Highest":     {icon: "↑↑", color: lipgloss.Color("#FF5630")},
-	"High":        {icon: "↑", color: lipgloss.Color("#FF7452")},
-	"Medium":      {icon: "≡", color: lipgloss.Color("#FFAB00")},
-	"Medium-Rare": {icon: "↓", color: lipgloss.Color("#6B778C")},
-	"Low":         {icon: "↓↓", color: lipgloss.Color("#2684FF")},
-	"Lowest":      {icon: "↓↓", color: lipgloss.Color("#2684FF")},
+	"Blocked":     {icon: "⊘", level: "highest"},
+	"Blocker":     {icon: "⊘", level: "highest"},
+	"Critical":    {icon: "↑↑", level: "highest"},
+	"Highest":     {icon: "↑↑", level: "highest"},
+	"High":        {icon: "↑", level: "high"},
+	"Medium":      {icon: "≡", level: "medium"},
+	"Medium-Rare": {icon: "↓", level: "medium_low"},
+	"Low":         {icon: "↓↓", level: "low"},
+	"Lowest":      {icon: "↓↓", level: "low"},
 }
 
 // priorityIcon returns a plain icon string for the given priority name.
@@ -50,7 +71,7 @@ func priorityIcon(name string) string {
 // Falls back to the raw name if unknown.
 func priorityLabel(name string) string {
 	if def, ok := priorityMap[name]; ok {
-		style := lipgloss.NewStyle().Foreground(def.color)
+		style := lipgloss.NewStyle().Foreground(lipgloss.Color(def.colorCode()))
 		return style.Render(def.icon) + " " + name
 	}
 	return name
@@ -72,16 +93,45 @@ func ansiColorIcon(icon, hex string) string {
 }
 
 // priorityReplacer post-processes rendered table output to colorize known
-// priority icons. Longer icons (↑↑, ↓↓) are listed first so the Replacer's
-// trie-based matching handles them before single-character subsets (↑, ↓).
-var priorityReplacer = strings.NewReplacer(
-	"⊘", ansiColorIcon("⊘", "#FF5630"),
-	"↑↑", ansiColorIcon("↑↑", "#FF5630"),
-	"↓↓", ansiColorIcon("↓↓", "#2684FF"),
-	"↑", ansiColorIcon("↑", "#FF7452"),
-	"≡", ansiColorIcon("≡", "#FFAB00"),
-	"↓", ansiColorIcon("↓", "#6B778C"),
-)
+// priority icons.
+var priorityReplacer = newPriorityReplacer()
+
+// newPriorityReplacer builds priorityReplacer from the theme's colors and
+// the icons of theme.priorities, which win over the built-in ones. Longer
+// icons (↑↑, ↓↓) are listed first so the Replacer's matching handles them
+// before single-character subsets (↑, ↓).
+func newPriorityReplacer() *strings.Replacer {
+	colors := map[string]string{
+		"⊘":  priorityColors["highest"],
+		"↑↑": priorityColors["highest"],
+		"↓↓": priorityColors["low"],
+		"↑":  priorityColors["high"],
+		"≡":  priorityColors["medium"],
+		"↓":  priorityColors["medium_low"],
+	}
+	for _, def := range priorityMap {
+		if def.color != "" {
+			colors[def.icon] = def.color
+		}
+	}
+	icons := make([]string, 0, len(colors))
+	for icon, color := range colors {
+		if icon != "" && color != "" {
+			icons = append(icons, icon)
+		}
+	}
+	sort.Slice(icons, func(i, j int) bool {
+		if len(icons[i]) != len(icons[j]) {
+			return len(icons[i]) > len(icons[j])
+		}
+		return icons[i] < icons[j]
+	})
+	pairs := make([]string, 0, len(icons)*2)
+	for _, icon := range icons {
+		pairs = append(pairs, icon, ansiColorText(icon, colors[icon]))
+	}
+	return strings.NewReplacer(pairs...)
+}
 
 // colorizePriorities applies ANSI foreground colors to known priority icons
 // in a rendered table string. This works around the bubbles table's use of
@@ -91,50 +141,73 @@ func colorizePriorities(s string) string {
 	return priorityReplacer.Replace(s)
 }
 
-// statusCategoryColor maps Jira status category keys to ANSI color codes,
-// matching the detail view's statusColor function.
+// statusCategoryColor maps Jira status category keys to ANSI color codes
+// or "#RRGGBB", as the theme sets them, matching the detail view's
+// statusColor function. "other" is for statuses without a known category.
 var statusCategoryColor = map[string]string{
 	"new":           "12",  // blue
 	"indeterminate": "11",  // yellow
 	"done":          "10",  // green
+	"other":         "252", // light gray
 }
 
-// ansiColorText wraps text in ANSI foreground color using a 256-color code.
+// ansiColorText wraps text in ANSI foreground color using a 256-color code,
+// or a "#RRGGBB" color with ansiColorIcon.
 // Uses SGR 38;5 to set color and SGR 39 to reset only the foreground.
 func ansiColorText(text, colorCode string) string {
+	if strings.HasPrefix(colorCode, "#") {
+		return ansiColorIcon(text, colorCode)
+	}
 	return fmt.Sprintf("\x1b[38;5;%sm%s\x1b[39m", colorCode, text)
 }
 
 // statusNameColor overrides color for specific status names,
-// taking precedence over the category-based color.
+// taking precedence over the category-based color. theme.statuses adds
+// to it.
 var statusNameColor = map[string]string{
 	"Backlog": "240", // dark gray
 	"Triage":  "248", // light gray
 }
 
+// statusIcons maps status names to the icon theme.statuses shows before
+// them.
+var statusIcons = map[string]string{}
+
+// statusLabel returns a status name with its icon, if it has one.
+func statusLabel(name string) string {
+	if icon := statusIcons[name]; icon != "" {
+		return icon + " " + name
+	}
+	return name
+}
+
+// statusColorCode returns the color of a status: its name's own, else its
+// category's.
+func statusColorCode(s *jira.Status) string {
+	if code, ok := statusNameColor[s.Name]; ok {
+		return code
+	}
+	catKey := ""
+	if s.StatusCategory != nil {
+		catKey = s.StatusCategory.Key
+	}
+	if code, ok := statusCategoryColor[catKey]; ok {
+		return code
+	}
+	return statusCategoryColor["other"]
+}
+
 // buildStatusReplacer scans issues for unique status names and their category
-// keys, returning a Replacer that colorizes those names in rendered output.
+// keys, returning a Replacer that colorizes those names, with their icons,
+// in rendered output.
 func buildStatusReplacer(issues []jira.Issue) *strings.Replacer {
-	seen := make(map[string]string) // status name → color code
+	seen := make(map[string]string) // status label → color code
 	for _, issue := range issues {
 		s := issue.Fields.Status
-		if s == nil || seen[s.Name] != "" {
+		if s == nil || seen[statusLabel(s.Name)] != "" {
 			continue
 		}
-		// Check name-level overrides first.
-		if code, ok := statusNameColor[s.Name]; ok {
-			seen[s.Name] = code
-			continue
-		}
-		catKey := ""
-		if s.StatusCategory != nil {
-			catKey = s.StatusCategory.Key
-		}
-		if code, ok := statusCategoryColor[catKey]; ok {
-			seen[s.Name] = code
-		} else {
-			seen[s.Name] = "252" // light gray default
-		}
+		seen[statusLabel(s.Name)] = statusColorCode(s)
 	}
 	if len(seen) == 0 {
 		return nil
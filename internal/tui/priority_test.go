@@ -68,8 +68,8 @@ func TestPriorityMapCoversAllEntries(t *testing.T) {
 		if def.icon == "" {
 			t.Errorf("priorityMap[%q] has empty icon", name)
 		}
-		if def.color == "" {
-			t.Errorf("priorityMap[%q] has empty color", name)
+		if priorityColors[def.level] == "" {
+			t.Errorf("priorityMap[%q] has no color for level %q", name, def.level)
 		}
 	}
 }
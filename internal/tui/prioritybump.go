@@ -0,0 +1,106 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// priorityBumpedMsg reports the outcome of a +/- priority change.
+type priorityBumpedMsg struct {
+	issueKey string
+	previous *jira.Named // the priority before the change, restored on failure
+	priority string
+	err      error
+}
+
+// bumpPriority moves an issue's priority one level along the instance's
+// priority list, highest first: delta -1 raises it, +1 lowers it. The
+// list is fetched on first use. The change shows immediately and is
+// undone if Jira rejects it.
+func (a App) bumpPriority(issueKey string, delta int) (tea.Model, tea.Cmd) {
+	issue := a.findIssue(issueKey)
+	if issue == nil {
+		return a, nil
+	}
+	if len(a.cachedPriorities) == 0 {
+		a.flash = "Loading priorities..."
+		a.flashIsErr = false
+		fetch := a.cmdFetchPriorities(issueKey)
+		return a, func() tea.Msg {
+			msg := fetch().(prioritiesLoadedMsg)
+			msg.bump = delta
+			return msg
+		}
+	}
+
+	current := -1
+	if issue.Fields.Priority != nil {
+		for i, p := range a.cachedPriorities {
+			if p.ID == issue.Fields.Priority.ID || p.Name == issue.Fields.Priority.Name {
+				current = i
+				break
+			}
+		}
+	}
+	if current < 0 {
+		a.flash = issueKey + " has no known priority; press p to set one"
+		a.flashIsErr = true
+		return a, nil
+	}
+	next := current + delta
+	if next < 0 || next >= len(a.cachedPriorities) {
+		a.flash = fmt.Sprintf("%s is already at %s", issueKey, a.cachedPriorities[current].Name)
+		a.flashIsErr = false
+		return a, nil
+	}
+
+	target := a.cachedPriorities[next]
+	return a.confirmEdit(fmt.Sprintf("Set priority of %s to %s?", issueKey, target.Name), func(a App) (tea.Model, tea.Cmd) {
+		return a.applyPriorityBump(issueKey, target)
+	})
+}
+
+// applyPriorityBump shows target as issueKey's priority and sends it.
+func (a App) applyPriorityBump(issueKey string, target jira.Priority) (tea.Model, tea.Cmd) {
+	issue := a.findIssue(issueKey)
+	if issue == nil {
+		return a, nil
+	}
+	previous := issue.Fields.Priority
+	updated := *issue
+	updated.Fields.Priority = &jira.Named{ID: target.ID, Name: target.Name}
+	a.replaceIssue(issueKey, &updated)
+	a.flash = fmt.Sprintf("%s priority → %s", issueKey, target.Name)
+	a.flashIsErr = false
+
+	client := a.client
+	cmd := a.trackWrite(writeUpdate, issueKey, a.startNetwork(func() tea.Msg {
+		err := client.UpdateIssue(context.Background(), issueKey, map[string]interface{}{
+			"priority": map[string]interface{}{"id": target.ID},
+		})
+		return priorityBumpedMsg{issueKey: issueKey, previous: previous, priority: target.Name, err: err}
+	}))
+	return a, cmd
+}
+
+// handlePriorityBumped restores the previous priority when the change
+// failed; success needs no further update.
+func (a App) handlePriorityBumped(msg priorityBumpedMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	a.finishWrite(writeUpdate, msg.issueKey)
+	if msg.err == nil {
+		return a, nil
+	}
+	if issue := a.findIssue(msg.issueKey); issue != nil {
+		restored := *issue
+		restored.Fields.Priority = msg.previous
+		a.replaceIssue(msg.issueKey, &restored)
+	}
+	a.flash = fmt.Sprintf("Setting %s priority to %s failed: %v", msg.issueKey, msg.priority, msg.err)
+	a.flashIsErr = true
+	return a, nil
+}
@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// testAppWithPriorities returns a connected app whose first issue is Medium.
+func testAppWithPriorities() App {
+	app := testAppConnected()
+	app.cachedPriorities = []jira.Priority{
+		{ID: "1", Name: "Highest"}, {ID: "2", Name: "High"}, {ID: "3", Name: "Medium"},
+		{ID: "4", Name: "Low"},
+	}
+	app.tabs[0].issues[0].Fields.Priority = &jira.Named{ID: "3", Name: "Medium"}
+	return app
+}
+
+func TestPriorityBumpUpAndDown(t *testing.T) {
+	tests := []struct {
+		key, want string
+	}{
+		{"+", "High"},
+		{"-", "Low"},
+	}
+	for _, tt := range tests {
+		app := testAppWithPriorities()
+		pending := len(app.pending)
+		model, cmd := app.Update(keyMsg(tt.key))
+		app = model.(App)
+		if cmd == nil {
+			t.Fatalf("%s: expected an update command", tt.key)
+		}
+		if got := app.findIssue("PROJ-1").Fields.Priority.Name; got != tt.want {
+			t.Errorf("%s: priority = %s, want %s shown immediately", tt.key, got, tt.want)
+		}
+		if len(app.pending) != pending+1 {
+			t.Errorf("%s: the change should be tracked as a pending write", tt.key)
+		}
+	}
+}
+
+func TestPriorityBumpStopsAtEnds(t *testing.T) {
+	app := testAppWithPriorities()
+	app.tabs[0].issues[0].Fields.Priority = &jira.Named{ID: "1", Name: "Highest"}
+	model, cmd := app.Update(keyMsg("+"))
+	app = model.(App)
+	if cmd != nil || !strings.Contains(app.flash, "already at Highest") {
+		t.Errorf("flash = %q, cmd = %v; want no change at the top", app.flash, cmd)
+	}
+}
+
+func TestPriorityBumpFetchesPrioritiesFirst(t *testing.T) {
+	app := testAppWithPriorities()
+	priorities := app.cachedPriorities
+	app.cachedPriorities = nil
+	model, cmd := app.Update(keyMsg("-"))
+	app = model.(App)
+	if cmd == nil || app.overlay != nil {
+		t.Fatal("expected a priority fetch and no overlay")
+	}
+
+	model, _ = app.Update(prioritiesLoadedMsg{issues: "PROJ-1", bump: 1, priorities: priorities})
+	app = model.(App)
+	if app.overlay != nil {
+		t.Error("a bump should not open the priority overlay")
+	}
+	if got := app.findIssue("PROJ-1").Fields.Priority.Name; got != "Low" {
+		t.Errorf("priority = %s, want Low", got)
+	}
+}
+
+func TestPriorityBumpRevertsOnFailure(t *testing.T) {
+	app := testAppWithPriorities()
+	model, _ := app.Update(keyMsg("+"))
+	app = model.(App)
+
+	model, _ = app.Update(priorityBumpedMsg{
+		issueKey: "PROJ-1", previous: &jira.Named{ID: "3", Name: "Medium"}, priority: "High",
+		err: errors.New("forbidden"),
+	})
+	app = model.(App)
+	if got := app.findIssue("PROJ-1").Fields.Priority.Name; got != "Medium" {
+		t.Errorf("priority = %s, want Medium restored", got)
+	}
+	if !app.flashIsErr || len(app.pending) != 0 {
+		t.Errorf("flash = %q, pending = %v", app.flash, app.pending)
+	}
+}
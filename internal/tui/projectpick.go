@@ -0,0 +1,97 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// projectsLoadedMsg delivers the projects the create hotkey can create in.
+type projectsLoadedMsg struct {
+	projects []jira.Project
+	err      error
+}
+
+// startProjectPick asks which project to create an issue in, fetching
+// the project list on first use.
+func (a App) startProjectPick() (tea.Model, tea.Cmd) {
+	a.overlayAction = overlayActionCreateProject
+	if len(a.cachedProjects) > 0 {
+		return a.openProjectPick()
+	}
+	a.flash = "Loading projects..."
+	a.flashIsErr = false
+	client := a.client
+	return a, a.startNetwork(func() tea.Msg {
+		projects, err := client.SearchProjects(context.Background())
+		if err != nil {
+			return projectsLoadedMsg{err: fmt.Errorf("list projects: %w", err)}
+		}
+		return projectsLoadedMsg{projects: projects}
+	})
+}
+
+// handleProjectsLoaded opens the project picker. When the projects can't
+// be listed, the create goes to the default project as it used to.
+func (a App) handleProjectsLoaded(msg projectsLoadedMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	if a.overlayAction != overlayActionCreateProject || a.overlay != nil {
+		return a, nil
+	}
+	if msg.err == nil && len(msg.projects) > 0 {
+		a.cachedProjects = msg.projects
+		a.flash = ""
+		return a.openProjectPick()
+	}
+	a.overlayAction = overlayActionNone
+	if a.defaultProject == "" {
+		a.flash = "Set default_project in config to create issues"
+		if msg.err != nil {
+			a.flash = msg.err.Error()
+		}
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.flash = ""
+	return a.startCreate("New " + a.defaultProject + " Issue")
+}
+
+// openProjectPick lists the cached projects, the one last created in
+// this session and the default project first.
+func (a App) openProjectPick() (tea.Model, tea.Cmd) {
+	a.overlay = newSelectionOverlay("Create In Project", projectItems(a.cachedProjects, a.lastProject, a.defaultProject))
+	a.overlayAction = overlayActionCreateProject
+	return a, nil
+}
+
+// projectItems lists projects as picker items, those in first leading in
+// the order given.
+func projectItems(projects []jira.Project, first ...string) []selectionItem {
+	var items []selectionItem
+	seen := make(map[string]bool)
+	for _, key := range first {
+		for _, p := range projects {
+			if p.Key == key && !seen[key] {
+				seen[key] = true
+				items = append(items, selectionItem{ID: p.Key, Label: p.Key, Desc: p.Name})
+			}
+		}
+	}
+	for _, p := range projects {
+		if !seen[p.Key] {
+			items = append(items, selectionItem{ID: p.Key, Label: p.Key, Desc: p.Name})
+		}
+	}
+	return items
+}
+
+// handleProjectPick opens the create form in the picked project and
+// remembers it for the next create.
+func (a App) handleProjectPick(item *selectionItem) (tea.Model, tea.Cmd) {
+	a.createIn = item.ID
+	a.lastProject = item.ID
+	return a.startCreate("New " + item.ID + " Issue")
+}
@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+var pickProjects = []jira.Project{{Key: "APP", Name: "App"}, {Key: "OPS", Name: "Ops"}, {Key: "PROJ", Name: "Project"}}
+
+func TestProjectItems(t *testing.T) {
+	items := projectItems(pickProjects, "OPS", "PROJ", "OPS")
+	var got []string
+	for _, item := range items {
+		got = append(got, item.ID)
+	}
+	if len(got) != 3 || got[0] != "OPS" || got[1] != "PROJ" || got[2] != "APP" {
+		t.Errorf("order = %v, want last used, default, then the rest", got)
+	}
+}
+
+func TestCreateInPickedProject(t *testing.T) {
+	app := testAppConnected()
+	app.defaultProject = "PROJ"
+	model, cmd := app.Update(keyMsg("c"))
+	app = model.(App)
+	if cmd == nil || app.overlay != nil {
+		t.Fatal("c should load the projects first")
+	}
+	model, _ = app.Update(projectsLoadedMsg{projects: pickProjects})
+	app = model.(App)
+	sel, ok := app.overlay.(*selectionOverlay)
+	if !ok || sel.items[0].ID != "PROJ" {
+		t.Fatalf("expected the picker with the default project first, got %T", app.overlay)
+	}
+
+	model, _ = app.Update(keyMsg("down"))
+	app = model.(App)
+	model, _ = app.Update(keyMsg("enter"))
+	app = model.(App)
+	form, ok := app.overlay.(*createFormOverlay)
+	if !ok || form.title != "New APP Issue" || app.createProject() != "APP" {
+		t.Fatalf("expected the form for APP, got %T in %s", app.overlay, app.createProject())
+	}
+
+	// The next create offers APP first, without fetching again
+	model, _ = app.Update(keyMsg("esc"))
+	app = model.(App)
+	model, cmd = app.Update(keyMsg("c"))
+	app = model.(App)
+	sel, ok = app.overlay.(*selectionOverlay)
+	if cmd != nil || !ok || sel.items[0].ID != "APP" {
+		t.Errorf("expected the cached projects with APP first, got %T", app.overlay)
+	}
+	if app.createProject() != "PROJ" {
+		t.Errorf("create project = %s, want the default until one is picked", app.createProject())
+	}
+}
+
+func TestProjectListFailureUsesDefault(t *testing.T) {
+	app := testAppConnected()
+	app.defaultProject = "PROJ"
+	model, _ := app.Update(keyMsg("c"))
+	app = model.(App)
+	model, _ = app.Update(projectsLoadedMsg{err: errors.New("forbidden")})
+	app = model.(App)
+	if form, ok := app.overlay.(*createFormOverlay); !ok || form.title != "New PROJ Issue" {
+		t.Errorf("expected the form for the default project, got %T", app.overlay)
+	}
+}
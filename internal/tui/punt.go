@@ -0,0 +1,115 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// puntOp is an issue waiting for confirmation to move to the next sprint.
+type puntOp struct {
+	issueKey string
+	to       jira.Sprint
+}
+
+// puntTargetMsg delivers the sprint an issue is in and the next planned
+// sprint of its board. Either is nil when there is none.
+type puntTargetMsg struct {
+	issueKey string
+	from     *jira.Sprint
+	to       *jira.Sprint
+	err      error
+}
+
+// issuePuntedMsg reports the outcome of moving an issue to the next sprint.
+type issuePuntedMsg struct {
+	issueKey string
+	sprint   string
+	err      error
+}
+
+// startPunt looks up the sprint after the one an issue is in, on the board
+// that sprint belongs to, to confirm moving the issue there.
+func (a *App) startPunt(issueKey string) tea.Cmd {
+	a.flash = "Finding the next sprint for " + issueKey + "..."
+	a.flashIsErr = false
+	client := a.client
+	return a.startNetwork(func() tea.Msg {
+		ctx := context.Background()
+		from, err := client.GetIssueSprint(ctx, issueKey)
+		if err != nil || from == nil {
+			return puntTargetMsg{issueKey: issueKey, err: err}
+		}
+		future, err := client.GetBoardSprints(ctx, from.OriginBoardID, "future")
+		if err != nil {
+			return puntTargetMsg{issueKey: issueKey, from: from, err: err}
+		}
+		msg := puntTargetMsg{issueKey: issueKey, from: from}
+		if len(future) > 0 {
+			msg.to = &future[0]
+		}
+		return msg
+	})
+}
+
+// handlePuntTarget asks to confirm the move to the next sprint.
+func (a App) handlePuntTarget(msg puntTargetMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	switch {
+	case msg.err != nil:
+		a.flash = "Finding the next sprint failed: " + msg.err.Error()
+		a.flashIsErr = true
+		return a, nil
+	case msg.from == nil:
+		a.flash = msg.issueKey + " is not in a sprint"
+		a.flashIsErr = false
+		return a, nil
+	case msg.to == nil:
+		a.flash = "No sprint is planned after " + msg.from.Name
+		a.flashIsErr = false
+		return a, nil
+	}
+	a.flash = ""
+	a.punt = &puntOp{issueKey: msg.issueKey, to: *msg.to}
+	a.overlay = newConfirmOverlay(fmt.Sprintf("Move %s from %s to %s?", msg.issueKey, msg.from.Name, msg.to.Name))
+	a.overlayIssue = msg.issueKey
+	a.overlayAction = overlayActionPunt
+	return a, nil
+}
+
+// confirmPunt moves the confirmed issue to the next sprint.
+func (a App) confirmPunt() (tea.Model, tea.Cmd) {
+	op := a.punt
+	a.punt = nil
+	if op == nil {
+		return a, nil
+	}
+	a.flash = "Moving " + op.issueKey + " to " + op.to.Name + "..."
+	a.flashIsErr = false
+	client := a.client
+	return a, a.trackWrite(writeUpdate, op.issueKey, a.startNetwork(func() tea.Msg {
+		err := client.MoveIssuesToSprint(context.Background(), op.to.ID, []string{op.issueKey})
+		return issuePuntedMsg{issueKey: op.issueKey, sprint: op.to.Name, err: err}
+	}))
+}
+
+// handleIssuePunted reports the move and reloads the tab, which may no
+// longer list the issue.
+func (a App) handleIssuePunted(msg issuePuntedMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	a.finishWrite(writeUpdate, msg.issueKey)
+	if msg.err != nil {
+		a.flash = fmt.Sprintf("Moving %s to %s failed: %v", msg.issueKey, msg.sprint, msg.err)
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.flash = "Moved " + msg.issueKey + " to " + msg.sprint
+	a.flashIsErr = false
+	if a.connected && a.activeTab < len(a.tabs) {
+		return a, a.startNetwork(a.loadTab(a.activeTab))
+	}
+	return a, nil
+}
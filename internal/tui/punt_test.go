@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestPuntConfirmsTargetSprint(t *testing.T) {
+	app := testAppConnected()
+	model, cmd := app.Update(keyMsg("N"))
+	app = model.(App)
+	if cmd == nil || app.flash != "Finding the next sprint for PROJ-1..." {
+		t.Fatalf("N should look up the next sprint, flash = %q", app.flash)
+	}
+
+	model, _ = app.Update(puntTargetMsg{
+		issueKey: "PROJ-1",
+		from:     &jira.Sprint{ID: 7, Name: "Sprint 7", OriginBoardID: 3},
+		to:       &jira.Sprint{ID: 8, Name: "Sprint 8"},
+	})
+	app = model.(App)
+	confirm, ok := app.overlay.(*confirmOverlay)
+	if !ok || app.overlayAction != overlayActionPunt {
+		t.Fatalf("overlay = %T, want a confirmation", app.overlay)
+	}
+	if want := "Move PROJ-1 from Sprint 7 to Sprint 8?"; confirm.message != want {
+		t.Errorf("message = %q, want %q", confirm.message, want)
+	}
+
+	model, cmd = app.handleOverlayResult(true)
+	app = model.(App)
+	if cmd == nil || app.punt != nil || app.flash != "Moving PROJ-1 to Sprint 8..." {
+		t.Fatalf("confirming should move the issue, flash = %q", app.flash)
+	}
+	if len(app.pending) != 1 {
+		t.Errorf("pending = %d, want the move tracked", len(app.pending))
+	}
+
+	model, _ = app.Update(issuePuntedMsg{issueKey: "PROJ-1", sprint: "Sprint 8"})
+	app = model.(App)
+	if app.flash != "Moved PROJ-1 to Sprint 8" || len(app.pending) != 0 {
+		t.Errorf("flash = %q, pending = %d", app.flash, len(app.pending))
+	}
+}
+
+func TestPuntCancel(t *testing.T) {
+	app := testAppConnected()
+	model, _ := app.Update(puntTargetMsg{
+		issueKey: "PROJ-1",
+		from:     &jira.Sprint{ID: 7, Name: "Sprint 7"},
+		to:       &jira.Sprint{ID: 8, Name: "Sprint 8"},
+	})
+	app = model.(App)
+	model, cmd := app.handleOverlayResult(nil)
+	app = model.(App)
+	if cmd != nil || app.punt != nil {
+		t.Error("cancelling should move nothing")
+	}
+}
+
+func TestPuntWithoutTarget(t *testing.T) {
+	tests := []struct {
+		msg  puntTargetMsg
+		want string
+	}{
+		{puntTargetMsg{issueKey: "PROJ-1"}, "PROJ-1 is not in a sprint"},
+		{puntTargetMsg{issueKey: "PROJ-1", from: &jira.Sprint{Name: "Sprint 9"}}, "No sprint is planned after Sprint 9"},
+		{puntTargetMsg{issueKey: "PROJ-1", err: errors.New("boom")}, "Finding the next sprint failed: boom"},
+	}
+	for _, tt := range tests {
+		model, _ := testAppConnected().Update(tt.msg)
+		app := model.(App)
+		if app.flash != tt.want || app.overlay != nil {
+			t.Errorf("flash = %q, want %q", app.flash, tt.want)
+		}
+	}
+}
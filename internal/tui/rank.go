@@ -0,0 +1,191 @@
+package tui
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+var (
+	orderByPattern   = regexp.MustCompile(`(?i)\border\s+by\b`)
+	sprintPattern    = regexp.MustCompile(`(?i)\bsprint\b`)
+	rankOrderPattern = regexp.MustCompile(`(?i)\border\s+by\s+rank(\s+asc)?\s*$`)
+)
+
+// issueRankedMsg reports the outcome of moving an issue up or down in rank.
+type issueRankedMsg struct {
+	tabIndex int
+	issueKey string
+	neighbor string // the issue it was moved past
+	down     bool
+	err      error
+}
+
+// rankedJQL makes sprint-backed queries without their own ORDER BY sort by
+// Rank, so the list matches the board. Other queries are returned as is.
+func rankedJQL(jql string) string {
+	if !sprintPattern.MatchString(jql) || orderByPattern.MatchString(jql) {
+		return jql
+	}
+	return strings.TrimSpace(jql) + " ORDER BY Rank ASC"
+}
+
+// hasColumn reports whether columns includes name.
+func hasColumn(columns []string, name string) bool {
+	for _, c := range columns {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// rankPositions numbers issues 1..n in board order. LexoRank values sort
+// lexically; issues without a rank are left out.
+func rankPositions(issues []jira.Issue, rankField string) map[string]int {
+	type ranked struct {
+		key, rank string
+	}
+	var list []ranked
+	for _, issue := range issues {
+		if r := issue.Fields.CustomString(rankField); r != "" {
+			list = append(list, ranked{issue.Key, r})
+		}
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].rank < list[j].rank })
+	pos := make(map[string]int, len(list))
+	for i, r := range list {
+		pos[r.key] = i + 1
+	}
+	return pos
+}
+
+// rankBlocker returns why the tab's rows can't be reordered by rank, or ""
+// if they can: the list must show the board's order as is.
+func (t *tab) rankBlocker() string {
+	switch {
+	case !rankOrderPattern.MatchString(t.search.JQL):
+		return "Reordering needs a tab ordered by Rank"
+	case t.sortCol != 0:
+		return "Turn off the column sort to reorder"
+	case t.tree || t.groupBy != "":
+		return "Reordering needs an ungrouped list"
+	}
+	return ""
+}
+
+// rankNeighbor returns the issue in the row below the cursor, or above it
+// when up, or nil at the edge of the list.
+func (t *tab) rankNeighbor(down bool) *jira.Issue {
+	idx := t.table.Cursor() - 1
+	if down {
+		idx += 2
+	}
+	if idx < 0 || idx >= len(t.entries) {
+		return nil
+	}
+	return t.entries[idx].issue
+}
+
+// moveRanked moves issue key just before neighbor in the list, or just
+// after it, keeping the cursor on the moved issue.
+func (t *tab) moveRanked(key, neighbor string, after bool) {
+	var moved jira.Issue
+	rest := make([]jira.Issue, 0, len(t.issues))
+	for _, issue := range t.issues {
+		if issue.Key == key {
+			moved = issue
+		} else {
+			rest = append(rest, issue)
+		}
+	}
+	for i, issue := range rest {
+		if issue.Key == neighbor {
+			if after {
+				i++
+			}
+			rest = slices.Insert(rest, i, moved)
+			break
+		}
+	}
+	t.issues = rest
+	if a, ok := t.rankPos[key]; ok {
+		if b, ok := t.rankPos[neighbor]; ok {
+			t.rankPos[key], t.rankPos[neighbor] = b, a
+		}
+	}
+	t.setQueryOrder(t.issues)
+	t.applyFilterKeepCursor(key)
+}
+
+// rankIssue moves the cursor's issue one row down, or up, in rank, in the
+// list at once and then on the board.
+func (a App) rankIssue(down bool) (tea.Model, tea.Cmd) {
+	if a.client == nil {
+		a.flash = "Not connected to Jira"
+		a.flashIsErr = true
+		return a, nil
+	}
+	if a.activeTab >= len(a.tabs) {
+		return a, nil
+	}
+	t := &a.tabs[a.activeTab]
+	issue := t.selectedIssue()
+	if issue == nil {
+		return a, nil
+	}
+	if reason := t.rankBlocker(); reason != "" {
+		a.flash = reason
+		a.flashIsErr = true
+		return a, nil
+	}
+	neighbor := t.rankNeighbor(down)
+	if neighbor == nil {
+		return a, nil
+	}
+	key, other := issue.Key, neighbor.Key
+	t.moveRanked(key, other, down)
+	a.flash = "Moving " + rankMove(key, other, down) + "..."
+	a.flashIsErr = false
+	client := a.client
+	index := a.activeTab
+	return a, a.trackWrite(writeUpdate, key, a.startNetwork(func() tea.Msg {
+		err := client.RankIssues(context.Background(), []string{key}, other, down)
+		return issueRankedMsg{tabIndex: index, issueKey: key, neighbor: other, down: down, err: err}
+	}))
+}
+
+// rankMove describes a move in rank, e.g. "PROJ-2 above PROJ-1".
+func rankMove(key, neighbor string, down bool) string {
+	if down {
+		return key + " below " + neighbor
+	}
+	return key + " above " + neighbor
+}
+
+// handleIssueRanked reports the move. When it failed the tab is reloaded,
+// putting the issue back where the board has it.
+func (a App) handleIssueRanked(msg issueRankedMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	a.finishWrite(writeUpdate, msg.issueKey)
+	if msg.err == nil {
+		a.flash = "Moved " + rankMove(msg.issueKey, msg.neighbor, msg.down)
+		a.flashIsErr = false
+		return a, nil
+	}
+	a.flash = "Reordering " + msg.issueKey + " failed: " + msg.err.Error()
+	a.flashIsErr = true
+	if a.connected && msg.tabIndex < len(a.tabs) {
+		return a, a.startNetwork(a.loadTab(msg.tabIndex))
+	}
+	return a, nil
+}
@@ -0,0 +1,167 @@
+package tui
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestRankedJQL(t *testing.T) {
+	tests := []struct {
+		jql  string
+		want string
+	}{
+		{"sprint in openSprints()", "sprint in openSprints() ORDER BY Rank ASC"},
+		{"project = PROJ AND Sprint = 42 ", "project = PROJ AND Sprint = 42 ORDER BY Rank ASC"},
+		{"sprint in openSprints() ORDER BY priority DESC", "sprint in openSprints() ORDER BY priority DESC"},
+		{"sprint in openSprints() order  by created", "sprint in openSprints() order  by created"},
+		{"project = PROJ", "project = PROJ"},
+		{"labels = sprinter", "labels = sprinter"},
+	}
+	for _, tt := range tests {
+		if got := rankedJQL(tt.jql); got != tt.want {
+			t.Errorf("rankedJQL(%q) = %q, want %q", tt.jql, got, tt.want)
+		}
+	}
+}
+
+func rankedIssue(key, rank string) jira.Issue {
+	issue := jira.Issue{Key: key, Fields: jira.IssueFields{Summary: key}}
+	if rank != "" {
+		raw, _ := json.Marshal(rank)
+		issue.Fields.Custom = map[string]json.RawMessage{"customfield_10019": raw}
+	}
+	return issue
+}
+
+func TestRankPositions(t *testing.T) {
+	issues := []jira.Issue{
+		rankedIssue("A-1", "0|i0003:"),
+		rankedIssue("A-2", "0|i0001:"),
+		rankedIssue("A-3", ""),
+		rankedIssue("A-4", "0|i0002:"),
+	}
+	pos := rankPositions(issues, "customfield_10019")
+	want := map[string]int{"A-2": 1, "A-4": 2, "A-1": 3}
+	if len(pos) != len(want) {
+		t.Fatalf("positions = %v, want %v", pos, want)
+	}
+	for key, p := range want {
+		if pos[key] != p {
+			t.Errorf("pos[%s] = %d, want %d", key, pos[key], p)
+		}
+	}
+	if rankPositions(issues, "") != nil {
+		t.Error("expected no positions without a rank field")
+	}
+}
+
+func TestTabRankColumn(t *testing.T) {
+	tb := newTab(config.TabConfig{Label: "Sprint", Columns: []string{"rank", "key"}})
+	tb.setSize(80, 10)
+	tb.rankField = "customfield_10019"
+	tb.setIssues([]jira.Issue{
+		rankedIssue("A-1", "0|i0002:"),
+		rankedIssue("A-2", "0|i0001:"),
+		rankedIssue("A-3", ""),
+	})
+	rows := tb.rows(tb.issues)
+	for i, want := range []string{"2", "1", ""} {
+		if rows[i][0] != want {
+			t.Errorf("row %d rank = %q, want %q", i, rows[i][0], want)
+		}
+	}
+}
+
+func TestMergeSearchFieldsSkipsRank(t *testing.T) {
+	for _, f := range mergeSearchFields([]string{"rank", "summary"}) {
+		if f == "rank" {
+			t.Error("rank is a pseudo-column and must not be requested by name")
+		}
+	}
+}
+
+// testAppRanked is connected with a tab ordered by Rank, the cursor on
+// PROJ-2.
+func testAppRanked() App {
+	app := testAppConnected()
+	app.tabs[0].search = jira.SearchOptions{JQL: "sprint in openSprints() ORDER BY Rank ASC"}
+	app.tabs[0].table.SetCursor(1)
+	return app
+}
+
+// tabKeys lists the tab's issue keys in row order.
+func tabKeys(t *tab) string {
+	keys := make([]string, len(t.entries))
+	for i, e := range t.entries {
+		keys[i] = e.issue.Key
+	}
+	return strings.Join(keys, ",")
+}
+
+func TestRankIssueUpAndDown(t *testing.T) {
+	app := testAppRanked()
+	model, cmd := app.Update(keyMsg("K"))
+	app = model.(App)
+	if cmd == nil || app.flash != "Moving PROJ-2 above PROJ-1..." {
+		t.Fatalf("K should rank the issue up, flash = %q", app.flash)
+	}
+	if got := tabKeys(&app.tabs[0]); got != "PROJ-2,PROJ-1,PROJ-3" {
+		t.Errorf("rows = %s, want PROJ-2 moved up at once", got)
+	}
+	if sel := app.tabs[0].selectedIssue(); sel == nil || sel.Key != "PROJ-2" {
+		t.Errorf("cursor = %v, want it to follow PROJ-2", sel)
+	}
+	model, _ = app.Update(issueRankedMsg{tabIndex: 0, issueKey: "PROJ-2", neighbor: "PROJ-1"})
+	app = model.(App)
+	if app.flash != "Moved PROJ-2 above PROJ-1" || len(app.pending) != 0 {
+		t.Errorf("flash = %q, pending = %d", app.flash, len(app.pending))
+	}
+
+	// Already at the top: nothing to do
+	model, cmd = app.Update(keyMsg("K"))
+	if app = model.(App); cmd != nil {
+		t.Error("K on the first row should do nothing")
+	}
+
+	model, _ = app.Update(keyMsg("J"))
+	app = model.(App)
+	model, _ = app.Update(keyMsg("J"))
+	app = model.(App)
+	if got := tabKeys(&app.tabs[0]); got != "PROJ-1,PROJ-3,PROJ-2" {
+		t.Errorf("rows = %s, want PROJ-2 moved to the bottom", got)
+	}
+}
+
+func TestRankIssueNeedsRankOrder(t *testing.T) {
+	app := testAppConnected()
+	app.tabs[0].search = jira.SearchOptions{JQL: "project = PROJ ORDER BY created DESC"}
+	model, cmd := app.Update(keyMsg("J"))
+	app = model.(App)
+	if cmd != nil || app.flash != "Reordering needs a tab ordered by Rank" {
+		t.Errorf("flash = %q, want ranking refused", app.flash)
+	}
+
+	app = testAppRanked()
+	app.tabs[0].cycleSort(1)
+	model, _ = app.Update(keyMsg("J"))
+	if app = model.(App); app.flash != "Turn off the column sort to reorder" {
+		t.Errorf("flash = %q, want ranking refused while sorted", app.flash)
+	}
+}
+
+func TestRankIssueFailureReloads(t *testing.T) {
+	app := testAppRanked()
+	app.connected = true
+	model, _ := app.Update(keyMsg("J"))
+	app = model.(App)
+	model, cmd := app.Update(issueRankedMsg{tabIndex: 0, issueKey: "PROJ-2", neighbor: "PROJ-3", down: true, err: errors.New("boom")})
+	app = model.(App)
+	if cmd == nil || !app.flashIsErr || app.flash != "Reordering PROJ-2 failed: boom" {
+		t.Errorf("flash = %q, want the failure and a reload", app.flash)
+	}
+}
@@ -0,0 +1,266 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// releaseOp tracks a version release through picking the version, where
+// its unresolved issues go, and the confirmation.
+type releaseOp struct {
+	project    string
+	versions   []jira.Version // the project's unreleased versions
+	version    jira.Version   // the version being released
+	unresolved int            // its unresolved issues
+	moveTo     *jira.Version  // where they move, nil to leave them
+}
+
+// releaseVersionsMsg delivers a project's versions for the release picker.
+type releaseVersionsMsg struct {
+	versions []jira.Version
+	err      error
+}
+
+// releaseCountMsg delivers how many unresolved issues the picked version
+// has.
+type releaseCountMsg struct {
+	count int
+	err   error
+}
+
+// versionReleasedMsg reports the outcome of a release.
+type versionReleasedMsg struct {
+	err error
+}
+
+// startRelease lists the unreleased versions of the project the cursor's
+// issue belongs to, or the default project, for releasing one.
+func (a App) startRelease() (tea.Model, tea.Cmd) {
+	if a.client == nil {
+		a.flash = "Not connected to Jira"
+		a.flashIsErr = true
+		return a, nil
+	}
+	project := a.cursorProject()
+	if project == "" {
+		a.flash = "Set default_project in config to release versions"
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.release = &releaseOp{project: project}
+	a.flash = "Loading " + project + " versions..."
+	a.flashIsErr = false
+	client := a.client
+	return a, a.startNetwork(func() tea.Msg {
+		versions, err := client.GetProjectVersions(context.Background(), project)
+		return releaseVersionsMsg{versions: versions, err: err}
+	})
+}
+
+// cursorProject returns the project of the cursor's issue, or the default
+// project when the cursor is on none.
+func (a App) cursorProject() string {
+	if a.activeTab < len(a.tabs) {
+		if issue := a.tabs[a.activeTab].selectedIssue(); issue != nil {
+			return projectKeyOf(issue.Key)
+		}
+	}
+	return a.defaultProject
+}
+
+// handleReleaseVersions asks which unreleased version to release.
+func (a App) handleReleaseVersions(msg releaseVersionsMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	op := a.release
+	if op == nil {
+		return a, nil
+	}
+	if msg.err != nil {
+		a.release = nil
+		a.flash = "Loading versions failed: " + msg.err.Error()
+		a.flashIsErr = true
+		return a, nil
+	}
+	op.versions = unreleasedVersions(msg.versions)
+	if len(op.versions) == 0 {
+		a.release = nil
+		a.flash = op.project + " has no unreleased versions"
+		a.flashIsErr = false
+		return a, nil
+	}
+	items := make([]selectionItem, len(op.versions))
+	for i, v := range op.versions {
+		items[i] = selectionItem{ID: v.ID, Label: v.Name, Desc: versionDesc(v)}
+	}
+	a.flash = ""
+	a.overlay = newSelectionOverlay("Release a "+op.project+" version", items)
+	a.overlayAction = overlayActionReleaseVersion
+	return a, nil
+}
+
+// unreleasedVersions keeps the versions that are neither released nor
+// archived.
+func unreleasedVersions(versions []jira.Version) []jira.Version {
+	var out []jira.Version
+	for _, v := range versions {
+		if !v.Released && !v.Archived {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// versionDesc describes a version in the picker, e.g. "due 2026-11-01 ·
+// Billing fixes".
+func versionDesc(v jira.Version) string {
+	switch {
+	case v.ReleaseDate == "":
+		return v.Description
+	case v.Description == "":
+		return "due " + v.ReleaseDate
+	}
+	return "due " + v.ReleaseDate + " · " + v.Description
+}
+
+// unresolvedIssues counts unresolved issues, e.g. "1 unresolved issue".
+func unresolvedIssues(n int) string {
+	if n == 1 {
+		return "1 unresolved issue"
+	}
+	return fmt.Sprintf("%d unresolved issues", n)
+}
+
+// handleReleasePick counts the picked version's unresolved issues.
+func (a App) handleReleasePick(item *selectionItem) (tea.Model, tea.Cmd) {
+	op := a.release
+	if op == nil {
+		return a, nil
+	}
+	for _, v := range op.versions {
+		if v.ID == item.ID {
+			op.version = v
+		}
+	}
+	a.flash = "Checking " + op.version.Name + " for unresolved issues..."
+	a.flashIsErr = false
+	client := a.client
+	id := op.version.ID
+	return a, a.startNetwork(func() tea.Msg {
+		count, err := client.GetVersionUnresolvedCount(context.Background(), id)
+		return releaseCountMsg{count: count, err: err}
+	})
+}
+
+// handleReleaseCount confirms the release, first asking where unresolved
+// issues go when there are any.
+func (a App) handleReleaseCount(msg releaseCountMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	op := a.release
+	if op == nil {
+		return a, nil
+	}
+	if msg.err != nil {
+		a.release = nil
+		a.flash = "Release failed: " + msg.err.Error()
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.flash = ""
+	op.unresolved = msg.count
+	if op.unresolved == 0 {
+		return a.confirmRelease(), nil
+	}
+	var items []selectionItem
+	for _, v := range op.versions {
+		if v.ID != op.version.ID {
+			items = append(items, selectionItem{ID: v.ID, Label: "Move them to " + v.Name, Desc: versionDesc(v)})
+		}
+	}
+	items = append(items, selectionItem{Label: "Leave them in " + op.version.Name})
+	title := op.version.Name + " has " + unresolvedIssues(op.unresolved)
+	a.overlay = newSelectionOverlay(title, items)
+	a.overlayAction = overlayActionReleaseMove
+	return a, nil
+}
+
+// handleReleaseMove records where unresolved issues go and confirms.
+func (a App) handleReleaseMove(item *selectionItem) (tea.Model, tea.Cmd) {
+	op := a.release
+	if op == nil {
+		return a, nil
+	}
+	for i, v := range op.versions {
+		if item.ID != "" && v.ID == item.ID {
+			op.moveTo = &op.versions[i]
+		}
+	}
+	return a.confirmRelease(), nil
+}
+
+// confirmRelease spells out what the release will do before doing it.
+func (a App) confirmRelease() App {
+	op := a.release
+	date := a.now().Format("2006-01-02")
+	message := fmt.Sprintf("Release %s %s as of %s?", op.project, op.version.Name, date)
+	switch {
+	case op.moveTo != nil:
+		message = fmt.Sprintf("Release %s %s as of %s and move its %s to %s?",
+			op.project, op.version.Name, date, unresolvedIssues(op.unresolved), op.moveTo.Name)
+	case op.unresolved > 0:
+		message = fmt.Sprintf("Release %s %s as of %s with %s?",
+			op.project, op.version.Name, date, unresolvedIssues(op.unresolved))
+	}
+	a.overlay = newConfirmOverlay(message)
+	a.overlayAction = overlayActionReleaseConfirm
+	return a
+}
+
+// releaseVersion releases the version, moving its unresolved issues.
+func (a App) releaseVersion() (tea.Model, tea.Cmd) {
+	op := a.release
+	if op == nil {
+		return a, nil
+	}
+	a.flash = "Releasing " + op.version.Name + "..."
+	a.flashIsErr = false
+	client := a.client
+	id := op.version.ID
+	date := a.now().Format("2006-01-02")
+	var moveTo string
+	if op.moveTo != nil {
+		moveTo = op.moveTo.Self
+	}
+	return a, a.startNetwork(func() tea.Msg {
+		return versionReleasedMsg{err: client.ReleaseVersion(context.Background(), id, date, moveTo)}
+	})
+}
+
+// handleVersionReleased reports the release and, when issues moved,
+// reloads the active tab to show their new fix version.
+func (a App) handleVersionReleased(msg versionReleasedMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	op := a.release
+	a.release = nil
+	if op == nil {
+		return a, nil
+	}
+	if msg.err != nil {
+		a.flash = fmt.Sprintf("Releasing %s failed: %v", op.version.Name, msg.err)
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.flashIsErr = false
+	if op.moveTo == nil {
+		a.flash = "Released " + op.version.Name
+		return a, nil
+	}
+	a.flash = fmt.Sprintf("Released %s; moved %s to %s", op.version.Name, unresolvedIssues(op.unresolved), op.moveTo.Name)
+	if a.connected && a.activeTab < len(a.tabs) {
+		return a, a.startNetwork(a.loadTab(a.activeTab))
+	}
+	return a, nil
+}
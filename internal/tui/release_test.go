@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func releaseVersions() []jira.Version {
+	return []jira.Version{
+		{ID: "10", Name: "1.0", Released: true},
+		{ID: "11", Name: "1.1", ReleaseDate: "2026-10-20"},
+		{ID: "12", Name: "1.2", Self: "https://x/rest/api/3/version/12"},
+		{ID: "13", Name: "0.9", Archived: true},
+	}
+}
+
+// pickItem picks the overlay item labeled label.
+func pickItem(t *testing.T, app App, label string) App {
+	t.Helper()
+	sel, ok := app.overlay.(*selectionOverlay)
+	if !ok {
+		t.Fatalf("overlay = %T, want a selection", app.overlay)
+	}
+	for i := range sel.items {
+		if sel.items[i].Label == label {
+			model, _ := app.handleOverlayResult(&sel.items[i])
+			return model.(App)
+		}
+	}
+	t.Fatalf("no item %q in %+v", label, sel.items)
+	return app
+}
+
+func TestReleaseFlow(t *testing.T) {
+	app := testAppConnected()
+	app.SetClock(&fakeClock{t: time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)})
+
+	model, cmd := app.Update(keyMsg("v"))
+	app = model.(App)
+	if cmd == nil || app.release == nil || app.release.project != "PROJ" {
+		t.Fatalf("v should load the versions of the cursor's project, release = %+v", app.release)
+	}
+
+	model, _ = app.Update(releaseVersionsMsg{versions: releaseVersions()})
+	app = model.(App)
+	if sel := app.overlay.(*selectionOverlay); len(sel.items) != 2 || sel.items[0].Desc != "due 2026-10-20" {
+		t.Errorf("picker = %+v, want only the unreleased, unarchived versions", sel.items)
+	}
+	app = pickItem(t, app, "1.1")
+	if app.release.version.ID != "11" {
+		t.Errorf("version = %+v, want 1.1", app.release.version)
+	}
+
+	model, _ = app.Update(releaseCountMsg{count: 3})
+	app = model.(App)
+	if title := app.overlay.(*selectionOverlay).title; title != "1.1 has 3 unresolved issues" {
+		t.Errorf("title = %q", title)
+	}
+	app = pickItem(t, app, "Move them to 1.2")
+	confirm, ok := app.overlay.(*confirmOverlay)
+	if !ok {
+		t.Fatalf("overlay = %T, want a confirmation", app.overlay)
+	}
+	if want := "Release PROJ 1.1 as of 2026-10-16 and move its 3 unresolved issues to 1.2?"; confirm.message != want {
+		t.Errorf("confirmation = %q, want %q", confirm.message, want)
+	}
+
+	model, cmd = app.handleOverlayResult(true)
+	app = model.(App)
+	if cmd == nil || app.flash != "Releasing 1.1..." {
+		t.Fatalf("confirming should release, flash = %q", app.flash)
+	}
+	model, _ = app.Update(versionReleasedMsg{})
+	app = model.(App)
+	if app.flash != "Released 1.1; moved 3 unresolved issues to 1.2" || app.release != nil {
+		t.Errorf("flash = %q, release = %+v", app.flash, app.release)
+	}
+}
+
+func TestReleaseWithoutUnresolved(t *testing.T) {
+	app := testAppConnected()
+	app.release = &releaseOp{project: "PROJ", versions: releaseVersions()[1:2], version: releaseVersions()[1]}
+	model, _ := app.Update(releaseCountMsg{count: 0})
+	app = model.(App)
+	confirm, ok := app.overlay.(*confirmOverlay)
+	if !ok || !strings.HasPrefix(confirm.message, "Release PROJ 1.1 as of ") || strings.Contains(confirm.message, "unresolved") {
+		t.Errorf("overlay = %#v, want a plain release confirmation", app.overlay)
+	}
+
+	// Declining drops the release
+	model, _ = app.Update(keyMsg("n"))
+	app = model.(App)
+	if app.overlay != nil || app.release != nil {
+		t.Error("n should cancel the release")
+	}
+}
+
+func TestReleaseNoVersions(t *testing.T) {
+	app := testAppConnected()
+	app.release = &releaseOp{project: "PROJ"}
+	app.inflight = 1
+	model, _ := app.Update(releaseVersionsMsg{versions: []jira.Version{{ID: "10", Released: true}}})
+	app = model.(App)
+	if app.overlay != nil || app.flash != "PROJ has no unreleased versions" {
+		t.Errorf("flash = %q, want no picker", app.flash)
+	}
+}
@@ -0,0 +1,104 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// remoteLinksLoadedMsg delivers an issue's web links for the detail view.
+type remoteLinksLoadedMsg struct {
+	issueKey string
+	links    []jira.RemoteLink
+	err      error
+}
+
+// cmdFetchRemoteLinks fetches an issue's web links for the detail view.
+func (a App) cmdFetchRemoteLinks(issueKey string) tea.Cmd {
+	if a.client == nil {
+		return nil
+	}
+	client := a.client
+	return func() tea.Msg {
+		links, err := client.GetRemoteLinks(context.Background(), issueKey)
+		return remoteLinksLoadedMsg{issueKey: issueKey, links: links, err: err}
+	}
+}
+
+// remoteLinkTitle is what a web link is called: its title, else its URL.
+func remoteLinkTitle(link jira.RemoteLink) string {
+	if link.Object.Title != "" {
+		return link.Object.Title
+	}
+	return link.Object.URL
+}
+
+// remoteLinkKind labels where a web link points, e.g. "Confluence", or
+// how it relates to the issue.
+func remoteLinkKind(link jira.RemoteLink) string {
+	if link.Application != nil && link.Application.Name != "" {
+		return link.Application.Name
+	}
+	if link.Relationship != "" {
+		return link.Relationship
+	}
+	return "Web link"
+}
+
+// renderRemoteLinks renders the Web Links section, one link per line with
+// its URL below.
+func renderRemoteLinks(links []jira.RemoteLink, maxWidth int) string {
+	var b strings.Builder
+	b.WriteString(renderSection(fmt.Sprintf("Web Links (%d)", len(links)), maxWidth))
+	for _, link := range links {
+		title := remoteLinkTitle(link)
+		if link.Object.Status != nil && link.Object.Status.Resolved {
+			title += " ✓"
+		}
+		b.WriteString(fmt.Sprintf("  %s %s\n", detailLinkTypeStyle.Render(remoteLinkKind(link)), title))
+		if link.Object.Title != "" {
+			b.WriteString("    " + detailHintStyle.Render(truncateRunes(link.Object.URL, maxWidth-4)) + "\n")
+		}
+	}
+	return b.String()
+}
+
+// openRemoteLink opens the detail view's web link, or pull request, in the
+// browser, asking which one when there are several.
+func (a App) openRemoteLink(dv *issueDetailView) (tea.Model, tea.Cmd) {
+	var items []selectionItem
+	for _, link := range dv.remoteLinks {
+		if link.Object.URL == "" {
+			continue
+		}
+		items = append(items, selectionItem{ID: link.Object.URL, Label: remoteLinkTitle(link), Desc: remoteLinkKind(link)})
+	}
+	items = append(items, pullRequestLinks(dv.dev)...)
+	switch len(items) {
+	case 0:
+		a.flash = "No web links"
+		a.flashIsErr = false
+		return a, nil
+	case 1:
+		return a.openURL(items[0].ID), nil
+	}
+	a.overlay = newSelectionOverlay("Open Web Link", items)
+	a.overlayAction = overlayActionOpenLink
+	return a, nil
+}
+
+// openURL opens url in the browser, reporting the outcome in the flash.
+func (a App) openURL(url string) App {
+	if err := openBrowser(url); err != nil {
+		a.flash = "Could not open browser"
+		a.flashIsErr = true
+		return a
+	}
+	a.flash = "Opened " + url
+	a.flashIsErr = false
+	return a
+}
@@ -0,0 +1,61 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func testRemoteLinks() []jira.RemoteLink {
+	return []jira.RemoteLink{
+		{
+			Object:      jira.RemoteObject{URL: "https://wiki.example.com/pages/1", Title: "Design doc"},
+			Application: &jira.RemoteApplication{Name: "Confluence"},
+		},
+		{Relationship: "fixed by", Object: jira.RemoteObject{URL: "https://github.com/o/r/pull/7", Title: "PR #7"}},
+		{Object: jira.RemoteObject{URL: "https://example.com/x"}},
+	}
+}
+
+func TestRenderRemoteLinks(t *testing.T) {
+	out := renderRemoteLinks(testRemoteLinks(), 80)
+	for _, want := range []string{"Web Links (3)", "Confluence", "Design doc", "https://wiki.example.com/pages/1", "fixed by", "Web link", "https://example.com/x"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("section missing %q:\n%s", want, out)
+		}
+	}
+	// A link without a title shows its URL once, as the title
+	if strings.Count(out, "https://example.com/x") != 1 {
+		t.Errorf("untitled link URL should appear once:\n%s", out)
+	}
+}
+
+func TestRemoteLinksInDetail(t *testing.T) {
+	app := testAppConnected()
+	issue := app.tabs[0].issues[0]
+	app.openDetail(issue)
+	dv := app.topDetail(issue.Key)
+
+	model, _ := app.Update(keyMsg("w"))
+	app = model.(App)
+	if app.flash != "No web links" {
+		t.Errorf("flash = %q, want no web links", app.flash)
+	}
+
+	model, _ = app.Update(remoteLinksLoadedMsg{issueKey: issue.Key, links: testRemoteLinks()})
+	app = model.(App)
+	if !strings.Contains(dv.renderContent(), "Design doc") {
+		t.Error("the detail view should list the web links")
+	}
+
+	model, _ = app.Update(keyMsg("w"))
+	app = model.(App)
+	sel, ok := app.overlay.(*selectionOverlay)
+	if !ok || app.overlayAction != overlayActionOpenLink {
+		t.Fatalf("overlay = %T, want the web link picker", app.overlay)
+	}
+	if len(sel.items) != 3 || sel.items[2].Label != "https://example.com/x" || sel.items[0].Desc != "Confluence" {
+		t.Errorf("items = %+v", sel.items)
+	}
+}
@@ -0,0 +1,116 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// maxAutoRetries is how many times a write that failed transiently is
+// sent again on its own before it waits for ctrl+r.
+const maxAutoRetries = 3
+
+// retryBackoff returns the wait before retry number attempt+1: 2s, 4s, 8s.
+func retryBackoff(attempt int) time.Duration {
+	return 2 * time.Second << attempt
+}
+
+// failedWrite is a write that failed for a reason that may pass, like a
+// dropped connection, kept so the change isn't lost.
+type failedWrite struct {
+	id    int
+	write pendingWrite
+	err   error
+}
+
+// retryDueMsg fires when the automatic retry of a failed write is due.
+type retryDueMsg struct {
+	id int
+}
+
+// queueRetry keeps a write that failed transiently so it can be sent
+// again, scheduling an automatic retry while attempts remain. Offline,
+// the write waits for the connection to return instead. It reports false
+// for failures a retry can't fix, like a rejected field value.
+func (a *App) queueRetry(w pendingWrite, err error) (tea.Cmd, bool) {
+	if w.cmd == nil || !jira.IsTransient(err) {
+		return nil, false
+	}
+	a.retrySeq++
+	id := a.retrySeq
+	a.failed = append(a.failed, failedWrite{id: id, write: w, err: err})
+	a.flashIsErr = true
+	if jira.IsUnreachable(err) || a.offline {
+		probe := a.goOffline()
+		a.flash = fmt.Sprintf("Offline — %s is queued and syncs when Jira is reachable", w.describe())
+		return probe, true
+	}
+	if w.attempt >= maxAutoRetries {
+		a.flash = fmt.Sprintf("Couldn't %s: %v — ctrl+r retries", w.describe(), err)
+		return nil, true
+	}
+	delay := retryBackoff(w.attempt)
+	a.flash = fmt.Sprintf("Couldn't %s: %v — retrying in %s", w.describe(), err, delay)
+	return tea.Tick(delay, func(time.Time) tea.Msg { return retryDueMsg{id: id} }), true
+}
+
+// resend sends a failed write again, tracking it as pending.
+func (a *App) resend(w pendingWrite) tea.Cmd {
+	a.pending = append(a.pending, w)
+	return a.startNetwork(w.cmd)
+}
+
+// handleRetryDue retries a failed write automatically, unless ctrl+r
+// already did.
+func (a App) handleRetryDue(msg retryDueMsg) (tea.Model, tea.Cmd) {
+	for i, f := range a.failed {
+		if f.id == msg.id {
+			a.failed = append(a.failed[:i:i], a.failed[i+1:]...)
+			f.write.attempt++
+			return a, a.resend(f.write)
+		}
+	}
+	return a, nil
+}
+
+// retryFailed sends every failed write again, with a fresh round of
+// automatic retries should they fail again.
+func (a App) retryFailed() (tea.Model, tea.Cmd) {
+	if len(a.failed) == 0 {
+		a.flash = "No failed changes to retry"
+		a.flashIsErr = false
+		return a, nil
+	}
+	var cmds []tea.Cmd
+	for _, f := range a.failed {
+		f.write.attempt = 0
+		cmds = append(cmds, a.resend(f.write))
+	}
+	noun := "changes"
+	if len(a.failed) == 1 {
+		noun = "change"
+	}
+	a.flash = fmt.Sprintf("Retrying %d failed %s...", len(a.failed), noun)
+	a.flashIsErr = false
+	a.failed = nil
+	return a, tea.Batch(cmds...)
+}
+
+// failedSummary describes the writes waiting to be retried for the quit
+// prompt.
+func failedSummary(failed []failedWrite) string {
+	labels := make([]string, len(failed))
+	for i, f := range failed {
+		labels[i] = f.write.describe()
+	}
+	noun := "changes failed and are"
+	if len(failed) == 1 {
+		noun = "change failed and is"
+	}
+	return fmt.Sprintf("%d %s not saved (%s). Quit anyway?",
+		len(failed), noun, strings.Join(labels, ", "))
+}
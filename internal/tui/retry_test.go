@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestRetryQueueTransientFailure(t *testing.T) {
+	app := testAppConnected()
+	sent := 0
+	send := func() tea.Msg {
+		sent++
+		return nil
+	}
+	app.trackWrite(writeUpdate, "PROJ-1", send)
+
+	timeout := fmt.Errorf("transition: %w", context.DeadlineExceeded)
+	model, cmd := app.Update(issueUpdatedMsg{issueKey: "PROJ-1", err: timeout})
+	app = model.(App)
+	if cmd == nil || len(app.failed) != 1 || len(app.pending) != 0 {
+		t.Fatalf("failed = %d, pending = %d, want the write queued with a retry scheduled", len(app.failed), len(app.pending))
+	}
+	if want := "Couldn't update PROJ-1: transition: context deadline exceeded — retrying in 2s"; app.flash != want {
+		t.Errorf("flash = %q", app.flash)
+	}
+
+	// The scheduled retry sends the same write again
+	model, cmd = app.Update(retryDueMsg{id: app.failed[0].id})
+	app = model.(App)
+	if len(app.failed) != 0 || len(app.pending) != 1 || app.pending[0].attempt != 1 {
+		t.Fatalf("pending = %+v", app.pending)
+	}
+	collectMsgs(cmd)
+	if sent != 1 {
+		t.Errorf("sent = %d, want the write resent once", sent)
+	}
+}
+
+func TestRetryQueueGivesUp(t *testing.T) {
+	app := testAppConnected()
+	app.pending = []pendingWrite{{kind: writeComment, issueKey: "PROJ-1", cmd: func() tea.Msg { return nil }, attempt: maxAutoRetries}}
+	model, cmd := app.Update(commentAddedMsg{issueKey: "PROJ-1", err: errors.New("API error 503: down for maintenance")})
+	app = model.(App)
+	if cmd != nil || len(app.failed) != 1 {
+		t.Fatalf("out of automatic retries the write should wait, failed = %d", len(app.failed))
+	}
+	if want := "Couldn't comment on PROJ-1: API error 503: down for maintenance — ctrl+r retries"; app.flash != want {
+		t.Errorf("flash = %q", app.flash)
+	}
+
+	model, cmd = app.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	app = model.(App)
+	if cmd == nil || len(app.failed) != 0 || len(app.pending) != 1 || app.pending[0].attempt != 0 {
+		t.Errorf("ctrl+r should resend with fresh retries, pending = %+v", app.pending)
+	}
+	if app.flash != "Retrying 1 failed change..." {
+		t.Errorf("flash = %q", app.flash)
+	}
+}
+
+func TestRetryQueueSkipsRejectedEdits(t *testing.T) {
+	app := testAppConnected()
+	app.trackWrite(writeUpdate, "PROJ-1", func() tea.Msg { return nil })
+	model, _ := app.Update(issueUpdatedMsg{issueKey: "PROJ-1", err: errors.New("update: API error 400: bad priority")})
+	app = model.(App)
+	if len(app.failed) != 0 || app.flash != "update: API error 400: bad priority" {
+		t.Errorf("a rejected edit can't be fixed by retrying, failed = %d, flash = %q", len(app.failed), app.flash)
+	}
+	if jira.IsTransient(errors.New("update: API error 400: bad priority")) {
+		t.Error("400 should not be transient")
+	}
+}
+
+func TestQuitWithFailedWrites(t *testing.T) {
+	app := testAppReady()
+	app.failed = []failedWrite{{id: 1, write: pendingWrite{kind: writeUpdate, issueKey: "PROJ-1"}}}
+	model, _ := app.quit(false)
+	app = model.(App)
+	c, ok := app.overlay.(*confirmOverlay)
+	if !ok {
+		t.Fatalf("overlay = %T, want a confirmation", app.overlay)
+	}
+	if want := "1 change failed and is not saved (update PROJ-1). Quit anyway?"; c.message != want {
+		t.Errorf("message = %q", c.message)
+	}
+}
@@ -0,0 +1,156 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+)
+
+// searchTabLabel is the label of the temporary tab holding ad-hoc JQL results.
+const searchTabLabel = "Search"
+
+// defaultSearchColumns are used for the search tab when no tab is configured.
+var defaultSearchColumns = []string{"key", "type", "summary", "status", "assignee"}
+
+// jqlValidatedMsg delivers the result of validating an ad-hoc query.
+type jqlValidatedMsg struct {
+	query    string
+	problems []string // JQL errors reported by Jira; empty when valid
+	err      error    // the validation request itself failed
+}
+
+// jqlHistorySavedMsg reports whether the query history reached disk.
+type jqlHistorySavedMsg struct {
+	err error
+}
+
+// startJQLSearch opens the JQL input overlay, pre-filled with query and
+// showing errMsg when re-opened after a failed validation.
+func (a App) startJQLSearch(query, errMsg string) (tea.Model, tea.Cmd) {
+	if a.client == nil {
+		a.flash = "Not connected to Jira"
+		a.flashIsErr = true
+		return a, nil
+	}
+	o := newTextInputOverlay("JQL Search", query)
+	o.input.Placeholder = "project = PROJ AND status = \"In Progress\""
+	o.input.CharLimit = 2000
+	o.history = a.jqlHistory
+	o.errMsg = errMsg
+	a.overlay = o
+	a.overlayIssue = ""
+	a.overlayAction = overlayActionJQLSearch
+	return a, nil
+}
+
+// cmdValidateJQL checks query against Jira's JQL parser.
+func (a App) cmdValidateJQL(query string) tea.Cmd {
+	client := a.client
+	return func() tea.Msg {
+		results, err := client.ParseJQL(context.Background(), query)
+		if err != nil {
+			return jqlValidatedMsg{query: query, err: fmt.Errorf("validate JQL: %w", err)}
+		}
+		var problems []string
+		for _, r := range results {
+			problems = append(problems, r.Errors...)
+		}
+		return jqlValidatedMsg{query: query, problems: problems}
+	}
+}
+
+// handleJQLValidated re-opens the input on errors, otherwise records the
+// query in history and shows its results in the search tab.
+func (a App) handleJQLValidated(msg jqlValidatedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		a.flash = msg.err.Error()
+		a.flashIsErr = true
+		return a, nil
+	}
+	if len(msg.problems) > 0 {
+		a.flash = ""
+		return a.startJQLSearch(msg.query, strings.Join(msg.problems, " "))
+	}
+
+	a.flash = ""
+	a.jqlHistory = config.AddJQLHistory(a.jqlHistory, msg.query)
+	a.jqlHistoryDirty = true
+	history := a.jqlHistory
+	save := func() tea.Msg {
+		return jqlHistorySavedMsg{err: config.SaveJQLHistory(history)}
+	}
+	return a, tea.Batch(a.openSearchTab(msg.query), save)
+}
+
+// openSearchTab shows query results in the temporary search tab, replacing
+// the previous search if there is one, and switches to it.
+func (a *App) openSearchTab(query string) tea.Cmd {
+	return a.openTemporaryTab(config.TabConfig{Label: searchTabLabel, JQL: query})
+}
+
+// openTemporaryTab shows cfg's results in the temporary tab, replacing any
+// search already there, and switches to it. Without columns it takes the
+// first tab's.
+func (a *App) openTemporaryTab(cfg config.TabConfig) tea.Cmd {
+	if len(cfg.Columns) == 0 {
+		cfg.Columns = a.defaultColumns()
+	}
+	t := a.runtimeTab(cfg)
+	t.temporary = true
+	t.jqlChecked = true // validated before the search ran, or built here
+
+	idx := -1
+	for i := range a.tabs {
+		if a.tabs[i].temporary {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		a.tabs = append(a.tabs, t)
+		idx = len(a.tabs) - 1
+	} else {
+		a.tabs[idx] = t
+	}
+	a.leaveTab()
+	a.activeTab = idx
+	return a.startNetwork(a.loadTab(idx))
+}
+
+// defaultColumns are the columns of tabs opened while running: the first
+// tab's, or defaultSearchColumns.
+func (a App) defaultColumns() []string {
+	if len(a.tabs) > 0 && !a.tabs[0].temporary && len(a.tabs[0].config.Columns) > 0 {
+		return a.tabs[0].config.Columns
+	}
+	return defaultSearchColumns
+}
+
+// runtimeTab makes a tab opened while running, set up as the configured
+// ones are.
+func (a App) runtimeTab(cfg config.TabConfig) tab {
+	t := newTab(cfg)
+	t.fileLabel = ""
+	t.keepFilter = a.persistFilters
+	t.workflows = a.workflows
+	t.clock = a.clock
+	t.quickFilter.fuzzy = a.fuzzyFilter
+	t.setSize(a.listWidth(), a.tableHeight())
+	return t
+}
+
+// closeTemporaryTab removes the active tab if it is a search tab.
+func (a *App) closeTemporaryTab() bool {
+	if a.activeTab >= len(a.tabs) || !a.tabs[a.activeTab].temporary {
+		return false
+	}
+	a.tabs = append(a.tabs[:a.activeTab], a.tabs[a.activeTab+1:]...)
+	if a.activeTab >= len(a.tabs) {
+		a.activeTab = max(0, len(a.tabs)-1)
+	}
+	return true
+}
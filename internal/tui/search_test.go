@@ -0,0 +1,109 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func testSearchApp() App {
+	app := testAppReady()
+	app.client = jira.NewClient("https://fake.atlassian.net", "test@test.com", "token")
+	app.connected = true
+	return app
+}
+
+func TestColonOpensJQLOverlay(t *testing.T) {
+	app := testSearchApp()
+	app.jqlHistory = []string{"project = PROJ"}
+
+	model, _ := app.Update(keyMsg(":"))
+	app = model.(App)
+	o, ok := app.overlay.(*textInputOverlay)
+	if !ok {
+		t.Fatalf("expected textInputOverlay, got %T", app.overlay)
+	}
+	if app.overlayAction != overlayActionJQLSearch {
+		t.Errorf("overlayAction = %d, want overlayActionJQLSearch", app.overlayAction)
+	}
+	if len(o.history) != 1 {
+		t.Errorf("expected history to be offered, got %v", o.history)
+	}
+
+	model, cmd := app.handleOverlayResult("assignee = currentUser()")
+	if cmd == nil {
+		t.Error("expected a validation cmd")
+	}
+	if model.(App).flash != "Validating JQL..." {
+		t.Errorf("flash = %q", model.(App).flash)
+	}
+}
+
+func TestInvalidJQLReopensOverlay(t *testing.T) {
+	app := testSearchApp()
+	model, _ := app.Update(jqlValidatedMsg{query: "projct = X", problems: []string{"Field 'projct' does not exist."}})
+	app = model.(App)
+
+	o, ok := app.overlay.(*textInputOverlay)
+	if !ok {
+		t.Fatalf("expected overlay to reopen, got %T", app.overlay)
+	}
+	if o.input.Value() != "projct = X" {
+		t.Errorf("input = %q, want the rejected query", o.input.Value())
+	}
+	if o.errMsg != "Field 'projct' does not exist." {
+		t.Errorf("errMsg = %q", o.errMsg)
+	}
+	if len(app.tabs) != 2 {
+		t.Errorf("expected no search tab, got %d tabs", len(app.tabs))
+	}
+}
+
+func TestValidJQLOpensSearchTab(t *testing.T) {
+	app := testSearchApp()
+	model, cmd := app.Update(jqlValidatedMsg{query: "project = PROJ"})
+	app = model.(App)
+	if cmd == nil {
+		t.Fatal("expected load and history-save cmds")
+	}
+	if len(app.tabs) != 3 || !app.tabs[2].temporary {
+		t.Fatalf("expected a temporary third tab, got %d tabs", len(app.tabs))
+	}
+	if app.activeTab != 2 || app.tabs[2].config.JQL != "project = PROJ" {
+		t.Errorf("activeTab=%d jql=%q", app.activeTab, app.tabs[2].config.JQL)
+	}
+	if len(app.jqlHistory) != 1 || !app.jqlHistoryDirty {
+		t.Errorf("history=%v dirty=%v", app.jqlHistory, app.jqlHistoryDirty)
+	}
+
+	// A second search replaces the first instead of adding a tab
+	model, _ = app.Update(jqlValidatedMsg{query: "project = OTHER"})
+	app = model.(App)
+	if len(app.tabs) != 3 || app.tabs[2].config.JQL != "project = OTHER" {
+		t.Errorf("expected search tab to be replaced, got %d tabs", len(app.tabs))
+	}
+	if app.jqlHistory[0] != "project = OTHER" {
+		t.Errorf("history = %v", app.jqlHistory)
+	}
+
+	model, _ = app.Update(jqlHistorySavedMsg{})
+	app = model.(App)
+	if app.jqlHistoryDirty {
+		t.Error("expected history to be clean after saving")
+	}
+
+	// x closes it
+	model, _ = app.Update(keyMsg("x"))
+	app = model.(App)
+	if len(app.tabs) != 2 || app.activeTab != 1 {
+		t.Errorf("after close: %d tabs, active %d", len(app.tabs), app.activeTab)
+	}
+}
+
+func TestXIgnoredOnConfiguredTab(t *testing.T) {
+	app := testSearchApp()
+	model, _ := app.Update(keyMsg("x"))
+	if len(model.(App).tabs) != 2 {
+		t.Error("x must not close configured tabs")
+	}
+}
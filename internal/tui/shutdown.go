@@ -0,0 +1,113 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+)
+
+// flushTimeout bounds how long quitting waits for state to reach disk.
+const flushTimeout = 3 * time.Second
+
+// flushTask persists one piece of session state on quit.
+type flushTask struct {
+	name string
+	run  func() error
+}
+
+// flushDoneMsg is sent when all flush tasks finished or the timeout hit.
+type flushDoneMsg struct {
+	errs []error
+}
+
+// flushTasks returns the state that still needs to be written to disk.
+// Features that keep on-disk state register their writes here so quitting
+// persists them instead of dropping them.
+func (a App) flushTasks() []flushTask {
+	var tasks []flushTask
+	if a.usersDirty {
+		users := a.cachedUsers
+		tasks = append(tasks, flushTask{
+			name: "user cache",
+			run:  func() error { return config.SaveUserCache(users) },
+		})
+	}
+	if a.jqlHistoryDirty {
+		history := a.jqlHistory
+		tasks = append(tasks, flushTask{
+			name: "JQL history",
+			run:  func() error { return config.SaveJQLHistory(history) },
+		})
+	}
+	if a.tabCacheDirty {
+		cache := a.tabCache()
+		tasks = append(tasks, flushTask{
+			name: "tab cache",
+			run:  func() error { return config.SaveTabCache(cache) },
+		})
+	}
+	return tasks
+}
+
+// runFlush executes tasks in order and returns their errors. Tasks still
+// running when the timeout expires are reported as failed.
+func runFlush(tasks []flushTask, timeout time.Duration) []error {
+	type result struct {
+		name string
+		err  error
+	}
+	results := make(chan result, len(tasks))
+	go func() {
+		for _, t := range tasks {
+			results <- result{name: t.name, err: t.run()}
+		}
+	}()
+
+	var errs []error
+	finished := make(map[string]bool, len(tasks))
+	deadline := time.After(timeout)
+	for range tasks {
+		select {
+		case r := <-results:
+			finished[r.name] = true
+			if r.err != nil {
+				errs = append(errs, fmt.Errorf("saving %s: %w", r.name, r.err))
+			}
+		case <-deadline:
+			var unfinished []string
+			for _, t := range tasks {
+				if !finished[t.name] {
+					unfinished = append(unfinished, t.name)
+				}
+			}
+			return append(errs, fmt.Errorf("timed out after %s saving %s",
+				timeout, strings.Join(unfinished, ", ")))
+		}
+	}
+	return errs
+}
+
+// shutdown flushes pending state to disk and then quits. With nothing to
+// flush it quits immediately.
+func (a App) shutdown() (tea.Model, tea.Cmd) {
+	tasks := a.flushTasks()
+	if len(tasks) == 0 {
+		return a, tea.Quit
+	}
+	a.quitting = true
+	a.flash = "Saving…"
+	a.flashIsErr = false
+	return a, func() tea.Msg {
+		return flushDoneMsg{errs: runFlush(tasks, flushTimeout)}
+	}
+}
+
+// FlushErrors returns the errors from the shutdown flush, if any. main
+// reports them after the terminal has been restored.
+func (a App) FlushErrors() []error {
+	return a.flushErrs
+}
@@ -0,0 +1,76 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestRunFlushCollectsErrors(t *testing.T) {
+	tasks := []flushTask{
+		{name: "ok", run: func() error { return nil }},
+		{name: "broken", run: func() error { return fmt.Errorf("disk full") }},
+	}
+	errs := runFlush(tasks, time.Second)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %v", errs)
+	}
+	if !strings.Contains(errs[0].Error(), "saving broken: disk full") {
+		t.Errorf("unexpected error: %v", errs[0])
+	}
+}
+
+func TestRunFlushTimesOut(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	tasks := []flushTask{
+		{name: "fast", run: func() error { return nil }},
+		{name: "slow", run: func() error { <-block; return nil }},
+	}
+	errs := runFlush(tasks, 20*time.Millisecond)
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "timed out") ||
+		!strings.Contains(errs[0].Error(), "slow") {
+		t.Errorf("expected timeout error naming the slow task, got %v", errs)
+	}
+}
+
+func TestQuitFlushesDirtyStateBeforeExit(t *testing.T) {
+	app := testAppReady()
+	app.usersDirty = true
+
+	model, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	updated := model.(App)
+	if !updated.quitting {
+		t.Fatal("expected app to enter the quitting state")
+	}
+	if cmd == nil {
+		t.Fatal("expected a flush command")
+	}
+
+	// Keys other than ctrl+c are ignored while saving
+	model, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	if cmd != nil {
+		t.Error("expected keys to be ignored during shutdown")
+	}
+
+	model, cmd = model.(App).Update(flushDoneMsg{errs: []error{fmt.Errorf("saving user cache: denied")}})
+	if cmd == nil {
+		t.Fatal("expected quit after flush")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("expected QuitMsg after flush completes")
+	}
+	if errs := model.(App).FlushErrors(); len(errs) != 1 {
+		t.Errorf("expected flush errors to be kept for main, got %v", errs)
+	}
+}
+
+func TestFlushTasksEmptyWhenClean(t *testing.T) {
+	app := testAppReady()
+	if tasks := app.flushTasks(); len(tasks) != 0 {
+		t.Errorf("expected no flush tasks, got %d", len(tasks))
+	}
+}
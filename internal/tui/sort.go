@@ -0,0 +1,182 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// shiftDigits maps shift+1..9 (on a US layout) to the column they sort.
+var shiftDigits = map[string]int{
+	"!": 1, "@": 2, "#": 3, "$": 4, "%": 5, "^": 6, "&": 7, "*": 8, "(": 9,
+}
+
+// sortFieldAliases maps column names to the JQL fields they sort by.
+var sortFieldAliases = map[string]string{
+	"type":     "issuetype",
+	"due":      "duedate",
+	"due_date": "duedate",
+}
+
+// priorityOrder ranks priority names from most to least urgent, so sorting
+// ascending puts the most urgent first.
+var priorityOrder = map[string]int{
+	"Blocker": 0, "Blocked": 0,
+	"Highest": 1, "Critical": 1,
+	"High":        2,
+	"Medium":      3,
+	"Medium-Rare": 4,
+	"Low":         5,
+	"Lowest":      6,
+}
+
+// sortedJQL applies a tab's configured sort. A query with its own ORDER BY
+// keeps it; without a configured sort, sprint queries are sorted by Rank.
+func sortedJQL(jql, sortTerms string) string {
+	if sortTerms == "" {
+		return rankedJQL(jql)
+	}
+	if orderByPattern.MatchString(jql) {
+		return jql
+	}
+	terms := strings.Split(sortTerms, ",")
+	for i, term := range terms {
+		fields := strings.Fields(term)
+		if alias, ok := sortFieldAliases[strings.ToLower(fields[0])]; ok {
+			fields[0] = alias
+		}
+		terms[i] = strings.Join(fields, " ")
+	}
+	return strings.TrimSpace(jql) + " ORDER BY " + strings.Join(terms, ", ")
+}
+
+// cycleSort advances column col (1-based) from unsorted to ascending,
+// descending, and back to the query's order. Sorting another column starts
+// it at ascending. The cursor stays on the same issue. It returns false if
+// the tab has no such column.
+func (t *tab) cycleSort(col int) bool {
+	if col < 1 || col > len(t.columns) {
+		return false
+	}
+	switch {
+	case t.sortCol != col:
+		t.sortCol, t.sortDesc = col, false
+	case !t.sortDesc:
+		t.sortDesc = true
+	default:
+		t.sortCol, t.sortDesc = 0, false
+	}
+
+	selectedKey := ""
+	if sel := t.selectedIssue(); sel != nil {
+		selectedKey = sel.Key
+	}
+	t.sortIssues()
+	if t.quickFilter.isActive() {
+		t.quickFilter.updateQuery(t.issues, t.columns)
+	}
+	t.layoutColumns()
+	if t.state == tabReady {
+		t.applyFilterKeepCursor(selectedKey)
+	}
+	return true
+}
+
+// sortIssues orders t.issues by the sort column, or restores the query's
+// order when there is none. Empty values sort last in either direction.
+func (t *tab) sortIssues() {
+	if t.sortCol == 0 {
+		sort.SliceStable(t.issues, func(i, j int) bool {
+			return t.queryPos(t.issues[i].Key) < t.queryPos(t.issues[j].Key)
+		})
+		return
+	}
+	col := t.columns[t.sortCol-1]
+	sort.SliceStable(t.issues, func(i, j int) bool {
+		a, b := t.sortValue(col, t.issues[i]), t.sortValue(col, t.issues[j])
+		if (a == "") != (b == "") {
+			return b == ""
+		}
+		if t.sortDesc {
+			return a > b
+		}
+		return a < b
+	})
+}
+
+// setQueryOrder remembers the order Jira returned, so turning sorting off
+// can restore it, and re-applies the active sort.
+func (t *tab) setQueryOrder(issues []jira.Issue) {
+	t.queryOrder = make(map[string]int, len(issues))
+	for i, issue := range issues {
+		t.queryOrder[issue.Key] = i
+	}
+	if t.sortCol > 0 {
+		t.sortIssues()
+	}
+}
+
+// queryPos returns where Jira placed key; unknown issues go last.
+func (t *tab) queryPos(key string) int {
+	if pos, ok := t.queryOrder[key]; ok {
+		return pos
+	}
+	return len(t.queryOrder)
+}
+
+// sortValue returns a string that orders issues by column when compared
+// lexically: keys and ranks are zero-padded, priorities use their urgency,
+// and dates keep Jira's ISO format.
+func (t *tab) sortValue(col string, issue jira.Issue) string {
+	switch col {
+	case "key":
+		project, num, _ := strings.Cut(issue.Key, "-")
+		n, err := strconv.Atoi(num)
+		if err != nil {
+			return issue.Key
+		}
+		return fmt.Sprintf("%s-%010d", project, n)
+	case "priority":
+		if issue.Fields.Priority == nil || issue.Fields.Priority.Name == "" {
+			return ""
+		}
+		order, ok := priorityOrder[issue.Fields.Priority.Name]
+		if !ok {
+			order = 50 // unknown priorities between the known ones and none
+		}
+		return fmt.Sprintf("%02d", order)
+	case "rank":
+		if pos, ok := t.rankPos[issue.Key]; ok {
+			return fmt.Sprintf("%010d", pos)
+		}
+		return ""
+	case nextColumn:
+		return strings.ToLower(t.workflows.nextLabel(issue))
+	case "created":
+		return issue.Fields.Created
+	case "updated":
+		return issue.Fields.Updated
+	case "duedate", "due_date", "due date", "due":
+		return issue.Fields.DueDate
+	}
+	return strings.ToLower(fieldValue(issue, col))
+}
+
+// headerColumns builds the table columns, marking the sorted one with its
+// direction.
+func (t *tab) headerColumns(width int) []table.Column {
+	cols := sizedColumns(t.columns, t.widths, width)
+	if t.sortCol > 0 && t.sortCol <= len(cols) {
+		arrow := " ▲"
+		if t.sortDesc {
+			arrow = " ▼"
+		}
+		cols[t.sortCol-1].Title += arrow
+	}
+	return cols
+}
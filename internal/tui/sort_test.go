@@ -0,0 +1,113 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestSortedJQL(t *testing.T) {
+	tests := []struct {
+		jql, sort, want string
+	}{
+		{"project = PROJ", "updated DESC", "project = PROJ ORDER BY updated DESC"},
+		{"project = PROJ", "type, due ASC", "project = PROJ ORDER BY issuetype, duedate ASC"},
+		{"project = PROJ ORDER BY created", "updated DESC", "project = PROJ ORDER BY created"},
+		{"sprint in openSprints()", "", "sprint in openSprints() ORDER BY Rank ASC"},
+		{"sprint in openSprints()", "priority", "sprint in openSprints() ORDER BY priority"},
+	}
+	for _, tt := range tests {
+		if got := sortedJQL(tt.jql, tt.sort); got != tt.want {
+			t.Errorf("sortedJQL(%q, %q) = %q, want %q", tt.jql, tt.sort, got, tt.want)
+		}
+	}
+}
+
+func visibleKeys(tab *tab) string {
+	var keys []string
+	for _, issue := range tab.quickFilter.visibleIssues(tab.issues) {
+		keys = append(keys, issue.Key)
+	}
+	return strings.Join(keys, ",")
+}
+
+func TestShiftNumberCyclesSort(t *testing.T) {
+	app := testAppReady()
+	app.tabs[0].table.SetCursor(1) // PROJ-2
+
+	steps := []struct {
+		want, header string
+	}{
+		{"PROJ-1,PROJ-3,PROJ-2", "Summary ▲"},
+		{"PROJ-2,PROJ-3,PROJ-1", "Summary ▼"},
+		{"PROJ-1,PROJ-2,PROJ-3", ""},
+	}
+	for i, step := range steps {
+		model, _ := app.Update(keyMsg("@")) // shift+2: summary
+		app = model.(App)
+		tab := &app.tabs[0]
+		if got := visibleKeys(tab); got != step.want {
+			t.Errorf("press %d: order = %s, want %s", i+1, got, step.want)
+		}
+		header := tab.table.Columns()[1].Title
+		if step.header != "" && header != step.header {
+			t.Errorf("press %d: header = %q, want %q", i+1, header, step.header)
+		}
+		if step.header == "" && strings.ContainsAny(header, "▲▼") {
+			t.Errorf("press %d: header %q should have no indicator", i+1, header)
+		}
+		if sel := tab.selectedIssue(); sel == nil || sel.Key != "PROJ-2" {
+			t.Errorf("press %d: cursor moved off PROJ-2", i+1)
+		}
+	}
+}
+
+func TestSortSurvivesRefreshAndFilter(t *testing.T) {
+	app := testAppReady()
+	model, _ := app.Update(keyMsg("#")) // shift+3: status
+	app = model.(App)
+	tab := &app.tabs[0]
+	tab.quickFilter.input.SetValue("fix")
+	tab.quickFilter.apply(tab.issues, tab.columns)
+	tab.applyFilter()
+
+	reloaded := []jira.Issue{
+		{Key: "PROJ-4", Fields: jira.IssueFields{Summary: "Fix the fix", Status: &jira.Status{Name: "Done"}}},
+		{Key: "PROJ-3", Fields: jira.IssueFields{Summary: "Fix logout bug", Status: &jira.Status{Name: "Open"}}},
+	}
+	model, _ = app.Update(tabDataMsg{tabIndex: 0, issues: reloaded})
+	app = model.(App)
+	if got := visibleKeys(&app.tabs[0]); got != "PROJ-4,PROJ-3" {
+		t.Errorf("order after refresh = %s, want status-sorted PROJ-4,PROJ-3", got)
+	}
+}
+
+func TestSortValueOrdering(t *testing.T) {
+	tab := &tab{}
+	issue := func(key, priority string) jira.Issue {
+		i := jira.Issue{Key: key}
+		if priority != "" {
+			i.Fields.Priority = &jira.Named{Name: priority}
+		}
+		return i
+	}
+	if a, b := tab.sortValue("key", issue("PROJ-9", "")), tab.sortValue("key", issue("PROJ-10", "")); a >= b {
+		t.Errorf("PROJ-9 (%s) should sort before PROJ-10 (%s)", a, b)
+	}
+	if a, b := tab.sortValue("priority", issue("X-1", "Highest")), tab.sortValue("priority", issue("X-2", "Low")); a >= b {
+		t.Errorf("Highest (%s) should sort before Low (%s)", a, b)
+	}
+	if v := tab.sortValue("priority", issue("X-3", "")); v != "" {
+		t.Errorf("missing priority should be empty, got %q", v)
+	}
+}
+
+func TestSortMissingColumn(t *testing.T) {
+	app := testAppReady()
+	model, _ := app.Update(keyMsg("$")) // shift+4; the tab has 3 columns
+	app = model.(App)
+	if !app.flashIsErr || !strings.Contains(app.flash, "No column 4") {
+		t.Errorf("flash = %q, want missing column error", app.flash)
+	}
+}
@@ -0,0 +1,393 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// defaultSprintLength is how long a started sprint runs when it has no
+// end date planned.
+const defaultSprintLength = 14 * 24 * time.Hour
+
+// incompleteJQL selects the issues a completed sprint leaves unfinished.
+const incompleteJQL = "statusCategory != Done"
+
+// sprintOp tracks starting or completing a sprint through picking the
+// board, the sprint, where unfinished issues go, and the confirmation.
+type sprintOp struct {
+	project    string
+	board      jira.Board
+	boards     []jira.Board
+	sprints    []jira.Sprint // the board's active and future sprints
+	sprint     jira.Sprint   // the sprint being started or completed
+	complete   bool          // completing rather than starting
+	incomplete []string      // keys of the sprint's unfinished issues
+	moveTo     *jira.Sprint  // where they go, nil for the backlog
+}
+
+// sprintBoardsMsg delivers a project's scrum boards.
+type sprintBoardsMsg struct {
+	boards []jira.Board
+	err    error
+}
+
+// sprintListMsg delivers a board's active and future sprints.
+type sprintListMsg struct {
+	sprints []jira.Sprint
+	err     error
+}
+
+// sprintIncompleteMsg delivers the unfinished issues of a sprint being
+// completed.
+type sprintIncompleteMsg struct {
+	keys []string
+	err  error
+}
+
+// sprintDoneMsg reports the outcome of starting or completing a sprint.
+type sprintDoneMsg struct {
+	err error
+}
+
+// startSprintAction looks up the scrum boards of the project the cursor's
+// issue belongs to, or the default project, to start or complete one of
+// their sprints.
+func (a App) startSprintAction() (tea.Model, tea.Cmd) {
+	if a.client == nil {
+		a.flash = "Not connected to Jira"
+		a.flashIsErr = true
+		return a, nil
+	}
+	project := a.cursorProject()
+	if project == "" {
+		a.flash = "Set default_project in config to manage sprints"
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.sprint = &sprintOp{project: project}
+	a.flash = "Loading " + project + " boards..."
+	a.flashIsErr = false
+	client := a.client
+	return a, a.startNetwork(func() tea.Msg {
+		boards, err := client.GetBoards(context.Background(), project, "scrum")
+		return sprintBoardsMsg{boards: boards, err: err}
+	})
+}
+
+// handleSprintBoards loads the sprints of the project's board, asking
+// which board when there are several.
+func (a App) handleSprintBoards(msg sprintBoardsMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	op := a.sprint
+	if op == nil {
+		return a, nil
+	}
+	if msg.err != nil {
+		a.sprint = nil
+		a.flash = "Loading boards failed: " + msg.err.Error()
+		a.flashIsErr = true
+		return a, nil
+	}
+	op.boards = msg.boards
+	switch len(op.boards) {
+	case 0:
+		a.sprint = nil
+		a.flash = op.project + " has no scrum board"
+		a.flashIsErr = false
+		return a, nil
+	case 1:
+		cmd := a.loadSprints(op.boards[0])
+		return a, cmd
+	}
+	items := make([]selectionItem, len(op.boards))
+	for i, b := range op.boards {
+		items[i] = selectionItem{ID: strconv.Itoa(b.ID), Label: b.Name}
+	}
+	a.flash = ""
+	a.overlay = newSelectionOverlay(op.project+" boards", items)
+	a.overlayAction = overlayActionSprintBoard
+	return a, nil
+}
+
+// handleSprintBoardPick loads the picked board's sprints.
+func (a App) handleSprintBoardPick(item *selectionItem) (tea.Model, tea.Cmd) {
+	if a.sprint == nil {
+		return a, nil
+	}
+	for _, b := range a.sprint.boards {
+		if strconv.Itoa(b.ID) == item.ID {
+			cmd := a.loadSprints(b)
+			return a, cmd
+		}
+	}
+	return a, nil
+}
+
+// loadSprints fetches a board's active and future sprints.
+func (a *App) loadSprints(board jira.Board) tea.Cmd {
+	a.sprint.board = board
+	a.flash = "Loading " + board.Name + " sprints..."
+	a.flashIsErr = false
+	client := a.client
+	return a.startNetwork(func() tea.Msg {
+		sprints, err := client.GetBoardSprints(context.Background(), board.ID, "active,future")
+		return sprintListMsg{sprints: sprints, err: err}
+	})
+}
+
+// handleSprintList offers to complete the active sprints and start the
+// future ones.
+func (a App) handleSprintList(msg sprintListMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	op := a.sprint
+	if op == nil {
+		return a, nil
+	}
+	if msg.err != nil {
+		a.sprint = nil
+		a.flash = "Loading sprints failed: " + msg.err.Error()
+		a.flashIsErr = true
+		return a, nil
+	}
+	op.sprints = msg.sprints
+	items := sprintActionItems(op.sprints)
+	if len(items) == 0 {
+		a.sprint = nil
+		a.flash = op.board.Name + " has no active or future sprints"
+		a.flashIsErr = false
+		return a, nil
+	}
+	a.flash = ""
+	a.overlay = newSelectionOverlay(op.board.Name+" sprints", items)
+	a.overlayAction = overlayActionSprintPick
+	return a, nil
+}
+
+// sprintActionItems lists "Complete" for each active sprint, then "Start"
+// for each future one. IDs are "complete:ID" or "start:ID".
+func sprintActionItems(sprints []jira.Sprint) []selectionItem {
+	var items []selectionItem
+	for _, s := range sprints {
+		if s.State == "active" {
+			items = append(items, selectionItem{
+				ID: "complete:" + strconv.Itoa(s.ID), Label: "Complete " + s.Name, Desc: sprintDates(s),
+			})
+		}
+	}
+	for _, s := range sprints {
+		if s.State == "future" {
+			items = append(items, selectionItem{
+				ID: "start:" + strconv.Itoa(s.ID), Label: "Start " + s.Name, Desc: sprintDates(s),
+			})
+		}
+	}
+	return items
+}
+
+// sprintDates describes a sprint's planned dates, e.g. "Oct 2 – Oct 16".
+func sprintDates(s jira.Sprint) string {
+	start, startErr := time.Parse(time.RFC3339, s.StartDate)
+	end, endErr := time.Parse(time.RFC3339, s.EndDate)
+	switch {
+	case startErr == nil && endErr == nil:
+		return start.Format("Jan 2") + " – " + end.Format("Jan 2")
+	case endErr == nil:
+		return "ends " + end.Format("Jan 2")
+	}
+	return s.Goal
+}
+
+// handleSprintPick confirms starting a sprint, or looks up what a sprint
+// being completed leaves unfinished.
+func (a App) handleSprintPick(item *selectionItem) (tea.Model, tea.Cmd) {
+	op := a.sprint
+	if op == nil {
+		return a, nil
+	}
+	action, id, _ := strings.Cut(item.ID, ":")
+	for _, s := range op.sprints {
+		if strconv.Itoa(s.ID) == id {
+			op.sprint = s
+		}
+	}
+	op.complete = action == "complete"
+	if !op.complete {
+		return a.confirmSprint(), nil
+	}
+	a.flash = "Checking " + op.sprint.Name + " for unfinished issues..."
+	a.flashIsErr = false
+	client := a.client
+	sprintID := op.sprint.ID
+	return a, a.startNetwork(func() tea.Msg {
+		keys, err := client.GetSprintIssueKeys(context.Background(), sprintID, incompleteJQL)
+		return sprintIncompleteMsg{keys: keys, err: err}
+	})
+}
+
+// handleSprintIncomplete asks where the unfinished issues go, or confirms
+// right away when there are none.
+func (a App) handleSprintIncomplete(msg sprintIncompleteMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	op := a.sprint
+	if op == nil {
+		return a, nil
+	}
+	if msg.err != nil {
+		a.sprint = nil
+		a.flash = "Completing the sprint failed: " + msg.err.Error()
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.flash = ""
+	op.incomplete = msg.keys
+	if len(op.incomplete) == 0 {
+		return a.confirmSprint(), nil
+	}
+	var items []selectionItem
+	for _, s := range op.sprints {
+		if s.State == "future" {
+			items = append(items, selectionItem{ID: strconv.Itoa(s.ID), Label: "Move them to " + s.Name, Desc: sprintDates(s)})
+		}
+	}
+	items = append(items, selectionItem{Label: "Move them to the backlog"})
+	title := fmt.Sprintf("%s has %s", op.sprint.Name, unfinishedIssues(len(op.incomplete)))
+	a.overlay = newSelectionOverlay(title, items)
+	a.overlayAction = overlayActionSprintMove
+	return a, nil
+}
+
+// unfinishedIssues counts unfinished issues, e.g. "1 unfinished issue".
+func unfinishedIssues(n int) string {
+	if n == 1 {
+		return "1 unfinished issue"
+	}
+	return fmt.Sprintf("%d unfinished issues", n)
+}
+
+// handleSprintMove records where unfinished issues go and confirms.
+func (a App) handleSprintMove(item *selectionItem) (tea.Model, tea.Cmd) {
+	op := a.sprint
+	if op == nil {
+		return a, nil
+	}
+	for i, s := range op.sprints {
+		if item.ID != "" && strconv.Itoa(s.ID) == item.ID {
+			op.moveTo = &op.sprints[i]
+		}
+	}
+	return a.confirmSprint(), nil
+}
+
+// sprintEnd is when a sprint started now ends: its planned end if that's
+// still ahead, otherwise defaultSprintLength from now.
+func sprintEnd(s jira.Sprint, now time.Time) time.Time {
+	if end, err := time.Parse(time.RFC3339, s.EndDate); err == nil && end.After(now) {
+		return end
+	}
+	return now.Add(defaultSprintLength)
+}
+
+// confirmSprint spells out what will happen and asks for the sprint's name
+// to be typed, since starting or completing a sprint affects the whole team
+// and can't be undone from here.
+func (a App) confirmSprint() App {
+	op := a.sprint
+	var what string
+	switch {
+	case !op.complete:
+		what = fmt.Sprintf("Start %s, ending %s", op.sprint.Name, sprintEnd(op.sprint, a.now()).Format("Mon Jan 2"))
+	case len(op.incomplete) == 0:
+		what = "Complete " + op.sprint.Name
+	case op.moveTo != nil:
+		what = fmt.Sprintf("Complete %s and move %s to %s", op.sprint.Name, unfinishedIssues(len(op.incomplete)), op.moveTo.Name)
+	default:
+		what = fmt.Sprintf("Complete %s and move %s to the backlog", op.sprint.Name, unfinishedIssues(len(op.incomplete)))
+	}
+	a.overlay = newTextInputOverlay(fmt.Sprintf("%s? Type %q to confirm", what, op.sprint.Name), "")
+	a.overlayAction = overlayActionSprintConfirm
+	return a
+}
+
+// handleSprintConfirm starts or completes the sprint once its name was
+// typed exactly.
+func (a App) handleSprintConfirm(typed string) (tea.Model, tea.Cmd) {
+	op := a.sprint
+	if op == nil {
+		return a, nil
+	}
+	if strings.TrimSpace(typed) != op.sprint.Name {
+		a.sprint = nil
+		a.flash = "The name didn't match; " + op.sprint.Name + " is unchanged"
+		a.flashIsErr = true
+		return a, nil
+	}
+	client := a.client
+	sprint := op.sprint
+	if !op.complete {
+		now := a.now()
+		fields := map[string]interface{}{
+			"state":     "active",
+			"startDate": now.Format(time.RFC3339),
+			"endDate":   sprintEnd(sprint, now).Format(time.RFC3339),
+		}
+		a.flash = "Starting " + sprint.Name + "..."
+		a.flashIsErr = false
+		return a, a.startNetwork(func() tea.Msg {
+			return sprintDoneMsg{err: client.UpdateSprint(context.Background(), sprint.ID, fields)}
+		})
+	}
+
+	keys, moveTo := op.incomplete, op.moveTo
+	a.flash = "Completing " + sprint.Name + "..."
+	a.flashIsErr = false
+	return a, a.startNetwork(func() tea.Msg {
+		ctx := context.Background()
+		// Closing a sprint leaves its unfinished issues in the backlog, so
+		// move them first when they go to another sprint
+		var err error
+		switch {
+		case len(keys) == 0:
+		case moveTo != nil:
+			err = client.MoveIssuesToSprint(ctx, moveTo.ID, keys)
+		default:
+			err = client.MoveIssuesToBacklog(ctx, keys)
+		}
+		if err == nil {
+			err = client.UpdateSprint(ctx, sprint.ID, map[string]interface{}{"state": "closed"})
+		}
+		return sprintDoneMsg{err: err}
+	})
+}
+
+// handleSprintDone reports the outcome and reloads the active tab, whose
+// sprint query likely matches different issues now.
+func (a App) handleSprintDone(msg sprintDoneMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	op := a.sprint
+	a.sprint = nil
+	if op == nil {
+		return a, nil
+	}
+	doing, done := "Starting", "Started"
+	if op.complete {
+		doing, done = "Completing", "Completed"
+	}
+	if msg.err != nil {
+		a.flash = fmt.Sprintf("%s %s failed: %v", doing, op.sprint.Name, msg.err)
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.flash = done + " " + op.sprint.Name
+	a.flashIsErr = false
+	if a.connected && a.activeTab < len(a.tabs) {
+		return a, a.startNetwork(a.loadTab(a.activeTab))
+	}
+	return a, nil
+}
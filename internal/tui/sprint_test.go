@@ -0,0 +1,117 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func testSprints() []jira.Sprint {
+	return []jira.Sprint{
+		{ID: 7, Name: "Sprint 7", State: "active", StartDate: "2026-10-02T09:00:00Z", EndDate: "2026-10-16T17:00:00Z"},
+		{ID: 8, Name: "Sprint 8", State: "future", EndDate: "2026-10-23T17:00:00Z"},
+		{ID: 9, Name: "Sprint 9", State: "future"},
+	}
+}
+
+// testAppSprints is connected, on 2026-10-16, with the sprint picker open.
+func testAppSprints(t *testing.T) App {
+	t.Helper()
+	app := testAppConnected()
+	app.SetClock(&fakeClock{t: time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)})
+	model, cmd := app.Update(keyMsg("S"))
+	app = model.(App)
+	if cmd == nil || app.sprint == nil || app.sprint.project != "PROJ" {
+		t.Fatalf("S should load the project's boards, sprint = %+v", app.sprint)
+	}
+	model, _ = app.Update(sprintBoardsMsg{boards: []jira.Board{{ID: 3, Name: "PROJ board", Type: "scrum"}}})
+	app = model.(App)
+	model, _ = app.Update(sprintListMsg{sprints: testSprints()})
+	return model.(App)
+}
+
+func TestSprintActionItems(t *testing.T) {
+	items := sprintActionItems(testSprints())
+	var labels []string
+	for _, item := range items {
+		labels = append(labels, item.Label)
+	}
+	if got := strings.Join(labels, ","); got != "Complete Sprint 7,Start Sprint 8,Start Sprint 9" {
+		t.Errorf("items = %s", got)
+	}
+	if items[0].ID != "complete:7" || items[0].Desc != "Oct 2 – Oct 16" || items[1].Desc != "ends Oct 23" {
+		t.Errorf("first items = %+v", items[:2])
+	}
+}
+
+func TestStartSprint(t *testing.T) {
+	app := testAppSprints(t)
+	app = pickItem(t, app, "Start Sprint 9")
+	input, ok := app.overlay.(*textInputOverlay)
+	if !ok {
+		t.Fatalf("overlay = %T, want the typed confirmation", app.overlay)
+	}
+	if want := `Start Sprint 9, ending Fri Oct 30? Type "Sprint 9" to confirm`; input.title != want {
+		t.Errorf("title = %q, want %q", input.title, want)
+	}
+
+	model, cmd := app.handleOverlayResult("Sprint 8")
+	app = model.(App)
+	if cmd != nil || app.sprint != nil || !app.flashIsErr {
+		t.Errorf("a wrong name should change nothing, flash = %q", app.flash)
+	}
+}
+
+func TestStartSprintKeepsPlannedEnd(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	if got := sprintEnd(testSprints()[1], now); !got.Equal(time.Date(2026, 10, 23, 17, 0, 0, 0, time.UTC)) {
+		t.Errorf("end = %v, want the planned end", got)
+	}
+	if got := sprintEnd(testSprints()[0], now.Add(24*time.Hour)); !got.Equal(now.Add(24*time.Hour + defaultSprintLength)) {
+		t.Errorf("end = %v, want two weeks out when the planned end is past", got)
+	}
+}
+
+func TestCompleteSprint(t *testing.T) {
+	app := testAppSprints(t)
+	app = pickItem(t, app, "Complete Sprint 7")
+	if app.sprint == nil || !app.sprint.complete || app.sprint.sprint.ID != 7 {
+		t.Fatalf("sprint = %+v, want Sprint 7 being completed", app.sprint)
+	}
+
+	model, _ := app.Update(sprintIncompleteMsg{keys: []string{"PROJ-1", "PROJ-2"}})
+	app = model.(App)
+	sel := app.overlay.(*selectionOverlay)
+	if sel.title != "Sprint 7 has 2 unfinished issues" || len(sel.items) != 3 {
+		t.Errorf("move picker = %q %+v", sel.title, sel.items)
+	}
+	app = pickItem(t, app, "Move them to Sprint 8")
+	input := app.overlay.(*textInputOverlay)
+	if !strings.HasPrefix(input.title, "Complete Sprint 7 and move 2 unfinished issues to Sprint 8?") {
+		t.Errorf("title = %q", input.title)
+	}
+
+	model, cmd := app.handleOverlayResult("Sprint 7")
+	app = model.(App)
+	if cmd == nil || app.flash != "Completing Sprint 7..." {
+		t.Fatalf("typing the name should complete the sprint, flash = %q", app.flash)
+	}
+	model, _ = app.Update(sprintDoneMsg{})
+	app = model.(App)
+	if app.flash != "Completed Sprint 7" || app.sprint != nil {
+		t.Errorf("flash = %q", app.flash)
+	}
+}
+
+func TestSprintNoBoard(t *testing.T) {
+	app := testAppConnected()
+	app.sprint = &sprintOp{project: "PROJ"}
+	app.inflight = 1
+	model, _ := app.Update(sprintBoardsMsg{})
+	app = model.(App)
+	if app.sprint != nil || app.flash != "PROJ has no scrum board" {
+		t.Errorf("flash = %q", app.flash)
+	}
+}
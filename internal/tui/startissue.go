@@ -0,0 +1,20 @@
+package tui
+
+import (
+	"regexp"
+	"strings"
+)
+
+// issueKeyPattern matches an issue key such as PROJ-123.
+var issueKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*-[1-9][0-9]*$`)
+
+// IsIssueKey reports whether s looks like an issue key, ignoring case.
+func IsIssueKey(s string) bool {
+	return issueKeyPattern.MatchString(strings.ToUpper(s))
+}
+
+// SetStartIssue makes the app open the detail view of issueKey once it has
+// connected, instead of the first tab; esc then returns to the tabs.
+func (a *App) SetStartIssue(issueKey string) {
+	a.startIssue = strings.ToUpper(issueKey)
+}
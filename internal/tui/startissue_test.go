@@ -0,0 +1,41 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestIsIssueKey(t *testing.T) {
+	for _, s := range []string{"PROJ-123", "proj-1", "AB_2-99"} {
+		if !IsIssueKey(s) {
+			t.Errorf("IsIssueKey(%q) = false", s)
+		}
+	}
+	for _, s := range []string{"PROJ", "PROJ-", "PROJ-0", "1PROJ-2", "list", "PROJ-12x"} {
+		if IsIssueKey(s) {
+			t.Errorf("IsIssueKey(%q) = true", s)
+		}
+	}
+}
+
+func TestStartIssueOpensDetail(t *testing.T) {
+	app := testAppConnected()
+	app.SetStartIssue("proj-7")
+
+	model, _ := app.Update(connStatusMsg{user: &jira.User{DisplayName: "Me"}})
+	app = model.(App)
+	dv := app.topDetail("PROJ-7")
+	if dv == nil {
+		t.Fatalf("expected the PROJ-7 detail view, stack = %v", app.viewStack)
+	}
+	if app.startIssue != "" {
+		t.Error("the start issue should open only once")
+	}
+
+	model, _ = app.Update(keyMsg("esc"))
+	app = model.(App)
+	if len(app.viewStack) != 0 {
+		t.Error("esc should return to the tabs")
+	}
+}
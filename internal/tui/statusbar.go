@@ -0,0 +1,149 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// defaultStatusBar is what the status bar shows without a status_bar
+// config: refresh and counts then only appear when there's something to
+// act on.
+var defaultStatusBar = []string{"user", "connection", "refresh", "counts", "message", "keys"}
+
+// maxStatusJQL is how much of the tab's query the filter segment shows.
+const maxStatusJQL = 60
+
+// SetStatusBar sets the status bar's segments, in order; empty keeps the
+// default.
+func (a *App) SetStatusBar(segments []string) {
+	a.statusBar = segments
+}
+
+// statusBarTicks reports whether the status bar shows a time that needs
+// redrawing as it passes.
+func (a App) statusBarTicks() bool {
+	for _, s := range a.statusBar {
+		if s == "clock" || s == "refresh" {
+			return true
+		}
+	}
+	return false
+}
+
+// renderStatusBar draws the bottom help/status line.
+func (a App) renderStatusBar() string {
+	segments, chosen := a.statusBar, len(a.statusBar) > 0
+	if !chosen {
+		segments = defaultStatusBar
+	}
+	var parts []string
+	for _, s := range segments {
+		parts = append(parts, a.statusSegment(s, chosen)...)
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		strings.Join(parts, helpStyle.Render("  │  ")),
+	)
+}
+
+// statusSegment renders one status bar segment, as none, one, or more
+// parts. A segment the config chose shows even when there's nothing
+// pressing about it: every tab's refresh time, and its issue count.
+func (a App) statusSegment(name string, chosen bool) []string {
+	onList := len(a.viewStack) == 0 && a.activeTab < len(a.tabs)
+	switch name {
+	case "user":
+		if a.user != nil {
+			return []string{a.renderAccount()}
+		}
+
+	case "connection":
+		var parts []string
+		if a.readOnly {
+			parts = append(parts, loadingStyle.Render("read-only"))
+		}
+		if a.offline {
+			parts = append(parts, errorStyle.Render(fmt.Sprintf("offline · %d queued", len(a.failed))))
+		} else if len(a.failed) > 0 {
+			parts = append(parts, errorStyle.Render(fmt.Sprintf("%d failed · ctrl+r retries · ctrl+p lists", len(a.failed))))
+		}
+		return parts
+
+	case "refresh":
+		if !onList {
+			return nil
+		}
+		// Cached results still waiting for their refresh
+		t := a.tabs[a.activeTab]
+		if t.stale {
+			return []string{loadingStyle.Render("stale · cached " + timeAgo(t.fetchedAt, a.now()))}
+		}
+		// Auto-refreshing tabs show how current the list is
+		if (chosen || t.refreshEvery > 0) && !t.fetchedAt.IsZero() {
+			return []string{helpStyle.Render("updated " + timeAgo(t.fetchedAt, a.now()))}
+		}
+
+	case "counts":
+		if !onList {
+			return nil
+		}
+		t := a.tabs[a.activeTab]
+		// Results cut off at a page boundary
+		if t.nextPage != "" {
+			return []string{loadingStyle.Render(fmt.Sprintf("%d shown +more · M: load all", len(t.issues)))}
+		}
+		if chosen && t.hasData() {
+			count := fmt.Sprintf("%d issues", len(t.issues))
+			if shown := len(t.quickFilter.visibleIssues(t.issues)); shown != len(t.issues) {
+				count = fmt.Sprintf("%d of %d issues", shown, len(t.issues))
+			}
+			return []string{helpStyle.Render(count)}
+		}
+
+	case "filter":
+		if onList && a.tabs[a.activeTab].search.JQL != "" {
+			return []string{helpStyle.Render(truncateRunes(a.tabs[a.activeTab].search.JQL, maxStatusJQL))}
+		}
+
+	case "clock":
+		return []string{helpStyle.Render(a.now().Format("15:04"))}
+
+	case "message":
+		// Startup warnings, then the flash message (transient feedback)
+		var parts []string
+		if len(a.warnings) > 0 {
+			parts = append(parts, errorStyle.Render(strings.Join(a.warnings, "; ")))
+		}
+		if a.flash != "" {
+			if a.flashIsErr {
+				parts = append(parts, errorStyle.Render(a.flash))
+			} else {
+				parts = append(parts, successStyle.Render(a.flash))
+			}
+		}
+		return parts
+
+	case "keys":
+		return []string{helpStyle.Render(a.keyHints())}
+	}
+	return nil
+}
+
+// keyHints returns the keys most useful in the current view.
+func (a App) keyHints() string {
+	switch {
+	case len(a.viewStack) > 0:
+		if _, ok := a.viewStack[len(a.viewStack)-1].(*timelineView); ok {
+			return "h/l: week  H/L: month  t: today  enter: open  esc: back"
+		}
+		return "enter: related  m: comment  d: done  del: delete  q: quit"
+	case a.activeTab < len(a.tabs) && a.tabs[a.activeTab].inline != nil:
+		return "editing title  enter: save  esc: cancel"
+	case a.selectionCount() > 0:
+		return fmt.Sprintf("%d selected  space: toggle  V: range  s/p/a/L/d/i: apply  esc: clear", a.selectionCount())
+	case a.activeTab < len(a.tabs) && a.tabs[a.activeTab].temporary:
+		return ":: search  x: close  /: filter  o: open  q: quit"
+	}
+	return "/: filter  :: search  c: create  o: open  q: quit"
+}
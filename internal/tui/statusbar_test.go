@@ -0,0 +1,45 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStatusBarSegments(t *testing.T) {
+	app := testAppReady()
+	app.SetClock(&fakeClock{t: time.Date(2026, 10, 16, 9, 5, 0, 0, time.Local)})
+	app.tabs[0].fetchedAt = app.now().Add(-2 * time.Minute)
+	app.tabs[0].search.JQL = "project = PROJ ORDER BY Rank"
+	app.flash = "Saved"
+	app.SetStatusBar([]string{"clock", "counts", "filter", "refresh", "message"})
+
+	got := app.renderStatusBar()
+	want := "09:05  │  3 issues  │  project = PROJ ORDER BY Rank  │  updated 2m ago  │  Saved"
+	if got != want {
+		t.Errorf("status bar = %q, want %q", got, want)
+	}
+	if !app.statusBarTicks() {
+		t.Error("a clock should be redrawn as time passes")
+	}
+
+	// The quick filter narrows the count; key hints are left out
+	tab := &app.tabs[0]
+	tab.quickFilter.input.SetValue("login")
+	tab.quickFilter.apply(tab.issues, tab.columns)
+	if got := app.renderStatusBar(); !strings.Contains(got, "1 of 3 issues") || strings.Contains(got, "q: quit") {
+		t.Errorf("status bar = %q", got)
+	}
+}
+
+func TestStatusBarDefault(t *testing.T) {
+	app := testAppReady()
+	app.tabs[0].fetchedAt = time.Now()
+	got := app.renderStatusBar()
+	if strings.Contains(got, "updated") || strings.Contains(got, "issues") || !strings.Contains(got, "q: quit") {
+		t.Errorf("status bar = %q, want only what needs attention and the keys", got)
+	}
+	if app.statusBarTicks() {
+		t.Error("the default bar has no clock to redraw")
+	}
+}
@@ -64,4 +64,14 @@ var (
 
 	filterCountStyle = lipgloss.NewStyle().
 				Foreground(lipgloss.Color("241"))
+
+	// Mentions of the current user in descriptions and comments
+	mentionMeStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("11")) // yellow
+
+	// Issue keys in descriptions and comments, openable with enter
+	issueLinkStyle = lipgloss.NewStyle().
+			Underline(true).
+			Foreground(lipgloss.Color("12")) // blue
 )
@@ -0,0 +1,49 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// startCreateChild opens the create form for a child of parent: a
+// subtask, or for an epic a standard issue in the epic.
+func (a App) startCreateChild(parent *jira.Issue) (tea.Model, tea.Cmd) {
+	if a.client == nil {
+		a.flash = "Not connected to Jira"
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.createParent = parent.Key
+	a.createSubtask = !isEpic(parent)
+	title := "New Subtask of " + parent.Key
+	if !a.createSubtask {
+		title = "New Issue in " + parent.Key
+	}
+	return a.startCreate(title)
+}
+
+// isEpic reports whether issue is an epic, whose children are standard
+// issues rather than subtasks.
+func isEpic(issue *jira.Issue) bool {
+	return issue.Fields.IssueType != nil && strings.EqualFold(issue.Fields.IssueType.Name, "Epic")
+}
+
+// withoutEpics drops the Epic type, which can't be a child of an epic.
+func withoutEpics(types []jira.IssueType) []jira.IssueType {
+	var out []jira.IssueType
+	for _, t := range types {
+		if !strings.EqualFold(t.Name, "Epic") {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// projectKeyOf returns the project key prefix of an issue key ("PROJ-12" → "PROJ").
+func projectKeyOf(issueKey string) string {
+	project, _, _ := strings.Cut(issueKey, "-")
+	return project
+}
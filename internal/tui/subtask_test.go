@@ -0,0 +1,76 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestCreateChildFromDetailView(t *testing.T) {
+	tests := []struct {
+		name        string
+		issueType   string
+		wantSubtask bool
+		wantTitle   string
+	}{
+		{"story gets a subtask", "Story", true, "New Subtask of PROJ-1"},
+		{"epic gets a child issue", "Epic", false, "New Issue in PROJ-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := testAppReady()
+			app.client = jira.NewClient("https://fake.atlassian.net", "test@test.com", "token")
+			issue := jira.Issue{Key: "PROJ-1", Fields: jira.IssueFields{IssueType: &jira.Named{Name: tt.issueType}}}
+			dv := newIssueDetailViewReady(issue, app.width, app.height)
+			app.viewStack = append(app.viewStack, &dv)
+
+			model, _ := app.Update(keyMsg("c"))
+			app = model.(App)
+			form, ok := app.overlay.(*createFormOverlay)
+			if !ok {
+				t.Fatalf("expected createFormOverlay, got %T", app.overlay)
+			}
+			if form.title != tt.wantTitle {
+				t.Errorf("title = %q, want %q", form.title, tt.wantTitle)
+			}
+			if form.field("parent").kind != formFixed || (form.field("sprint") == nil) != tt.wantSubtask {
+				t.Error("the parent should be fixed, and subtasks offer no sprint")
+			}
+			if app.createParent != "PROJ-1" || app.createSubtask != tt.wantSubtask {
+				t.Errorf("createParent=%q createSubtask=%v", app.createParent, app.createSubtask)
+			}
+		})
+	}
+}
+
+func TestChildCreatedRefreshesParent(t *testing.T) {
+	app := testAppReady()
+	app.client = jira.NewClient("https://fake.atlassian.net", "test@test.com", "token")
+	dv := newIssueDetailViewReady(jira.Issue{Key: "PROJ-1"}, app.width, app.height)
+	app.viewStack = append(app.viewStack, &dv)
+	app.inflight = 1 // the create request
+
+	model, cmd := app.Update(issueCreatedMsg{issueKey: "PROJ-9", parentKey: "PROJ-1"})
+	app = model.(App)
+	if len(app.viewStack) != 1 {
+		t.Errorf("expected to stay on the parent, stack depth %d", len(app.viewStack))
+	}
+	if cmd == nil {
+		t.Fatal("expected cmds to refresh the parent")
+	}
+	if !dv.childrenLoading || !dv.dirty {
+		t.Error("expected children to reload and the parent to be marked dirty")
+	}
+	if app.inflight != 2 {
+		t.Errorf("inflight = %d, want 2 for the parent and its children", app.inflight)
+	}
+	if app.flash != "Created PROJ-9 under PROJ-1" {
+		t.Errorf("flash = %q", app.flash)
+	}
+}
+
+func TestProjectKeyOf(t *testing.T) {
+	if got := projectKeyOf("PROJ-12"); got != "PROJ" {
+		t.Errorf("projectKeyOf = %q, want PROJ", got)
+	}
+}
@@ -1,7 +1,10 @@
 package tui
 
 import (
+	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/charmbracelet/bubbles/table"
 	"github.com/jbeckham/jira-tui/internal/config"
@@ -18,17 +21,56 @@ const (
 	tabEmpty
 )
 
+// lastTabID numbers tabs as they're created.
+var lastTabID atomic.Int64
+
 // tab holds the state for a single filter-backed tab.
 type tab struct {
+	id             int // tells tabs apart as they're opened, closed, and moved
 	config         config.TabConfig
 	table          table.Model
 	issues         []jira.Issue
 	state          tabState
 	errMsg         string
-	jiraFilter     *jira.Filter // the resolved filter (contains JQL)
-	columns        []string     // column names from config
-	quickFilter    issueFilter  // client-side quick filter
-	statusReplacer *strings.Replacer // post-render status colorizer
+	jiraFilter     *jira.Filter       // the resolved filter (contains JQL)
+	columns        []string           // column names from config
+	widths         map[string]int     // column → fixed width; others auto-size
+	quickFilter    issueFilter        // client-side quick filter
+	statusReplacer *strings.Replacer  // post-render status colorizer
+	selected       map[string]bool    // multi-selected issue keys
+	anchor         int                // visible row of the last toggle (range start)
+	temporary      bool               // ad-hoc search tab, not from config
+	rankField      string             // Rank field id, set when the tab has a rank column
+	rankPos        map[string]int     // issue key → board position for the rank column
+	workflows      *workflowCache     // transitions for the next column, shared with the app
+	clock          Clock              // decides which due dates are past, nil for the wall clock
+	fetchedAt      time.Time          // when the results were fetched, zero if never
+	stale          bool               // cached results older than the TTL, refresh pending
+	refreshEvery   time.Duration      // background reload interval, zero if off
+	refreshSeq     int                // generation of the pending refresh tick
+	changed        map[string]bool    // keys added or changed by the last refresh
+	lastChanges    issueChanges       // what the last refresh that changed anything changed
+	changedSeq     int                // generation of the pending highlight expiry
+	sortCol        int                // 1-based column sorted by, 0 for the query's order
+	sortDesc       bool               // sortCol is sorted descending
+	queryOrder     map[string]int     // issue key → position Jira returned it in
+	groupBy        string             // field the rows are grouped by, "" for none
+	collapsed      map[string]bool    // groups whose issues are hidden
+	tree           bool               // issues shown under their parents, overriding groupBy
+	folded         map[string]bool    // tree issues whose children are hidden
+	entries        []listEntry        // what each table row shows, in row order
+	inline         *inlineEdit        // summary being edited in its row, nil if none
+	jqlChecked     bool               // the query passed the JQL check on first load
+	keepFilter     bool               // the quick filter survives leaving the tab and new results
+	search         jira.SearchOptions // the last search, for loading the rest of a truncated result
+	nextPage       string             // token for results past those shown, "" when complete
+	fetchAll       bool               // the remainder was loaded, so reloads fetch every page
+	width          int                // visible width; a scrolling tab's table may be wider
+	hscroll        int                // columns after the first panned past, in a scrolling tab
+	fileLabel      string             // label in config.yaml, "" for tabs opened while running
+	autoLabel      bool               // named after its query until its filter's name is known
+	seen           map[string]bool    // keys in the results last looked at, nil until loaded
+	seenAt         time.Time          // fetchedAt of the results last looked at
 }
 
 // newTab creates a tab from a TabConfig. The table is initialized empty;
@@ -44,42 +86,98 @@ func newTab(cfg config.TabConfig) tab {
 	s.Cell = tableCellStyle
 	t.SetStyles(s)
 
+	// Validated when the config is loaded
+	every, _ := cfg.RefreshDuration()
+
 	return tab{
-		config:      cfg,
-		table:       t,
-		state:       tabLoading,
-		columns:     cfg.Columns,
-		quickFilter: newIssueFilter(),
+		id:           int(lastTabID.Add(1)),
+		config:       cfg,
+		fileLabel:    cfg.Label,
+		table:        t,
+		state:        tabLoading,
+		columns:      cfg.Columns,
+		widths:       cfg.Widths,
+		quickFilter:  newIssueFilter(),
+		refreshEvery: every,
+		groupBy:      cfg.GroupBy,
+		tree:         cfg.Tree,
 	}
 }
 
+// tabIndexFor returns where the tab a message was meant for is now, or -1
+// once it has been closed or replaced. Messages without a tab id go by
+// index.
+func (a App) tabIndexFor(index, id int) int {
+	if id == 0 {
+		return index
+	}
+	if index >= 0 && index < len(a.tabs) && a.tabs[index].id == id {
+		return index
+	}
+	for i := range a.tabs {
+		if a.tabs[i].id == id {
+			return i
+		}
+	}
+	return -1
+}
+
 // setSize updates the table dimensions.
 func (t *tab) setSize(width, height int) {
-	cols := buildColumns(t.columns, width)
-	t.table.SetColumns(cols)
-	t.table.SetWidth(width)
+	t.width = width
+	t.layoutColumns()
 	t.table.SetHeight(height)
 
 	// Re-render rows with new column widths if we have data
 	if t.state == tabReady {
-		t.table.SetRows(issuesToRows(t.issues, t.columns))
+		t.setRows(t.quickFilter.visibleIssues(t.issues))
 	}
 }
 
 // setIssues populates the tab with search results.
 func (t *tab) setIssues(issues []jira.Issue) {
 	t.issues = issues
-	t.quickFilter.clear()
+	t.rankPos = rankPositions(issues, t.rankField)
+	t.setQueryOrder(issues)
+	if t.keepFilter && t.quickFilter.isActive() {
+		t.quickFilter.updateQuery(issues, t.columns)
+	} else {
+		t.quickFilter.clear()
+	}
 	t.statusReplacer = buildStatusReplacer(issues)
+	t.pruneSelection()
 	if len(issues) == 0 {
 		t.state = tabEmpty
 	} else {
 		t.state = tabReady
-		t.table.SetRows(issuesToRows(issues, t.columns))
+		t.setRows(t.quickFilter.visibleIssues(issues))
 		t.table.GotoTop()
 	}
 }
 
+// refreshIssues swaps in reloaded results without disturbing the user: an
+// active quick filter is reapplied and the cursor stays on the same issue.
+func (t *tab) refreshIssues(issues []jira.Issue) {
+	selectedKey := ""
+	if sel := t.selectedIssue(); sel != nil {
+		selectedKey = sel.Key
+	}
+	t.issues = issues
+	t.rankPos = rankPositions(issues, t.rankField)
+	t.setQueryOrder(issues)
+	t.statusReplacer = buildStatusReplacer(issues)
+	t.pruneSelection()
+	if t.quickFilter.isActive() {
+		t.quickFilter.updateQuery(issues, t.columns)
+	}
+	if len(issues) == 0 {
+		t.state = tabEmpty
+		return
+	}
+	t.state = tabReady
+	t.applyFilterKeepCursor(selectedKey)
+}
+
 // setError marks the tab as having an error.
 func (t *tab) setError(msg string) {
 	t.state = tabError
@@ -92,24 +190,23 @@ func (t *tab) setLoading() {
 	t.issues = nil
 }
 
-// selectedIssue returns the issue at the cursor, or nil.
-// When a quick filter is active, the cursor indexes into the filtered list.
+// selectedIssue returns the issue at the cursor, or nil, including when
+// the cursor is on a group header. When a quick filter is active, the
+// cursor indexes into the filtered list.
 func (t *tab) selectedIssue() *jira.Issue {
 	if t.state != tabReady || len(t.issues) == 0 {
 		return nil
 	}
-	visible := t.quickFilter.visibleIssues(t.issues)
 	idx := t.table.Cursor()
-	if idx >= 0 && idx < len(visible) {
-		return &visible[idx]
+	if idx >= 0 && idx < len(t.entries) {
+		return t.entries[idx].issue
 	}
 	return nil
 }
 
 // applyFilter updates the table rows based on the current quick filter.
 func (t *tab) applyFilter() {
-	visible := t.quickFilter.visibleIssues(t.issues)
-	t.table.SetRows(issuesToRows(visible, t.columns))
+	t.setRows(t.quickFilter.visibleIssues(t.issues))
 	t.table.GotoTop()
 }
 
@@ -117,21 +214,20 @@ func (t *tab) applyFilter() {
 // If the previously selected issue is still visible, the cursor stays on it.
 // Otherwise the cursor stays at the same numeric index (clamped to bounds).
 func (t *tab) applyFilterKeepCursor(selectedKey string) {
-	visible := t.quickFilter.visibleIssues(t.issues)
 	oldCursor := t.table.Cursor()
-	t.table.SetRows(issuesToRows(visible, t.columns))
+	t.setRows(t.quickFilter.visibleIssues(t.issues))
 
 	// Try to find the previously selected issue by key
-	for i, issue := range visible {
-		if issue.Key == selectedKey {
+	for i, e := range t.entries {
+		if e.issue != nil && e.issue.Key == selectedKey {
 			t.table.SetCursor(i)
 			return
 		}
 	}
 
 	// Fall back to same index, clamped
-	if oldCursor >= len(visible) {
-		oldCursor = len(visible) - 1
+	if oldCursor >= len(t.entries) {
+		oldCursor = len(t.entries) - 1
 	}
 	if oldCursor < 0 {
 		oldCursor = 0
@@ -142,15 +238,174 @@ func (t *tab) applyFilterKeepCursor(selectedKey string) {
 // clearFilter removes the quick filter and restores the full issue list.
 func (t *tab) clearFilter() {
 	t.quickFilter.clear()
-	t.table.SetRows(issuesToRows(t.issues, t.columns))
+	t.setRows(t.issues)
 	t.table.GotoTop()
 }
 
+// selectionMarker prefixes the first cell of multi-selected rows.
+const selectionMarker = "● "
+
+// defaultDescriptionLimit is how many characters of the description the
+// description column shows when the tab doesn't set description_limit.
+const defaultDescriptionLimit = 80
+
+// rows converts issues to table rows, marking multi-selected issues and
+// those changed by the last refresh.
+func (t *tab) rows(issues []jira.Issue) []table.Row {
+	rows := issuesToRows(issues, t.columns)
+	if t.rankPos != nil {
+		for j, col := range t.columns {
+			if col != "rank" {
+				continue
+			}
+			for i, issue := range issues {
+				if pos, ok := t.rankPos[issue.Key]; ok {
+					rows[i][j] = strconv.Itoa(pos)
+				}
+			}
+		}
+	}
+	for j, col := range t.columns {
+		switch col {
+		case nextColumn:
+			for i, issue := range issues {
+				rows[i][j] = t.workflows.nextLabel(issue)
+			}
+		case "duedate", "due_date", "due date", "due":
+			now := clockNow(t.clock)
+			for i, issue := range issues {
+				if isOverdue(issue, now) {
+					rows[i][j] = overdueMarker + rows[i][j]
+				}
+			}
+		case "description":
+			limit := t.config.DescriptionLimit
+			if limit == 0 {
+				limit = defaultDescriptionLimit
+			}
+			for i := range issues {
+				rows[i][j] = truncateRunes(rows[i][j], limit)
+			}
+		}
+	}
+	if t.inline != nil {
+		cols := t.table.Columns()
+		for j, col := range t.columns {
+			if col != "summary" || j >= len(cols) {
+				continue
+			}
+			for i, issue := range issues {
+				if issue.Key == t.inline.issueKey {
+					rows[i][j] = t.inline.cell(cols[j].Width)
+				}
+			}
+		}
+	}
+	if len(t.selected) == 0 && len(t.changed) == 0 {
+		return rows
+	}
+	for i, issue := range issues {
+		if len(rows[i]) == 0 {
+			continue
+		}
+		if t.selected[issue.Key] {
+			rows[i][0] = selectionMarker + rows[i][0]
+		}
+		if t.changed[issue.Key] {
+			rows[i][0] = changedMarker + rows[i][0]
+		}
+	}
+	return rows
+}
+
+// refreshRows re-renders the visible rows without moving the cursor.
+func (t *tab) refreshRows() {
+	t.setRows(t.quickFilter.visibleIssues(t.issues))
+}
+
+// toggleSelected flips the multi-select mark on the issue under the cursor
+// and makes that row the anchor for range selection.
+func (t *tab) toggleSelected() {
+	issue := t.selectedIssue()
+	if issue == nil {
+		return
+	}
+	if t.selected == nil {
+		t.selected = make(map[string]bool)
+	}
+	if t.selected[issue.Key] {
+		delete(t.selected, issue.Key)
+	} else {
+		t.selected[issue.Key] = true
+	}
+	t.anchor = t.table.Cursor()
+	t.refreshRows()
+}
+
+// selectRange marks every visible issue between the anchor and the cursor.
+func (t *tab) selectRange() {
+	if t.state != tabReady || len(t.entries) == 0 {
+		return
+	}
+	from, to := t.anchor, t.table.Cursor()
+	if from > to {
+		from, to = to, from
+	}
+	from = max(from, 0)
+	to = min(to, len(t.entries)-1)
+	if t.selected == nil {
+		t.selected = make(map[string]bool)
+	}
+	for i := from; i <= to; i++ {
+		if issue := t.entries[i].issue; issue != nil {
+			t.selected[issue.Key] = true
+		}
+	}
+	t.anchor = t.table.Cursor()
+	t.refreshRows()
+}
+
+// clearSelection drops all multi-select marks.
+func (t *tab) clearSelection() {
+	t.selected = nil
+	t.anchor = 0
+	if t.state == tabReady {
+		t.refreshRows()
+	}
+}
+
+// pruneSelection drops marks for issues no longer in the tab.
+func (t *tab) pruneSelection() {
+	if len(t.selected) == 0 {
+		return
+	}
+	present := make(map[string]bool, len(t.issues))
+	for _, issue := range t.issues {
+		present[issue.Key] = true
+	}
+	for key := range t.selected {
+		if !present[key] {
+			delete(t.selected, key)
+		}
+	}
+}
+
+// selectedKeys returns the multi-selected issue keys in list order.
+func (t *tab) selectedKeys() []string {
+	var keys []string
+	for _, issue := range t.issues {
+		if t.selected[issue.Key] {
+			keys = append(keys, issue.Key)
+		}
+	}
+	return keys
+}
+
 // detailBaseFields are the Jira API field names always requested so the detail
 // view can render partial data immediately when opened from the list.
 var detailBaseFields = []string{
 	"summary", "status", "priority", "issuetype", "assignee",
-	"reporter", "project", "created", "updated", "duedate",
+	"reporter", "project", "created", "updated", "duedate", "labels",
 }
 
 // mergeSearchFields combines configured columns with the base fields needed by
@@ -165,8 +420,8 @@ func mergeSearchFields(columns []string) []string {
 			f = "issuetype"
 		case "due_date", "due date", "due":
 			f = "duedate"
-		case "key":
-			return // key is always returned by the API
+		case "key", "rank", nextColumn:
+			return // key is always returned; rank is added by field id; next is derived
 		}
 		if !seen[f] {
 			seen[f] = true
@@ -183,7 +438,8 @@ func mergeSearchFields(columns []string) []string {
 }
 
 // issuesToRows converts issues to table rows based on the configured columns.
-// Priority columns display a colored icon instead of text.
+// Priority columns display a colored icon instead of text, and statuses
+// their icon from the theme, if any.
 func issuesToRows(issues []jira.Issue, columns []string) []table.Row {
 	rows := make([]table.Row, len(issues))
 	for i, issue := range issues {
@@ -191,6 +447,8 @@ func issuesToRows(issues []jira.Issue, columns []string) []table.Row {
 		for j, col := range columns {
 			if col == "priority" && issue.Fields.Priority != nil {
 				row[j] = priorityIcon(issue.Fields.Priority.Name)
+			} else if col == "status" && issue.Fields.Status != nil {
+				row[j] = statusLabel(issue.Fields.Status.Name)
 			} else {
 				row[j] = fieldValue(issue, col)
 			}
@@ -207,6 +465,8 @@ func fieldValue(issue jira.Issue, column string) string {
 		return issue.Key
 	case "summary":
 		return issue.Fields.Summary
+	case "description":
+		return firstLine(extractADFText(issue.Fields.Description))
 	case "status":
 		if issue.Fields.Status != nil {
 			return issue.Fields.Status.Name
@@ -241,6 +501,26 @@ func fieldValue(issue jira.Issue, column string) string {
 	return ""
 }
 
+// firstLine returns the first non-blank line of text, trimmed.
+func firstLine(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// truncateRunes shortens s to at most limit characters, ending in "…" when
+// it cuts.
+func truncateRunes(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit-1]) + "…"
+}
+
 // formatDate trims a Jira datetime to just the date portion.
 func formatDate(dt string) string {
 	if len(dt) >= 10 {
@@ -429,3 +429,102 @@ func TestMergeSearchFields(t *testing.T) {
 		}
 	})
 }
+
+// --- Multi-select tests ---
+
+func testSelectTab() tab {
+	tab := newTab(config.TabConfig{Label: "Sel", FilterID: "1", Columns: []string{"key", "summary"}})
+	tab.setSize(100, 20)
+	tab.setIssues([]jira.Issue{
+		{Key: "S-1", Fields: jira.IssueFields{Summary: "One"}},
+		{Key: "S-2", Fields: jira.IssueFields{Summary: "Two"}},
+		{Key: "S-3", Fields: jira.IssueFields{Summary: "Three"}},
+		{Key: "S-4", Fields: jira.IssueFields{Summary: "Four"}},
+	})
+	return tab
+}
+
+func TestTabToggleSelected(t *testing.T) {
+	tab := testSelectTab()
+	tab.table.SetCursor(1)
+	tab.toggleSelected()
+
+	if keys := tab.selectedKeys(); len(keys) != 1 || keys[0] != "S-2" {
+		t.Fatalf("expected [S-2] selected, got %v", keys)
+	}
+	if got := tab.table.Rows()[1][0]; got != selectionMarker+"S-2" {
+		t.Errorf("expected selected row to be marked, got %q", got)
+	}
+	if tab.table.Cursor() != 1 {
+		t.Errorf("expected cursor to stay on row 1, got %d", tab.table.Cursor())
+	}
+
+	tab.toggleSelected()
+	if len(tab.selectedKeys()) != 0 {
+		t.Errorf("expected toggle to deselect, got %v", tab.selectedKeys())
+	}
+}
+
+func TestTabSelectRange(t *testing.T) {
+	tab := testSelectTab()
+	tab.table.SetCursor(3)
+	tab.toggleSelected()
+	tab.table.SetCursor(1)
+	tab.selectRange()
+
+	keys := tab.selectedKeys()
+	want := []string{"S-2", "S-3", "S-4"}
+	if len(keys) != len(want) {
+		t.Fatalf("expected %v, got %v", want, keys)
+	}
+	for i := range want {
+		if keys[i] != want[i] {
+			t.Errorf("keys[%d] = %s, want %s", i, keys[i], want[i])
+		}
+	}
+}
+
+func TestTabSetIssuesPrunesSelection(t *testing.T) {
+	tab := testSelectTab()
+	tab.toggleSelected() // S-1
+	tab.table.SetCursor(2)
+	tab.toggleSelected() // S-3
+
+	tab.setIssues([]jira.Issue{{Key: "S-3", Fields: jira.IssueFields{Summary: "Three"}}})
+	if keys := tab.selectedKeys(); len(keys) != 1 || keys[0] != "S-3" {
+		t.Errorf("expected only S-3 to stay selected, got %v", keys)
+	}
+
+	tab.clearSelection()
+	if len(tab.selectedKeys()) != 0 {
+		t.Error("expected clearSelection to drop all marks")
+	}
+}
+
+// --- Description column ---
+
+func TestDescriptionColumn(t *testing.T) {
+	issue := jira.Issue{Key: "D-1", Fields: jira.IssueFields{
+		Summary:     "Desc",
+		Description: makeADFDocument("\nSteps to reproduce the crash\nThen more"),
+	}}
+	if got := fieldValue(issue, "description"); got != "Steps to reproduce the crash" {
+		t.Errorf("fieldValue = %q, want the first line", got)
+	}
+
+	tab := newTab(config.TabConfig{Label: "D", JQL: "x", Columns: []string{"key", "description"}, DescriptionLimit: 8})
+	tab.setSize(100, 20)
+	tab.setIssues([]jira.Issue{issue, {Key: "D-2"}})
+	rows := tab.table.Rows()
+	if rows[0][1] != "Steps t…" {
+		t.Errorf("cell = %q, want it cut to 8 characters", rows[0][1])
+	}
+	if rows[1][1] != "" {
+		t.Errorf("no description should show an empty cell, got %q", rows[1][1])
+	}
+
+	fields := mergeSearchFields([]string{"key", "description"})
+	if fields[0] != "description" {
+		t.Errorf("fields = %v, want description fetched", fields)
+	}
+}
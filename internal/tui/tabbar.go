@@ -0,0 +1,128 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SetTabBar sets what the tab bar shows beside each label, one of
+// config.TabBadges ("" shows counts), and how old a tab's results get
+// before it shows their age; zero never does.
+func (a *App) SetTabBar(badges string, staleAfter time.Duration) {
+	a.tabBadges = badges
+	a.staleAfter = staleAfter
+}
+
+// renderTabBar draws the tab strip across the top.
+func (a App) renderTabBar() string {
+	if len(a.tabs) == 0 {
+		return ""
+	}
+
+	var tabs []string
+	for i := range a.tabs {
+		t := &a.tabs[i]
+		label := fmt.Sprintf(" %d %s", i+1, t.config.Label)
+		if badge := a.tabBadge(t, i == a.activeTab); badge != "" {
+			label += " " + badge
+		}
+		label += " "
+		if i == a.activeTab {
+			tabs = append(tabs, activeTabStyle.Render(label))
+		} else {
+			tabs = append(tabs, inactiveTabStyle.Render(label))
+		}
+	}
+	return tabBarStyle.Render(lipgloss.JoinHorizontal(lipgloss.Top, tabs...))
+}
+
+// tabBadge is what the tab bar shows beside a tab's label: how many issues
+// it has, with a + when there are more to load, how many of them are new
+// since the tab was last looked at, and how old they are once stale.
+func (a App) tabBadge(t *tab, active bool) string {
+	if !t.hasData() {
+		return ""
+	}
+	var parts []string
+	if a.tabBadges != "none" {
+		count := fmt.Sprint(len(t.issues))
+		if t.nextPage != "" {
+			count += "+"
+		}
+		parts = append(parts, count)
+	}
+	if a.tabBadges == "new" && !active {
+		if n := t.unseen(); n > 0 {
+			parts = append(parts, fmt.Sprintf("%d new", n))
+		}
+	}
+	if a.tabStale(t) {
+		parts = append(parts, timeAgo(t.fetchedAt, a.now()))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// tabStale reports whether a tab's results are cached ones waiting for
+// their refresh, or older than staleAfter.
+func (a App) tabStale(t *tab) bool {
+	if t.fetchedAt.IsZero() {
+		return false
+	}
+	return t.stale || (a.staleAfter > 0 && a.now().Sub(t.fetchedAt) >= a.staleAfter)
+}
+
+// refreshAllTabs reloads every tab in the background, as an auto-refresh
+// does: each keeps its results on screen until the new ones land.
+func (a App) refreshAllTabs() (tea.Model, tea.Cmd) {
+	if !a.connected {
+		return a, nil
+	}
+	var cmds []tea.Cmd
+	for i := range a.tabs {
+		t := &a.tabs[i]
+		if t.state == tabLoading {
+			continue
+		}
+		if !t.hasData() {
+			t.setLoading()
+		}
+		cmds = append(cmds, a.startNetwork(a.loadTab(i)))
+	}
+	a.flash = fmt.Sprintf("Refreshing %d tabs", len(cmds))
+	a.flashIsErr = false
+	return a, tea.Batch(cmds...)
+}
+
+// markSeen takes the active tab's results as looked at, and the first
+// results of every other tab as what later ones are compared with.
+func (a *App) markSeen() {
+	for i := range a.tabs {
+		t := &a.tabs[i]
+		if !t.hasData() || (t.seen != nil && (i != a.activeTab || t.seenAt.Equal(t.fetchedAt))) {
+			continue
+		}
+		t.seen = make(map[string]bool, len(t.issues))
+		for _, issue := range t.issues {
+			t.seen[issue.Key] = true
+		}
+		t.seenAt = t.fetchedAt
+	}
+}
+
+// unseen counts the issues that weren't in the results last looked at.
+func (t *tab) unseen() int {
+	if t.seen == nil {
+		return 0
+	}
+	n := 0
+	for _, issue := range t.issues {
+		if !t.seen[issue.Key] {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,128 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func backlogIssues(keys ...string) tabDataMsg {
+	msg := tabDataMsg{tabIndex: 1}
+	for _, k := range keys {
+		msg.issues = append(msg.issues, jira.Issue{Key: k, Fields: jira.IssueFields{Summary: "Issue " + k}})
+	}
+	return msg
+}
+
+func TestTabBarShowsCounts(t *testing.T) {
+	app := testAppReady()
+	bar := app.renderTabBar()
+	if !strings.Contains(bar, "Sprint 3") {
+		t.Errorf("tab bar = %q, want the Sprint tab's count", bar)
+	}
+	if strings.Contains(bar, "Backlog 0") {
+		t.Errorf("tab bar = %q, want no count for a tab still loading", bar)
+	}
+
+	app.tabs[0].nextPage = "next"
+	if bar := app.renderTabBar(); !strings.Contains(bar, "Sprint 3+") {
+		t.Errorf("tab bar = %q, want a + for results with more to load", bar)
+	}
+
+	app.SetTabBar("none", 0)
+	if bar := app.renderTabBar(); strings.Contains(bar, "Sprint 3") {
+		t.Errorf("tab bar = %q, want no counts with badges: none", bar)
+	}
+}
+
+func TestTabBarCountsNewIssues(t *testing.T) {
+	app := testAppReady()
+	app.SetTabBar("new", 0)
+	model, _ := app.Update(backlogIssues("PROJ-10"))
+	model, _ = model.(App).Update(backlogIssues("PROJ-10", "PROJ-11", "PROJ-12"))
+	app = model.(App)
+	if bar := app.renderTabBar(); !strings.Contains(bar, "Backlog 3 · 2 new") {
+		t.Errorf("tab bar = %q, want the issues new since the first load", bar)
+	}
+
+	// Looking at the tab takes its issues as seen
+	app, _ = pressKeys(app, keyMsg("2"), keyMsg("1"))
+	if bar := app.renderTabBar(); !strings.Contains(bar, "Backlog 3") || strings.Contains(bar, "new") {
+		t.Errorf("tab bar = %q, want no new issues once the tab was viewed", bar)
+	}
+
+	model, _ = app.Update(backlogIssues("PROJ-12", "PROJ-13"))
+	app = model.(App)
+	if bar := app.renderTabBar(); !strings.Contains(bar, "Backlog 2 · 1 new") {
+		t.Errorf("tab bar = %q, want only the issue added since it was viewed", bar)
+	}
+}
+
+func TestTabBarHidesNewOnActiveTab(t *testing.T) {
+	app := testAppReady()
+	app.SetTabBar("new", 0)
+	model, _ := app.Update(backlogIssues("PROJ-10"))
+	app, _ = pressKeys(model.(App), keyMsg("2"))
+	model, _ = app.Update(backlogIssues("PROJ-10", "PROJ-11"))
+	app = model.(App)
+	if bar := app.renderTabBar(); strings.Contains(bar, "new") {
+		t.Errorf("tab bar = %q, want no new count on the tab being looked at", bar)
+	}
+	app, _ = pressKeys(app, keyMsg("1"))
+	if bar := app.renderTabBar(); strings.Contains(bar, "new") {
+		t.Errorf("tab bar = %q, want issues loaded while viewed to count as seen", bar)
+	}
+}
+
+func TestTabBarShowsStaleAge(t *testing.T) {
+	app := testAppReady()
+	app.SetTabBar("", 10*time.Minute)
+	app.tabs[0].fetchedAt = time.Now().Add(-5 * time.Minute)
+	if bar := app.renderTabBar(); strings.Contains(bar, "ago") {
+		t.Errorf("tab bar = %q, want no age for results newer than stale_after", bar)
+	}
+
+	app.tabs[0].fetchedAt = time.Now().Add(-12 * time.Minute)
+	if bar := app.renderTabBar(); !strings.Contains(bar, "Sprint 3 · 12m ago") {
+		t.Errorf("tab bar = %q, want the age of stale results", bar)
+	}
+
+	// Cached results waiting for their refresh show their age at once
+	app.SetTabBar("", 0)
+	app.tabs[0].fetchedAt = time.Now().Add(-2 * time.Minute)
+	app.tabs[0].stale = true
+	if bar := app.renderTabBar(); !strings.Contains(bar, "Sprint 3 · 2m ago") {
+		t.Errorf("tab bar = %q, want the age of cached results", bar)
+	}
+}
+
+func TestRefreshAllTabs(t *testing.T) {
+	app := testAppConnected()
+	app.connected = true
+	app.tabs[1].setError("boom")
+	inflight := app.inflight
+
+	model, cmd := app.Update(keyMsg("R"))
+	app = model.(App)
+	if cmd == nil || app.inflight != inflight+2 {
+		t.Fatalf("R should reload both tabs, inflight = %d, want %d", app.inflight, inflight+2)
+	}
+	if !app.tabs[0].hasData() {
+		t.Error("a tab with results should keep them on screen while it reloads")
+	}
+	if app.tabs[1].state != tabLoading {
+		t.Errorf("a tab without results should show it's loading, state = %v", app.tabs[1].state)
+	}
+	if app.flash != "Refreshing 2 tabs" {
+		t.Errorf("flash = %q", app.flash)
+	}
+
+	// A tab still loading isn't loaded twice
+	inflight = app.inflight
+	model, _ = app.Update(keyMsg("R"))
+	if got := model.(App).inflight; got != inflight+1 {
+		t.Errorf("inflight = %d, want %d: only the tab not loading reloaded", got, inflight+1)
+	}
+}
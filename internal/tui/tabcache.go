@@ -0,0 +1,93 @@
+package tui
+
+import (
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// SetTabCache turns on the disk cache of tab results and fills tabs with
+// their cached results so the first frame has data. Results older than ttl
+// are marked stale and refreshed once connected; younger ones are used as
+// is.
+func (a *App) SetTabCache(cache config.TabCache, ttl time.Duration) {
+	a.tabCacheOn = true
+	a.cacheTTL = ttl
+	for i := range a.tabs {
+		entry, ok := cache[config.TabCacheKey(a.tabs[i].config)]
+		if !ok {
+			continue
+		}
+		stale := a.now().Sub(entry.FetchedAt) >= ttl
+		a.tabs[i].restore(entry, stale)
+		a.markFetchedAt(entry.FetchedAt, entry.Issues...)
+	}
+}
+
+// restore shows cached results. Rows are built by setSize once the width
+// is known.
+func (t *tab) restore(entry config.TabCacheEntry, stale bool) {
+	t.issues = entry.Issues
+	t.rankField = entry.RankField
+	t.rankPos = rankPositions(entry.Issues, entry.RankField)
+	t.setQueryOrder(entry.Issues)
+	t.statusReplacer = buildStatusReplacer(entry.Issues)
+	t.fetchedAt = entry.FetchedAt
+	t.stale = stale
+	if len(entry.Issues) == 0 {
+		t.state = tabEmpty
+	} else {
+		t.state = tabReady
+	}
+}
+
+// cachedFresh reports whether the tab holds cached results young enough to
+// skip the startup fetch.
+func (t *tab) cachedFresh() bool {
+	return !t.fetchedAt.IsZero() && !t.stale
+}
+
+// hasData reports whether the tab has results to show, cached or loaded.
+func (t *tab) hasData() bool {
+	return t.state == tabReady || t.state == tabEmpty
+}
+
+// loadStaleTabs loads every tab without fresh cached results. Fresh tabs
+// with a refresh_interval are scheduled for when their results come due.
+func (a *App) loadStaleTabs() tea.Cmd {
+	var cmds []tea.Cmd
+	for i := range a.tabs {
+		if a.tabs[i].cachedFresh() {
+			cmds = append(cmds, a.scheduleRefresh(i, refreshDelay(&a.tabs[i], a.now())))
+			continue
+		}
+		a.inflight++
+		cmds = append(cmds, a.loadTab(i))
+	}
+	return tea.Batch(cmds...)
+}
+
+// tabCache collects the current results of the configured tabs.
+func (a App) tabCache() config.TabCache {
+	cache := make(config.TabCache, len(a.tabs))
+	for _, t := range a.tabs {
+		if t.temporary || t.fetchedAt.IsZero() {
+			continue
+		}
+		// Cache Jira's order; the interactive sort isn't persisted
+		issues := append([]jira.Issue(nil), t.issues...)
+		sort.SliceStable(issues, func(i, j int) bool {
+			return t.queryPos(issues[i].Key) < t.queryPos(issues[j].Key)
+		})
+		cache[config.TabCacheKey(t.config)] = config.TabCacheEntry{
+			FetchedAt: t.fetchedAt,
+			RankField: t.rankField,
+			Issues:    issues,
+		}
+	}
+	return cache
+}
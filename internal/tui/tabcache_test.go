@@ -0,0 +1,108 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func cachedTabs(app App, age time.Duration) config.TabCache {
+	issues := []jira.Issue{
+		{Key: "PROJ-7", Fields: jira.IssueFields{Summary: "Cached one", Status: &jira.Status{Name: "Open"}}},
+	}
+	return config.TabCache{
+		config.TabCacheKey(app.tabs[0].config): {FetchedAt: time.Now().Add(-age), Issues: issues},
+	}
+}
+
+func TestSetTabCacheRendersBeforeConnecting(t *testing.T) {
+	app := testAppWithTabs()
+	app.checking = true // still connecting
+	app.SetTabCache(cachedTabs(app, time.Hour), 5*time.Minute)
+
+	model, _ := app.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	app = model.(App)
+	view := app.View()
+	if !strings.Contains(view, "PROJ-7") {
+		t.Error("cached issues should render while connecting")
+	}
+	if !strings.Contains(view, "stale") {
+		t.Error("expired cache should be marked stale")
+	}
+	if app.tabs[1].hasData() {
+		t.Error("uncached tab should still be loading")
+	}
+}
+
+func TestTabCacheTTL(t *testing.T) {
+	tests := []struct {
+		name      string
+		age       time.Duration
+		wantStale bool
+		wantLoads int
+	}{
+		{"fresh cache skips the fetch", time.Minute, false, 1},
+		{"expired cache refreshes", time.Hour, true, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := testAppWithTabs()
+			app.client = jira.NewClient("https://fake.atlassian.net", "test@test.com", "token")
+			app.inflight = 1 // the connection check
+			app.SetTabCache(cachedTabs(app, tt.age), 5*time.Minute)
+			if app.tabs[0].stale != tt.wantStale {
+				t.Errorf("stale = %v, want %v", app.tabs[0].stale, tt.wantStale)
+			}
+
+			model, _ := app.Update(connStatusMsg{user: &jira.User{DisplayName: "Me"}})
+			app = model.(App)
+			if app.inflight != tt.wantLoads {
+				t.Errorf("inflight = %d, want %d tab loads", app.inflight, tt.wantLoads)
+			}
+		})
+	}
+}
+
+func TestStaleTabKeepsDataWhenRefreshFails(t *testing.T) {
+	app := testAppWithTabs()
+	app.SetTabCache(cachedTabs(app, time.Hour), 0)
+	model, _ := app.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	app = model.(App)
+
+	model, _ = app.Update(tabDataMsg{tabIndex: 0, err: errors.New("offline")})
+	app = model.(App)
+	if app.tabs[0].state != tabReady || !app.flashIsErr {
+		t.Errorf("state = %v, flash = %q", app.tabs[0].state, app.flash)
+	}
+}
+
+func TestTabCacheSavedOnQuit(t *testing.T) {
+	app := testAppWithTabs()
+	app.SetTabCache(nil, 0)
+	model, _ := app.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	app = model.(App)
+	model, _ = app.Update(tabDataMsg{tabIndex: 0, issues: []jira.Issue{{Key: "PROJ-1"}}})
+	app = model.(App)
+
+	var names []string
+	for _, task := range app.flushTasks() {
+		names = append(names, task.name)
+	}
+	if len(names) != 1 || names[0] != "tab cache" {
+		t.Fatalf("flush tasks = %v", names)
+	}
+	cache := app.tabCache()
+	entry, ok := cache[config.TabCacheKey(app.tabs[0].config)]
+	if !ok || len(entry.Issues) != 1 || entry.FetchedAt.IsZero() {
+		t.Errorf("cache = %+v", cache)
+	}
+	if len(cache) != 1 {
+		t.Errorf("only loaded tabs should be cached, got %d", len(cache))
+	}
+}
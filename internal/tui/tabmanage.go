@@ -0,0 +1,361 @@
+package tui
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+)
+
+// maxTabLabel is how much of a query names a tab opened from it.
+const maxTabLabel = 24
+
+// filterIDPattern matches a saved filter's id.
+var filterIDPattern = regexp.MustCompile(`^\d+$`)
+
+// tabsSavedMsg reports writing the tabs to config.yaml.
+type tabsSavedMsg struct {
+	err error
+}
+
+// tabRow is one tab in the tabs overlay.
+type tabRow struct {
+	index     int // position in a.tabs when the overlay opened
+	label     string
+	source    string // the tab's JQL or filter, for telling tabs apart
+	temporary bool
+	closed    bool
+}
+
+// tabsResult is the tabs overlay's result.
+type tabsResult struct {
+	rows   []tabRow // the tabs left open, in their new order
+	save   bool     // also write them to config.yaml
+	newTab bool     // then ask for a query to open in a new tab
+}
+
+// tabsOverlay renames, reorders, and closes tabs, and opens new ones.
+type tabsOverlay struct {
+	rows     []tabRow
+	cursor   int
+	renaming bool
+	input    textinput.Model
+	errMsg   string
+	isDone   bool
+	result   interface{} // tabsResult or nil
+}
+
+func newTabsOverlay(tabs []tab, active int) *tabsOverlay {
+	o := &tabsOverlay{cursor: active}
+	for i, t := range tabs {
+		o.rows = append(o.rows, tabRow{
+			index:     i,
+			label:     t.config.Label,
+			source:    tabSource(t.config),
+			temporary: t.temporary,
+		})
+	}
+	return o
+}
+
+// tabSource describes where a tab's issues come from.
+func tabSource(cfg config.TabConfig) string {
+	switch {
+	case cfg.FilterID != "":
+		return "filter " + cfg.FilterID
+	case cfg.FilterURL != "":
+		return cfg.FilterURL
+	}
+	return cfg.JQL
+}
+
+// move swaps the row under the cursor with its neighbour in direction d.
+func (o *tabsOverlay) move(d int) {
+	o.cursor = swapRow(o.rows, o.cursor, d)
+}
+
+// toggleClosed marks the row under the cursor to close, or keeps it, as
+// long as one tab stays open.
+func (o *tabsOverlay) toggleClosed() {
+	r := &o.rows[o.cursor]
+	if !r.closed {
+		open := 0
+		for _, row := range o.rows {
+			if !row.closed {
+				open++
+			}
+		}
+		if open == 1 {
+			o.errMsg = "At least one tab must stay open"
+			return
+		}
+	}
+	r.closed = !r.closed
+}
+
+// startRename edits the label of the row under the cursor in place.
+func (o *tabsOverlay) startRename() {
+	ti := textinput.New()
+	ti.SetValue(o.rows[o.cursor].label)
+	ti.CharLimit = 50
+	ti.Width = 30
+	ti.Focus()
+	o.input = ti
+	o.renaming = true
+}
+
+func (o *tabsOverlay) finish(save, newTab bool) {
+	res := tabsResult{save: save, newTab: newTab}
+	for _, r := range o.rows {
+		if !r.closed {
+			res.rows = append(res.rows, r)
+		}
+	}
+	o.isDone = true
+	o.result = res
+}
+
+func (o *tabsOverlay) Update(msg tea.Msg) (overlay, tea.Cmd) {
+	km, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return o, nil
+	}
+	o.errMsg = ""
+	if o.renaming {
+		switch km.String() {
+		case "esc":
+			o.renaming = false
+		case "enter":
+			label := strings.TrimSpace(o.input.Value())
+			if label == "" {
+				o.errMsg = "A tab needs a label"
+				return o, nil
+			}
+			o.rows[o.cursor].label = label
+			o.renaming = false
+		default:
+			var cmd tea.Cmd
+			o.input, cmd = o.input.Update(msg)
+			return o, cmd
+		}
+		return o, nil
+	}
+	switch km.String() {
+	case "esc":
+		o.isDone = true
+		o.result = nil
+	case "enter":
+		o.finish(false, false)
+	case "w":
+		o.finish(true, false)
+	case "n":
+		o.finish(false, true)
+	case "up", "k":
+		if o.cursor > 0 {
+			o.cursor--
+		}
+	case "down", "j":
+		if o.cursor < len(o.rows)-1 {
+			o.cursor++
+		}
+	case "K", "shift+up":
+		o.move(-1)
+	case "J", "shift+down":
+		o.move(1)
+	case "r":
+		o.startRename()
+	case "x", "delete":
+		o.toggleClosed()
+	}
+	return o, nil
+}
+
+func (o *tabsOverlay) View(width, height int) string {
+	boxWidth := min(max(width-10, 30), 70)
+	var b strings.Builder
+	b.WriteString(overlayTitleStyle.Render("Tabs"))
+	b.WriteString("\n")
+
+	for i, r := range o.rows {
+		label := r.label
+		if o.renaming && i == o.cursor {
+			b.WriteString("> " + o.input.View() + "\n")
+			continue
+		}
+		source := r.source
+		if r.temporary {
+			source = "search · " + source
+		}
+		line := fmt.Sprintf("%-20s %s", truncateRunes(label, 20), truncateRunes(source, max(boxWidth-26, 10)))
+		if r.closed {
+			line += " (closed)"
+		}
+		switch {
+		case i == o.cursor:
+			b.WriteString(overlaySelectedStyle.Render("> " + line))
+		case r.closed:
+			b.WriteString(overlayHintStyle.Render("  " + line))
+		default:
+			b.WriteString("  " + line)
+		}
+		b.WriteString("\n")
+	}
+
+	if o.errMsg != "" {
+		b.WriteString(errorStyle.Render(o.errMsg))
+		b.WriteString("\n")
+	}
+	hint := "r: rename  J/K: move  x: close/keep  n: new tab\nenter: apply  w: apply & save  esc: cancel"
+	if o.renaming {
+		hint = "enter: rename  esc: cancel"
+	}
+	b.WriteString(overlayHintStyle.Render(hint))
+
+	content := overlayBorderStyle.Width(boxWidth).Render(b.String())
+	return lipgloss.Place(width, height-2, lipgloss.Center, lipgloss.Center, content)
+}
+
+func (o *tabsOverlay) done() (bool, interface{}) {
+	return o.isDone, o.result
+}
+
+// startTabManager opens the tabs overlay.
+func (a App) startTabManager() (tea.Model, tea.Cmd) {
+	if len(a.tabs) == 0 {
+		return a, nil
+	}
+	a.overlay = newTabsOverlay(a.tabs, a.activeTab)
+	a.overlayIssue = ""
+	a.overlayAction = overlayActionTabs
+	return a, nil
+}
+
+// applyTabs puts the tabs in their new order with their new labels,
+// dropping the closed ones, and saves them if asked. The active tab stays
+// active unless it was closed.
+func (a App) applyTabs(res tabsResult) (tea.Model, tea.Cmd) {
+	activeID := 0
+	if a.activeTab < len(a.tabs) {
+		activeID = a.tabs[a.activeTab].id
+	}
+	tabs := make([]tab, 0, len(res.rows))
+	for _, r := range res.rows {
+		t := a.tabs[r.index]
+		t.config.Label = r.label
+		tabs = append(tabs, t)
+	}
+	a.tabs = tabs
+	if i := a.tabIndexFor(-1, activeID); i >= 0 {
+		a.activeTab = i
+	} else {
+		a.activeTab = min(a.activeTab, len(a.tabs)-1)
+	}
+	a.flash = "Tabs updated"
+	a.flashIsErr = false
+
+	var cmd tea.Cmd
+	if res.save {
+		cmd = a.saveTabs()
+	}
+	if res.newTab {
+		return a.startNewTab(cmd)
+	}
+	return a, cmd
+}
+
+// saveTabs writes the tabs other than search tabs to config.yaml.
+func (a *App) saveTabs() tea.Cmd {
+	if a.configPath == "" {
+		a.flash = "No config file to save to"
+		a.flashIsErr = true
+		return nil
+	}
+	var layout []config.TabLayout
+	for i := range a.tabs {
+		t := &a.tabs[i]
+		if t.temporary {
+			continue
+		}
+		l := config.TabLayout{Tab: t.config}
+		if t.fileLabel != "" && t.fileLabel != t.config.Label {
+			l.Previous = t.fileLabel
+		}
+		layout = append(layout, l)
+		t.fileLabel = t.config.Label
+	}
+	path := a.configPath
+	return func() tea.Msg {
+		return tabsSavedMsg{err: config.SaveTabLayout(path, layout)}
+	}
+}
+
+// handleTabsSaved reports the outcome of saving the tabs.
+func (a App) handleTabsSaved(msg tabsSavedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		a.flash = "Saving tabs failed: " + msg.err.Error()
+		a.flashIsErr = true
+	} else {
+		a.flash = "Saved tabs to config.yaml"
+		a.flashIsErr = false
+	}
+	return a, nil
+}
+
+// startNewTab asks for the query of a new tab; cmd is anything already
+// under way.
+func (a App) startNewTab(cmd tea.Cmd) (tea.Model, tea.Cmd) {
+	if a.client == nil {
+		a.flash = "Not connected to Jira"
+		a.flashIsErr = true
+		return a, cmd
+	}
+	o := newTextInputOverlay("New tab: JQL, filter ID, or filter URL", "")
+	o.input.Placeholder = "assignee = currentUser() AND resolution = Unresolved"
+	o.input.CharLimit = 2000
+	o.history = a.jqlHistory
+	a.overlay = o
+	a.overlayIssue = ""
+	a.overlayAction = overlayActionNewTab
+	return a, cmd
+}
+
+// newTabConfig makes a tab from what was typed: a filter's id, a link to
+// it, or JQL. Filters are named after themselves once loaded.
+func newTabConfig(input string, columns []string) config.TabConfig {
+	cfg := config.TabConfig{Columns: columns}
+	if filterIDPattern.MatchString(input) {
+		cfg.FilterID = input
+	} else if u, err := url.Parse(input); err == nil && u.Host != "" && filterIDPattern.MatchString(u.Query().Get("filter")) {
+		cfg.FilterID = u.Query().Get("filter")
+	}
+	if cfg.FilterID != "" {
+		cfg.Label = "Filter " + cfg.FilterID
+		return cfg
+	}
+	cfg.JQL = input
+	cfg.Label = truncateRunes(input, maxTabLabel)
+	return cfg
+}
+
+// openNewTab adds a tab for the query typed and switches to it.
+func (a App) openNewTab(input string) (tea.Model, tea.Cmd) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return a, nil
+	}
+	cfg := newTabConfig(input, a.defaultColumns())
+	t := a.runtimeTab(cfg)
+	t.autoLabel = cfg.FilterID != ""
+	a.tabs = append(a.tabs, t)
+	a.leaveTab()
+	a.activeTab = len(a.tabs) - 1
+	a.flash = "Opened " + cfg.Label + " · ctrl+t, w saves it to config.yaml"
+	a.flashIsErr = false
+	return a, a.startNetwork(a.loadTab(a.activeTab))
+}
@@ -0,0 +1,151 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"gopkg.in/yaml.v3"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// pressKeys sends each key to the app in turn.
+func pressKeys(app App, keys ...tea.KeyMsg) (App, tea.Cmd) {
+	var cmd tea.Cmd
+	for _, k := range keys {
+		var model tea.Model
+		model, cmd = app.Update(k)
+		app = model.(App)
+	}
+	return app, cmd
+}
+
+func tabLabels(app App) string {
+	var labels []string
+	for _, t := range app.tabs {
+		labels = append(labels, t.config.Label)
+	}
+	return strings.Join(labels, ",")
+}
+
+func TestTabManagerRenamesAndReorders(t *testing.T) {
+	app := testAppReady()
+	app, _ = pressKeys(app,
+		tea.KeyMsg{Type: tea.KeyCtrlT},
+		keyMsg("J"), keyMsg("r"), keyMsg(" "), keyMsg("2"), keyMsg("enter"),
+		keyMsg("enter"),
+	)
+	if app.overlay != nil {
+		t.Fatal("overlay should close on enter")
+	}
+	if got := tabLabels(app); got != "Backlog,Sprint 2" {
+		t.Errorf("tabs = %s, want Backlog,Sprint 2", got)
+	}
+	if app.activeTab != 1 || app.tabs[1].issues == nil {
+		t.Errorf("active tab = %d, want the moved tab with its issues still active", app.activeTab)
+	}
+}
+
+func TestTabManagerCloses(t *testing.T) {
+	app := testAppReady()
+	closedID := app.tabs[0].id
+	app, _ = pressKeys(app, tea.KeyMsg{Type: tea.KeyCtrlT}, keyMsg("x"), keyMsg("down"), keyMsg("x"))
+	if o := app.overlay.(*tabsOverlay); o.errMsg == "" || o.rows[1].closed {
+		t.Fatalf("closing the last open tab should be refused, rows = %+v", o.rows)
+	}
+	app, _ = pressKeys(app, keyMsg("enter"))
+	if got := tabLabels(app); got != "Backlog" || app.activeTab != 0 {
+		t.Fatalf("tabs = %s, active = %d", got, app.activeTab)
+	}
+
+	// Results for the closed tab don't land on the one now in its place
+	model, _ := app.Update(tabDataMsg{tabIndex: 0, tabID: closedID, issues: []jira.Issue{{Key: "PROJ-9"}}})
+	app = model.(App)
+	if len(app.tabs[0].issues) != 0 {
+		t.Errorf("issues = %+v, want the closed tab's results dropped", app.tabs[0].issues)
+	}
+}
+
+func TestTabManagerSavesLayout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	original := `jira:
+  base_url: https://example.atlassian.net
+tabs:
+  - label: Sprint
+    filter_id: "111"
+    columns: [key, summary, status]
+  - label: Backlog
+    filter_id: "222"
+    columns: [key, summary]
+`
+	if err := os.WriteFile(path, []byte(original), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	app := testAppReady()
+	app.SetConfigPath(path)
+
+	app, cmd := pressKeys(app,
+		tea.KeyMsg{Type: tea.KeyCtrlT},
+		keyMsg("down"), keyMsg("K"), keyMsg("r"), keyMsg("!"), keyMsg("enter"),
+		keyMsg("w"),
+	)
+	if cmd == nil {
+		t.Fatal("expected a save")
+	}
+	model, _ := app.Update(cmd())
+	app = model.(App)
+	if app.flashIsErr {
+		t.Fatalf("save failed: %s", app.flash)
+	}
+
+	data, _ := os.ReadFile(path)
+	var cfg config.Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Tabs) != 2 || cfg.Tabs[0].Label != "Backlog!" || cfg.Tabs[0].FilterID != "222" || cfg.Tabs[1].Label != "Sprint" {
+		t.Errorf("saved tabs = %+v", cfg.Tabs)
+	}
+}
+
+func TestNewTabConfig(t *testing.T) {
+	columns := []string{"key", "summary"}
+	for _, tt := range []struct {
+		input, label, filterID, jql string
+	}{
+		{"10042", "Filter 10042", "10042", ""},
+		{"https://example.atlassian.net/issues/?filter=10042", "Filter 10042", "10042", ""},
+		{"assignee = currentUser() AND resolution = Unresolved", "assignee = currentUser(…", "", "assignee = currentUser() AND resolution = Unresolved"},
+	} {
+		cfg := newTabConfig(tt.input, columns)
+		if cfg.Label != tt.label || cfg.FilterID != tt.filterID || cfg.JQL != tt.jql || len(cfg.Columns) != 2 {
+			t.Errorf("newTabConfig(%q) = %+v", tt.input, cfg)
+		}
+	}
+}
+
+func TestOpenNewTabFromFilter(t *testing.T) {
+	app := testAppConnected()
+	app, _ = pressKeys(app, tea.KeyMsg{Type: tea.KeyCtrlT}, keyMsg("n"))
+	if _, ok := app.overlay.(*textInputOverlay); !ok {
+		t.Fatalf("overlay = %T, want the query prompt", app.overlay)
+	}
+	app, cmd := pressKeys(app, keyMsg("1"), keyMsg("0"), keyMsg("enter"))
+	if cmd == nil || len(app.tabs) != 3 || app.activeTab != 2 {
+		t.Fatalf("tabs = %s, active = %d, want the new tab loading", tabLabels(app), app.activeTab)
+	}
+	tb := app.tabs[2]
+	if tb.config.FilterID != "10" || tb.temporary || strings.Join(tb.config.Columns, ",") != "key,summary,status" {
+		t.Errorf("new tab = %+v", tb.config)
+	}
+
+	model, _ := app.Update(tabDataMsg{tabIndex: 2, tabID: tb.id, filter: &jira.Filter{Name: "Support queue"}, issues: []jira.Issue{{Key: "SUP-1"}}})
+	app = model.(App)
+	if got := app.tabs[2].config.Label; got != "Support queue" {
+		t.Errorf("label = %q, want the filter's name", got)
+	}
+}
@@ -0,0 +1,102 @@
+package tui
+
+import (
+	"context"
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// maxAutoTeamSize is the largest of the user's own groups treated as their
+// team when no team_groups are configured; bigger groups, like
+// jira-software-users, span the whole organization.
+const maxAutoTeamSize = 50
+
+// Assignee picker sections.
+const (
+	sectionTeam   = "My team"
+	sectionOthers = "Others"
+)
+
+// teamLoadedMsg delivers the account IDs of the user's team.
+type teamLoadedMsg struct {
+	members map[string]bool
+	err     error
+}
+
+// SetTeamGroups sets the groups whose members are listed first in the
+// assignee picker. With none, the user's own small groups are used.
+func (a *App) SetTeamGroups(groups []string) {
+	a.teamGroups = groups
+}
+
+// cmdLoadTeam fetches the members of the team groups.
+func (a App) cmdLoadTeam() tea.Cmd {
+	client := a.client
+	groups := a.teamGroups
+	var accountID string
+	if a.user != nil {
+		accountID = a.user.AccountID
+	}
+	return func() tea.Msg {
+		members, err := loadTeam(context.Background(), client, groups, accountID)
+		return teamLoadedMsg{members: members, err: err}
+	}
+}
+
+// loadTeam returns the account IDs in the configured groups, or, with none
+// configured, in the groups accountID belongs to that are small enough to
+// be a team.
+func loadTeam(ctx context.Context, client *jira.Client, groups []string, accountID string) (map[string]bool, error) {
+	auto := len(groups) == 0
+	if auto {
+		if accountID == "" {
+			return nil, nil
+		}
+		mine, err := client.GetUserGroups(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range mine {
+			groups = append(groups, g.Name)
+		}
+	}
+	members := make(map[string]bool)
+	for _, name := range groups {
+		users, err := client.GetGroupMembers(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if auto && len(users) > maxAutoTeamSize {
+			continue
+		}
+		for _, u := range users {
+			members[u.AccountID] = true
+		}
+	}
+	return members, nil
+}
+
+// assigneeItems lists users for the assignee picker, the team first and
+// otherwise in cache order. Without a known team the list has no sections.
+func (a App) assigneeItems(users []config.CachedUser) []selectionItem {
+	items := make([]selectionItem, len(users))
+	for i, u := range users {
+		items[i] = selectionItem{ID: u.AccountID, Label: u.DisplayName, Desc: u.Email}
+		if len(a.teamMembers) > 0 {
+			items[i].Section = sectionOthers
+			if a.teamMembers[u.AccountID] {
+				items[i].Section = sectionTeam
+			}
+		}
+	}
+	if len(a.teamMembers) > 0 {
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].Section == sectionTeam && items[j].Section != sectionTeam
+		})
+	}
+	return items
+}
@@ -0,0 +1,123 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestLoadTeamSkipsOrgWideGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/user/groups":
+			if r.URL.Query().Get("accountId") != "me" {
+				t.Errorf("unexpected accountId: %s", r.URL.RawQuery)
+			}
+			json.NewEncoder(w).Encode([]jira.Group{{Name: "payments"}, {Name: "jira-software-users"}})
+		case "/rest/api/3/group/member":
+			page := jira.GroupMembersPage{IsLast: true}
+			if r.URL.Query().Get("groupname") == "payments" {
+				page.Values = []jira.User{{AccountID: "me", Active: true}, {AccountID: "jane", Active: true}}
+			} else {
+				for i := 0; i <= maxAutoTeamSize; i++ {
+					page.Values = append(page.Values, jira.User{AccountID: "u" + strconv.Itoa(i), Active: true})
+				}
+			}
+			json.NewEncoder(w).Encode(page)
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(server.URL, "test@test.com", "token")
+	members, err := loadTeam(context.Background(), client, nil, "me")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 2 || !members["jane"] {
+		t.Errorf("members = %v, want me and jane", members)
+	}
+}
+
+func TestLoadTeamUsesConfiguredGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/group/member" || r.URL.Query().Get("groupname") != "big team" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+		page := jira.GroupMembersPage{IsLast: true}
+		for i := 0; i <= maxAutoTeamSize; i++ {
+			page.Values = append(page.Values, jira.User{AccountID: strconv.Itoa(i), Active: true})
+		}
+		json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(server.URL, "test@test.com", "token")
+	members, err := loadTeam(context.Background(), client, []string{"big team"}, "me")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Configured groups are used whatever their size
+	if len(members) != maxAutoTeamSize+1 {
+		t.Errorf("got %d members, want %d", len(members), maxAutoTeamSize+1)
+	}
+}
+
+func TestAssigneePickerSectionsTeam(t *testing.T) {
+	app := testAppConnected()
+	app.cachedUsers = []config.CachedUser{
+		{AccountID: "1", DisplayName: "Alice"},
+		{AccountID: "2", DisplayName: "Bob"},
+		{AccountID: "3", DisplayName: "Carol"},
+	}
+	model, _ := app.Update(teamLoadedMsg{members: map[string]bool{"3": true}})
+	app = model.(App)
+
+	model, _ = app.Update(keyMsg("a"))
+	app = model.(App)
+	s, ok := app.overlay.(*selectionOverlay)
+	if !ok {
+		t.Fatalf("expected selection overlay, got %T", app.overlay)
+	}
+	if s.items[0].Label != "Carol" || s.items[0].Section != sectionTeam || s.items[1].Section != sectionOthers {
+		t.Errorf("items = %+v, want Carol first under %q", s.items, sectionTeam)
+	}
+	view := s.View(100, 30)
+	if !strings.Contains(view, sectionTeam) || !strings.Contains(view, sectionOthers) {
+		t.Errorf("view lacks section headings:\n%s", view)
+	}
+
+	o := updateOverlay(s, tea.KeyMsg{Type: tea.KeyTab})
+	s = o.(*selectionOverlay)
+	if len(s.filtered) != 1 {
+		t.Errorf("team only: %d items shown, want 1", len(s.filtered))
+	}
+	o = updateOverlay(o, tea.KeyMsg{Type: tea.KeyTab})
+	s = o.(*selectionOverlay)
+	if len(s.filtered) != 3 {
+		t.Errorf("show all: %d items shown, want 3", len(s.filtered))
+	}
+}
+
+func TestAssigneePickerWithoutTeam(t *testing.T) {
+	app := testAppReady()
+	app.cachedUsers = []config.CachedUser{{AccountID: "1", DisplayName: "Alice"}}
+	items := app.assigneeItems(app.cachedUsers)
+	if items[0].Section != "" {
+		t.Errorf("section = %q, want none without a team", items[0].Section)
+	}
+	s := newSelectionOverlay("Assign To", items)
+	if strings.Contains(s.View(100, 30), "tab:") {
+		t.Error("unsectioned picker should not offer the team toggle")
+	}
+}
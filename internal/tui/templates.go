@@ -0,0 +1,80 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// defaultDescriptionTemplates are the scaffolds offered for an empty
+// description, by issue type, unless description_templates overrides them.
+var defaultDescriptionTemplates = map[string]string{
+	"Bug":   "Steps to Reproduce:\n1. \n\nExpected:\n\nActual:\n",
+	"Story": "Acceptance Criteria:\n- \n",
+}
+
+// SetDescriptionTemplates adds to or replaces the built-in description
+// scaffolds. A type set to "" gets none.
+func (a *App) SetDescriptionTemplates(templates map[string]string) {
+	a.descTemplates = make(map[string]string, len(defaultDescriptionTemplates)+len(templates))
+	for name, text := range defaultDescriptionTemplates {
+		a.descTemplates[name] = text
+	}
+	for name, text := range templates {
+		for existing := range a.descTemplates {
+			if strings.EqualFold(existing, name) {
+				delete(a.descTemplates, existing)
+			}
+		}
+		a.descTemplates[name] = text
+	}
+}
+
+// descriptionTemplate returns the scaffold for an issue's type, or "" if
+// there is none.
+func (a App) descriptionTemplate(issue jira.Issue) string {
+	if issue.Fields.IssueType == nil {
+		return ""
+	}
+	templates := a.descTemplates
+	if templates == nil {
+		templates = defaultDescriptionTemplates
+	}
+	for name, text := range templates {
+		if strings.EqualFold(name, issue.Fields.IssueType.Name) {
+			return text
+		}
+	}
+	return ""
+}
+
+// offerTemplate asks whether an empty description starts from its type's
+// scaffold. It returns false when the issue's type has none.
+func (a *App) offerTemplate(issue jira.Issue) bool {
+	if a.descriptionTemplate(issue) == "" {
+		return false
+	}
+	typeName := issue.Fields.IssueType.Name
+	a.overlay = newSelectionOverlay("Empty Description", []selectionItem{
+		{ID: "template", Label: "Start from the " + typeName + " template"},
+		{ID: "empty", Label: "Start empty"},
+	})
+	a.overlayIssue = issue.Key
+	a.overlayAction = overlayActionDescTemplate
+	return true
+}
+
+// handleTemplatePick opens the description editor with the scaffold, or
+// empty.
+func (a App) handleTemplatePick(issueKey string, item *selectionItem) (tea.Model, tea.Cmd) {
+	initial := ""
+	if issue := a.findIssue(issueKey); issue != nil && item.ID == "template" {
+		initial = a.descriptionTemplate(*issue)
+	}
+	a.overlay = newTextEditorOverlay("Edit Description", initial, a.width, a.height)
+	a.overlayIssue = issueKey
+	a.overlayAction = overlayActionDescription
+	return a, nil
+}
@@ -0,0 +1,76 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func typedIssue(typeName string) jira.Issue {
+	return jira.Issue{Key: "PROJ-1", Fields: jira.IssueFields{IssueType: &jira.Named{Name: typeName}}}
+}
+
+func TestDescriptionTemplate(t *testing.T) {
+	app := testAppReady()
+	if got := app.descriptionTemplate(typedIssue("bug")); !strings.HasPrefix(got, "Steps to Reproduce:") {
+		t.Errorf("bug template = %q, want the built-in one", got)
+	}
+	if got := app.descriptionTemplate(typedIssue("Task")); got != "" {
+		t.Errorf("task template = %q, want none", got)
+	}
+
+	app.SetDescriptionTemplates(map[string]string{"story": "", "Task": "Done when:\n"})
+	if got := app.descriptionTemplate(typedIssue("Story")); got != "" {
+		t.Errorf("story template = %q, want it turned off", got)
+	}
+	if got := app.descriptionTemplate(typedIssue("Task")); got != "Done when:\n" {
+		t.Errorf("task template = %q, want the configured one", got)
+	}
+	if got := app.descriptionTemplate(typedIssue("Bug")); got == "" {
+		t.Error("bug template should stay built in")
+	}
+}
+
+func TestEmptyDescriptionOffersTemplate(t *testing.T) {
+	app := testAppConnected()
+	app.tabs[0].issues[0].Fields.IssueType = &jira.Named{Name: "Bug"}
+	app.tabs[0].setIssues(app.tabs[0].issues)
+
+	model, _ := app.Update(keyMsg("e"))
+	app = model.(App)
+	sel, ok := app.overlay.(*selectionOverlay)
+	if !ok || app.overlayAction != overlayActionDescTemplate {
+		t.Fatalf("overlay = %T, want the template offer", app.overlay)
+	}
+	if sel.items[0].Label != "Start from the Bug template" {
+		t.Errorf("first item = %q", sel.items[0].Label)
+	}
+
+	model, _ = app.handleOverlayResult(&sel.items[0])
+	app = model.(App)
+	editor, ok := app.overlay.(*textEditorOverlay)
+	if !ok || app.overlayAction != overlayActionDescription || app.overlayIssue != "PROJ-1" {
+		t.Fatalf("overlay = %T, want the description editor", app.overlay)
+	}
+	if !strings.HasPrefix(editor.editor.Value(), "Steps to Reproduce:") {
+		t.Errorf("editor = %q, want the scaffold", editor.editor.Value())
+	}
+}
+
+func TestDescriptionWithoutTemplateOpensEditor(t *testing.T) {
+	app := testAppConnected()
+	app.tabs[0].issues[0].Fields.IssueType = &jira.Named{Name: "Bug"}
+	app.tabs[0].issues[0].Fields.Description = makeADFDocument("Already written")
+	app.tabs[0].issues[1].Fields.IssueType = &jira.Named{Name: "Task"}
+	app.tabs[0].setIssues(app.tabs[0].issues)
+
+	for _, row := range []int{0, 1} {
+		app.tabs[0].table.SetCursor(row)
+		model, _ := app.Update(keyMsg("e"))
+		got := model.(App)
+		if _, ok := got.overlay.(*textEditorOverlay); !ok {
+			t.Errorf("row %d: overlay = %T, want the editor straight away", row, got.overlay)
+		}
+	}
+}
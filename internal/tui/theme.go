@@ -0,0 +1,87 @@
+package tui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+)
+
+// SetTheme colors the tabs, the table, statuses, and priorities from the
+// theme config, and gives statuses and priorities named in it their own
+// icons and colors. The styles are shared by every view, so it applies to the
+// whole program; call it before NewApp. Colors that differ by background
+// are settled once, by asking the terminal whether it is dark.
+func SetTheme(cfg config.ThemeConfig) {
+	t := cfg.Resolve()
+	dark := true
+	if t.Adaptive() {
+		dark = lipgloss.HasDarkBackground()
+	}
+	pick := func(c config.Color) string {
+		if dark {
+			return c.Dark
+		}
+		return c.Light
+	}
+	color := func(c config.Color) lipgloss.Color {
+		return lipgloss.Color(pick(c))
+	}
+
+	accent, accentText, muted := color(t.Accent), color(t.AccentText), color(t.Muted)
+	titleStyle = titleStyle.Foreground(accent)
+	activeTabStyle = activeTabStyle.Foreground(accentText).Background(accent)
+	inactiveTabStyle = inactiveTabStyle.Foreground(color(t.TabText)).Background(color(t.TabBackground))
+	tableHeaderStyle = tableHeaderStyle.Foreground(accent).BorderForeground(color(t.Border))
+	previewPaneStyle = previewPaneStyle.BorderForeground(color(t.Border))
+	tableSelectedStyle = tableSelectedStyle.Foreground(accentText).Background(accent)
+	filterPromptStyle = filterPromptStyle.Foreground(accent)
+	issueLinkStyle = issueLinkStyle.Foreground(accent)
+	detailKeyStyle = detailKeyStyle.Foreground(accent)
+	overlayBorderStyle = overlayBorderStyle.BorderForeground(accent)
+	overlayTitleStyle = overlayTitleStyle.Foreground(accent)
+	overlaySelectedStyle = overlaySelectedStyle.Foreground(accentText).Background(accent)
+
+	for _, s := range []*lipgloss.Style{
+		&helpStyle, &emptyStyle, &filterCountStyle,
+		&detailTypeStyle, &detailSectionStyle, &detailLabelStyle, &detailHintStyle,
+		&detailSubtaskOpen, &detailParentStyle, &overlayHintStyle, &overlayFilterStyle,
+	} {
+		*s = s.Foreground(muted)
+	}
+	errorStyle = errorStyle.Foreground(color(t.Error))
+	detailDueDateStyle = detailDueDateStyle.Foreground(color(t.Error))
+	successStyle = successStyle.Foreground(color(t.Success))
+	detailSubtaskDone = detailSubtaskDone.Foreground(color(t.Success))
+	loadingStyle = loadingStyle.Foreground(color(t.Warning))
+	mentionMeStyle = mentionMeStyle.Foreground(color(t.Warning))
+	detailLinkTypeStyle = detailLinkTypeStyle.Foreground(color(t.Warning))
+
+	for key, c := range t.Status {
+		statusCategoryColor[key] = pick(c)
+	}
+	for level, c := range t.Priority {
+		priorityColors[level] = pick(c)
+	}
+	for name, n := range t.Statuses {
+		if !n.Color.IsZero() {
+			statusNameColor[name] = pick(n.Color)
+		}
+		if n.Icon != "" {
+			statusIcons[name] = n.Icon
+		}
+	}
+	for name, n := range t.Priorities {
+		def := priorityMap[name]
+		if n.Icon != "" {
+			def.icon = n.Icon
+		}
+		if def.icon == "" {
+			def.icon = name
+		}
+		if !n.Color.IsZero() {
+			def.color = pick(n.Color)
+		}
+		priorityMap[name] = def
+	}
+	priorityReplacer = newPriorityReplacer()
+}
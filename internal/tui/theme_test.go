@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestSetThemeDefaultKeepsLook(t *testing.T) {
+	before := []lipgloss.TerminalColor{
+		activeTabStyle.GetBackground(), inactiveTabStyle.GetForeground(),
+		tableSelectedStyle.GetForeground(), helpStyle.GetForeground(), errorStyle.GetForeground(),
+	}
+	icons := colorizePriorities("⊘ ↑↑ ↑ ≡ ↓ ↓↓")
+	SetTheme(config.ThemeConfig{})
+	after := []lipgloss.TerminalColor{
+		activeTabStyle.GetBackground(), inactiveTabStyle.GetForeground(),
+		tableSelectedStyle.GetForeground(), helpStyle.GetForeground(), errorStyle.GetForeground(),
+	}
+	for i := range before {
+		if before[i] != after[i] {
+			t.Errorf("color %d = %v, want %v unchanged", i, after[i], before[i])
+		}
+	}
+	if got := colorizePriorities("⊘ ↑↑ ↑ ≡ ↓ ↓↓"); got != icons {
+		t.Errorf("priority icons = %q, want %q", got, icons)
+	}
+}
+
+func TestSetThemeLight(t *testing.T) {
+	t.Cleanup(func() { SetTheme(config.ThemeConfig{}) })
+	SetTheme(config.ThemeConfig{
+		Name:     "light",
+		Priority: map[string]config.Color{"medium": {Light: "130", Dark: "130"}},
+	})
+
+	if got := activeTabStyle.GetBackground(); got != lipgloss.Color("#0052CC") {
+		t.Errorf("active tab background = %v, want the light accent", got)
+	}
+	if statusCategoryColor["done"] != "#006644" {
+		t.Errorf("done = %q, want the light theme's green", statusCategoryColor["done"])
+	}
+	// Hex colors render as 24-bit, ANSI numbers as 256-color
+	got := colorizePriorities("↑↑ ≡")
+	if !strings.Contains(got, "\x1b[38;2;191;38;0m↑↑") || !strings.Contains(got, "\x1b[38;5;130m≡") {
+		t.Errorf("priority icons = %q", got)
+	}
+}
+
+func TestSetThemeNamedStatusesAndPriorities(t *testing.T) {
+	saved := []map[string]string{maps.Clone(statusNameColor), maps.Clone(statusIcons)}
+	savedPriorities := maps.Clone(priorityMap)
+	t.Cleanup(func() {
+		statusNameColor, statusIcons, priorityMap = saved[0], saved[1], savedPriorities
+		SetTheme(config.ThemeConfig{})
+	})
+	SetTheme(config.ThemeConfig{
+		Statuses: map[string]config.NameStyle{
+			"Code Review": {Icon: "◐", Color: config.Color{Light: "13", Dark: "13"}},
+		},
+		Priorities: map[string]config.NameStyle{
+			"P1":   {Icon: "!!", Color: config.Color{Light: "#FF0000", Dark: "#FF0000"}},
+			"High": {Color: config.Color{Light: "2", Dark: "2"}},
+		},
+	})
+
+	issues := []jira.Issue{{Key: "PROJ-1", Fields: jira.IssueFields{
+		Status:   &jira.Status{Name: "Code Review", StatusCategory: &jira.StatusCategory{Key: "indeterminate"}},
+		Priority: &jira.Named{Name: "P1"},
+	}}}
+	row := issuesToRows(issues, []string{"status", "priority"})[0]
+	if row[0] != "◐ Code Review" || row[1] != "!!" {
+		t.Fatalf("row = %q, want the configured icons", row)
+	}
+	if got := buildStatusReplacer(issues).Replace(row[0]); got != "\x1b[38;5;13m◐ Code Review\x1b[39m" {
+		t.Errorf("status = %q, want the name's color over the category's", got)
+	}
+	if got := colorizePriorities("!! ↑"); got != "\x1b[38;2;255;0;0m!!\x1b[39m \x1b[38;5;2m↑\x1b[39m" {
+		t.Errorf("priorities = %q", got)
+	}
+	if priorityIcon("High") != "↑" {
+		t.Errorf("High icon = %q, want the built-in one kept", priorityIcon("High"))
+	}
+}
@@ -0,0 +1,419 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// Ensure timelineView implements the view interface.
+var _ view = (*timelineView)(nil)
+
+// --- Styles for the timeline ---
+
+var (
+	timelineTodoStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("245"))
+
+	timelineProgressStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("12")) // blue
+
+	timelineDoneStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("10")) // green
+
+	timelineOverdueStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("9")) // red
+
+	timelineTodayStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("11")) // yellow
+
+	timelineGridStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("238"))
+)
+
+// timelineJQL finds a project's epics that are open or were resolved in the
+// last month.
+const timelineJQL = "project = %s AND issuetype = Epic AND (statusCategory != Done OR resolved >= -30d) ORDER BY created ASC"
+
+// startDateNames are the names Jira gives the epic start date field.
+var startDateNames = []string{"start date", "target start"}
+
+// timelineEpic is an epic with the dates its bar spans. Either date may be
+// zero.
+type timelineEpic struct {
+	issue jira.Issue
+	start time.Time
+	due   time.Time
+}
+
+// timelineLoadedMsg delivers a project's epics for the timeline.
+type timelineLoadedMsg struct {
+	project string
+	epics   []timelineEpic
+	err     error
+}
+
+// timelineView shows a project's epics as bars across the weeks from their
+// start to their due date, one column per day.
+type timelineView struct {
+	project string
+	epics   []timelineEpic
+	loaded  bool
+	err     error
+	today   time.Time
+	from    time.Time // the first day shown, always a Monday
+	cursor  int
+	offset  int // first epic row shown
+	width   int
+	height  int
+}
+
+// newTimelineView returns an empty timeline that starts the week before
+// today.
+func newTimelineView(project string, today time.Time, width, height int) *timelineView {
+	today = dateOnly(today)
+	return &timelineView{
+		project: project,
+		today:   today,
+		from:    weekStart(today).AddDate(0, 0, -7),
+		width:   width,
+		height:  height,
+	}
+}
+
+// title returns the view label.
+func (v *timelineView) title() string {
+	return v.project + " timeline"
+}
+
+// setSize updates the view dimensions.
+func (v *timelineView) setSize(width, height int) {
+	v.width = width
+	v.height = height
+	v.clampOffset()
+}
+
+// selected returns the epic under the cursor, or nil if there is none.
+func (v *timelineView) selected() *jira.Issue {
+	if v.cursor < 0 || v.cursor >= len(v.epics) {
+		return nil
+	}
+	return &v.epics[v.cursor].issue
+}
+
+// Update moves the cursor and scrolls the weeks shown.
+func (v *timelineView) Update(msg tea.KeyMsg) {
+	switch msg.String() {
+	case "j", "down":
+		if v.cursor < len(v.epics)-1 {
+			v.cursor++
+		}
+	case "k", "up":
+		if v.cursor > 0 {
+			v.cursor--
+		}
+	case "g", "home":
+		v.cursor = 0
+	case "h", "left":
+		v.from = v.from.AddDate(0, 0, -7)
+	case "l", "right":
+		v.from = v.from.AddDate(0, 0, 7)
+	case "H":
+		v.from = v.from.AddDate(0, 0, -28)
+	case "L":
+		v.from = v.from.AddDate(0, 0, 28)
+	case "t":
+		v.from = weekStart(v.today).AddDate(0, 0, -7)
+	}
+	v.clampOffset()
+}
+
+// rowsShown is how many epics fit below the week header.
+func (v *timelineView) rowsShown() int {
+	// Tab bar (2), status bar (1), and the week header with its rule (2)
+	if n := v.height - 5; n > 1 {
+		return n
+	}
+	return 1
+}
+
+// clampOffset scrolls the rows so the cursor stays visible.
+func (v *timelineView) clampOffset() {
+	n := v.rowsShown()
+	if v.cursor < v.offset {
+		v.offset = v.cursor
+	}
+	if v.cursor >= v.offset+n {
+		v.offset = v.cursor - n + 1
+	}
+}
+
+// labelWidth is the width of the epic column left of the chart.
+func (v *timelineView) labelWidth() int {
+	return min(max(v.width/3, 16), 40)
+}
+
+// days is the number of days, one per column, the chart shows.
+func (v *timelineView) days() int {
+	return max(v.width-v.labelWidth()-1, 7)
+}
+
+// View renders the week header and a row per epic.
+func (v *timelineView) View() string {
+	if v.err != nil {
+		return errorStyle.Render("Loading epics failed: " + v.err.Error())
+	}
+	if !v.loaded {
+		return loadingStyle.Render("Loading " + v.project + " epics...")
+	}
+	if len(v.epics) == 0 {
+		return emptyStyle.Render("No open epics in " + v.project)
+	}
+	lw := v.labelWidth()
+	lines := []string{tableHeaderStyle.Render(padRunes("EPIC", lw) + " " + v.renderWeeks())}
+	end := min(v.offset+v.rowsShown(), len(v.epics))
+	for i := v.offset; i < end; i++ {
+		e := v.epics[i]
+		label := padRunes(truncateRunes(e.issue.Key+" "+e.issue.Fields.Summary, lw), lw)
+		if i == v.cursor {
+			label = tableSelectedStyle.Render(label)
+		}
+		lines = append(lines, label+" "+v.renderBar(e))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderWeeks labels the Monday starting each week shown.
+func (v *timelineView) renderWeeks() string {
+	var b strings.Builder
+	n := v.days()
+	for col := 0; col < n; col += 7 {
+		label := v.from.AddDate(0, 0, col).Format("Jan 2")
+		b.WriteString(padRunes(truncateRunes(label, min(7, n-col)), min(7, n-col)))
+	}
+	return b.String()
+}
+
+// renderBar draws an epic's row of the chart: a bar from its start to its
+// due date, a ◆ on the due date when it has no start, or a ◇ on the start
+// when it has no due date. ◀ and ▶ mark a bar running past the edges.
+func (v *timelineView) renderBar(e timelineEpic) string {
+	n := v.days()
+	if e.start.IsZero() && e.due.IsZero() {
+		return emptyStyle.Render("no dates")
+	}
+	cells := make([]rune, n)
+	for i := range cells {
+		cells[i] = ' '
+		if i%7 == 0 {
+			cells[i] = '┊'
+		}
+	}
+	bar := make([]bool, n)
+	first, last := v.column(e.start), v.column(e.due)
+	var mark rune
+	switch {
+	case e.start.IsZero():
+		first, mark = last, '◆'
+	case e.due.IsZero():
+		last, mark = first, '◇'
+	case last < first:
+		first, last = last, first
+	}
+	for col := max(first, 0); col <= min(last, n-1); col++ {
+		cells[col], bar[col] = '█', true
+		if mark != 0 {
+			cells[col] = mark
+		}
+	}
+	if first < 0 {
+		cells[0], bar[0] = '◀', true
+	}
+	if last >= n {
+		cells[n-1], bar[n-1] = '▶', true
+	}
+	if today := v.column(v.today); today >= 0 && today < n && !bar[today] {
+		cells[today] = '│'
+	}
+
+	// Style runs of bar, today marker, and grid cells
+	style := v.barStyle(e)
+	var b strings.Builder
+	today := v.column(v.today)
+	for i := 0; i < n; {
+		j := i + 1
+		for j < n && bar[j] == bar[i] && (j == today) == (i == today) {
+			j++
+		}
+		run := string(cells[i:j])
+		switch {
+		case bar[i]:
+			b.WriteString(style.Render(run))
+		case i == today:
+			b.WriteString(timelineTodayStyle.Render(run))
+		default:
+			b.WriteString(timelineGridStyle.Render(run))
+		}
+		i = j
+	}
+	return b.String()
+}
+
+// barStyle colors an epic by its status category, or red once it is past
+// due and not done.
+func (v *timelineView) barStyle(e timelineEpic) lipgloss.Style {
+	switch rank := categoryRank(e.issue.Fields.Status); {
+	case rank == doneRank:
+		return timelineDoneStyle
+	case v.overdue(e):
+		return timelineOverdueStyle
+	case rank == 1:
+		return timelineProgressStyle
+	}
+	return timelineTodoStyle
+}
+
+// overdue reports whether the epic's due date has passed.
+func (v *timelineView) overdue(e timelineEpic) bool {
+	return !e.due.IsZero() && e.due.Before(v.today)
+}
+
+// column is the chart column of day t, which may lie outside the chart.
+func (v *timelineView) column(t time.Time) int {
+	return int(t.Sub(v.from).Hours() / 24)
+}
+
+// openTimeline pushes a timeline of the epics of the cursor issue's
+// project, or the default project.
+func (a App) openTimeline() (tea.Model, tea.Cmd) {
+	if a.client == nil {
+		a.flash = "Not connected to Jira"
+		a.flashIsErr = true
+		return a, nil
+	}
+	project := a.cursorProject()
+	if project == "" {
+		a.flash = "Set default_project in config to show the timeline"
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.viewStack = append(a.viewStack, newTimelineView(project, a.now(), a.width, a.height))
+	client := a.client
+	return a, a.startNetwork(func() tea.Msg {
+		epics, err := fetchTimeline(context.Background(), client, project)
+		return timelineLoadedMsg{project: project, epics: epics, err: err}
+	})
+}
+
+// fetchTimeline loads a project's epics with their dates, the start date
+// read from the instance's start date field when it has one.
+func fetchTimeline(ctx context.Context, client *jira.Client, project string) ([]timelineEpic, error) {
+	fields, err := client.GetFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	startField := startDateField(fields)
+	opts := jira.SearchOptions{
+		JQL:    fmt.Sprintf(timelineJQL, project),
+		Fields: []string{"summary", "status", "duedate"},
+	}
+	if startField != "" {
+		opts.Fields = append(opts.Fields, startField)
+	}
+	issues, _, err := searchPages(ctx, client, opts, true)
+	if err != nil {
+		return nil, err
+	}
+	return timelineEpics(issues, startField), nil
+}
+
+// startDateField returns the id of the date field holding an epic's start,
+// or "" if the instance has none.
+func startDateField(fields []jira.Field) string {
+	for _, name := range startDateNames {
+		for _, f := range fields {
+			if strings.EqualFold(f.Name, name) && f.Schema != nil && f.Schema.Type == "date" {
+				return f.ID
+			}
+		}
+	}
+	return ""
+}
+
+// timelineEpics pairs issues with their dates and orders them by when they
+// start, or are due, with undated epics last.
+func timelineEpics(issues []jira.Issue, startField string) []timelineEpic {
+	epics := make([]timelineEpic, len(issues))
+	for i, issue := range issues {
+		epics[i] = timelineEpic{issue: issue, due: parseDay(issue.Fields.DueDate)}
+		if startField != "" {
+			epics[i].start = parseDay(issue.Fields.CustomString(startField))
+		}
+	}
+	sort.SliceStable(epics, func(i, j int) bool {
+		return epicOrigin(epics[i]).Before(epicOrigin(epics[j]))
+	})
+	return epics
+}
+
+// epicOrigin is the day an epic's bar begins; undated epics sort last.
+func epicOrigin(e timelineEpic) time.Time {
+	switch {
+	case !e.start.IsZero():
+		return e.start
+	case !e.due.IsZero():
+		return e.due
+	}
+	return time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// parseDay reads a YYYY-MM-DD date, or the zero time if s is not one.
+func parseDay(s string) time.Time {
+	if len(s) < len(dateLayout) {
+		return time.Time{}
+	}
+	t, err := time.Parse(dateLayout, s[:len(dateLayout)])
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// padRunes pads s with spaces to width characters.
+func padRunes(s string, width int) string {
+	if n := len([]rune(s)); n < width {
+		return s + strings.Repeat(" ", width-n)
+	}
+	return s
+}
+
+// dateOnly returns t's calendar day as midnight UTC, the form parseDay
+// returns.
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// weekStart returns the Monday of t's week.
+func weekStart(t time.Time) time.Time {
+	return t.AddDate(0, 0, -((int(t.Weekday()) + 6) % 7))
+}
+
+// handleTimelineLoaded fills in the timeline the epics were fetched for.
+func (a App) handleTimelineLoaded(msg timelineLoadedMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	for _, v := range a.viewStack {
+		if tv, ok := v.(*timelineView); ok && tv.project == msg.project && !tv.loaded {
+			tv.loaded = true
+			tv.err = msg.err
+			tv.epics = msg.epics
+		}
+	}
+	return a, nil
+}
@@ -0,0 +1,149 @@
+package tui
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// testEpics returns epics starting, due, and undated around 2026-10-16.
+func testEpics() []timelineEpic {
+	return []timelineEpic{
+		{issue: jira.Issue{Key: "PROJ-10", Fields: jira.IssueFields{Summary: "Billing", Status: statusProgress}}, start: parseDay("2026-10-07"), due: parseDay("2026-10-20")},
+		{issue: jira.Issue{Key: "PROJ-11", Fields: jira.IssueFields{Summary: "Search", Status: statusToDo}}, due: parseDay("2026-10-14")},
+		{issue: jira.Issue{Key: "PROJ-12", Fields: jira.IssueFields{Summary: "Someday", Status: statusToDo}}},
+	}
+}
+
+// testAppTimeline is connected, on Friday 2026-10-16, with the timeline of
+// testEpics open.
+func testAppTimeline(t *testing.T) App {
+	t.Helper()
+	app := testAppConnected()
+	app.SetClock(&fakeClock{t: time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)})
+	model, cmd := app.Update(keyMsg("P"))
+	app = model.(App)
+	if cmd == nil || len(app.viewStack) != 1 {
+		t.Fatalf("P should push the timeline and load epics, stack = %d", len(app.viewStack))
+	}
+	model, _ = app.Update(timelineLoadedMsg{project: "PROJ", epics: testEpics()})
+	return model.(App)
+}
+
+func TestStartDateField(t *testing.T) {
+	fields := []jira.Field{
+		{ID: "customfield_1", Name: "Start date", Schema: &jira.FieldSchema{Type: "string"}},
+		{ID: "customfield_2", Name: "Target start", Schema: &jira.FieldSchema{Type: "date"}},
+		{ID: "customfield_3", Name: "Start Date", Schema: &jira.FieldSchema{Type: "date"}},
+	}
+	if got := startDateField(fields); got != "customfield_3" {
+		t.Errorf("startDateField = %q, want the date-typed Start date", got)
+	}
+	if got := startDateField(fields[:2]); got != "customfield_2" {
+		t.Errorf("startDateField = %q, want Target start as a fallback", got)
+	}
+	if got := startDateField(nil); got != "" {
+		t.Errorf("startDateField = %q, want none", got)
+	}
+}
+
+func TestTimelineEpicsOrder(t *testing.T) {
+	var undated, dueOnly, started jira.Issue
+	undated.Key = "PROJ-1"
+	dueOnly.Key = "PROJ-2"
+	dueOnly.Fields.DueDate = "2026-11-01"
+	if err := json.Unmarshal([]byte(`{"key":"PROJ-3","fields":{"customfield_9":"2026-10-20"}}`), &started); err != nil {
+		t.Fatal(err)
+	}
+	epics := timelineEpics([]jira.Issue{undated, dueOnly, started}, "customfield_9")
+	var keys []string
+	for _, e := range epics {
+		keys = append(keys, e.issue.Key)
+	}
+	if got := strings.Join(keys, ","); got != "PROJ-3,PROJ-2,PROJ-1" {
+		t.Errorf("order = %s, want by start or due, undated last", got)
+	}
+	if !epics[0].start.Equal(parseDay("2026-10-20")) || !epics[0].due.IsZero() {
+		t.Errorf("PROJ-3 dates = %v – %v", epics[0].start, epics[0].due)
+	}
+}
+
+func TestTimelineBars(t *testing.T) {
+	app := testAppTimeline(t)
+	tv := app.viewStack[0].(*timelineView)
+	if want := parseDay("2026-10-05"); !tv.from.Equal(want) {
+		t.Fatalf("from = %v, want the Monday of last week", tv.from)
+	}
+
+	out := tv.View()
+	if !strings.Contains(out, "Oct 5") || !strings.Contains(out, "Oct 12") {
+		t.Errorf("header should label each week:\n%s", out)
+	}
+	lines := strings.Split(out, "\n")
+	if len(lines) != 5 {
+		t.Fatalf("want a header, its rule, and 3 epics, got:\n%s", out)
+	}
+	// Oct 7 through Oct 20 is 14 days
+	if got := strings.Count(lines[2], "█"); got != 14 {
+		t.Errorf("PROJ-10 bar = %d days, want 14:\n%s", got, lines[2])
+	}
+	if !strings.Contains(lines[3], "◆") || !strings.Contains(lines[3], "│") {
+		t.Errorf("PROJ-11 should show its due date and today:\n%s", lines[3])
+	}
+	if !strings.Contains(lines[4], "no dates") {
+		t.Errorf("PROJ-12 should be undated:\n%s", lines[4])
+	}
+
+	epics := testEpics()
+	if tv.overdue(epics[0]) || !tv.overdue(epics[1]) {
+		t.Error("only PROJ-11, due Oct 14, should be overdue")
+	}
+}
+
+func TestTimelineScroll(t *testing.T) {
+	app := testAppTimeline(t)
+	tv := app.viewStack[0].(*timelineView)
+
+	model, _ := app.Update(keyMsg("L"))
+	app = model.(App)
+	if want := parseDay("2026-11-02"); !tv.from.Equal(want) {
+		t.Errorf("L: from = %v, want %v", tv.from, want)
+	}
+	// PROJ-10 now ends before the first day shown
+	if line := strings.Split(tv.View(), "\n")[2]; strings.Contains(line, "█") {
+		t.Errorf("PROJ-10 should be off screen:\n%s", line)
+	}
+	model, _ = app.Update(keyMsg("h"))
+	app = model.(App)
+	if want := parseDay("2026-10-26"); !tv.from.Equal(want) {
+		t.Errorf("h: from = %v, want %v", tv.from, want)
+	}
+	model, _ = app.Update(keyMsg("t"))
+	app = model.(App)
+	if want := parseDay("2026-10-05"); !tv.from.Equal(want) {
+		t.Errorf("t: from = %v, want %v", tv.from, want)
+	}
+}
+
+func TestTimelineOpenEpic(t *testing.T) {
+	app := testAppTimeline(t)
+	model, _ := app.Update(keyMsg("j"))
+	app = model.(App)
+	model, cmd := app.Update(keyMsg("enter"))
+	app = model.(App)
+	if cmd == nil || len(app.viewStack) != 2 {
+		t.Fatalf("enter should open the epic, stack = %d", len(app.viewStack))
+	}
+	if dv, ok := app.viewStack[1].(*issueDetailView); !ok || dv.issue.Key != "PROJ-11" {
+		t.Errorf("top = %+v, want PROJ-11's detail", app.viewStack[1])
+	}
+
+	model, _ = app.Update(keyMsg("esc"))
+	app = model.(App)
+	if _, ok := app.viewStack[0].(*timelineView); !ok || len(app.viewStack) != 1 {
+		t.Error("esc should return to the timeline")
+	}
+}
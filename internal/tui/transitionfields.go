@@ -0,0 +1,107 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// pendingTransition is a transition waiting on the form for the fields its
+// screen requires.
+type pendingTransition struct {
+	transition jira.Transition
+	flash      string // shown once it's under way
+}
+
+// checkBulkTransition fails a transition made for several issues at once whose
+// screen requires fields, which are asked for one issue at a time.
+func checkBulkTransition(t jira.Transition) error {
+	needed := t.RequiredFields()
+	if len(needed) == 0 {
+		return nil
+	}
+	names := make([]string, len(needed))
+	for i, f := range needed {
+		names[i] = f.Name
+	}
+	return fmt.Errorf("%s requires %s; transition it on its own", t.Name, strings.Join(names, ", "))
+}
+
+// newTransitionForm returns a form asking for the fields a transition's
+// screen requires: pickers for fields such as the resolution, and a
+// textarea for a comment.
+func newTransitionForm(title string, fields []jira.FieldMeta) *createFormOverlay {
+	f := &createFormOverlay{title: title, verb: "transition", extra: make(map[string]*formField)}
+	for _, m := range fields {
+		if m.Key == jira.CommentField {
+			f.fields = append(f.fields, &formField{key: jira.CommentField, label: "Comment", kind: formLong, required: true, area: formArea()})
+			continue
+		}
+		f.fields = append(f.fields, requiredRow(m))
+	}
+	f.setFocus(0)
+	return f
+}
+
+// transitionByID returns the transition last offered with the given id.
+func (a App) transitionByID(id string) jira.Transition {
+	for _, t := range a.offered {
+		if t.ID == id {
+			return t
+		}
+	}
+	return jira.Transition{ID: id}
+}
+
+// startTransition transitions an issue, first asking for the fields the
+// transition's screen requires, if any.
+func (a App) startTransition(issueKey string, t jira.Transition, flash string) (tea.Model, tea.Cmd) {
+	needed := t.RequiredFields()
+	if len(needed) == 0 {
+		return a.runTransition(issueKey, t.ID, flash, nil, nil)
+	}
+	a.overlay = newTransitionForm(t.Name+" "+issueKey, needed)
+	a.overlayIssue = issueKey
+	a.overlayAction = overlayActionTransitionFields
+	a.transitionForm = &pendingTransition{transition: t, flash: flash}
+	return a, nil
+}
+
+// handleTransitionFields runs the transition with the fields filled in.
+func (a App) handleTransitionFields(issueKey string, res createFormResult) (tea.Model, tea.Cmd) {
+	p := a.transitionForm
+	a.transitionForm = nil
+	if p == nil {
+		return a, nil
+	}
+	return a.runTransition(issueKey, p.transition.ID, p.flash, res.fields, res.comment)
+}
+
+// runTransition sends the transition, with its screen's fields and
+// comment when it has them.
+func (a App) runTransition(issueKey, transitionID, flash string, fields map[string]interface{}, comment interface{}) (tea.Model, tea.Cmd) {
+	a.rememberStatus(issueKey)
+	a.flash = flash
+	a.flashIsErr = false
+	return a, a.trackWrite(writeUpdate, issueKey, a.cmdTransitionIssue(issueKey, transitionID, fields, comment))
+}
+
+// cmdTransitionIssue executes a transition then re-fetches the issue.
+func (a App) cmdTransitionIssue(issueKey, transitionID string, fields map[string]interface{}, comment interface{}) tea.Cmd {
+	client := a.client
+	return func() tea.Msg {
+		ctx := context.Background()
+		if err := client.TransitionIssueWith(ctx, issueKey, transitionID, fields, comment); err != nil {
+			return issueUpdatedMsg{issueKey: issueKey, err: fmt.Errorf("transition: %w", err)}
+		}
+		issue, err := client.GetIssue(ctx, issueKey)
+		if err != nil {
+			return issueUpdatedMsg{issueKey: issueKey, err: fmt.Errorf("refresh: %w", err)}
+		}
+		return issueUpdatedMsg{issueKey: issueKey, issue: issue}
+	}
+}
@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// resolveTransition requires a resolution and a comment.
+func resolveTransition() jira.Transition {
+	return jira.Transition{ID: "31", Name: "Resolve", Fields: map[string]jira.FieldMeta{
+		"comment": {Key: "comment", Name: "Comment", Required: true, Schema: jira.FieldSchema{Type: "comments-page"}},
+		"resolution": {Key: "resolution", Name: "Resolution", Required: true, Schema: jira.FieldSchema{Type: "resolution"},
+			AllowedValues: []jira.AllowedValue{{ID: "1", Name: "Fixed"}, {ID: "2", Name: "Won't Do"}}},
+		"assignee":    {Key: "assignee", Name: "Assignee", Required: true, HasDefaultValue: true},
+		"fixVersions": {Key: "fixVersions", Name: "Fix versions"},
+	}}
+}
+
+// pickTransition loads transitions for PROJ-1 and picks the first.
+func pickTransition(t *testing.T, transitions ...jira.Transition) (App, tea.Cmd) {
+	t.Helper()
+	app := testAppConnected()
+	app.overlayAction = overlayActionTransition
+	model, _ := app.Update(transitionsLoadedMsg{issueKey: "PROJ-1", transitions: transitions})
+	return pressKeys(model.(App), keyMsg("enter"))
+}
+
+func TestTransitionWithoutFieldsRunsAtOnce(t *testing.T) {
+	app, cmd := pickTransition(t, jira.Transition{ID: "21", Name: "In Progress"})
+	if cmd == nil || len(app.pending) != 1 {
+		t.Fatalf("transition should be sent at once, pending = %d", len(app.pending))
+	}
+	if app.overlay != nil {
+		t.Errorf("overlay = %T, want none", app.overlay)
+	}
+}
+
+func TestTransitionAsksForRequiredFields(t *testing.T) {
+	app, cmd := pickTransition(t, resolveTransition())
+	form, ok := app.overlay.(*createFormOverlay)
+	if !ok || app.overlayAction != overlayActionTransitionFields {
+		t.Fatalf("overlay = %T, want the transition's fields form", app.overlay)
+	}
+	if cmd != nil || len(app.pending) != 0 {
+		t.Fatal("nothing should be sent before the fields are filled in")
+	}
+	if len(form.fields) != 2 || form.fields[0].key != "resolution" || form.fields[1].key != "comment" {
+		t.Fatalf("form rows = %v, want resolution and comment", form.fields)
+	}
+
+	// The comment is required too
+	app, _ = pressKeys(app, keyMsg("enter"), keyMsg("down"), keyMsg("enter"), keyMsg("ctrl+s"))
+	if form.errMsg != "Comment is required" || form.focus != 1 {
+		t.Errorf("error = %q, focus = %d; want the comment asked for", form.errMsg, form.focus)
+	}
+
+	app, _ = pressKeys(app, keyMsg("D"), keyMsg("u"), keyMsg("p"))
+	app, cmd = pressKeys(app, keyMsg("ctrl+s"))
+	if cmd == nil || len(app.pending) != 1 || app.transitionForm != nil {
+		t.Fatalf("transition should be sent once the form is complete, pending = %d", len(app.pending))
+	}
+	if app.flash != "Transitioning PROJ-1..." {
+		t.Errorf("flash = %q", app.flash)
+	}
+	if res := form.result.(createFormResult); res.fields["resolution"].(map[string]interface{})["id"] != "2" || res.comment == nil {
+		t.Errorf("result = %+v, want Won't Do with the comment", res)
+	}
+}
+
+func TestTransitionFormCancelled(t *testing.T) {
+	app, _ := pickTransition(t, resolveTransition())
+	app, cmd := pressKeys(app, keyMsg("esc"))
+	if cmd != nil || len(app.pending) != 0 || app.transitionForm != nil || app.overlay != nil {
+		t.Errorf("esc should drop the transition, pending = %d", len(app.pending))
+	}
+}
+
+func TestBulkTransitionRefusesRequiredFields(t *testing.T) {
+	err := checkBulkTransition(resolveTransition())
+	if err == nil || err.Error() != "Resolve requires Resolution, Comment; transition it on its own" {
+		t.Errorf("err = %v", err)
+	}
+	if err := checkBulkTransition(jira.Transition{Name: "Done"}); err != nil {
+		t.Errorf("err = %v, want none for a transition without fields", err)
+	}
+}
@@ -0,0 +1,247 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// treeLayout lists the visible issues as a tree: each issue under its
+// parent when the parent is in the list too, siblings in list order.
+// Children of folded issues are left out.
+func treeLayout(visible []jira.Issue, folded map[string]bool) []listEntry {
+	index := make(map[string]int, len(visible))
+	for i, issue := range visible {
+		index[issue.Key] = i
+	}
+	children := make(map[string][]int)
+	var roots []int
+	for i, issue := range visible {
+		if p := issue.Fields.Parent; p != nil {
+			if _, ok := index[p.Key]; ok && p.Key != issue.Key {
+				children[p.Key] = append(children[p.Key], i)
+				continue
+			}
+		}
+		roots = append(roots, i)
+	}
+
+	entries := make([]listEntry, 0, len(visible))
+	placed := make(map[string]bool, len(visible))
+	var walk func(i, depth int)
+	walk = func(i, depth int) {
+		issue := &visible[i]
+		if placed[issue.Key] {
+			return // a parent cycle; show each issue once
+		}
+		placed[issue.Key] = true
+		kids := children[issue.Key]
+		entries = append(entries, listEntry{issue: issue, depth: depth, kids: len(kids)})
+		if folded[issue.Key] {
+			return
+		}
+		for _, k := range kids {
+			walk(k, depth+1)
+		}
+	}
+	for _, i := range roots {
+		walk(i, 0)
+	}
+	// Issues only reachable through a cycle have no root; list them flat
+	for i := range visible {
+		if !placed[visible[i].Key] && !hiddenByFold(visible, index, i, folded) {
+			walk(i, 0)
+		}
+	}
+	return entries
+}
+
+// hiddenByFold reports whether an ancestor of visible[i] is folded.
+func hiddenByFold(visible []jira.Issue, index map[string]int, i int, folded map[string]bool) bool {
+	seen := make(map[string]bool)
+	for p := visible[i].Fields.Parent; p != nil && !seen[p.Key]; {
+		seen[p.Key] = true
+		if folded[p.Key] {
+			return true
+		}
+		j, ok := index[p.Key]
+		if !ok {
+			return false
+		}
+		p = visible[j].Fields.Parent
+	}
+	return false
+}
+
+// treeColumn is the column the tree is drawn in: the summary, else the
+// first column.
+func (t *tab) treeColumn() int {
+	for i, col := range t.columns {
+		if col == "summary" {
+			return i
+		}
+	}
+	return 0
+}
+
+// treePrefix indents a tree row and marks whether it has children, folded
+// or not, and its status category, e.g. "  ▾ ◐ ".
+func (t *tab) treePrefix(e listEntry) string {
+	marker := "  "
+	if e.kids > 0 {
+		marker = "▾ "
+		if t.folded[e.issue.Key] {
+			marker = "▸ "
+		}
+	}
+	return strings.Repeat("  ", e.depth) + marker + statusCategoryIcon(e.issue.Fields.Status) + " "
+}
+
+// statusCategoryIcon is ○ for to do, ◐ for in progress, and ● for done.
+func statusCategoryIcon(s *jira.Status) string {
+	switch categoryRank(s) {
+	case 1:
+		return "◐"
+	case doneRank:
+		return "●"
+	}
+	return "○"
+}
+
+// setTreeRows shows the visible issues as a tree.
+func (t *tab) setTreeRows(visible []jira.Issue) {
+	t.entries = treeLayout(visible, t.folded)
+	issues := make([]jira.Issue, len(t.entries))
+	for i, e := range t.entries {
+		issues[i] = *e.issue
+	}
+	rows := t.rows(issues)
+	col := t.treeColumn()
+	for i, e := range t.entries {
+		if col < len(rows[i]) {
+			rows[i][col] = t.treePrefix(e) + rows[i][col]
+		}
+	}
+	t.table.SetRows(rows)
+}
+
+// toggleNode folds or unfolds the issue under the cursor. On an issue
+// without children it folds the parent and moves the cursor there.
+func (t *tab) toggleNode() {
+	idx := t.table.Cursor()
+	if idx < 0 || idx >= len(t.entries) {
+		return
+	}
+	e := t.entries[idx]
+	key := e.issue.Key
+	if e.kids == 0 {
+		// The parent is the nearest row above that is one level up
+		key = ""
+		for i := idx - 1; i >= 0; i-- {
+			if t.entries[i].depth < e.depth {
+				key = t.entries[i].issue.Key
+				break
+			}
+		}
+		if key == "" {
+			return
+		}
+	}
+	if t.folded == nil {
+		t.folded = make(map[string]bool)
+	}
+	t.folded[key] = !t.folded[key]
+	t.refreshRows()
+	t.gotoIssue(key)
+}
+
+// toggleAllNodes folds every issue with children, or unfolds them all when
+// any is folded.
+func (t *tab) toggleAllNodes() {
+	var key string
+	if issue := t.selectedIssue(); issue != nil {
+		key = issue.Key
+	}
+	if len(t.folded) > 0 {
+		t.folded = nil
+	} else {
+		t.folded = make(map[string]bool)
+		for _, e := range treeLayout(t.quickFilter.visibleIssues(t.issues), nil) {
+			if e.kids > 0 {
+				t.folded[e.issue.Key] = true
+			}
+		}
+	}
+	t.refreshRows()
+	// The cursor's issue may now be hidden; fall back to its root
+	if !t.gotoIssue(key) {
+		for i := t.table.Cursor(); i >= 0 && i < len(t.entries); i-- {
+			if t.entries[i].depth == 0 {
+				t.table.SetCursor(i)
+				break
+			}
+		}
+	}
+}
+
+// gotoIssue puts the cursor on the row of an issue, reporting whether it
+// is shown.
+func (t *tab) gotoIssue(key string) bool {
+	for i, e := range t.entries {
+		if e.issue != nil && e.issue.Key == key {
+			t.table.SetCursor(i)
+			return true
+		}
+	}
+	return false
+}
+
+// toggleTree switches the active tab between the tree and its usual rows.
+// The first switch reloads a tab fetched without parents.
+func (a App) toggleTree() (tea.Model, tea.Cmd) {
+	if a.activeTab >= len(a.tabs) || !a.tabs[a.activeTab].hasData() {
+		return a, nil
+	}
+	tab := &a.tabs[a.activeTab]
+	tab.tree = !tab.tree
+	a.flashIsErr = false
+	if !tab.tree {
+		a.flash = "Tree view off"
+		tab.refreshRows()
+		return a, nil
+	}
+	a.flash = "Tree view: z folds an issue, Z folds all"
+	tab.refreshRows()
+	if !fetchesParents(tab.config) {
+		// Until the reload the issues show flat
+		tab.config.Tree = true
+		return a, a.startNetwork(a.loadTab(a.activeTab))
+	}
+	return a, nil
+}
+
+// fetchesParents reports whether a tab's search includes each issue's
+// parent, which the tree and epic grouping need.
+func fetchesParents(cfg config.TabConfig) bool {
+	return cfg.Tree || cfg.GroupBy == "epic"
+}
+
+// openTreeTab shows issue and its descendants as a tree in a temporary
+// tab: an epic's stories and their subtasks, or an issue's subtasks.
+func (a App) openTreeTab(issue jira.Issue) (tea.Model, tea.Cmd) {
+	if a.client == nil {
+		a.flash = "Not connected to Jira"
+		a.flashIsErr = true
+		return a, nil
+	}
+	jql := "key = " + issue.Key + " OR parent = " + issue.Key
+	if isEpic(&issue) {
+		jql = "parentEpic = " + issue.Key
+	}
+	a.viewStack = nil
+	cmd := a.openTemporaryTab(config.TabConfig{Label: issue.Key + " tree", JQL: jql, Tree: true})
+	return a, cmd
+}
@@ -0,0 +1,169 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func child(key, summary, parent string, status *jira.Status) jira.Issue {
+	issue := jira.Issue{Key: key, Fields: jira.IssueFields{Summary: summary, Status: status}}
+	if parent != "" {
+		issue.Fields.Parent = &jira.ParentIssue{Key: parent}
+	}
+	return issue
+}
+
+// treeIssues is an epic with two stories, one with a subtask, listed
+// children first, plus an issue whose parent isn't in the list.
+func treeIssues() []jira.Issue {
+	return []jira.Issue{
+		child("PROJ-3", "Subtask", "PROJ-2", statusDone),
+		child("PROJ-2", "Story", "PROJ-1", statusProgress),
+		child("PROJ-4", "Other story", "PROJ-1", statusToDo),
+		child("PROJ-1", "Epic", "", statusProgress),
+		child("PROJ-9", "Orphan", "OTHER-1", statusToDo),
+	}
+}
+
+func treeShape(entries []listEntry) string {
+	var parts []string
+	for _, e := range entries {
+		parts = append(parts, strings.Repeat(".", e.depth)+e.issue.Key)
+	}
+	return strings.Join(parts, ",")
+}
+
+func testAppTree() App {
+	tabs := []config.TabConfig{
+		{Label: "Epic", JQL: "project = PROJ", Columns: []string{"key", "summary"}, Tree: true},
+	}
+	app := NewApp(nil, tabs, "")
+	model, _ := app.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	app = model.(App)
+	model, _ = app.Update(tabDataMsg{tabIndex: 0, issues: treeIssues()})
+	return model.(App)
+}
+
+func TestTreeLayout(t *testing.T) {
+	entries := treeLayout(treeIssues(), nil)
+	if got := treeShape(entries); got != "PROJ-1,.PROJ-2,..PROJ-3,.PROJ-4,PROJ-9" {
+		t.Errorf("tree = %s, want the epic with its stories and subtask nested", got)
+	}
+	if entries[0].kids != 2 || entries[1].kids != 1 || entries[2].kids != 0 {
+		t.Errorf("kids = %d,%d,%d, want 2,1,0", entries[0].kids, entries[1].kids, entries[2].kids)
+	}
+
+	folded := treeLayout(treeIssues(), map[string]bool{"PROJ-2": true})
+	if got := treeShape(folded); got != "PROJ-1,.PROJ-2,.PROJ-4,PROJ-9" {
+		t.Errorf("folded tree = %s, want PROJ-3 hidden", got)
+	}
+}
+
+func TestTreeLayoutCycle(t *testing.T) {
+	issues := []jira.Issue{
+		child("PROJ-1", "A", "PROJ-2", nil),
+		child("PROJ-2", "B", "PROJ-1", nil),
+	}
+	if got := treeShape(treeLayout(issues, nil)); got != "PROJ-1,.PROJ-2" {
+		t.Errorf("cycle = %s, want each issue once", got)
+	}
+}
+
+func TestTreeRowsShowIndentAndStatus(t *testing.T) {
+	app := testAppTree()
+	tab := &app.tabs[0]
+	rows := tab.table.Rows()
+	if len(rows) != 5 {
+		t.Fatalf("rows = %d, want 5", len(rows))
+	}
+	if got := rows[0][1]; got != "▾ ◐ Epic" {
+		t.Errorf("epic row = %q, want an open marker and in-progress icon", got)
+	}
+	if got := rows[2][1]; got != "      ● Subtask" {
+		t.Errorf("subtask row = %q, want two levels of indent and the done icon", got)
+	}
+	if got := rows[0][0]; got != "PROJ-1" {
+		t.Errorf("key column = %q, want it untouched", got)
+	}
+}
+
+func TestTreeFoldKeys(t *testing.T) {
+	app := testAppTree()
+	app.tabs[0].table.SetCursor(2) // PROJ-3, a leaf
+	model, _ := app.Update(keyMsg("z"))
+	app = model.(App)
+	tab := &app.tabs[0]
+	if got := treeShape(tab.entries); got != "PROJ-1,.PROJ-2,.PROJ-4,PROJ-9" {
+		t.Errorf("after z on a leaf = %s, want its parent folded", got)
+	}
+	if issue := tab.selectedIssue(); issue == nil || issue.Key != "PROJ-2" {
+		t.Errorf("cursor = %v, want the folded parent", issue)
+	}
+	if got := tab.table.Rows()[1][1]; !strings.HasPrefix(got, "  ▸ ") {
+		t.Errorf("folded row = %q, want a closed marker", got)
+	}
+
+	model, _ = app.Update(keyMsg("Z"))
+	app = model.(App)
+	if got := treeShape(app.tabs[0].entries); got != "PROJ-1,.PROJ-2,..PROJ-3,.PROJ-4,PROJ-9" {
+		t.Errorf("after Z with a fold = %s, want everything unfolded", got)
+	}
+	model, _ = app.Update(keyMsg("Z"))
+	app = model.(App)
+	if got := treeShape(app.tabs[0].entries); got != "PROJ-1,PROJ-9" {
+		t.Errorf("after Z = %s, want every parent folded", got)
+	}
+}
+
+func TestToggleTree(t *testing.T) {
+	app := testAppGrouped()
+	model, _ := app.Update(keyMsg("T"))
+	app = model.(App)
+	tab := &app.tabs[0]
+	if !tab.tree || !tab.config.Tree {
+		t.Fatalf("tree = %v, config.Tree = %v, want both on", tab.tree, tab.config.Tree)
+	}
+	if len(tab.entries) != 4 || tab.entries[0].issue == nil {
+		t.Errorf("entries = %d, want the issues without group headers", len(tab.entries))
+	}
+	if tab.cursorGroup() != "" {
+		t.Error("cursorGroup should be empty in the tree view")
+	}
+	if fields := strings.Join(tabFields(tab.config), ","); !strings.Contains(fields, "parent") {
+		t.Errorf("fields = %s, want parent requested", fields)
+	}
+
+	model, _ = app.Update(keyMsg("T"))
+	app = model.(App)
+	if app.tabs[0].tree || app.tabs[0].entries[0].issue != nil {
+		t.Error("second T should restore the grouped rows")
+	}
+}
+
+func TestOpenTreeTab(t *testing.T) {
+	app := testAppConnected()
+	epic := jira.Issue{Key: "PROJ-1", Fields: jira.IssueFields{Summary: "Epic", IssueType: &jira.Named{Name: "Epic"}}}
+	app.openDetail(epic)
+	model, _ := app.Update(keyMsg("T"))
+	app = model.(App)
+	if len(app.viewStack) != 0 {
+		t.Error("T should leave the detail view")
+	}
+	tab := app.tabs[app.activeTab]
+	if !tab.temporary || !tab.tree || tab.config.JQL != "parentEpic = PROJ-1" {
+		t.Errorf("tab = %+v, want a temporary tree of the epic", tab.config)
+	}
+
+	story := jira.Issue{Key: "PROJ-2", Fields: jira.IssueFields{IssueType: &jira.Named{Name: "Story"}}}
+	app.openDetail(story)
+	model, _ = app.Update(keyMsg("T"))
+	app = model.(App)
+	if got := app.tabs[app.activeTab].config.JQL; got != "key = PROJ-2 OR parent = PROJ-2" {
+		t.Errorf("JQL = %q, want the story and its subtasks", got)
+	}
+}
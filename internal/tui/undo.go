@@ -0,0 +1,246 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// undoWindow is how long after a change ctrl+z can still revert it.
+const undoWindow = 2 * time.Minute
+
+// undoLimit caps the undo stack; older changes drop off the bottom.
+const undoLimit = 20
+
+// undoKind identifies what an undo entry reverts.
+type undoKind int
+
+const (
+	undoTransition undoKind = iota // move back to the previous status
+	undoAssign                     // give back to the previous assignee
+	undoDelete                     // recreate the deleted issue
+)
+
+// undoEntry is one change ctrl+z can revert, with the state from before it.
+type undoEntry struct {
+	kind     undoKind
+	issueKey string
+	at       time.Time
+	status   string      // undoTransition: status to return to
+	assignee *jira.User  // undoAssign: previous assignee, nil for unassigned
+	issue    *jira.Issue // undoDelete: the issue as fetched before deleting it
+}
+
+// describe returns what undoing e does, e.g. "PROJ-1 back to In Progress".
+func (e undoEntry) describe() string {
+	switch e.kind {
+	case undoTransition:
+		return e.issueKey + " back to " + e.status
+	case undoAssign:
+		if e.assignee == nil {
+			return e.issueKey + " back to unassigned"
+		}
+		return e.issueKey + " back to " + e.assignee.DisplayName
+	default:
+		return "restore " + e.issueKey
+	}
+}
+
+// undoneMsg reports the outcome of an undo.
+type undoneMsg struct {
+	entry  undoEntry
+	newKey string      // undoDelete: key of the recreated issue
+	issue  *jira.Issue // refreshed issue after reverting an update
+	err    error
+}
+
+// pushUndo records a change that ctrl+z can revert.
+func (a *App) pushUndo(e undoEntry) {
+	e.at = a.now()
+	a.undo = append(a.undo, e)
+	if len(a.undo) > undoLimit {
+		a.undo = a.undo[len(a.undo)-undoLimit:]
+	}
+}
+
+// rememberStatus records issueKey's current status before a transition.
+func (a *App) rememberStatus(issueKey string) {
+	if issue := a.findIssue(issueKey); issue != nil && issue.Fields.Status != nil {
+		a.pushUndo(undoEntry{kind: undoTransition, issueKey: issueKey, status: issue.Fields.Status.Name})
+	}
+}
+
+// rememberAssignee records issueKey's current assignee before it changes.
+func (a *App) rememberAssignee(issueKey string) {
+	if issue := a.findIssue(issueKey); issue != nil {
+		a.pushUndo(undoEntry{kind: undoAssign, issueKey: issueKey, assignee: issue.Fields.Assignee})
+	}
+}
+
+// forgetUndo drops the newest entry for issueKey after the change it
+// would revert failed.
+func (a *App) forgetUndo(issueKey string) {
+	for i := len(a.undo) - 1; i >= 0; i-- {
+		if a.undo[i].issueKey == issueKey && a.undo[i].kind != undoDelete {
+			a.undo = append(a.undo[:i], a.undo[i+1:]...)
+			return
+		}
+	}
+}
+
+// undoLast reverts the newest change still inside the undo window.
+// Older entries are dropped, as the issues may have moved on since.
+func (a App) undoLast() (tea.Model, tea.Cmd) {
+	if len(a.undo) == 0 {
+		a.flash = "Nothing to undo"
+		a.flashIsErr = false
+		return a, nil
+	}
+	e := a.undo[len(a.undo)-1]
+	a.undo = a.undo[:len(a.undo)-1]
+	if a.now().Sub(e.at) > undoWindow {
+		a.undo = nil
+		a.flash = "Too late to undo: " + e.describe()
+		a.flashIsErr = true
+		return a, nil
+	}
+	if a.client == nil {
+		a.flash = "Not connected to Jira"
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.flash = "Undoing: " + e.describe() + "..."
+	a.flashIsErr = false
+	if e.kind == undoDelete {
+		return a, a.trackWrite(writeCreate, "", a.startNetwork(a.cmdUndo(e)))
+	}
+	return a, a.trackWrite(writeUpdate, e.issueKey, a.startNetwork(a.cmdUndo(e)))
+}
+
+// cmdUndo reverts e against Jira.
+func (a App) cmdUndo(e undoEntry) tea.Cmd {
+	client := a.client
+	return func() tea.Msg {
+		ctx := context.Background()
+		switch e.kind {
+		case undoTransition:
+			if err := transitionTo(ctx, client, e.issueKey, e.status); err != nil {
+				return undoneMsg{entry: e, err: err}
+			}
+		case undoAssign:
+			var assignee interface{}
+			if e.assignee != nil {
+				assignee = map[string]interface{}{"accountId": e.assignee.AccountID}
+			}
+			if err := client.UpdateIssue(ctx, e.issueKey, map[string]interface{}{"assignee": assignee}); err != nil {
+				return undoneMsg{entry: e, err: fmt.Errorf("assign: %w", err)}
+			}
+		case undoDelete:
+			key, err := restoreIssue(ctx, client, e.issue)
+			return undoneMsg{entry: e, newKey: key, err: err}
+		}
+		issue, err := client.GetIssue(ctx, e.issueKey)
+		if err != nil {
+			return undoneMsg{entry: e, err: fmt.Errorf("refresh: %w", err)}
+		}
+		return undoneMsg{entry: e, issue: issue}
+	}
+}
+
+// transitionTo moves issueKey into the status named status, if a
+// transition leads there.
+func transitionTo(ctx context.Context, client *jira.Client, issueKey, status string) error {
+	transitions, err := client.GetTransitions(ctx, issueKey)
+	if err != nil {
+		return fmt.Errorf("transition: %w", err)
+	}
+	for _, t := range transitions {
+		if t.To != nil && strings.EqualFold(t.To.Name, status) {
+			if err := client.TransitionIssue(ctx, issueKey, t.ID); err != nil {
+				return fmt.Errorf("transition: %w", err)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("no transition from here back to %s", status)
+}
+
+// restoreIssue recreates a deleted issue from its last fetched copy: its
+// summary, type, description, priority, labels, components, assignee,
+// and parent, moved back to its status when a transition leads there.
+// Comments, history, and links are gone with the original.
+func restoreIssue(ctx context.Context, client *jira.Client, issue *jira.Issue) (string, error) {
+	f := issue.Fields
+	extra := map[string]interface{}{}
+	if f.Description != nil {
+		extra["description"] = f.Description
+	}
+	if f.Priority != nil {
+		extra["priority"] = map[string]interface{}{"id": f.Priority.ID}
+	}
+	if len(f.Labels) > 0 {
+		extra["labels"] = f.Labels
+	}
+	if len(f.Components) > 0 {
+		var ids []map[string]string
+		for _, c := range f.Components {
+			ids = append(ids, map[string]string{"id": c.ID})
+		}
+		extra["components"] = ids
+	}
+	var typeName, parent, assignee string
+	if f.IssueType != nil {
+		typeName = f.IssueType.Name
+	}
+	if f.Parent != nil {
+		parent = f.Parent.Key
+	}
+	if f.Assignee != nil {
+		assignee = f.Assignee.AccountID
+	}
+	key, err := createIssue(ctx, client, projectKeyOf(issue.Key), parent, f.Summary, typeName, extra, assignee)
+	if err != nil {
+		return "", fmt.Errorf("restore %s: %w", issue.Key, err)
+	}
+	if f.Status != nil && !strings.EqualFold(f.Status.Name, "To Do") {
+		// Best effort: the copy is back either way
+		if err := transitionTo(ctx, client, key, f.Status.Name); err != nil {
+			client.Logger().Warn("restoring the status", "issue", key, "err", err)
+		}
+	}
+	return key, nil
+}
+
+// handleUndone reports the undo, patching the reverted issue into the
+// views or reloading the tab to show a restored one.
+func (a App) handleUndone(msg undoneMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	if msg.entry.kind == undoDelete {
+		a.finishWrite(writeCreate, "")
+	} else {
+		a.finishWrite(writeUpdate, msg.entry.issueKey)
+	}
+	if msg.err != nil {
+		a.flash = "Undo failed: " + msg.err.Error()
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.flashIsErr = false
+	if msg.entry.kind == undoDelete {
+		a.flash = fmt.Sprintf("Restored %s as %s", msg.entry.issueKey, msg.newKey)
+		if a.connected && a.activeTab < len(a.tabs) {
+			return a, a.startNetwork(a.loadTab(a.activeTab))
+		}
+		return a, nil
+	}
+	a.flash = "Undone: " + msg.entry.describe()
+	if msg.issue != nil {
+		a.applyIssueUpdate(msg.entry.issueKey, msg.issue)
+	}
+	return a, a.loadWorkflows()
+}
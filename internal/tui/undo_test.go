@@ -0,0 +1,141 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+var ctrlZ = tea.KeyMsg{Type: tea.KeyCtrlZ}
+
+func TestUndoTransition(t *testing.T) {
+	app := testAppConnected()
+	clock := &fakeClock{t: time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)}
+	app.SetClock(clock)
+
+	// Move PROJ-1 from Open through the status picker
+	app.overlayIssue = "PROJ-1"
+	app.overlayAction = overlayActionTransition
+	model, _ := app.handleOverlayResult(&selectionItem{ID: "21", Label: "Start"})
+	app = model.(App)
+	if len(app.undo) != 1 || app.undo[0].status != "Open" {
+		t.Fatalf("undo = %+v, want PROJ-1's old status", app.undo)
+	}
+
+	model, cmd := app.Update(ctrlZ)
+	app = model.(App)
+	if cmd == nil || app.flash != "Undoing: PROJ-1 back to Open..." || len(app.undo) != 0 {
+		t.Errorf("flash = %q, undo = %d", app.flash, len(app.undo))
+	}
+
+	model, _ = app.Update(undoneMsg{entry: undoEntry{kind: undoTransition, issueKey: "PROJ-1", status: "Open"}})
+	app = model.(App)
+	if app.flash != "Undone: PROJ-1 back to Open" || len(app.pending) != 1 {
+		t.Errorf("flash = %q, pending = %d", app.flash, len(app.pending))
+	}
+}
+
+func TestUndoWindow(t *testing.T) {
+	app := testAppConnected()
+	clock := &fakeClock{t: time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)}
+	app.SetClock(clock)
+	app.rememberAssignee("PROJ-1")
+	app.rememberStatus("PROJ-2")
+
+	clock.t = clock.t.Add(undoWindow + time.Second)
+	model, cmd := app.Update(ctrlZ)
+	app = model.(App)
+	if cmd != nil || app.flash != "Too late to undo: PROJ-2 back to Done" || len(app.undo) != 0 {
+		t.Errorf("flash = %q, undo = %d", app.flash, len(app.undo))
+	}
+
+	model, _ = app.Update(ctrlZ)
+	if got := model.(App).flash; got != "Nothing to undo" {
+		t.Errorf("flash = %q", got)
+	}
+}
+
+func TestUndoForgetsFailedChange(t *testing.T) {
+	app := testAppConnected()
+	app.rememberStatus("PROJ-1")
+	app.rememberAssignee("PROJ-1")
+	app.inflight = 1
+	model, _ := app.Update(issueUpdatedMsg{issueKey: "PROJ-1", err: context.DeadlineExceeded})
+	app = model.(App)
+	if len(app.undo) != 1 || app.undo[0].kind != undoTransition {
+		t.Errorf("undo = %+v, want only the transition left", app.undo)
+	}
+}
+
+func TestRestoreIssue(t *testing.T) {
+	var created map[string]interface{}
+	var transitioned string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/3/issue":
+			var body struct {
+				Fields map[string]interface{} `json:"fields"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			created = body.Fields
+			w.Write([]byte(`{"key":"PROJ-9"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/PROJ-9/transitions":
+			json.NewEncoder(w).Encode(jira.TransitionsResponse{Transitions: []jira.Transition{
+				{ID: "11", To: &jira.Status{Name: "To Do"}},
+				{ID: "21", To: &jira.Status{Name: "In Progress"}},
+			}})
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/3/issue/PROJ-9/transitions":
+			var body struct {
+				Transition struct {
+					ID string `json:"id"`
+				} `json:"transition"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			transitioned += body.Transition.ID + " "
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	deleted := &jira.Issue{Key: "PROJ-4", Fields: jira.IssueFields{
+		Summary:   "Flaky test",
+		IssueType: &jira.Named{Name: "Bug"},
+		Status:    &jira.Status{Name: "In Progress"},
+		Priority:  &jira.Named{ID: "2"},
+		Assignee:  &jira.User{AccountID: "ana"},
+		Labels:    []string{"ci"},
+	}}
+	client := jira.NewClient(server.URL, "test@test.com", "token")
+	key, err := restoreIssue(context.Background(), client, deleted)
+	if err != nil || key != "PROJ-9" {
+		t.Fatalf("key = %q, err = %v", key, err)
+	}
+	if created["summary"] != "Flaky test" || created["assignee"] == nil || created["labels"] == nil {
+		t.Errorf("created = %v", created)
+	}
+	if transitioned != "11 21 " {
+		t.Errorf("transitions = %q, want To Do then back to In Progress", transitioned)
+	}
+}
+
+func TestUndoDeleteOffered(t *testing.T) {
+	app := testAppConnected()
+	app.inflight = 1
+	model, _ := app.Update(issueDeletedMsg{issueKey: "PROJ-1", issue: &jira.Issue{Key: "PROJ-1"}})
+	app = model.(App)
+	if len(app.undo) != 1 || app.undo[0].kind != undoDelete {
+		t.Fatalf("undo = %+v", app.undo)
+	}
+	if app.flash != "Deleted PROJ-1 — ctrl+z recreates it" {
+		t.Errorf("flash = %q", app.flash)
+	}
+}
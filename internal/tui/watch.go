@@ -0,0 +1,138 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// maxWatcherNames is how many watchers the detail view names before
+// summarizing the rest as "+N more".
+const maxWatcherNames = 5
+
+// watchersLoadedMsg delivers an issue's watchers for the detail view.
+type watchersLoadedMsg struct {
+	issueKey string
+	watchers *jira.Watchers
+	err      error
+}
+
+// watchToggledMsg reports the outcome of watching or unwatching an issue.
+type watchToggledMsg struct {
+	issueKey string
+	watching bool // whether the user now watches the issue
+	err      error
+}
+
+// cmdFetchWatchers fetches an issue's watchers for the detail view.
+func (a App) cmdFetchWatchers(issueKey string) tea.Cmd {
+	if a.client == nil {
+		return nil
+	}
+	client := a.client
+	return func() tea.Msg {
+		watchers, err := client.GetWatchers(context.Background(), issueKey)
+		return watchersLoadedMsg{issueKey: issueKey, watchers: watchers, err: err}
+	}
+}
+
+// toggleWatch starts or stops the signed-in user watching an issue. Whether
+// they watch it is checked first, so the list needn't know.
+func (a *App) toggleWatch(issueKey string) tea.Cmd {
+	if a.user == nil {
+		a.flash = "Not logged in"
+		a.flashIsErr = true
+		return nil
+	}
+	a.flash = "Updating watch on " + issueKey + "..."
+	a.flashIsErr = false
+	client := a.client
+	accountID := a.user.AccountID
+	return a.trackWrite(writeUpdate, issueKey, a.startNetwork(func() tea.Msg {
+		ctx := context.Background()
+		watchers, err := client.GetWatchers(ctx, issueKey)
+		if err != nil {
+			return watchToggledMsg{issueKey: issueKey, err: err}
+		}
+		if watchers.IsWatching {
+			err = client.RemoveWatcher(ctx, issueKey, accountID)
+		} else {
+			err = client.AddWatcher(ctx, issueKey, accountID)
+		}
+		return watchToggledMsg{issueKey: issueKey, watching: !watchers.IsWatching, err: err}
+	}))
+}
+
+// handleWatchToggled reports the change and updates the watchers an open
+// detail view shows.
+func (a App) handleWatchToggled(msg watchToggledMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	a.finishWrite(writeUpdate, msg.issueKey)
+	if msg.err != nil {
+		a.flash = fmt.Sprintf("Watching %s failed: %v", msg.issueKey, msg.err)
+		a.flashIsErr = true
+		return a, nil
+	}
+	a.flashIsErr = false
+	if msg.watching {
+		a.flash = "Watching " + msg.issueKey
+	} else {
+		a.flash = "Stopped watching " + msg.issueKey
+	}
+	if dv := a.topDetail(msg.issueKey); dv != nil && dv.watchers != nil && a.user != nil {
+		dv.watchers = withWatcher(dv.watchers, *a.user, msg.watching)
+		return a, a.markDetailStale(dv)
+	}
+	return a, nil
+}
+
+// withWatcher returns a copy of w with user added to or removed from the
+// watchers.
+func withWatcher(w *jira.Watchers, user jira.User, watching bool) *jira.Watchers {
+	out := &jira.Watchers{IsWatching: watching, WatchCount: w.WatchCount}
+	for _, u := range w.Watchers {
+		if u.AccountID != user.AccountID {
+			out.Watchers = append(out.Watchers, u)
+		}
+	}
+	if watching {
+		out.Watchers = append(out.Watchers, user)
+		if !w.IsWatching {
+			out.WatchCount++
+		}
+	} else if w.IsWatching {
+		out.WatchCount--
+	}
+	return out
+}
+
+// watchersValue renders the watchers field, e.g. "3 · Ann, Bob, you".
+// The signed-in user, me, is named "you".
+func watchersValue(w *jira.Watchers, me string) string {
+	if w.WatchCount == 0 {
+		return "None"
+	}
+	var names []string
+	for _, u := range w.Watchers {
+		if len(names) == maxWatcherNames {
+			break
+		}
+		if u.AccountID == me && me != "" {
+			names = append(names, "you")
+		} else if u.DisplayName != "" {
+			names = append(names, u.DisplayName)
+		}
+	}
+	// The count includes watchers the user isn't allowed to see
+	if more := w.WatchCount - len(names); more > 0 && len(names) > 0 {
+		names = append(names, fmt.Sprintf("+%d more", more))
+	}
+	if len(names) == 0 {
+		return fmt.Sprint(w.WatchCount)
+	}
+	return fmt.Sprintf("%d · %s", w.WatchCount, strings.Join(names, ", "))
+}
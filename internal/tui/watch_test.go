@@ -0,0 +1,101 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func testWatchers() *jira.Watchers {
+	return &jira.Watchers{WatchCount: 2, Watchers: []jira.User{
+		{AccountID: "u1", DisplayName: "Ann"},
+		{AccountID: "u2", DisplayName: "Bob"},
+	}}
+}
+
+func TestWatchersValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		watchers *jira.Watchers
+		want     string
+	}{
+		{"none", &jira.Watchers{}, "None"},
+		{"named", testWatchers(), "2 · Ann, you"},
+		{"hidden watchers", &jira.Watchers{WatchCount: 4, Watchers: []jira.User{{AccountID: "u1", DisplayName: "Ann"}}}, "4 · Ann, +3 more"},
+		{"count only", &jira.Watchers{WatchCount: 3}, "3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := watchersValue(tt.watchers, "u2"); got != tt.want {
+				t.Errorf("watchersValue = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	many := &jira.Watchers{WatchCount: 8}
+	for _, name := range []string{"A", "B", "C", "D", "E", "F", "G", "H"} {
+		many.Watchers = append(many.Watchers, jira.User{AccountID: name, DisplayName: name})
+	}
+	if got := watchersValue(many, ""); got != "8 · A, B, C, D, E, +3 more" {
+		t.Errorf("watchersValue = %q, want the first five named", got)
+	}
+}
+
+func TestWithWatcher(t *testing.T) {
+	me := jira.User{AccountID: "u3", DisplayName: "Cy"}
+	added := withWatcher(testWatchers(), me, true)
+	if added.WatchCount != 3 || !added.IsWatching || len(added.Watchers) != 3 {
+		t.Errorf("after watching = %+v, want Cy added", added)
+	}
+	removed := withWatcher(added, me, false)
+	if removed.WatchCount != 2 || removed.IsWatching || len(removed.Watchers) != 2 {
+		t.Errorf("after unwatching = %+v, want Cy removed", removed)
+	}
+	if again := withWatcher(added, me, true); again.WatchCount != 3 || len(again.Watchers) != 3 {
+		t.Errorf("watching twice = %+v, want no double count", again)
+	}
+}
+
+func TestWatchersInDetail(t *testing.T) {
+	app := testAppConnected()
+	app.user = &jira.User{AccountID: "u2", DisplayName: "Bob"}
+	issue := app.tabs[0].issues[0]
+	app.openDetail(issue)
+	dv := app.topDetail(issue.Key)
+	dv.people.me = "u2"
+
+	watchers := testWatchers()
+	watchers.IsWatching = true
+	model, _ := app.Update(watchersLoadedMsg{issueKey: issue.Key, watchers: watchers})
+	app = model.(App)
+	if !strings.Contains(dv.renderContent(), "2 · Ann, you") {
+		t.Error("the Fields section should list the watchers")
+	}
+
+	app.inflight++
+	model, _ = app.Update(watchToggledMsg{issueKey: issue.Key, watching: false})
+	app = model.(App)
+	if app.flash != "Stopped watching "+issue.Key {
+		t.Errorf("flash = %q", app.flash)
+	}
+	if content := dv.renderContent(); !strings.Contains(content, "1 · Ann") || strings.Contains(content, "Ann, you") {
+		t.Error("unwatching should drop the user from the watchers shown")
+	}
+}
+
+func TestWatchKeyNeedsUser(t *testing.T) {
+	app := testAppConnected()
+	model, cmd := app.Update(keyMsg("W"))
+	app = model.(App)
+	if cmd != nil || app.flash != "Not logged in" || !app.flashIsErr {
+		t.Errorf("flash = %q, want a not logged in error", app.flash)
+	}
+
+	app.user = &jira.User{AccountID: "u2"}
+	model, cmd = app.Update(keyMsg("W"))
+	app = model.(App)
+	if cmd == nil || !strings.HasPrefix(app.flash, "Updating watch on") {
+		t.Errorf("flash = %q, want the watch toggled", app.flash)
+	}
+}
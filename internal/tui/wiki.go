@@ -0,0 +1,195 @@
+package tui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Server and Data Center, and issues migrated from them, can hold a
+// description or comment as a Jira wiki markup string instead of ADF.
+
+// wikiSignals match markup that plain text rarely contains, so a
+// description with a stray asterisk is left as it is.
+var wikiSignals = regexp.MustCompile(`(?m)^h[1-6]\.\s|^bq\.\s|^\|\||\{(?:code|noformat|quote|panel|color)[:}]|\{\{[^}\n]+\}\}|\[[^\]|\n]+\|[^\]\n]+\]|\[~[^\]\n]+\]|(?:^|\s)\*[^*\s][^*\n]*\*(?:\s|$|[.,;:!?])`)
+
+var (
+	wikiHeading    = regexp.MustCompile(`^h[1-6]\.\s+(.*)$`)
+	wikiQuoteLine  = regexp.MustCompile(`^bq\.\s+(.*)$`)
+	wikiListItem   = regexp.MustCompile(`^([*#-]+)\s+(.*)$`)
+	wikiRule       = regexp.MustCompile(`^-{4,}$`)
+	wikiBlockStart = regexp.MustCompile(`^\{(code|noformat)(?::[^}]*)?\}(.*)$`)
+	wikiPanel      = regexp.MustCompile(`^\{panel(?::[^}]*)?\}$`)
+
+	wikiMonospace = regexp.MustCompile(`\{\{(.+?)\}\}`)
+	wikiColor     = regexp.MustCompile(`\{color(?::[^}]*)?\}`)
+	wikiMention   = regexp.MustCompile(`\[~(?:accountid:)?([^\]]+)\]`)
+	wikiLink      = regexp.MustCompile(`\[(?:([^\]|]+)\|)?([^\]|]+)\]`)
+	wikiBold      = regexp.MustCompile(`(^|[\s(])\*([^*\s](?:[^*]*[^*\s])?)\*`)
+	wikiItalic    = regexp.MustCompile(`(^|[\s(])_([^_\s](?:[^_]*[^_\s])?)_`)
+)
+
+var (
+	wikiBoldStyle   = lipgloss.NewStyle().Bold(true)
+	wikiItalicStyle = lipgloss.NewStyle().Italic(true)
+)
+
+// isWikiMarkup reports whether a string body looks like wiki markup.
+func isWikiMarkup(text string) bool {
+	return wikiSignals.MatchString(text)
+}
+
+// renderWiki renders the common wiki markup — headings, lists, quotes,
+// {code} and {noformat} blocks, tables, links, mentions, and bold and
+// italic text — the way renderADF lays out the same structure.
+func renderWiki(text string, opts adfOptions) string {
+	w := adfWriter{opts: opts}
+	var block string // "code" or "noformat" while inside one
+	var quote string // "│ " inside {quote}
+	var numbers []int
+	blank := false
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if block != "" {
+			end := "{" + block + "}"
+			if before, _, ok := strings.Cut(line, end); ok {
+				if before != "" {
+					w.b.WriteString(quote + before + "\n")
+				}
+				block = ""
+				continue
+			}
+			w.b.WriteString(quote + line + "\n")
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		m := wikiListItem.FindStringSubmatch(trimmed)
+		if m == nil || wikiRule.MatchString(trimmed) {
+			numbers = numbers[:0]
+		}
+		if trimmed == "" {
+			if !blank && w.b.Len() > 0 {
+				w.b.WriteString("\n")
+			}
+			blank = true
+			continue
+		}
+		blank = false
+
+		switch {
+		case trimmed == "{quote}":
+			if quote == "" {
+				quote = "│ "
+			} else {
+				quote = ""
+			}
+		case wikiPanel.MatchString(trimmed):
+			// Panels are frames; their text renders as usual
+		case wikiBlockStart.MatchString(trimmed):
+			start := wikiBlockStart.FindStringSubmatch(trimmed)
+			rest := start[2]
+			if before, _, ok := strings.Cut(rest, "{"+start[1]+"}"); ok {
+				if before != "" {
+					w.b.WriteString(quote + before + "\n")
+				}
+				continue
+			}
+			block = start[1]
+			if rest != "" {
+				w.b.WriteString(quote + rest + "\n")
+			}
+		case wikiRule.MatchString(trimmed):
+			w.b.WriteString(quote + "───\n")
+		case wikiHeading.MatchString(trimmed):
+			heading := wikiHeading.FindStringSubmatch(trimmed)[1]
+			w.text(w.wikiInline(heading, true), quote, quote)
+		case wikiQuoteLine.MatchString(trimmed):
+			text := wikiQuoteLine.FindStringSubmatch(trimmed)[1]
+			w.text(w.wikiInline(text, false), quote+"│ ", quote+"│ ")
+		case m != nil:
+			depth := len(m[1]) - 1
+			for len(numbers) <= depth {
+				numbers = append(numbers, 0)
+			}
+			numbers = numbers[:depth+1]
+			numbers[depth]++
+			marker := bulletMarkers[depth%len(bulletMarkers)]
+			if strings.HasSuffix(m[1], "#") {
+				marker = fmt.Sprintf("%d. ", numbers[depth])
+			}
+			indent := quote + strings.Repeat("  ", depth)
+			w.text(w.wikiInline(m[2], false), indent+marker, indent+strings.Repeat(" ", lipgloss.Width(marker)))
+		case strings.HasPrefix(trimmed, "|"):
+			w.text(w.wikiTableRow(trimmed), quote, quote)
+		default:
+			w.text(w.wikiInline(trimmed, false), quote, quote)
+		}
+	}
+	return strings.TrimSpace(w.b.String())
+}
+
+// wikiTableRow renders a table row as its cells separated by bars; header
+// cells (||) are bold when styled.
+func (w *adfWriter) wikiTableRow(row string) string {
+	header := strings.HasPrefix(row, "||")
+	var cells []string
+	for _, cell := range strings.Split(strings.Trim(row, "|"), "|") {
+		if cell = strings.TrimSpace(cell); cell != "" {
+			cells = append(cells, w.wikiInline(cell, header))
+		}
+	}
+	return strings.Join(cells, " │ ")
+}
+
+// wikiInline renders a line's inline markup. Styling is applied word by
+// word, as for mentions, so wrapping can't split it.
+func (w *adfWriter) wikiInline(text string, bold bool) string {
+	text = wikiColor.ReplaceAllString(text, "")
+	text = wikiMonospace.ReplaceAllString(text, "$1")
+	text = wikiMention.ReplaceAllStringFunc(text, func(m string) string {
+		id := wikiMention.FindStringSubmatch(m)[1]
+		if name := w.opts.people.names[id]; name != "" {
+			return "@" + name
+		}
+		return "@" + id
+	})
+	text = wikiLink.ReplaceAllStringFunc(text, func(m string) string {
+		parts := wikiLink.FindStringSubmatch(m)
+		if parts[1] == "" || parts[1] == parts[2] {
+			return parts[2]
+		}
+		return parts[1] + " (" + parts[2] + ")"
+	})
+	text = w.wikiEmphasis(text, wikiBold, wikiBoldStyle)
+	text = w.wikiEmphasis(text, wikiItalic, wikiItalicStyle)
+	text = w.linkKeys(text)
+	if bold && w.opts.styled {
+		text = styleWords(text, wikiBoldStyle)
+	}
+	return text
+}
+
+// wikiEmphasis strips the delimiters of the spans re matches, styling
+// their text when styled.
+func (w *adfWriter) wikiEmphasis(text string, re *regexp.Regexp, style lipgloss.Style) string {
+	return re.ReplaceAllStringFunc(text, func(m string) string {
+		parts := re.FindStringSubmatch(m)
+		if w.opts.styled {
+			return parts[1] + styleWords(parts[2], style)
+		}
+		return parts[1] + parts[2]
+	})
+}
+
+// styleWords renders each space-separated word of text with style.
+func styleWords(text string, style lipgloss.Style) string {
+	words := strings.Split(text, " ")
+	for i, word := range words {
+		if word != "" {
+			words[i] = style.Render(word)
+		}
+	}
+	return strings.Join(words, " ")
+}
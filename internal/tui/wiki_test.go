@@ -0,0 +1,58 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderWiki(t *testing.T) {
+	text := strings.Join([]string{
+		"h2. Steps to reproduce",
+		"",
+		"The *login* page times out, see [the runbook|https://wiki.example.com/run] and PROJ-7.",
+		"# Open {{/login}}",
+		"# Wait",
+		"** with _SSO_",
+		"{code:java}",
+		"String s = \"*not bold*\";",
+		"{code}",
+		"bq. Reported by [~accountid:abc123]",
+		"||Env||Result||",
+		"|staging|fails|",
+	}, "\r\n")
+	want := strings.Join([]string{
+		"Steps to reproduce",
+		"",
+		"The login page times out, see the runbook (https://wiki.example.com/run) and PROJ-7.",
+		"1. Open /login",
+		"2. Wait",
+		"  ◦ with SSO",
+		`String s = "*not bold*";`,
+		"│ Reported by @Ana Lee",
+		"Env │ Result",
+		"staging │ fails",
+	}, "\n")
+	opts := adfOptions{people: mentionContext{names: map[string]string{"abc123": "Ana Lee"}}}
+	if got := renderADF(text, opts); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWikiDetection(t *testing.T) {
+	for text, want := range map[string]bool{
+		"h1. Overview":                      true,
+		"Run {code}make{code} first":        true,
+		"This is *important* to fix":        true,
+		"Costs 2 * 3 * 4 dollars":           false,
+		"Use snake_case_names in the query": false,
+		"Plain text with a - dash":          false,
+	} {
+		if got := isWikiMarkup(text); got != want {
+			t.Errorf("isWikiMarkup(%q) = %v, want %v", text, got, want)
+		}
+	}
+	// Plain strings still come back untouched
+	if got := extractADFText("Costs 2 * 3 * 4 dollars"); got != "Costs 2 * 3 * 4 dollars" {
+		t.Errorf("got %q", got)
+	}
+}
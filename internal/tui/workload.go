@@ -0,0 +1,111 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+// workloadTTL is how long a project's open-issue counts are reused before
+// the assignee picker counts again.
+const workloadTTL = 5 * time.Minute
+
+// workloadJQL selects a project's open, assigned issues.
+const workloadJQL = `project = "%s" AND statusCategory != Done AND assignee IS NOT EMPTY`
+
+// projectWorkload is how many open issues each account has in a project.
+type projectWorkload struct {
+	counts map[string]int // account ID → open issues
+	at     time.Time
+}
+
+// workloadMsg delivers a project's open-issue counts.
+type workloadMsg struct {
+	project string
+	counts  map[string]int
+	err     error
+}
+
+// assigneeWorkload shows open-issue counts in the assignee picker just
+// opened, counting the project of the issue being assigned when its
+// counts are missing or stale.
+func (a *App) assigneeWorkload() tea.Cmd {
+	if a.overlayIssue == "" || a.client == nil {
+		return nil
+	}
+	project := projectKeyOf(a.overlayIssue)
+	if w, ok := a.workload[project]; ok && a.now().Sub(w.at) < workloadTTL {
+		a.showWorkload(project)
+		return nil
+	}
+	client := a.client
+	return a.startNetwork(func() tea.Msg {
+		counts, err := countWorkload(context.Background(), client, project)
+		return workloadMsg{project: project, counts: counts, err: err}
+	})
+}
+
+// countWorkload counts a project's open issues by assignee with one
+// search, paging through every result.
+func countWorkload(ctx context.Context, client *jira.Client, project string) (map[string]int, error) {
+	issues, _, err := searchPages(ctx, client, jira.SearchOptions{
+		JQL:        fmt.Sprintf(workloadJQL, project),
+		Fields:     []string{"assignee"},
+		MaxResults: remainderPageSize,
+	}, true)
+	if err != nil {
+		return nil, fmt.Errorf("count open issues in %s: %w", project, err)
+	}
+	counts := make(map[string]int)
+	for _, issue := range issues {
+		if issue.Fields.Assignee != nil {
+			counts[issue.Fields.Assignee.AccountID]++
+		}
+	}
+	return counts, nil
+}
+
+// handleWorkload caches the counts and adds them to the assignee picker
+// if it's still open. Without counts the picker just lacks the hints.
+func (a App) handleWorkload(msg workloadMsg) (tea.Model, tea.Cmd) {
+	a.inflight--
+	if msg.err != nil {
+		return a, nil
+	}
+	if a.workload == nil {
+		a.workload = make(map[string]projectWorkload)
+	}
+	a.workload[msg.project] = projectWorkload{counts: msg.counts, at: a.now()}
+	a.showWorkload(msg.project)
+	return a, nil
+}
+
+// showWorkload adds project's counts to the open assignee picker, e.g.
+// "3 open · ana@example.com".
+func (a *App) showWorkload(project string) {
+	sel, ok := a.overlay.(*selectionOverlay)
+	if !ok || a.overlayAction != overlayActionAssignee || projectKeyOf(a.overlayIssue) != project {
+		return
+	}
+	counts := a.workload[project].counts
+	items := a.assigneeItems(a.cachedUsers)
+	for i := range items {
+		hint := fmt.Sprintf("%d open", counts[items[i].ID])
+		if items[i].Desc != "" {
+			hint += " · " + items[i].Desc
+		}
+		items[i].Desc = hint
+	}
+	sel.items = items
+	sel.applyFilter()
+}
+
+// forgetWorkload drops the counts of issueKey's project after an
+// assignment changes them.
+func (a *App) forgetWorkload(issueKey string) {
+	delete(a.workload, projectKeyOf(issueKey))
+}
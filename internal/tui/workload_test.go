@@ -0,0 +1,82 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jbeckham/jira-tui/internal/config"
+	"github.com/jbeckham/jira-tui/internal/jira"
+)
+
+func TestCountWorkload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			JQL string `json:"jql"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if !strings.HasPrefix(body.JQL, `project = "PROJ" AND statusCategory != Done`) {
+			t.Errorf("jql = %s", body.JQL)
+		}
+		ana := &jira.User{AccountID: "ana"}
+		json.NewEncoder(w).Encode(jira.SearchResult{IsLast: true, Issues: []jira.Issue{
+			{Key: "PROJ-1", Fields: jira.IssueFields{Assignee: ana}},
+			{Key: "PROJ-2", Fields: jira.IssueFields{Assignee: &jira.User{AccountID: "bo"}}},
+			{Key: "PROJ-3", Fields: jira.IssueFields{Assignee: ana}},
+		}})
+	}))
+	defer server.Close()
+
+	counts, err := countWorkload(context.Background(), jira.NewClient(server.URL, "test@test.com", "token"), "PROJ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts["ana"] != 2 || counts["bo"] != 1 {
+		t.Errorf("counts = %v", counts)
+	}
+}
+
+func TestAssigneePickerShowsWorkload(t *testing.T) {
+	app := testAppConnected()
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	clock := &fakeClock{t: now}
+	app.SetClock(clock)
+	app.cachedUsers = []config.CachedUser{
+		{AccountID: "ana", DisplayName: "Ana", Email: "ana@example.com"},
+		{AccountID: "bo", DisplayName: "Bo"},
+	}
+
+	model, cmd := app.Update(keyMsg("a"))
+	app = model.(App)
+	if cmd == nil {
+		t.Fatal("opening the picker should count the project's open issues")
+	}
+	model, _ = app.Update(workloadMsg{project: "PROJ", counts: map[string]int{"ana": 4}})
+	app = model.(App)
+	sel := app.overlay.(*selectionOverlay)
+	if sel.items[0].Desc != "4 open · ana@example.com" || sel.items[1].Desc != "0 open" {
+		t.Errorf("descs = %q, %q", sel.items[0].Desc, sel.items[1].Desc)
+	}
+
+	// Reopening within the TTL reuses the counts
+	model, _ = app.Update(keyMsg("esc"))
+	app = model.(App)
+	model, cmd = app.Update(keyMsg("a"))
+	app = model.(App)
+	if cmd != nil || app.overlay.(*selectionOverlay).items[0].Desc != "4 open · ana@example.com" {
+		t.Error("fresh counts should be shown without counting again")
+	}
+
+	// Assigning changes the counts, so the next picker counts again
+	model, _ = app.Update(keyMsg("enter"))
+	app = model.(App)
+	model, cmd = app.Update(keyMsg("a"))
+	app = model.(App)
+	if cmd == nil {
+		t.Error("counts should be fetched again after an assignment")
+	}
+}